@@ -7,6 +7,7 @@ import (
 
 	"github.com/onflow/flow-go/engine/access/subscription"
 	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/events"
 	"github.com/onflow/flow-go/model/flow"
 )
 
@@ -35,6 +36,9 @@ type API interface {
 	GetTransactionResultsByBlockID(ctx context.Context, blockID flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) ([]*accessmodel.TransactionResult, error)
 	GetSystemTransaction(ctx context.Context, blockID flow.Identifier) (*flow.TransactionBody, error)
 	GetSystemTransactionResult(ctx context.Context, blockID flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) (*accessmodel.TransactionResult, error)
+	// GetPendingTransactionDetails queries the collection nodes of the cluster responsible for the transaction for its
+	// status, and returns their aggregated answers. It is meant for transactions which are not included in a block yet.
+	GetPendingTransactionDetails(ctx context.Context, id flow.Identifier) (*accessmodel.PendingTransactionDetails, error)
 
 	GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error)
 	GetAccountAtLatestBlock(ctx context.Context, address flow.Address) (*flow.Account, error)
@@ -48,12 +52,56 @@ type API interface {
 	GetAccountKeysAtLatestBlock(ctx context.Context, address flow.Address) ([]flow.AccountPublicKey, error)
 	GetAccountKeysAtBlockHeight(ctx context.Context, address flow.Address, height uint64) ([]flow.AccountPublicKey, error)
 
+	// GetTransactionsByAddress returns a page of the transactions which touched the given account as payer,
+	// proposer, authorizer or event emitter, ordered by descending block height and transaction index.
+	// If cursor is nil, the most recent transactions are returned, otherwise the page starts with the
+	// entry referenced by the cursor.
+	GetTransactionsByAddress(ctx context.Context, address flow.Address, limit uint32, cursor *accessmodel.AccountTransactionCursor) (*accessmodel.AccountTransactionsPage, error)
+	// GetAccountStateDiff returns the changes to the state of the given account between the start and end block
+	// heights, grouped into balance, keys, contracts and storage used changes, along with the account status core
+	// events emitted for the account within the range.
+	GetAccountStateDiff(ctx context.Context, address flow.Address, startHeight uint64, endHeight uint64) (*accessmodel.AccountStateDiff, error)
+
+	// GetContractHistory returns all versions of the given contract which were deployed, updated or removed within
+	// the indexed blocks, ordered from oldest to newest.
+	GetContractHistory(ctx context.Context, address flow.Address, name string) ([]accessmodel.ContractVersion, error)
+	// GetContractAtBlockHeight returns the version of the given contract which was live at the given block height.
+	GetContractAtBlockHeight(ctx context.Context, address flow.Address, name string, height uint64) (*accessmodel.ContractVersion, error)
+	// GetRegisterProofsAtBlockID returns a batch proof of the given registers against the final state commitment of
+	// the sealed execution result of the given block.
+	GetRegisterProofsAtBlockID(ctx context.Context, blockID flow.Identifier, registerIDs []flow.RegisterID) (*accessmodel.RegisterProofs, error)
+	// GetRegisterProofsAtStateCommitment returns a batch proof of the given registers against the given state commitment.
+	GetRegisterProofsAtStateCommitment(ctx context.Context, commit flow.StateCommitment, registerIDs []flow.RegisterID) (*accessmodel.RegisterProofs, error)
+
 	ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments [][]byte) ([]byte, error)
 	ExecuteScriptAtBlockHeight(ctx context.Context, blockHeight uint64, script []byte, arguments [][]byte) ([]byte, error)
 	ExecuteScriptAtBlockID(ctx context.Context, blockID flow.Identifier, script []byte, arguments [][]byte) ([]byte, error)
 
+	// ExecuteScriptAtLatestBlockWithReport executes the script at the latest sealed block, and returns the encoded value
+	// along with the computation used, memory estimate, register reads, bytes read and contracts loaded by the script.
+	// Only the computation used is reported if the script was executed on an execution node.
+	ExecuteScriptAtLatestBlockWithReport(ctx context.Context, script []byte, arguments [][]byte) (*accessmodel.ScriptExecutionResult, error)
+	// ExecuteScriptAtBlockHeightWithReport executes the script at the given block height, and returns the encoded value
+	// along with the resources used by the script.
+	ExecuteScriptAtBlockHeightWithReport(ctx context.Context, blockHeight uint64, script []byte, arguments [][]byte) (*accessmodel.ScriptExecutionResult, error)
+	// ExecuteScriptAtBlockIDWithReport executes the script at the given block ID, and returns the encoded value
+	// along with the resources used by the script.
+	ExecuteScriptAtBlockIDWithReport(ctx context.Context, blockID flow.Identifier, script []byte, arguments [][]byte) (*accessmodel.ScriptExecutionResult, error)
+
+	// DryRunTransactionAtLatestBlock executes the transaction against the latest sealed block without submitting it,
+	// and returns the computation used, the fees that would be charged, the emitted events and the error if any.
+	DryRunTransactionAtLatestBlock(ctx context.Context, tx *flow.TransactionBody, options accessmodel.TransactionDryRunOptions, requiredEventEncodingVersion entities.EventEncodingVersion) (*accessmodel.TransactionDryRunResult, error)
+	// DryRunTransactionAtBlockHeight executes the transaction against the block at the given height without submitting it.
+	DryRunTransactionAtBlockHeight(ctx context.Context, blockHeight uint64, tx *flow.TransactionBody, options accessmodel.TransactionDryRunOptions, requiredEventEncodingVersion entities.EventEncodingVersion) (*accessmodel.TransactionDryRunResult, error)
+	// DryRunTransactionAtBlockID executes the transaction against the block with the given ID without submitting it.
+	DryRunTransactionAtBlockID(ctx context.Context, blockID flow.Identifier, tx *flow.TransactionBody, options accessmodel.TransactionDryRunOptions, requiredEventEncodingVersion entities.EventEncodingVersion) (*accessmodel.TransactionDryRunResult, error)
+
 	GetEventsForHeightRange(ctx context.Context, eventType string, startHeight, endHeight uint64, requiredEventEncodingVersion entities.EventEncodingVersion) ([]flow.BlockEvents, error)
 	GetEventsForBlockIDs(ctx context.Context, eventType string, blockIDs []flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) ([]flow.BlockEvents, error)
+	// GetEventsForHeightRangeWithFieldFilters returns a page of the events with the given type emitted in the sealed
+	// blocks of the height range (inclusive), whose decoded payload matches all the field filters. If cursor is nil,
+	// the page starts with the first matching event of the range, otherwise it starts with the event referenced by the cursor.
+	GetEventsForHeightRangeWithFieldFilters(ctx context.Context, eventType string, startHeight, endHeight uint64, fieldFilters events.FieldFilters, limit uint32, cursor *accessmodel.EventCursor, requiredEventEncodingVersion entities.EventEncodingVersion) (*accessmodel.EventsPage, error)
 
 	GetLatestProtocolStateSnapshot(ctx context.Context) ([]byte, error)
 	GetProtocolStateSnapshotByBlockID(ctx context.Context, blockID flow.Identifier) ([]byte, error)
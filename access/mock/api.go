@@ -13,6 +13,7 @@ import (
 	modelaccess "github.com/onflow/flow-go/model/access"
 
 	subscription "github.com/onflow/flow-go/engine/access/subscription"
+	events "github.com/onflow/flow-go/model/events"
 )
 
 // API is an autogenerated mock type for the API type
@@ -20,6 +21,96 @@ type API struct {
 	mock.Mock
 }
 
+// DryRunTransactionAtBlockHeight provides a mock function with given fields: ctx, blockHeight, tx, options, requiredEventEncodingVersion
+func (_m *API) DryRunTransactionAtBlockHeight(ctx context.Context, blockHeight uint64, tx *flow.TransactionBody, options modelaccess.TransactionDryRunOptions, requiredEventEncodingVersion entities.EventEncodingVersion) (*modelaccess.TransactionDryRunResult, error) {
+	ret := _m.Called(ctx, blockHeight, tx, options, requiredEventEncodingVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunTransactionAtBlockHeight")
+	}
+
+	var r0 *modelaccess.TransactionDryRunResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *flow.TransactionBody, modelaccess.TransactionDryRunOptions, entities.EventEncodingVersion) (*modelaccess.TransactionDryRunResult, error)); ok {
+		return rf(ctx, blockHeight, tx, options, requiredEventEncodingVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *flow.TransactionBody, modelaccess.TransactionDryRunOptions, entities.EventEncodingVersion) *modelaccess.TransactionDryRunResult); ok {
+		r0 = rf(ctx, blockHeight, tx, options, requiredEventEncodingVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.TransactionDryRunResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *flow.TransactionBody, modelaccess.TransactionDryRunOptions, entities.EventEncodingVersion) error); ok {
+		r1 = rf(ctx, blockHeight, tx, options, requiredEventEncodingVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DryRunTransactionAtBlockID provides a mock function with given fields: ctx, blockID, tx, options, requiredEventEncodingVersion
+func (_m *API) DryRunTransactionAtBlockID(ctx context.Context, blockID flow.Identifier, tx *flow.TransactionBody, options modelaccess.TransactionDryRunOptions, requiredEventEncodingVersion entities.EventEncodingVersion) (*modelaccess.TransactionDryRunResult, error) {
+	ret := _m.Called(ctx, blockID, tx, options, requiredEventEncodingVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunTransactionAtBlockID")
+	}
+
+	var r0 *modelaccess.TransactionDryRunResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier, *flow.TransactionBody, modelaccess.TransactionDryRunOptions, entities.EventEncodingVersion) (*modelaccess.TransactionDryRunResult, error)); ok {
+		return rf(ctx, blockID, tx, options, requiredEventEncodingVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier, *flow.TransactionBody, modelaccess.TransactionDryRunOptions, entities.EventEncodingVersion) *modelaccess.TransactionDryRunResult); ok {
+		r0 = rf(ctx, blockID, tx, options, requiredEventEncodingVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.TransactionDryRunResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier, *flow.TransactionBody, modelaccess.TransactionDryRunOptions, entities.EventEncodingVersion) error); ok {
+		r1 = rf(ctx, blockID, tx, options, requiredEventEncodingVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DryRunTransactionAtLatestBlock provides a mock function with given fields: ctx, tx, options, requiredEventEncodingVersion
+func (_m *API) DryRunTransactionAtLatestBlock(ctx context.Context, tx *flow.TransactionBody, options modelaccess.TransactionDryRunOptions, requiredEventEncodingVersion entities.EventEncodingVersion) (*modelaccess.TransactionDryRunResult, error) {
+	ret := _m.Called(ctx, tx, options, requiredEventEncodingVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunTransactionAtLatestBlock")
+	}
+
+	var r0 *modelaccess.TransactionDryRunResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, modelaccess.TransactionDryRunOptions, entities.EventEncodingVersion) (*modelaccess.TransactionDryRunResult, error)); ok {
+		return rf(ctx, tx, options, requiredEventEncodingVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, modelaccess.TransactionDryRunOptions, entities.EventEncodingVersion) *modelaccess.TransactionDryRunResult); ok {
+		r0 = rf(ctx, tx, options, requiredEventEncodingVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.TransactionDryRunResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *flow.TransactionBody, modelaccess.TransactionDryRunOptions, entities.EventEncodingVersion) error); ok {
+		r1 = rf(ctx, tx, options, requiredEventEncodingVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExecuteScriptAtBlockHeight provides a mock function with given fields: ctx, blockHeight, script, arguments
 func (_m *API) ExecuteScriptAtBlockHeight(ctx context.Context, blockHeight uint64, script []byte, arguments [][]byte) ([]byte, error) {
 	ret := _m.Called(ctx, blockHeight, script, arguments)
@@ -50,6 +141,36 @@ func (_m *API) ExecuteScriptAtBlockHeight(ctx context.Context, blockHeight uint6
 	return r0, r1
 }
 
+// ExecuteScriptAtBlockHeightWithReport provides a mock function with given fields: ctx, blockHeight, script, arguments
+func (_m *API) ExecuteScriptAtBlockHeightWithReport(ctx context.Context, blockHeight uint64, script []byte, arguments [][]byte) (*modelaccess.ScriptExecutionResult, error) {
+	ret := _m.Called(ctx, blockHeight, script, arguments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteScriptAtBlockHeightWithReport")
+	}
+
+	var r0 *modelaccess.ScriptExecutionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []byte, [][]byte) (*modelaccess.ScriptExecutionResult, error)); ok {
+		return rf(ctx, blockHeight, script, arguments)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []byte, [][]byte) *modelaccess.ScriptExecutionResult); ok {
+		r0 = rf(ctx, blockHeight, script, arguments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.ScriptExecutionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []byte, [][]byte) error); ok {
+		r1 = rf(ctx, blockHeight, script, arguments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExecuteScriptAtBlockID provides a mock function with given fields: ctx, blockID, script, arguments
 func (_m *API) ExecuteScriptAtBlockID(ctx context.Context, blockID flow.Identifier, script []byte, arguments [][]byte) ([]byte, error) {
 	ret := _m.Called(ctx, blockID, script, arguments)
@@ -80,6 +201,36 @@ func (_m *API) ExecuteScriptAtBlockID(ctx context.Context, blockID flow.Identifi
 	return r0, r1
 }
 
+// ExecuteScriptAtBlockIDWithReport provides a mock function with given fields: ctx, blockID, script, arguments
+func (_m *API) ExecuteScriptAtBlockIDWithReport(ctx context.Context, blockID flow.Identifier, script []byte, arguments [][]byte) (*modelaccess.ScriptExecutionResult, error) {
+	ret := _m.Called(ctx, blockID, script, arguments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteScriptAtBlockIDWithReport")
+	}
+
+	var r0 *modelaccess.ScriptExecutionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier, []byte, [][]byte) (*modelaccess.ScriptExecutionResult, error)); ok {
+		return rf(ctx, blockID, script, arguments)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier, []byte, [][]byte) *modelaccess.ScriptExecutionResult); ok {
+		r0 = rf(ctx, blockID, script, arguments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.ScriptExecutionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier, []byte, [][]byte) error); ok {
+		r1 = rf(ctx, blockID, script, arguments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExecuteScriptAtLatestBlock provides a mock function with given fields: ctx, script, arguments
 func (_m *API) ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments [][]byte) ([]byte, error) {
 	ret := _m.Called(ctx, script, arguments)
@@ -110,6 +261,36 @@ func (_m *API) ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, ar
 	return r0, r1
 }
 
+// ExecuteScriptAtLatestBlockWithReport provides a mock function with given fields: ctx, script, arguments
+func (_m *API) ExecuteScriptAtLatestBlockWithReport(ctx context.Context, script []byte, arguments [][]byte) (*modelaccess.ScriptExecutionResult, error) {
+	ret := _m.Called(ctx, script, arguments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteScriptAtLatestBlockWithReport")
+	}
+
+	var r0 *modelaccess.ScriptExecutionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte) (*modelaccess.ScriptExecutionResult, error)); ok {
+		return rf(ctx, script, arguments)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte) *modelaccess.ScriptExecutionResult); ok {
+		r0 = rf(ctx, script, arguments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.ScriptExecutionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, [][]byte) error); ok {
+		r1 = rf(ctx, script, arguments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAccount provides a mock function with given fields: ctx, address
 func (_m *API) GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error) {
 	ret := _m.Called(ctx, address)
@@ -376,6 +557,36 @@ func (_m *API) GetAccountKeysAtLatestBlock(ctx context.Context, address flow.Add
 	return r0, r1
 }
 
+// GetAccountStateDiff provides a mock function with given fields: ctx, address, startHeight, endHeight
+func (_m *API) GetAccountStateDiff(ctx context.Context, address flow.Address, startHeight uint64, endHeight uint64) (*modelaccess.AccountStateDiff, error) {
+	ret := _m.Called(ctx, address, startHeight, endHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountStateDiff")
+	}
+
+	var r0 *modelaccess.AccountStateDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64, uint64) (*modelaccess.AccountStateDiff, error)); ok {
+		return rf(ctx, address, startHeight, endHeight)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64, uint64) *modelaccess.AccountStateDiff); ok {
+		r0 = rf(ctx, address, startHeight, endHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.AccountStateDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, uint64, uint64) error); ok {
+		r1 = rf(ctx, address, startHeight, endHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockByHeight provides a mock function with given fields: ctx, height
 func (_m *API) GetBlockByHeight(ctx context.Context, height uint64) (*flow.Block, flow.BlockStatus, error) {
 	ret := _m.Called(ctx, height)
@@ -554,6 +765,66 @@ func (_m *API) GetCollectionByID(ctx context.Context, id flow.Identifier) (*flow
 	return r0, r1
 }
 
+// GetContractAtBlockHeight provides a mock function with given fields: ctx, address, name, height
+func (_m *API) GetContractAtBlockHeight(ctx context.Context, address flow.Address, name string, height uint64) (*modelaccess.ContractVersion, error) {
+	ret := _m.Called(ctx, address, name, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContractAtBlockHeight")
+	}
+
+	var r0 *modelaccess.ContractVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, string, uint64) (*modelaccess.ContractVersion, error)); ok {
+		return rf(ctx, address, name, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, string, uint64) *modelaccess.ContractVersion); ok {
+		r0 = rf(ctx, address, name, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.ContractVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, string, uint64) error); ok {
+		r1 = rf(ctx, address, name, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetContractHistory provides a mock function with given fields: ctx, address, name
+func (_m *API) GetContractHistory(ctx context.Context, address flow.Address, name string) ([]modelaccess.ContractVersion, error) {
+	ret := _m.Called(ctx, address, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetContractHistory")
+	}
+
+	var r0 []modelaccess.ContractVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, string) ([]modelaccess.ContractVersion, error)); ok {
+		return rf(ctx, address, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, string) []modelaccess.ContractVersion); ok {
+		r0 = rf(ctx, address, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]modelaccess.ContractVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, string) error); ok {
+		r1 = rf(ctx, address, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEventsForBlockIDs provides a mock function with given fields: ctx, eventType, blockIDs, requiredEventEncodingVersion
 func (_m *API) GetEventsForBlockIDs(ctx context.Context, eventType string, blockIDs []flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) ([]flow.BlockEvents, error) {
 	ret := _m.Called(ctx, eventType, blockIDs, requiredEventEncodingVersion)
@@ -614,6 +885,36 @@ func (_m *API) GetEventsForHeightRange(ctx context.Context, eventType string, st
 	return r0, r1
 }
 
+// GetEventsForHeightRangeWithFieldFilters provides a mock function with given fields: ctx, eventType, startHeight, endHeight, fieldFilters, limit, cursor, requiredEventEncodingVersion
+func (_m *API) GetEventsForHeightRangeWithFieldFilters(ctx context.Context, eventType string, startHeight uint64, endHeight uint64, fieldFilters events.FieldFilters, limit uint32, cursor *modelaccess.EventCursor, requiredEventEncodingVersion entities.EventEncodingVersion) (*modelaccess.EventsPage, error) {
+	ret := _m.Called(ctx, eventType, startHeight, endHeight, fieldFilters, limit, cursor, requiredEventEncodingVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEventsForHeightRangeWithFieldFilters")
+	}
+
+	var r0 *modelaccess.EventsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64, events.FieldFilters, uint32, *modelaccess.EventCursor, entities.EventEncodingVersion) (*modelaccess.EventsPage, error)); ok {
+		return rf(ctx, eventType, startHeight, endHeight, fieldFilters, limit, cursor, requiredEventEncodingVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64, uint64, events.FieldFilters, uint32, *modelaccess.EventCursor, entities.EventEncodingVersion) *modelaccess.EventsPage); ok {
+		r0 = rf(ctx, eventType, startHeight, endHeight, fieldFilters, limit, cursor, requiredEventEncodingVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.EventsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64, uint64, events.FieldFilters, uint32, *modelaccess.EventCursor, entities.EventEncodingVersion) error); ok {
+		r1 = rf(ctx, eventType, startHeight, endHeight, fieldFilters, limit, cursor, requiredEventEncodingVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetExecutionResultByID provides a mock function with given fields: ctx, id
 func (_m *API) GetExecutionResultByID(ctx context.Context, id flow.Identifier) (*flow.ExecutionResult, error) {
 	ret := _m.Called(ctx, id)
@@ -856,6 +1157,36 @@ func (_m *API) GetNodeVersionInfo(ctx context.Context) (*modelaccess.NodeVersion
 	return r0, r1
 }
 
+// GetPendingTransactionDetails provides a mock function with given fields: ctx, id
+func (_m *API) GetPendingTransactionDetails(ctx context.Context, id flow.Identifier) (*modelaccess.PendingTransactionDetails, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingTransactionDetails")
+	}
+
+	var r0 *modelaccess.PendingTransactionDetails
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier) (*modelaccess.PendingTransactionDetails, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier) *modelaccess.PendingTransactionDetails); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.PendingTransactionDetails)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProtocolStateSnapshotByBlockID provides a mock function with given fields: ctx, blockID
 func (_m *API) GetProtocolStateSnapshotByBlockID(ctx context.Context, blockID flow.Identifier) ([]byte, error) {
 	ret := _m.Called(ctx, blockID)
@@ -916,6 +1247,66 @@ func (_m *API) GetProtocolStateSnapshotByHeight(ctx context.Context, blockHeight
 	return r0, r1
 }
 
+// GetRegisterProofsAtBlockID provides a mock function with given fields: ctx, blockID, registerIDs
+func (_m *API) GetRegisterProofsAtBlockID(ctx context.Context, blockID flow.Identifier, registerIDs []flow.RegisterID) (*modelaccess.RegisterProofs, error) {
+	ret := _m.Called(ctx, blockID, registerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegisterProofsAtBlockID")
+	}
+
+	var r0 *modelaccess.RegisterProofs
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier, []flow.RegisterID) (*modelaccess.RegisterProofs, error)); ok {
+		return rf(ctx, blockID, registerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier, []flow.RegisterID) *modelaccess.RegisterProofs); ok {
+		r0 = rf(ctx, blockID, registerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.RegisterProofs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier, []flow.RegisterID) error); ok {
+		r1 = rf(ctx, blockID, registerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRegisterProofsAtStateCommitment provides a mock function with given fields: ctx, commit, registerIDs
+func (_m *API) GetRegisterProofsAtStateCommitment(ctx context.Context, commit flow.StateCommitment, registerIDs []flow.RegisterID) (*modelaccess.RegisterProofs, error) {
+	ret := _m.Called(ctx, commit, registerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegisterProofsAtStateCommitment")
+	}
+
+	var r0 *modelaccess.RegisterProofs
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.StateCommitment, []flow.RegisterID) (*modelaccess.RegisterProofs, error)); ok {
+		return rf(ctx, commit, registerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.StateCommitment, []flow.RegisterID) *modelaccess.RegisterProofs); ok {
+		r0 = rf(ctx, commit, registerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.RegisterProofs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.StateCommitment, []flow.RegisterID) error); ok {
+		r1 = rf(ctx, commit, registerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSystemTransaction provides a mock function with given fields: ctx, blockID
 func (_m *API) GetSystemTransaction(ctx context.Context, blockID flow.Identifier) (*flow.TransactionBody, error) {
 	ret := _m.Called(ctx, blockID)
@@ -1096,6 +1487,36 @@ func (_m *API) GetTransactionResultsByBlockID(ctx context.Context, blockID flow.
 	return r0, r1
 }
 
+// GetTransactionsByAddress provides a mock function with given fields: ctx, address, limit, cursor
+func (_m *API) GetTransactionsByAddress(ctx context.Context, address flow.Address, limit uint32, cursor *modelaccess.AccountTransactionCursor) (*modelaccess.AccountTransactionsPage, error) {
+	ret := _m.Called(ctx, address, limit, cursor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionsByAddress")
+	}
+
+	var r0 *modelaccess.AccountTransactionsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint32, *modelaccess.AccountTransactionCursor) (*modelaccess.AccountTransactionsPage, error)); ok {
+		return rf(ctx, address, limit, cursor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint32, *modelaccess.AccountTransactionCursor) *modelaccess.AccountTransactionsPage); ok {
+		r0 = rf(ctx, address, limit, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*modelaccess.AccountTransactionsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, uint32, *modelaccess.AccountTransactionCursor) error); ok {
+		r1 = rf(ctx, address, limit, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransactionsByBlockID provides a mock function with given fields: ctx, blockID
 func (_m *API) GetTransactionsByBlockID(ctx context.Context, blockID flow.Identifier) ([]*flow.TransactionBody, error) {
 	ret := _m.Called(ctx, blockID)
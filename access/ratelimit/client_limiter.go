@@ -0,0 +1,208 @@
+package ratelimit
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"golang.org/x/time/rate"
+)
+
+// DefaultMaxTrackedClients is the default number of clients whose token buckets are tracked.
+// When more clients are seen, the buckets of the least recently seen clients are dropped.
+const DefaultMaxTrackedClients = 10_000
+
+// quotaClientsPerTrackedClient is the number of clients whose daily quota usage is tracked, per client
+// whose token buckets are tracked. The quota usage is kept for many more clients than the buckets, so
+// that clients cannot reset their quota by making requests as a few other clients.
+const quotaClientsPerTrackedClient = 100
+
+// defaultRoute is the bucket used for routes without a route specific limit.
+const defaultRoute = ""
+
+// ClientLimit is a per client request rate limit.
+type ClientLimit struct {
+	// Rate is the number of requests per second allowed for a single client. 0 means unlimited.
+	Rate uint
+	// Burst is the number of requests a single client can make at once. Defaults to Rate if 0.
+	Burst uint
+}
+
+// ClientLimits are the limits applied to the requests of each client.
+type ClientLimits struct {
+	// Default is the rate limit applied to all routes without a route specific limit. All these
+	// routes share a single bucket for each client.
+	Default ClientLimit
+	// Routes are route specific rate limits, keyed by route name. Each route has its own bucket for each client.
+	Routes map[string]ClientLimit
+	// DailyQuota is the maximum number of requests a single client can make across all routes each UTC day.
+	// 0 means unlimited.
+	DailyQuota uint64
+}
+
+// enabled returns true if any limit is configured.
+func (l ClientLimits) enabled() bool {
+	return l.Default.Rate > 0 || len(l.Routes) > 0 || l.DailyQuota > 0
+}
+
+// copy returns a deep copy of the limits.
+func (l ClientLimits) copy() ClientLimits {
+	routes := make(map[string]ClientLimit, len(l.Routes))
+	for route, limit := range l.Routes {
+		routes[route] = limit
+	}
+	l.Routes = routes
+	return l
+}
+
+// clientState is the rate limiting state of a single client.
+type clientState struct {
+	version uint64                   // version of the limits the buckets were created with
+	buckets map[string]*rate.Limiter // token buckets keyed by route, or defaultRoute
+}
+
+// ClientLimiter limits the requests of individual clients, identified by an opaque client key such as
+// their IP address or API key. Each client has a token bucket for each route with a specific limit,
+// a shared token bucket for all other routes, and a daily quota across all routes.
+//
+// The token buckets are kept in an LRU cache of bounded size. The daily quota usage of the clients seen
+// during the current UTC day is kept in a separate, much larger LRU cache, and is only counted while a
+// daily quota is configured.
+//
+// Limits can be updated while the limiter is in use. Updating the limits resets the token buckets of
+// all clients, but keeps the quota usage of the current day.
+//
+// All methods are safe for concurrent use.
+type ClientLimiter struct {
+	mu      sync.Mutex
+	limits  ClientLimits
+	version uint64
+	clients *lru.Cache[string, *clientState]
+	// quotaDay is the start of the UTC day the quota usage is counted for
+	quotaDay time.Time
+	// quotaUsed is the number of requests allowed during quotaDay, keyed by client
+	quotaUsed *lru.Cache[string, uint64]
+	now       func() time.Time
+}
+
+// NewClientLimiter creates a new ClientLimiter with the given limits, tracking the token buckets of at
+// most maxClients clients, and the daily quota usage of at most 100 times as many clients.
+// No errors are expected during normal operations.
+func NewClientLimiter(limits ClientLimits, maxClients int) (*ClientLimiter, error) {
+	clients, err := lru.New[string, *clientState](maxClients)
+	if err != nil {
+		return nil, fmt.Errorf("could not create client cache: %w", err)
+	}
+
+	quotaUsed, err := lru.New[string, uint64](maxClients * quotaClientsPerTrackedClient)
+	if err != nil {
+		return nil, fmt.Errorf("could not create quota usage cache: %w", err)
+	}
+
+	return &ClientLimiter{
+		limits:    limits.copy(),
+		clients:   clients,
+		quotaUsed: quotaUsed,
+		now:       time.Now,
+	}, nil
+}
+
+// Limits returns a copy of the current limits.
+func (l *ClientLimiter) Limits() ClientLimits {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limits.copy()
+}
+
+// SetLimits replaces the current limits, and resets the token buckets of all clients.
+func (l *ClientLimiter) SetLimits(limits ClientLimits) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limits = limits.copy()
+	l.version++
+}
+
+// UpdateLimits applies the given change to a copy of the current limits, and replaces the current limits
+// with the result, resetting the token buckets of all clients. The change is applied atomically with
+// respect to all other limit updates. If change returns an error, the current limits are kept.
+// Any error returned by change is returned unchanged.
+func (l *ClientLimiter) UpdateLimits(change func(limits *ClientLimits) error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limits := l.limits.copy()
+	if err := change(&limits); err != nil {
+		return err
+	}
+	l.limits = limits.copy()
+	l.version++
+	return nil
+}
+
+// Allow returns true if the given client may make a request to the given route, and counts the request.
+// If the request is not allowed, it also returns the duration after which the client may retry.
+func (l *ClientLimiter) Allow(client string, route string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.limits.enabled() {
+		return true, 0
+	}
+
+	now := l.now()
+	state, ok := l.clients.Get(client)
+	if !ok {
+		state = &clientState{version: l.version, buckets: make(map[string]*rate.Limiter)}
+		l.clients.Add(client, state)
+	}
+
+	if l.limits.DailyQuota > 0 {
+		day := now.UTC().Truncate(24 * time.Hour)
+		if !l.quotaDay.Equal(day) {
+			l.quotaDay = day
+			l.quotaUsed.Purge()
+		}
+		if used, _ := l.quotaUsed.Get(client); used >= l.limits.DailyQuota {
+			return false, day.Add(24 * time.Hour).Sub(now)
+		}
+	}
+
+	if state.version != l.version {
+		state.version = l.version
+		state.buckets = make(map[string]*rate.Limiter)
+	}
+
+	limit, ok := l.limits.Routes[route]
+	if !ok {
+		route = defaultRoute
+		limit = l.limits.Default
+	}
+
+	if limit.Rate > 0 {
+		bucket, ok := state.buckets[route]
+		if !ok {
+			burst := limit.Burst
+			if burst == 0 {
+				burst = limit.Rate
+			}
+			bucket = rate.NewLimiter(rate.Limit(limit.Rate), int(burst))
+			state.buckets[route] = bucket
+		}
+
+		reservation := bucket.ReserveN(now, 1)
+		if !reservation.OK() {
+			return false, time.Second
+		}
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			reservation.CancelAt(now)
+			return false, delay
+		}
+	}
+
+	if l.limits.DailyQuota > 0 {
+		used, _ := l.quotaUsed.Get(client)
+		l.quotaUsed.Add(client, used+1)
+	}
+	return true, 0
+}
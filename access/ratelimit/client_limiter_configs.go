@@ -0,0 +1,93 @@
+package ratelimit
+
+import (
+	"github.com/onflow/flow-go/module/updatable_configs"
+)
+
+// RegisterClientLimitConfigs registers the limits of the given REST client limiter for dynamic configuration
+// via the set-config admin command. Each update is applied atomically using ClientLimiter.UpdateLimits.
+// No errors are expected during normal operations.
+func RegisterClientLimitConfigs(manager *updatable_configs.Manager, limiter *ClientLimiter) error {
+	err := manager.RegisterUintConfig("rest-client-rate-limit",
+		func() uint { return limiter.Limits().Default.Rate },
+		func(rate uint) error {
+			return limiter.UpdateLimits(func(limits *ClientLimits) error {
+				limits.Default.Rate = rate
+				return nil
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	err = manager.RegisterUintConfig("rest-client-burst-limit",
+		func() uint { return limiter.Limits().Default.Burst },
+		func(burst uint) error {
+			return limiter.UpdateLimits(func(limits *ClientLimits) error {
+				limits.Default.Burst = burst
+				return nil
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	err = manager.RegisterUintConfig("rest-client-daily-quota",
+		func() uint { return uint(limiter.Limits().DailyQuota) },
+		func(quota uint) error {
+			return limiter.UpdateLimits(func(limits *ClientLimits) error {
+				limits.DailyQuota = uint64(quota)
+				return nil
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	err = manager.RegisterUintMapConfig("rest-client-route-rate-limits",
+		func() map[string]uint {
+			rates := make(map[string]uint)
+			for route, limit := range limiter.Limits().Routes {
+				rates[route] = limit.Rate
+			}
+			return rates
+		},
+		func(rates map[string]uint) error {
+			// routes which are not included are removed, the burst limits of the remaining routes are kept
+			return limiter.UpdateLimits(func(limits *ClientLimits) error {
+				routes := make(map[string]ClientLimit, len(rates))
+				for route, rate := range rates {
+					routes[route] = ClientLimit{Rate: rate, Burst: limits.Routes[route].Burst}
+				}
+				limits.Routes = routes
+				return nil
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	return manager.RegisterUintMapConfig("rest-client-route-burst-limits",
+		func() map[string]uint {
+			bursts := make(map[string]uint)
+			for route, limit := range limiter.Limits().Routes {
+				bursts[route] = limit.Burst
+			}
+			return bursts
+		},
+		func(bursts map[string]uint) error {
+			// routes which are not included use their rate limit as burst limit
+			return limiter.UpdateLimits(func(limits *ClientLimits) error {
+				for route := range bursts {
+					if _, ok := limits.Routes[route]; !ok {
+						return updatable_configs.NewValidationErrorf("route %s has no rate limit", route)
+					}
+				}
+				for route, limit := range limits.Routes {
+					limit.Burst = bursts[route]
+					limits.Routes[route] = limit
+				}
+				return nil
+			})
+		})
+}
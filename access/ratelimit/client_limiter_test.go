@@ -0,0 +1,172 @@
+package ratelimit
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClientLimiter(t *testing.T, limits ClientLimits) (*ClientLimiter, *time.Time) {
+	limiter, err := NewClientLimiter(limits, DefaultMaxTrackedClients)
+	require.NoError(t, err)
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+// TestClientLimiter_Rate tests that clients are limited independently, with per route buckets.
+func TestClientLimiter_Rate(t *testing.T) {
+	limiter, now := newTestClientLimiter(t, ClientLimits{
+		Default: ClientLimit{Rate: 2},
+		Routes: map[string]ClientLimit{
+			"getBlocks": {Rate: 1, Burst: 1},
+			"ping":      {},
+		},
+	})
+
+	// the default bucket is shared by all routes without a specific limit
+	allowed, _ := limiter.Allow("a", "getAccount")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("a", "getTransaction")
+	assert.True(t, allowed)
+	allowed, retryAfter := limiter.Allow("a", "getAccount")
+	assert.False(t, allowed)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// routes with a specific limit have their own bucket
+	allowed, _ = limiter.Allow("a", "getBlocks")
+	assert.True(t, allowed)
+	allowed, retryAfter = limiter.Allow("a", "getBlocks")
+	assert.False(t, allowed)
+	assert.Equal(t, time.Second, retryAfter)
+
+	// routes without a rate are not limited
+	for i := 0; i < 10; i++ {
+		allowed, _ = limiter.Allow("a", "ping")
+		assert.True(t, allowed)
+	}
+
+	// other clients are not affected
+	allowed, _ = limiter.Allow("b", "getBlocks")
+	assert.True(t, allowed)
+
+	// tokens are refilled over time
+	*now = now.Add(time.Second)
+	allowed, _ = limiter.Allow("a", "getBlocks")
+	assert.True(t, allowed)
+}
+
+// TestClientLimiter_DailyQuota tests that the daily quota is shared by all routes and reset every day.
+func TestClientLimiter_DailyQuota(t *testing.T) {
+	limiter, now := newTestClientLimiter(t, ClientLimits{DailyQuota: 2})
+
+	allowed, _ := limiter.Allow("a", "getBlocks")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("a", "getAccount")
+	assert.True(t, allowed)
+
+	allowed, retryAfter := limiter.Allow("a", "getBlocks")
+	assert.False(t, allowed)
+	assert.Equal(t, 12*time.Hour, retryAfter)
+
+	*now = now.Add(12 * time.Hour)
+	allowed, _ = limiter.Allow("a", "getBlocks")
+	assert.True(t, allowed)
+}
+
+// TestClientLimiter_SetLimits tests that limits can be updated while the limiter is in use.
+func TestClientLimiter_SetLimits(t *testing.T) {
+	limiter, _ := newTestClientLimiter(t, ClientLimits{})
+
+	// no limits are applied by default
+	for i := 0; i < 10; i++ {
+		allowed, _ := limiter.Allow("a", "getBlocks")
+		assert.True(t, allowed)
+	}
+
+	limits := limiter.Limits()
+	limits.Default = ClientLimit{Rate: 1}
+	limiter.SetLimits(limits)
+
+	allowed, _ := limiter.Allow("a", "getBlocks")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("a", "getBlocks")
+	assert.False(t, allowed)
+
+	// updating the limits resets the buckets
+	limits.Default = ClientLimit{Rate: 2}
+	limiter.SetLimits(limits)
+	allowed, _ = limiter.Allow("a", "getBlocks")
+	assert.True(t, allowed)
+	assert.Equal(t, ClientLimit{Rate: 2}, limiter.Limits().Default)
+}
+
+// TestClientLimiter_UpdateLimits tests that concurrent limit updates are applied atomically, and that a
+// failed update keeps the current limits.
+func TestClientLimiter_UpdateLimits(t *testing.T) {
+	limiter, _ := newTestClientLimiter(t, ClientLimits{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := limiter.UpdateLimits(func(limits *ClientLimits) error {
+				limits.Default.Rate++
+				return nil
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, uint(100), limiter.Limits().Default.Rate)
+
+	err := limiter.UpdateLimits(func(limits *ClientLimits) error {
+		limits.Default.Rate = 0
+		return fmt.Errorf("invalid limits")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, uint(100), limiter.Limits().Default.Rate)
+}
+
+// TestClientLimiter_DailyQuotaEviction tests that the quota usage of a client is kept when its token buckets
+// are evicted by requests of other clients.
+func TestClientLimiter_DailyQuotaEviction(t *testing.T) {
+	limiter, err := NewClientLimiter(ClientLimits{Default: ClientLimit{Rate: 100}, DailyQuota: 1}, 1)
+	require.NoError(t, err)
+
+	allowed, _ := limiter.Allow("a", "getBlocks")
+	assert.True(t, allowed)
+	allowed, _ = limiter.Allow("a", "getBlocks")
+	assert.False(t, allowed)
+
+	// evict the buckets of client a
+	allowed, _ = limiter.Allow("b", "getBlocks")
+	assert.True(t, allowed)
+	assert.False(t, limiter.clients.Contains("a"))
+
+	allowed, _ = limiter.Allow("a", "getBlocks")
+	assert.False(t, allowed)
+}
+
+// TestClientLimiter_DailyQuotaBounded tests that the quota usage is tracked for a bounded number of clients,
+// dropping the usage of the least recently seen clients.
+func TestClientLimiter_DailyQuotaBounded(t *testing.T) {
+	limiter, err := NewClientLimiter(ClientLimits{DailyQuota: 1}, 1)
+	require.NoError(t, err)
+
+	allowed, _ := limiter.Allow("a", "getBlocks")
+	assert.True(t, allowed)
+
+	for i := 0; i < quotaClientsPerTrackedClient; i++ {
+		allowed, _ = limiter.Allow(fmt.Sprintf("client-%d", i), "getBlocks")
+		assert.True(t, allowed)
+	}
+	assert.Equal(t, quotaClientsPerTrackedClient, limiter.quotaUsed.Len())
+	assert.False(t, limiter.quotaUsed.Contains("a"))
+}
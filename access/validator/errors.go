@@ -6,6 +6,7 @@ import (
 
 	"github.com/onflow/cadence"
 
+	fvmerrors "github.com/onflow/flow-go/fvm/errors"
 	"github.com/onflow/flow-go/model/flow"
 )
 
@@ -130,3 +131,40 @@ func (e IndexedHeightFarBehindError) Error() string {
 	return fmt.Sprintf("the difference between the latest sealed height (%d) and indexed height (%d) exceeds the maximum gap allowed",
 		e.SealedHeight, e.IndexedHeight)
 }
+
+// InvalidAuthorizationError indicates that a transaction signature does not verify against the public key
+// of the signer account, that a signer key is revoked, or that the signatures of the payer or of an
+// authorizer do not reach the key weight threshold.
+// Err is the error returned by the FVM transaction verifier, and carries the same error code the
+// transaction would fail with during execution.
+type InvalidAuthorizationError struct {
+	Err fvmerrors.CodedError
+}
+
+func (e InvalidAuthorizationError) Error() string {
+	return fmt.Sprintf("transaction authorization failed: %s", e.Err)
+}
+
+func (e InvalidAuthorizationError) Code() fvmerrors.ErrorCode {
+	return e.Err.Code()
+}
+
+func (e InvalidAuthorizationError) Unwrap() error {
+	return e.Err
+}
+
+// InvalidSequenceNumberError indicates that the sequence number of the transaction proposal key was already
+// used, i.e. it is lower than the sequence number of the key in the latest indexed state.
+type InvalidSequenceNumberError struct {
+	ProposalKey      flow.ProposalKey
+	CurrentSeqNumber uint64
+}
+
+func (e InvalidSequenceNumberError) Error() string {
+	return fmt.Sprintf("%v invalid proposal key: public key %d on account %s has sequence number %d, but given %d",
+		e.Code(), e.ProposalKey.KeyIndex, e.ProposalKey.Address, e.CurrentSeqNumber, e.ProposalKey.SequenceNumber)
+}
+
+func (e InvalidSequenceNumberError) Code() fvmerrors.ErrorCode {
+	return fvmerrors.ErrCodeInvalidProposalSeqNumberError
+}
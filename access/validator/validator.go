@@ -15,7 +15,9 @@ import (
 
 	"github.com/onflow/flow-go/access/ratelimit"
 	cadenceutils "github.com/onflow/flow-go/access/utils"
+	"github.com/onflow/flow-go/engine/execution/computation/query"
 	"github.com/onflow/flow-go/fvm"
+	fvmerrors "github.com/onflow/flow-go/fvm/errors"
 	"github.com/onflow/flow-go/fvm/systemcontracts"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
@@ -145,6 +147,10 @@ type TransactionValidationOptions struct {
 	MaxTransactionByteSize       uint64
 	MaxCollectionByteSize        uint64
 	CheckPayerBalanceMode        PayerBalanceMode
+	// CheckAuthorization enables verifying the transaction signatures against the public keys of the
+	// signer accounts, the key weights of the payer and authorizers, and the proposal key sequence number,
+	// using the account keys from the latest indexed state.
+	CheckAuthorization bool
 }
 
 type ValidationStep struct {
@@ -175,6 +181,9 @@ func NewTransactionValidator(
 	if options.CheckPayerBalanceMode != Disabled && executor == nil {
 		return nil, errors.New("transaction validator cannot use checkPayerBalance with nil executor")
 	}
+	if options.CheckAuthorization && executor == nil {
+		return nil, errors.New("transaction validator cannot use checkAuthorization with nil executor")
+	}
 
 	env := systemcontracts.SystemContractsForChain(chain.ChainID()).AsTemplateEnv()
 
@@ -242,6 +251,26 @@ func (v *TransactionValidator) Validate(ctx context.Context, tx *flow.Transactio
 		}
 	}
 
+	err = v.checkAuthorization(ctx, tx)
+	if err != nil {
+		// we only return errors which would cause the transaction to fail during execution. Other
+		// errors are related to the local state or script execution, and shouldn't prevent the
+		// transaction from proceeding.
+		var authorizationErr InvalidAuthorizationError
+		if errors.As(err, &authorizationErr) {
+			v.transactionValidationMetrics.TransactionValidationFailed(metrics.InvalidAuthorization)
+			return err
+		}
+		var seqNumberErr InvalidSequenceNumberError
+		if errors.As(err, &seqNumberErr) {
+			v.transactionValidationMetrics.TransactionValidationFailed(metrics.InvalidSequenceNumber)
+			return err
+		}
+
+		v.transactionValidationMetrics.TransactionValidationSkipped()
+		log.Info().Err(err).Msg("check authorization validation skipped due to error")
+	}
+
 	err = v.checkSufficientBalanceToPayForTransaction(ctx, tx)
 	if err != nil {
 		// we only return InsufficientBalanceError as it's a client-side issue
@@ -262,8 +291,6 @@ func (v *TransactionValidator) Validate(ctx context.Context, tx *flow.Transactio
 		log.Info().Err(err).Msg("check payer validation skipped due to error")
 	}
 
-	// TODO replace checkSignatureFormat by verifying the account/payer signatures
-
 	v.transactionValidationMetrics.TransactionValidated()
 
 	return nil
@@ -458,27 +485,94 @@ func (v *TransactionValidator) checkSignatureFormat(tx *flow.TransactionBody) er
 	return nil
 }
 
-func (v *TransactionValidator) checkSufficientBalanceToPayForTransaction(ctx context.Context, tx *flow.TransactionBody) error {
-	if v.options.CheckPayerBalanceMode == Disabled {
+// checkAuthorization verifies the transaction signatures, key weights and proposal key sequence number
+// against the latest indexed state, using the same checks the FVM performs before executing a transaction.
+//
+// Since the indexed state may be behind the latest sealed state, only errors which would also occur
+// against any later state are returned:
+//   - InvalidAuthorizationError if a signature is invalid, a signer key is revoked, or the signatures
+//     of an account do not reach the key weight threshold.
+//   - InvalidSequenceNumberError if the proposal key sequence number is lower than the sequence number
+//     of the key. Higher sequence numbers are accepted, since the proposer may have transactions which
+//     are pending or not yet indexed.
+//
+// Transactions signed with accounts or keys which do not exist in the indexed state are accepted, since
+// they may have been created after the indexed height. All other returned errors are benign and mean
+// the check could not be performed.
+func (v *TransactionValidator) checkAuthorization(ctx context.Context, tx *flow.TransactionBody) error {
+	if !v.options.CheckAuthorization {
 		return nil
 	}
 
+	indexedHeight, err := v.indexedHeight()
+	if err != nil {
+		return err
+	}
+
+	result, err := v.scriptExecutor.DryRunTransaction(ctx, tx, query.TransactionDryRunOptions{
+		AuthorizationChecksEnabled: true,
+		SequenceNumberCheckEnabled: true,
+		SkipBodyExecution:          true,
+	}, indexedHeight)
+	if err != nil {
+		return fmt.Errorf("could not check transaction authorization: %w", err)
+	}
+
+	if result.Err == nil {
+		return nil
+	}
+
+	var seqNumberErr fvmerrors.InvalidProposalSeqNumberError
+	if fvmerrors.As(result.Err, &seqNumberErr) {
+		if seqNumberErr.ProvidedSeqNumber() > seqNumberErr.CurrentSeqNumber() {
+			return nil
+		}
+		return InvalidSequenceNumberError{
+			ProposalKey:      tx.ProposalKey,
+			CurrentSeqNumber: seqNumberErr.CurrentSeqNumber(),
+		}
+	}
+
+	if fvmerrors.HasErrorCode(result.Err, fvmerrors.ErrCodeAccountNotFoundError) ||
+		fvmerrors.HasErrorCode(result.Err, fvmerrors.ErrCodeAccountPublicKeyNotFoundError) {
+		return fmt.Errorf("signer account or key not found at indexed height %d: %w", indexedHeight, result.Err)
+	}
+
+	return InvalidAuthorizationError{Err: result.Err}
+}
+
+// indexedHeight returns the latest indexed height, which is used to get the most up-to-date state data
+// available for executing scripts.
+// All returned errors are benign and mean the indexed state can not be used for validation.
+func (v *TransactionValidator) indexedHeight() (uint64, error) {
 	header, err := v.blocks.SealedHeader()
 	if err != nil {
-		return fmt.Errorf("could not fetch block header: %w", err)
+		return 0, fmt.Errorf("could not fetch block header: %w", err)
 	}
 
 	indexedHeight, err := v.blocks.IndexedHeight()
 	if err != nil {
-		return fmt.Errorf("could not get indexed height: %w", err)
+		return 0, fmt.Errorf("could not get indexed height: %w", err)
 	}
 
-	// we use latest indexed block to get the most up-to-date state data available for executing scripts.
 	// check here to make sure indexing is within an acceptable tolerance of sealing to avoid issues
 	// if indexing falls behind
 	sealedHeight := header.Height
 	if indexedHeight < sealedHeight-DefaultSealedIndexedHeightThreshold {
-		return IndexedHeightFarBehindError{SealedHeight: sealedHeight, IndexedHeight: indexedHeight}
+		return 0, IndexedHeightFarBehindError{SealedHeight: sealedHeight, IndexedHeight: indexedHeight}
+	}
+
+	return indexedHeight, nil
+}
+
+func (v *TransactionValidator) checkSufficientBalanceToPayForTransaction(ctx context.Context, tx *flow.TransactionBody) error {
+	if v.options.CheckPayerBalanceMode == Disabled {
+		return nil
+	}
+
+	indexedHeight, err := v.indexedHeight()
+	if err != nil {
+		return err
 	}
 
 	payerAddress := cadence.NewAddress(tx.Payer)
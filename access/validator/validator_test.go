@@ -15,7 +15,9 @@ import (
 
 	"github.com/onflow/flow-go/access/validator"
 	validatormock "github.com/onflow/flow-go/access/validator/mock"
+	"github.com/onflow/flow-go/engine/execution/computation/query"
 	"github.com/onflow/flow-go/fvm"
+	fvmerrors "github.com/onflow/flow-go/fvm/errors"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	execmock "github.com/onflow/flow-go/module/execution/mock"
@@ -208,3 +210,108 @@ func (s *TransactionValidatorSuite) TestTransactionValidator_SealedIndexedHeight
 	assert.NoError(s.T(), err)
 
 }
+
+func (s *TransactionValidatorSuite) TestTransactionValidator_CheckAuthorization() {
+	s.validatorOptions.CheckPayerBalanceMode = validator.Disabled
+	s.validatorOptions.CheckAuthorization = true
+
+	s.blocks.
+		On("IndexedHeight").
+		Return(s.header.Height, nil)
+
+	expectedOptions := query.TransactionDryRunOptions{
+		AuthorizationChecksEnabled: true,
+		SequenceNumberCheckEnabled: true,
+		SkipBodyExecution:          true,
+	}
+
+	// validateTx validates a transaction, where checking the authorization against the indexed state
+	// returns the given result and error
+	validateTx := func(txBody *flow.TransactionBody, result *query.TransactionDryRunResult, executorErr error) error {
+		scriptExecutor := execmock.NewScriptExecutor(s.T())
+		scriptExecutor.
+			On("DryRunTransaction", mock.Anything, txBody, expectedOptions, s.header.Height).
+			Return(result, executorErr).
+			Once()
+
+		validator, err := validator.NewTransactionValidator(s.blocks, s.chain, s.metrics, s.validatorOptions, scriptExecutor)
+		s.Require().NoError(err)
+
+		return validator.Validate(context.Background(), txBody)
+	}
+
+	s.Run("valid transaction", func() {
+		txBody := unittest.TransactionBodyFixture()
+		err := validateTx(&txBody, &query.TransactionDryRunResult{}, nil)
+		s.Assert().NoError(err)
+	})
+
+	s.Run("invalid signature", func() {
+		txBody := unittest.TransactionBodyFixture()
+		signatureErr := fvmerrors.NewInvalidProposalSignatureError(
+			txBody.ProposalKey,
+			fvmerrors.NewInvalidEnvelopeSignatureError(txBody.EnvelopeSignatures[0], errors.New("signature is not valid")))
+
+		err := validateTx(&txBody, &query.TransactionDryRunResult{Err: signatureErr}, nil)
+
+		var authorizationErr validator.InvalidAuthorizationError
+		s.Require().ErrorAs(err, &authorizationErr)
+		s.Assert().Equal(fvmerrors.ErrCodeInvalidProposalSignatureError, authorizationErr.Code())
+	})
+
+	s.Run("insufficient key weight", func() {
+		txBody := unittest.TransactionBodyFixture()
+		weightErr := fvmerrors.NewAccountAuthorizationErrorf(txBody.Payer, "payer account does not have sufficient signatures (%d < %d)", 500, 1000)
+
+		err := validateTx(&txBody, &query.TransactionDryRunResult{Err: weightErr}, nil)
+
+		var authorizationErr validator.InvalidAuthorizationError
+		s.Require().ErrorAs(err, &authorizationErr)
+		s.Assert().Equal(fvmerrors.ErrCodeAccountAuthorizationError, authorizationErr.Code())
+	})
+
+	s.Run("used sequence number", func() {
+		txBody := unittest.TransactionBodyFixture()
+		txBody.ProposalKey.SequenceNumber = 3
+		seqNumberErr := fvmerrors.NewInvalidProposalSeqNumberError(txBody.ProposalKey, 5)
+
+		err := validateTx(&txBody, &query.TransactionDryRunResult{Err: seqNumberErr}, nil)
+
+		s.Assert().ErrorIs(err, validator.InvalidSequenceNumberError{ProposalKey: txBody.ProposalKey, CurrentSeqNumber: 5})
+		s.Assert().Contains(err.Error(), fvmerrors.ErrCodeInvalidProposalSeqNumberError.String())
+	})
+
+	s.Run("sequence number ahead of indexed state", func() {
+		// the proposer may have pending transactions, so higher sequence numbers are accepted
+		txBody := unittest.TransactionBodyFixture()
+		txBody.ProposalKey.SequenceNumber = 7
+		seqNumberErr := fvmerrors.NewInvalidProposalSeqNumberError(txBody.ProposalKey, 5)
+
+		err := validateTx(&txBody, &query.TransactionDryRunResult{Err: seqNumberErr}, nil)
+		s.Assert().NoError(err)
+	})
+
+	s.Run("key not in indexed state", func() {
+		// the key may have been added after the indexed height
+		txBody := unittest.TransactionBodyFixture()
+		keyErr := fvmerrors.NewInvalidProposalSignatureError(
+			txBody.ProposalKey,
+			fvmerrors.NewInvalidEnvelopeSignatureError(
+				txBody.EnvelopeSignatures[0],
+				fvmerrors.NewAccountPublicKeyNotFoundError(txBody.Payer, txBody.EnvelopeSignatures[0].KeyIndex)))
+
+		err := validateTx(&txBody, &query.TransactionDryRunResult{Err: keyErr}, nil)
+		s.Assert().NoError(err)
+	})
+
+	s.Run("script executor internal error", func() {
+		txBody := unittest.TransactionBodyFixture()
+		err := validateTx(&txBody, nil, errors.New("script executor internal error"))
+		s.Assert().NoError(err)
+	})
+
+	s.Run("nil executor", func() {
+		_, err := validator.NewTransactionValidator(s.blocks, s.chain, s.metrics, s.validatorOptions, nil)
+		s.Assert().Error(err)
+	})
+}
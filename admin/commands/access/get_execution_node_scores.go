@@ -0,0 +1,65 @@
+package access
+
+import (
+	"context"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/engine/access/rpc/backend"
+)
+
+var _ commands.AdminCommand = (*GetExecutionNodeScoresCommand)(nil)
+
+// executionNodeScore is the admin representation of the score of an execution node.
+type executionNodeScore struct {
+	NodeID         string  `json:"node_id"`
+	Requests       uint64  `json:"requests"`
+	Failures       uint64  `json:"failures"`
+	LatencyP50     string  `json:"latency_p50"`
+	LatencyP95     string  `json:"latency_p95"`
+	LatencyP99     string  `json:"latency_p99"`
+	ErrorRate      float64 `json:"error_rate"`
+	ExecutedHeight uint64  `json:"executed_height"`
+	HeightLag      uint64  `json:"height_lag"`
+	Score          float64 `json:"score"`
+}
+
+// GetExecutionNodeScoresCommand returns the current scores of the execution nodes used by the access node,
+// ordered from the best to the worst node.
+type GetExecutionNodeScoresCommand struct {
+	scorer *backend.NodeScorer
+}
+
+// NewGetExecutionNodeScoresCommand creates a new instance of GetExecutionNodeScoresCommand.
+func NewGetExecutionNodeScoresCommand(scorer *backend.NodeScorer) *GetExecutionNodeScoresCommand {
+	return &GetExecutionNodeScoresCommand{
+		scorer: scorer,
+	}
+}
+
+func (c *GetExecutionNodeScoresCommand) Handler(_ context.Context, _ *admin.CommandRequest) (interface{}, error) {
+	scores := c.scorer.Scores()
+
+	result := make([]executionNodeScore, len(scores))
+	for i, score := range scores {
+		result[i] = executionNodeScore{
+			NodeID:         score.NodeID.String(),
+			Requests:       score.Requests,
+			Failures:       score.Failures,
+			LatencyP50:     score.LatencyP50.String(),
+			LatencyP95:     score.LatencyP95.String(),
+			LatencyP99:     score.LatencyP99.String(),
+			ErrorRate:      score.ErrorRate,
+			ExecutedHeight: score.ExecutedHeight,
+			HeightLag:      score.HeightLag,
+			Score:          score.Score,
+		}
+	}
+
+	return commands.ConvertToInterfaceList(result)
+}
+
+// Validator does not validate anything, since the command does not take any input.
+func (c *GetExecutionNodeScoresCommand) Validator(_ *admin.CommandRequest) error {
+	return nil
+}
@@ -0,0 +1,43 @@
+package access
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/engine/access/rpc/backend"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestGetExecutionNodeScores(t *testing.T) {
+	scorer := backend.NewNodeScorer(unittest.Logger(), nil, metrics.NewNoopCollector())
+	fast := unittest.IdentifierFixture()
+	slow := unittest.IdentifierFixture()
+	scorer.RecordRequest(slow, 2*time.Second, false)
+	scorer.RecordRequest(fast, 10*time.Millisecond, false)
+	scorer.ReportExecutedHeight(fast, 10)
+
+	command := NewGetExecutionNodeScoresCommand(scorer)
+	req := &admin.CommandRequest{}
+	require.NoError(t, command.Validator(req))
+
+	result, err := command.Handler(context.Background(), req)
+	require.NoError(t, err)
+
+	scores := result.([]interface{})
+	require.Len(t, scores, 2)
+
+	best := scores[0].(map[string]interface{})
+	require.Equal(t, fast.String(), best["node_id"])
+	require.Equal(t, "10ms", best["latency_p99"])
+	require.Equal(t, float64(10), best["executed_height"])
+	require.Equal(t, float64(1), best["requests"])
+
+	worst := scores[1].(map[string]interface{})
+	require.Equal(t, slow.String(), worst["node_id"])
+	require.Equal(t, "2s", worst["latency_p50"])
+}
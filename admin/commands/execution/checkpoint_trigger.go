@@ -8,6 +8,7 @@ import (
 
 	"github.com/onflow/flow-go/admin"
 	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/ledger/complete"
 )
 
 var _ commands.AdminCommand = (*TriggerCheckpointCommand)(nil)
@@ -16,17 +17,28 @@ var _ commands.AdminCommand = (*TriggerCheckpointCommand)(nil)
 // once finishing writing the current WAL segment file
 type TriggerCheckpointCommand struct {
 	trigger *atomic.Bool
+	kind    *atomic.Uint32
 }
 
-func NewTriggerCheckpointCommand(trigger *atomic.Bool) *TriggerCheckpointCommand {
+func NewTriggerCheckpointCommand(trigger *atomic.Bool, kind *atomic.Uint32) *TriggerCheckpointCommand {
 	return &TriggerCheckpointCommand{
 		trigger: trigger,
+		kind:    kind,
 	}
 }
 
-func (s *TriggerCheckpointCommand) Handler(_ context.Context, _ *admin.CommandRequest) (interface{}, error) {
+func (s *TriggerCheckpointCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	kind := req.ValidatorData.(complete.CheckpointKind)
+
+	if s.trigger.Load() {
+		log.Info().Msgf("admintool: checkpoint is already set to be triggered")
+		return "ok", nil
+	}
+
+	// the kind must be set before the trigger, since the compactor reads it once triggered
+	s.kind.Store(uint32(kind))
 	if s.trigger.CompareAndSwap(false, true) {
-		log.Info().Msgf("admintool: trigger checkpoint as soon as finishing writing the current segment file. you can find log about 'compactor' to check the checkpointing progress")
+		log.Info().Str("kind", kind.String()).Msgf("admintool: trigger checkpoint as soon as finishing writing the current segment file. you can find log about 'compactor' to check the checkpointing progress")
 	} else {
 		log.Info().Msgf("admintool: checkpoint is already set to be triggered")
 	}
@@ -34,6 +46,34 @@ func (s *TriggerCheckpointCommand) Handler(_ context.Context, _ *admin.CommandRe
 	return "ok", nil
 }
 
-func (s *TriggerCheckpointCommand) Validator(_ *admin.CommandRequest) error {
+// Validator checks the inputs for TriggerCheckpoint command.
+// It accepts an optional "kind" field in the Data field of the req object, which is
+// either "full" or "delta". If it is omitted, the compactor decides the kind of checkpoint.
+// The following sentinel errors are expected during normal operations:
+// * `admin.InvalidAdminReqError` if the input is in a wrong format
+func (s *TriggerCheckpointCommand) Validator(req *admin.CommandRequest) error {
+	req.ValidatorData = complete.CheckpointKindDefault
+	if req.Data == nil {
+		return nil
+	}
+
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+	result, ok := input["kind"]
+	if !ok {
+		return nil
+	}
+	name, ok := result.(string)
+	if !ok {
+		return admin.NewInvalidAdminReqParameterError("kind", "must be a string", result)
+	}
+	kind, err := complete.ParseCheckpointKind(name)
+	if err != nil {
+		return admin.NewInvalidAdminReqParameterError("kind", "must be one of default, full or delta", result)
+	}
+
+	req.ValidatorData = kind
 	return nil
 }
@@ -0,0 +1,86 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/ledger/complete"
+)
+
+func TestTriggerCheckpointCommand(t *testing.T) {
+
+	t.Run("no input", func(t *testing.T) {
+		trigger := atomic.NewBool(false)
+		kind := atomic.NewUint32(uint32(complete.CheckpointKindDelta))
+		cmd := NewTriggerCheckpointCommand(trigger, kind)
+
+		req := &admin.CommandRequest{}
+		require.NoError(t, cmd.Validator(req))
+
+		_, err := cmd.Handler(context.Background(), req)
+		require.NoError(t, err)
+
+		require.True(t, trigger.Load())
+		require.Equal(t, uint32(complete.CheckpointKindDefault), kind.Load())
+	})
+
+	t.Run("full checkpoint", func(t *testing.T) {
+		trigger := atomic.NewBool(false)
+		kind := atomic.NewUint32(uint32(complete.CheckpointKindDefault))
+		cmd := NewTriggerCheckpointCommand(trigger, kind)
+
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"kind": "full",
+			},
+		}
+		require.NoError(t, cmd.Validator(req))
+
+		_, err := cmd.Handler(context.Background(), req)
+		require.NoError(t, err)
+
+		require.True(t, trigger.Load())
+		require.Equal(t, uint32(complete.CheckpointKindFull), kind.Load())
+	})
+
+	t.Run("already triggered", func(t *testing.T) {
+		trigger := atomic.NewBool(true)
+		kind := atomic.NewUint32(uint32(complete.CheckpointKindFull))
+		cmd := NewTriggerCheckpointCommand(trigger, kind)
+
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"kind": "delta",
+			},
+		}
+		require.NoError(t, cmd.Validator(req))
+
+		_, err := cmd.Handler(context.Background(), req)
+		require.NoError(t, err)
+
+		// the kind of the pending checkpoint is not changed
+		require.Equal(t, uint32(complete.CheckpointKindFull), kind.Load())
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		cmd := NewTriggerCheckpointCommand(atomic.NewBool(false), atomic.NewUint32(0))
+
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"kind": "partial",
+			},
+		}
+		require.True(t, admin.IsInvalidAdminParameterError(cmd.Validator(req)))
+
+		req = &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"kind": 1,
+			},
+		}
+		require.True(t, admin.IsInvalidAdminParameterError(cmd.Validator(req)))
+	})
+}
@@ -0,0 +1,136 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/network/alsp"
+)
+
+var _ commands.AdminCommand = (*AlspDisallowListNodeCommand)(nil)
+var _ commands.AdminCommand = (*AlspAllowListNodeCommand)(nil)
+var _ commands.AdminCommand = (*AlspResetSpamRecordCommand)(nil)
+
+// AlspDisallowListNodeCommand manually disallow-lists a node at the ALSP module. The node is allow-listed again once
+// its penalty decays back to zero, unless it is allow-listed manually before that.
+type AlspDisallowListNodeCommand struct {
+	admin alsp.SpamRecordAdmin
+}
+
+// NewAlspDisallowListNodeCommand creates a new instance of AlspDisallowListNodeCommand.
+// The given admin may be nil on nodes that do not expose the spam records, in which case the command returns an error.
+func NewAlspDisallowListNodeCommand(admin alsp.SpamRecordAdmin) *AlspDisallowListNodeCommand {
+	return &AlspDisallowListNodeCommand{
+		admin: admin,
+	}
+}
+
+func (c *AlspDisallowListNodeCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	if c.admin == nil {
+		return nil, errSpamRecordsUnavailable
+	}
+
+	nodeID := req.ValidatorData.(flow.Identifier)
+	if err := c.admin.DisallowListNode(nodeID); err != nil {
+		return nil, fmt.Errorf("failed to disallow-list node %s: %w", nodeID, err)
+	}
+	return "ok", nil
+}
+
+// Validator validates the request.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (c *AlspDisallowListNodeCommand) Validator(req *admin.CommandRequest) error {
+	return validateNodeID(req)
+}
+
+// AlspAllowListNodeCommand manually allow-lists a node at the ALSP module. The penalty of the node is cleared, while
+// its history of disallow-listings is retained.
+type AlspAllowListNodeCommand struct {
+	admin alsp.SpamRecordAdmin
+}
+
+// NewAlspAllowListNodeCommand creates a new instance of AlspAllowListNodeCommand.
+// The given admin may be nil on nodes that do not expose the spam records, in which case the command returns an error.
+func NewAlspAllowListNodeCommand(admin alsp.SpamRecordAdmin) *AlspAllowListNodeCommand {
+	return &AlspAllowListNodeCommand{
+		admin: admin,
+	}
+}
+
+func (c *AlspAllowListNodeCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	if c.admin == nil {
+		return nil, errSpamRecordsUnavailable
+	}
+
+	nodeID := req.ValidatorData.(flow.Identifier)
+	if err := c.admin.AllowListNode(nodeID); err != nil {
+		return nil, fmt.Errorf("failed to allow-list node %s: %w", nodeID, err)
+	}
+	return "ok", nil
+}
+
+// Validator validates the request.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (c *AlspAllowListNodeCommand) Validator(req *admin.CommandRequest) error {
+	return validateNodeID(req)
+}
+
+// AlspResetSpamRecordCommand removes the spam record of a node at the ALSP module, giving the node a clean slate.
+type AlspResetSpamRecordCommand struct {
+	admin alsp.SpamRecordAdmin
+}
+
+// NewAlspResetSpamRecordCommand creates a new instance of AlspResetSpamRecordCommand.
+// The given admin may be nil on nodes that do not expose the spam records, in which case the command returns an error.
+func NewAlspResetSpamRecordCommand(admin alsp.SpamRecordAdmin) *AlspResetSpamRecordCommand {
+	return &AlspResetSpamRecordCommand{
+		admin: admin,
+	}
+}
+
+func (c *AlspResetSpamRecordCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	if c.admin == nil {
+		return nil, errSpamRecordsUnavailable
+	}
+
+	nodeID := req.ValidatorData.(flow.Identifier)
+	if !c.admin.ResetSpamRecord(nodeID) {
+		return "no spam record found", nil
+	}
+	return "ok", nil
+}
+
+// Validator validates the request.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (c *AlspResetSpamRecordCommand) Validator(req *admin.CommandRequest) error {
+	return validateNodeID(req)
+}
+
+// validateNodeID validates that the request carries the node ID of the target node in the "node_id" field, and
+// stores the parsed node ID as the validator data of the request.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func validateNodeID(req *admin.CommandRequest) error {
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+
+	raw, ok := input["node_id"]
+	if !ok {
+		return admin.NewInvalidAdminReqErrorf("the \"node_id\" field is required")
+	}
+	str, ok := raw.(string)
+	if !ok {
+		return admin.NewInvalidAdminReqParameterError("node_id", "must be 64-char hex string", raw)
+	}
+	nodeID, err := flow.HexStringToIdentifier(str)
+	if err != nil {
+		return admin.NewInvalidAdminReqParameterError("node_id", "must be 64-char hex string", raw)
+	}
+
+	req.ValidatorData = nodeID
+	return nil
+}
@@ -0,0 +1,122 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	mockalsp "github.com/onflow/flow-go/network/alsp/mock"
+	"github.com/onflow/flow-go/network/alsp/model"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestListAlspSpamRecords(t *testing.T) {
+	spamRecordAdmin := mockalsp.NewSpamRecordAdmin(t)
+	mild := model.ProtocolSpamRecord{
+		OriginId: unittest.IdentifierFixture(),
+		Decay:    model.InitialDecaySpeed,
+		Penalty:  model.DefaultPenaltyValue,
+	}
+	severe := model.ProtocolSpamRecord{
+		OriginId:       unittest.IdentifierFixture(),
+		Decay:          100,
+		CutoffCounter:  2,
+		DisallowListed: true,
+		Penalty:        model.DisallowListingThreshold,
+	}
+	spamRecordAdmin.On("SpamRecords").Return([]model.ProtocolSpamRecord{mild, severe}).Once()
+
+	command := NewListAlspSpamRecordsCommand(spamRecordAdmin)
+	req := &admin.CommandRequest{}
+	require.NoError(t, command.Validator(req))
+
+	result, err := command.Handler(context.Background(), req)
+	require.NoError(t, err)
+
+	records := result.([]interface{})
+	require.Len(t, records, 2)
+
+	// records are ordered from the most to the least penalized node.
+	first := records[0].(map[string]interface{})
+	require.Equal(t, severe.OriginId.String(), first["node_id"])
+	require.Equal(t, float64(model.DisallowListingThreshold), first["penalty"])
+	require.Equal(t, float64(2), first["cutoff_counter"])
+	require.Equal(t, true, first["disallow_listed"])
+
+	second := records[1].(map[string]interface{})
+	require.Equal(t, mild.OriginId.String(), second["node_id"])
+	require.Equal(t, false, second["disallow_listed"])
+}
+
+func TestAlspOverrides(t *testing.T) {
+	nodeID := unittest.IdentifierFixture()
+	validReq := func() *admin.CommandRequest {
+		return &admin.CommandRequest{
+			Data: map[string]interface{}{"node_id": nodeID.String()},
+		}
+	}
+
+	t.Run("disallow-list node", func(t *testing.T) {
+		spamRecordAdmin := mockalsp.NewSpamRecordAdmin(t)
+		spamRecordAdmin.On("DisallowListNode", nodeID).Return(nil).Once()
+
+		command := NewAlspDisallowListNodeCommand(spamRecordAdmin)
+		req := validReq()
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, "ok", result)
+	})
+
+	t.Run("allow-list node", func(t *testing.T) {
+		spamRecordAdmin := mockalsp.NewSpamRecordAdmin(t)
+		spamRecordAdmin.On("AllowListNode", nodeID).Return(nil).Once()
+
+		command := NewAlspAllowListNodeCommand(spamRecordAdmin)
+		req := validReq()
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, "ok", result)
+	})
+
+	t.Run("reset spam record", func(t *testing.T) {
+		spamRecordAdmin := mockalsp.NewSpamRecordAdmin(t)
+		spamRecordAdmin.On("ResetSpamRecord", nodeID).Return(true).Once()
+		spamRecordAdmin.On("ResetSpamRecord", nodeID).Return(false).Once()
+
+		command := NewAlspResetSpamRecordCommand(spamRecordAdmin)
+		req := validReq()
+		require.NoError(t, command.Validator(req))
+		result, err := command.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, "ok", result)
+
+		result, err = command.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, "no spam record found", result)
+	})
+
+	t.Run("invalid node id", func(t *testing.T) {
+		command := NewAlspDisallowListNodeCommand(mockalsp.NewSpamRecordAdmin(t))
+		for _, data := range []interface{}{
+			"not a map",
+			map[string]interface{}{},
+			map[string]interface{}{"node_id": 1},
+			map[string]interface{}{"node_id": "abcd"},
+		} {
+			err := command.Validator(&admin.CommandRequest{Data: data})
+			require.True(t, admin.IsInvalidAdminParameterError(err), "unexpected error for %v: %v", data, err)
+		}
+	})
+
+	t.Run("spam records unavailable", func(t *testing.T) {
+		command := NewAlspResetSpamRecordCommand(nil)
+		req := validReq()
+		require.NoError(t, command.Validator(req))
+		_, err := command.Handler(context.Background(), req)
+		require.ErrorIs(t, err, errSpamRecordsUnavailable)
+	})
+}
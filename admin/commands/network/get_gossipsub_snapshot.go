@@ -0,0 +1,43 @@
+package network
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/network/p2p/introspection"
+)
+
+var _ commands.AdminCommand = (*GetGossipSubSnapshotCommand)(nil)
+
+// errGossipSubSnapshotUnavailable is returned by the get-gossipsub-snapshot command on nodes whose networking layer does
+// not expose the introspection of its GossipSub state.
+var errGossipSubSnapshotUnavailable = errors.New("gossipsub snapshot is not available on this node")
+
+// GetGossipSubSnapshotCommand returns a live snapshot of the GossipSub state of the node, i.e., its local mesh per topic,
+// the score components of the remote peers, and the recent RPC inspector violations and connection gater decisions.
+type GetGossipSubSnapshotCommand struct {
+	introspector *introspection.Introspector
+}
+
+// NewGetGossipSubSnapshotCommand creates a new instance of GetGossipSubSnapshotCommand.
+// The given introspector may be nil on nodes that do not expose their GossipSub state, in which case the command returns an error.
+func NewGetGossipSubSnapshotCommand(introspector *introspection.Introspector) *GetGossipSubSnapshotCommand {
+	return &GetGossipSubSnapshotCommand{
+		introspector: introspector,
+	}
+}
+
+func (c *GetGossipSubSnapshotCommand) Handler(_ context.Context, _ *admin.CommandRequest) (interface{}, error) {
+	if c.introspector == nil {
+		return nil, errGossipSubSnapshotUnavailable
+	}
+
+	return commands.ConvertToMap(c.introspector.Snapshot())
+}
+
+// Validator does not validate anything, since the command does not take any input.
+func (c *GetGossipSubSnapshotCommand) Validator(_ *admin.CommandRequest) error {
+	return nil
+}
@@ -0,0 +1,61 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	mockmodule "github.com/onflow/flow-go/module/mock"
+	"github.com/onflow/flow-go/network/p2p"
+	"github.com/onflow/flow-go/network/p2p/introspection"
+	mockp2p "github.com/onflow/flow-go/network/p2p/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestGetGossipSubSnapshot(t *testing.T) {
+	meshPeer, scoredPeer := unittest.PeerIdFixture(t), unittest.PeerIdFixture(t)
+	idProvider := mockmodule.NewIdentityProvider(t)
+	idProvider.On("ByPeerID", meshPeer).Return(nil, false)
+	idProvider.On("ByPeerID", scoredPeer).Return(nil, false)
+
+	exposer := mockp2p.NewPeerScoreExposer(t)
+	exposer.On("GetPeerScoreSnapshots").Return(map[peer.ID]p2p.PeerScoreSnapshot{
+		scoredPeer: {Score: -10, AppSpecificScore: -10},
+	})
+	node := mockp2p.NewLibP2PNode(t)
+	node.On("GetLocalMesh").Return(map[string][]peer.ID{"topic": {meshPeer}})
+	node.On("PeerScoreExposer").Return(exposer)
+
+	introspector := introspection.NewIntrospector(node, introspection.NewRecorder(introspection.DefaultRecentEventsCapacity), idProvider, nil)
+	command := NewGetGossipSubSnapshotCommand(introspector)
+	req := &admin.CommandRequest{}
+	require.NoError(t, command.Validator(req))
+
+	result, err := command.Handler(context.Background(), req)
+	require.NoError(t, err)
+
+	snapshot := result.(map[string]interface{})
+	mesh := snapshot["mesh"].(map[string]interface{})
+	meshPeers := mesh["topic"].([]interface{})
+	require.Len(t, meshPeers, 1)
+	require.Equal(t, meshPeer.String(), meshPeers[0].(map[string]interface{})["peer_id"])
+
+	scores := snapshot["peer_scores"].([]interface{})
+	require.Len(t, scores, 1)
+	score := scores[0].(map[string]interface{})
+	require.Equal(t, scoredPeer.String(), score["peer_id"])
+	require.Equal(t, float64(-10), score["app_specific_score"])
+
+	require.Contains(t, snapshot, "inspector_violations")
+	require.Contains(t, snapshot, "connection_gater")
+}
+
+func TestGetGossipSubSnapshot_Unavailable(t *testing.T) {
+	command := NewGetGossipSubSnapshotCommand(nil)
+
+	_, err := command.Handler(context.Background(), &admin.CommandRequest{})
+	require.ErrorIs(t, err, errGossipSubSnapshotUnavailable)
+}
@@ -0,0 +1,69 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/network/alsp"
+)
+
+var _ commands.AdminCommand = (*ListAlspSpamRecordsCommand)(nil)
+
+// errSpamRecordsUnavailable is returned by the alsp admin commands on nodes whose networking layer does not expose
+// the spam records of the ALSP module.
+var errSpamRecordsUnavailable = errors.New("alsp spam records are not available on this node")
+
+// spamRecord is the admin representation of the spam record of a node.
+type spamRecord struct {
+	NodeID         string  `json:"node_id"`
+	Penalty        float64 `json:"penalty"`
+	Decay          float64 `json:"decay"`
+	CutoffCounter  uint64  `json:"cutoff_counter"`
+	DisallowListed bool    `json:"disallow_listed"`
+}
+
+// ListAlspSpamRecordsCommand returns the spam records of the ALSP module, i.e., the nodes with their penalty and
+// disallow-listing state, ordered from the most to the least penalized node.
+type ListAlspSpamRecordsCommand struct {
+	admin alsp.SpamRecordAdmin
+}
+
+// NewListAlspSpamRecordsCommand creates a new instance of ListAlspSpamRecordsCommand.
+// The given admin may be nil on nodes that do not expose the spam records, in which case the command returns an error.
+func NewListAlspSpamRecordsCommand(admin alsp.SpamRecordAdmin) *ListAlspSpamRecordsCommand {
+	return &ListAlspSpamRecordsCommand{
+		admin: admin,
+	}
+}
+
+func (c *ListAlspSpamRecordsCommand) Handler(_ context.Context, _ *admin.CommandRequest) (interface{}, error) {
+	if c.admin == nil {
+		return nil, errSpamRecordsUnavailable
+	}
+
+	records := c.admin.SpamRecords()
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Penalty < records[j].Penalty
+	})
+
+	result := make([]spamRecord, len(records))
+	for i, record := range records {
+		result[i] = spamRecord{
+			NodeID:         record.OriginId.String(),
+			Penalty:        record.Penalty,
+			Decay:          record.Decay,
+			CutoffCounter:  record.CutoffCounter,
+			DisallowListed: record.DisallowListed,
+		}
+	}
+
+	return commands.ConvertToInterfaceList(result)
+}
+
+// Validator does not validate anything, since the command does not take any input.
+func (c *ListAlspSpamRecordsCommand) Validator(_ *admin.CommandRequest) error {
+	return nil
+}
@@ -25,8 +25,10 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/onflow/flow-go/access/ratelimit"
 	txvalidator "github.com/onflow/flow-go/access/validator"
 	"github.com/onflow/flow-go/admin/commands"
+	accessCommands "github.com/onflow/flow-go/admin/commands/access"
 	stateSyncCommands "github.com/onflow/flow-go/admin/commands/state_synchronization"
 	storageCommands "github.com/onflow/flow-go/admin/commands/storage"
 	"github.com/onflow/flow-go/cmd"
@@ -47,6 +49,8 @@ import (
 	pingeng "github.com/onflow/flow-go/engine/access/ping"
 	"github.com/onflow/flow-go/engine/access/rest"
 	commonrest "github.com/onflow/flow-go/engine/access/rest/common"
+	restmiddleware "github.com/onflow/flow-go/engine/access/rest/common/middleware"
+	"github.com/onflow/flow-go/engine/access/rest/graphql"
 	"github.com/onflow/flow-go/engine/access/rest/router"
 	"github.com/onflow/flow-go/engine/access/rest/websockets"
 	"github.com/onflow/flow-go/engine/access/rpc"
@@ -59,6 +63,7 @@ import (
 	followereng "github.com/onflow/flow-go/engine/common/follower"
 	"github.com/onflow/flow-go/engine/common/requester"
 	commonrpc "github.com/onflow/flow-go/engine/common/rpc"
+	"github.com/onflow/flow-go/engine/common/rpc/pendingtx"
 	"github.com/onflow/flow-go/engine/common/stop"
 	synceng "github.com/onflow/flow-go/engine/common/synchronization"
 	"github.com/onflow/flow-go/engine/common/version"
@@ -147,6 +152,9 @@ type AccessNodeConfig struct {
 	nodeInfoFile                         string
 	apiRatelimits                        map[string]int
 	apiBurstlimits                       map[string]int
+	restClientLimits                     ratelimit.ClientLimits
+	restRouteRateLimits                  map[string]int
+	restRouteBurstLimits                 map[string]int
 	rpcConf                              rpc.Config
 	stateStreamConf                      statestreambackend.Config
 	stateStreamFilterConf                map[string]int
@@ -179,10 +187,13 @@ type AccessNodeConfig struct {
 	registerCacheSize                    uint
 	programCacheSize                     uint
 	checkPayerBalanceMode                string
+	checkTxAuthorization                 bool
+	queryPendingTxStatus                 bool
 	versionControlEnabled                bool
 	storeTxResultErrorMessages           bool
 	stopControlEnabled                   bool
 	registerDBPruneThreshold             uint64
+	registerDBPrunerConfig               pstorage.RegistersPrunerConfig
 }
 
 type PublicNetworkConfig struct {
@@ -223,11 +234,14 @@ func DefaultAccessNodeConfig() *AccessNodeConfig {
 				TxResultQueryMode:   backend.IndexQueryModeExecutionNodesOnly.String(), // default to ENs only for now
 			},
 			RestConfig: rest.Config{
-				ListenAddress:  "",
-				WriteTimeout:   rest.DefaultWriteTimeout,
-				ReadTimeout:    rest.DefaultReadTimeout,
-				IdleTimeout:    rest.DefaultIdleTimeout,
-				MaxRequestSize: commonrest.DefaultMaxRequestSize,
+				ListenAddress:   "",
+				WriteTimeout:    rest.DefaultWriteTimeout,
+				ReadTimeout:     rest.DefaultReadTimeout,
+				IdleTimeout:     rest.DefaultIdleTimeout,
+				MaxRequestSize:  commonrest.DefaultMaxRequestSize,
+				GraphQLConfig:   graphql.NewDefaultConfig(),
+				ClientKeySource: restmiddleware.ClientKeySourceIP,
+				ClientKeyHeader: restmiddleware.DefaultClientKeyHeader,
 			},
 			MaxMsgSize:                grpcutils.DefaultMaxMsgSize,
 			CompressorName:            grpcutils.NoCompressor,
@@ -257,6 +271,9 @@ func DefaultAccessNodeConfig() *AccessNodeConfig {
 		nodeInfoFile:                 "",
 		apiRatelimits:                nil,
 		apiBurstlimits:               nil,
+		restClientLimits:             ratelimit.ClientLimits{},
+		restRouteRateLimits:          nil,
+		restRouteBurstLimits:         nil,
 		TxResultCacheSize:            0,
 		PublicNetworkConfig: PublicNetworkConfig{
 			BindAddress: cmd.NotSet,
@@ -288,10 +305,13 @@ func DefaultAccessNodeConfig() *AccessNodeConfig {
 		registerCacheSize:                    0,
 		programCacheSize:                     0,
 		checkPayerBalanceMode:                txvalidator.Disabled.String(),
+		checkTxAuthorization:                 false,
+		queryPendingTxStatus:                 false,
 		versionControlEnabled:                true,
 		storeTxResultErrorMessages:           false,
 		stopControlEnabled:                   false,
 		registerDBPruneThreshold:             0,
+		registerDBPrunerConfig:               pstorage.DefaultRegistersPrunerConfig,
 	}
 }
 
@@ -308,6 +328,7 @@ type FlowAccessNodeBuilder struct {
 	RpcEng                       *rpc.Engine
 	FollowerDistributor          *consensuspubsub.FollowerDistributor
 	CollectionRPC                access.AccessAPIClient
+	CollectionPendingTxRPC       pendingtx.PendingTransactionsAPIClient
 	TransactionTimings           *stdmap.TransactionTimings
 	CollectionsToMarkFinalized   *stdmap.Times
 	CollectionsToMarkExecuted    *stdmap.Times
@@ -336,6 +357,8 @@ type FlowAccessNodeBuilder struct {
 	Reporter                     *index.Reporter
 	EventsIndex                  *index.EventsIndex
 	TxResultsIndex               *index.TransactionResultsIndex
+	AccountTransactionsIndex     *index.AccountTransactionsIndex
+	ContractUpdatesIndex         *index.ContractUpdatesIndex
 	IndexerDependencies          *cmd.DependencyList
 	collectionExecutedMetric     module.CollectionExecutedMetric
 	ExecutionDataPruner          *pruner.Pruner
@@ -347,6 +370,8 @@ type FlowAccessNodeBuilder struct {
 	// storage
 	events                         storage.Events
 	lightTransactionResults        storage.LightTransactionResults
+	accountTransactions            storage.AccountTransactions
+	contractUpdates                storage.ContractUpdates
 	transactionResultErrorMessages storage.TransactionResultErrorMessages
 
 	// The sync engine participants provider is the libp2p peer store for the access node
@@ -368,6 +393,8 @@ type FlowAccessNodeBuilder struct {
 
 	stateStreamBackend *statestreambackend.StateStreamBackend
 	nodeBackend        *backend.Backend
+	nodeScorer         *backend.NodeScorer
+	restRateLimiter    *ratelimit.ClientLimiter
 
 	ExecNodeIdentitiesProvider *commonrpc.ExecutionNodeIdentitiesProvider
 	TxResultErrorMessagesCore  *tx_error_messages.TxErrorMessagesCore
@@ -570,6 +597,11 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 	requesterDependable := module.NewProxiedReadyDoneAware()
 	builder.IndexerDependencies.Add(requesterDependable)
 
+	// setup dependency chain to ensure the register db pruner starts after the registers are bootstrapped
+	var registers *pstorage.Registers
+	indexerDependable := module.NewProxiedReadyDoneAware()
+	registersPrunerDependencies := cmd.NewDependencyList(indexerDependable)
+
 	executionDataPrunerEnabled := builder.executionDataPrunerHeightRangeTarget != 0
 
 	builder.
@@ -926,7 +958,7 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 					}
 				}
 
-				registers, err := pstorage.NewRegisters(pdb, builder.registerDBPruneThreshold)
+				registers, err = pstorage.NewRegisters(pdb, builder.registerDBPruneThreshold)
 				if err != nil {
 					return nil, fmt.Errorf("could not create registers storage: %w", err)
 				}
@@ -961,6 +993,8 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 					builder.Storage.Collections,
 					builder.Storage.Transactions,
 					builder.lightTransactionResults,
+					builder.accountTransactions,
+					builder.contractUpdates,
 					builder.RootChainID.Chain(),
 					indexerDerivedChainData,
 					builder.collectionExecutedMetric,
@@ -1024,8 +1058,23 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 					builder.StopControl.RegisterHeightRecorder(builder.ExecutionIndexer)
 				}
 
+				indexerDependable.Init(builder.ExecutionIndexer)
+
 				return builder.ExecutionIndexer, nil
-			}, builder.IndexerDependencies)
+			}, builder.IndexerDependencies).
+			DependableComponent("register db pruner", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+				err := node.ConfigManager.RegisterUintConfig("registerdb-pruning-threshold",
+					func() uint { return uint(registers.PruneThreshold()) },
+					func(threshold uint) error {
+						registers.SetPruneThreshold(uint64(threshold))
+						return nil
+					})
+				if err != nil {
+					return nil, err
+				}
+
+				return pstorage.NewRegistersPruner(node.Logger, registers, builder.registerDBPrunerConfig)
+			}, registersPrunerDependencies)
 	}
 
 	if builder.stateStreamConf.ListenAddr != "" {
@@ -1204,6 +1253,50 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 			"rest-max-request-size",
 			defaultConfig.rpcConf.RestConfig.MaxRequestSize,
 			"the maximum request size in bytes for payload sent over REST server")
+		flags.BoolVar(&builder.rpcConf.RestConfig.GraphQLConfig.Enabled,
+			"rest-graphql-enabled",
+			defaultConfig.rpcConf.RestConfig.GraphQLConfig.Enabled,
+			"whether to serve the GraphQL endpoint on the REST server")
+		flags.IntVar(&builder.rpcConf.RestConfig.GraphQLConfig.MaxQueryDepth,
+			"rest-graphql-max-query-depth",
+			defaultConfig.rpcConf.RestConfig.GraphQLConfig.MaxQueryDepth,
+			"maximum depth of nested selections in a GraphQL query")
+		flags.Uint64Var(&builder.rpcConf.RestConfig.GraphQLConfig.MaxQueryCost,
+			"rest-graphql-max-query-cost",
+			defaultConfig.rpcConf.RestConfig.GraphQLConfig.MaxQueryCost,
+			"maximum cost of a GraphQL query, where each Access API call made while resolving the query costs 1")
+		flags.StringVar(&builder.rpcConf.RestConfig.ClientKeySource,
+			"rest-client-key-source",
+			defaultConfig.rpcConf.RestConfig.ClientKeySource,
+			"source used to identify REST clients for rate limiting: ip, header (API key header with one of rest-client-api-keys, falls back to ip) or mtls (TLS client certificate, falls back to ip)")
+		flags.StringVar(&builder.rpcConf.RestConfig.ClientKeyHeader,
+			"rest-client-key-header",
+			defaultConfig.rpcConf.RestConfig.ClientKeyHeader,
+			"API key header used to identify REST clients if rest-client-key-source is header")
+		flags.StringSliceVar(&builder.rpcConf.RestConfig.ClientAPIKeys,
+			"rest-client-api-keys",
+			defaultConfig.rpcConf.RestConfig.ClientAPIKeys,
+			"comma separated list of API keys used to identify REST clients if rest-client-key-source is header. requests with other keys are identified by their IP address")
+		flags.UintVar(&builder.restClientLimits.Default.Rate,
+			"rest-client-rate-limit",
+			defaultConfig.restClientLimits.Default.Rate,
+			"per second rate limit for each REST client, shared by all routes without a route specific limit. 0 means unlimited")
+		flags.UintVar(&builder.restClientLimits.Default.Burst,
+			"rest-client-burst-limit",
+			defaultConfig.restClientLimits.Default.Burst,
+			"burst limit for each REST client, shared by all routes without a route specific limit. defaults to rest-client-rate-limit if 0")
+		flags.StringToIntVar(&builder.restRouteRateLimits,
+			"rest-client-route-rate-limits",
+			defaultConfig.restRouteRateLimits,
+			"per second rate limits for each REST client on specific routes e.g. getBlocksByHeight=10,getAccount=20. 0 means unlimited")
+		flags.StringToIntVar(&builder.restRouteBurstLimits,
+			"rest-client-route-burst-limits",
+			defaultConfig.restRouteBurstLimits,
+			"burst limits for each REST client on specific routes e.g. getBlocksByHeight=20. defaults to the route rate limit")
+		flags.Uint64Var(&builder.restClientLimits.DailyQuota,
+			"rest-client-daily-quota",
+			defaultConfig.restClientLimits.DailyQuota,
+			"maximum number of requests each REST client can make per UTC day across all routes. 0 means unlimited")
 		flags.StringVarP(&builder.rpcConf.CollectionAddr,
 			"static-collection-ingress-addr",
 			"",
@@ -1293,6 +1386,14 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 			"circuit-breaker-max-requests",
 			defaultConfig.rpcConf.BackendConfig.CircuitBreakerConfig.MaxRequests,
 			"maximum number of requests to check if connection restored after timeout. Default value is 1")
+		flags.BoolVar(&builder.rpcConf.BackendConfig.NodeScoringEnabled,
+			"execution-node-scoring-enabled",
+			defaultConfig.rpcConf.BackendConfig.NodeScoringEnabled,
+			"whether execution nodes are selected by their request latency, error rate and executed height, instead of randomly. Default value is false")
+		flags.DurationVar(&builder.rpcConf.BackendConfig.HedgeDelay,
+			"execution-node-hedge-delay",
+			defaultConfig.rpcConf.BackendConfig.HedgeDelay,
+			"delay after which script and account requests are also sent to the next execution node. requires execution-node-scoring-enabled. 0 disables hedged requests. Default value is 0")
 		flags.BoolVar(&builder.versionControlEnabled,
 			"version-control-enabled",
 			defaultConfig.versionControlEnabled,
@@ -1452,12 +1553,36 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 			"check-payer-balance-mode",
 			defaultConfig.checkPayerBalanceMode,
 			"flag for payer balance validation that specifies whether or not to enforce the balance check. one of [disabled(default), warn, enforce]")
+		flags.BoolVar(&builder.checkTxAuthorization,
+			"check-transaction-authorization",
+			defaultConfig.checkTxAuthorization,
+			"whether to verify the signatures, key weights and proposal key sequence number of sent transactions against the locally indexed state before forwarding them to collection nodes")
+		flags.BoolVar(&builder.queryPendingTxStatus,
+			"query-pending-transaction-status",
+			defaultConfig.queryPendingTxStatus,
+			"whether to query the collection nodes of the responsible cluster for the status of pending transactions when getting transaction results")
 
 		// Register DB Pruning
 		flags.Uint64Var(&builder.registerDBPruneThreshold,
 			"registerdb-pruning-threshold",
 			defaultConfig.registerDBPruneThreshold,
 			fmt.Sprintf("specifies the number of blocks below the latest stored block height to keep in register db. default: %d", defaultConfig.registerDBPruneThreshold))
+		flags.UintVar(&builder.registerDBPrunerConfig.BatchSize,
+			"registerdb-pruning-batch-size",
+			defaultConfig.registerDBPrunerConfig.BatchSize,
+			"number of register values deleted in one batch when pruning the register db")
+		flags.UintVar(&builder.registerDBPrunerConfig.ScanLimit,
+			"registerdb-pruning-scan-limit",
+			defaultConfig.registerDBPrunerConfig.ScanLimit,
+			"number of register values scanned in one pruning iteration of the register db. the next iteration continues where the previous one stopped")
+		flags.DurationVar(&builder.registerDBPrunerConfig.SleepAfterEachBatchCommit,
+			"registerdb-pruning-sleep-after-commit",
+			defaultConfig.registerDBPrunerConfig.SleepAfterEachBatchCommit,
+			"sleep time after each batch commit when pruning the register db")
+		flags.DurationVar(&builder.registerDBPrunerConfig.PruningInterval,
+			"registerdb-pruning-interval",
+			defaultConfig.registerDBPrunerConfig.PruningInterval,
+			"interval between two pruning iterations of the register db. 0 disables pruning of the register db")
 
 		// websockets config
 		flags.DurationVar(
@@ -1543,14 +1668,45 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 				return errors.New("circuit-breaker-restore-timeout must be greater than 0")
 			}
 		}
+		if _, err := restmiddleware.NewClientKeyFunc(builder.rpcConf.RestConfig.ClientKeySource, builder.rpcConf.RestConfig.ClientKeyHeader, builder.rpcConf.RestConfig.ClientAPIKeys); err != nil {
+			return fmt.Errorf("invalid rest-client-key-source: %w", err)
+		}
+		for route, limit := range builder.restRouteRateLimits {
+			if limit < 0 {
+				return fmt.Errorf("rest-client-route-rate-limits for %s must be greater than or equal to 0", route)
+			}
+		}
+		for route, limit := range builder.restRouteBurstLimits {
+			if limit < 0 {
+				return fmt.Errorf("rest-client-route-burst-limits for %s must be greater than or equal to 0", route)
+			}
+			if _, ok := builder.restRouteRateLimits[route]; !ok {
+				return fmt.Errorf("rest-client-route-burst-limits for %s requires a rest-client-route-rate-limits for the route", route)
+			}
+		}
+		if builder.rpcConf.BackendConfig.HedgeDelay < 0 {
+			return errors.New("execution-node-hedge-delay must be greater than or equal to 0")
+		}
+		if builder.rpcConf.BackendConfig.HedgeDelay > 0 && !builder.rpcConf.BackendConfig.NodeScoringEnabled {
+			return errors.New("execution-node-scoring-enabled must be set if execution-node-hedge-delay is set")
+		}
 
 		if builder.checkPayerBalanceMode != txvalidator.Disabled.String() && !builder.executionDataIndexingEnabled {
 			return errors.New("execution-data-indexing-enabled must be set if check-payer-balance is enabled")
 		}
+		if builder.checkTxAuthorization && !builder.executionDataIndexingEnabled {
+			return errors.New("execution-data-indexing-enabled must be set if check-transaction-authorization is enabled")
+		}
 
 		if builder.rpcConf.RestConfig.MaxRequestSize <= 0 {
 			return errors.New("rest-max-request-size must be greater than 0")
 		}
+		if builder.rpcConf.RestConfig.GraphQLConfig.MaxQueryDepth <= 0 {
+			return errors.New("rest-graphql-max-query-depth must be greater than 0")
+		}
+		if builder.rpcConf.RestConfig.GraphQLConfig.MaxQueryCost == 0 {
+			return errors.New("rest-graphql-max-query-cost must be greater than 0")
+		}
 
 		return nil
 	})
@@ -1622,6 +1778,12 @@ func (builder *FlowAccessNodeBuilder) Initialize() error {
 		return storageCommands.NewGetTransactionsCommand(conf.State, conf.Storage.Payloads, conf.Storage.Collections)
 	})
 
+	if builder.rpcConf.BackendConfig.NodeScoringEnabled {
+		builder.AdminCommand("get-execution-node-scores", func(conf *cmd.NodeConfig) commands.AdminCommand {
+			return accessCommands.NewGetExecutionNodeScoresCommand(builder.nodeScorer)
+		})
+	}
+
 	// if this is an access node that supports public followers, enqueue the public network
 	if builder.supportsObserver {
 		builder.enqueuePublicNetworkInit()
@@ -1696,6 +1858,7 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 				return err
 			}
 			builder.CollectionRPC = access.NewAccessAPIClient(collectionRPCConn)
+			builder.CollectionPendingTxRPC = pendingtx.NewPendingTransactionsAPIClient(collectionRPCConn)
 			return nil
 		}).
 		Module("historical access node clients", func(node *cmd.NodeConfig) error {
@@ -1797,6 +1960,35 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 			builder.PingMetrics = metrics.NewPingCollector()
 			return nil
 		}).
+		Module("rest rate limiter", func(node *cmd.NodeConfig) error {
+			limits := builder.restClientLimits
+			limits.Routes = make(map[string]ratelimit.ClientLimit, len(builder.restRouteRateLimits))
+			for route, limit := range builder.restRouteRateLimits {
+				limits.Routes[route] = ratelimit.ClientLimit{
+					Rate:  uint(limit),
+					Burst: uint(builder.restRouteBurstLimits[route]),
+				}
+			}
+
+			var err error
+			builder.restRateLimiter, err = ratelimit.NewClientLimiter(limits, ratelimit.DefaultMaxTrackedClients)
+			if err != nil {
+				return fmt.Errorf("could not create REST rate limiter: %w", err)
+			}
+
+			// register the limits for dynamic configuration via admin command
+			err = ratelimit.RegisterClientLimitConfigs(node.ConfigManager, builder.restRateLimiter)
+			if err != nil {
+				return fmt.Errorf("failed to register REST rate limits with config manager: %w", err)
+			}
+			return nil
+		}).
+		Module("execution node scorer", func(node *cmd.NodeConfig) error {
+			if builder.rpcConf.BackendConfig.NodeScoringEnabled {
+				builder.nodeScorer = backend.NewNodeScorer(node.Logger, node.Storage.Headers, metrics.NewNodeScoreCollector())
+			}
+			return nil
+		}).
 		Module("server certificate", func(node *cmd.NodeConfig) error {
 			// generate the server certificate that will be served by the GRPC server
 			x509Certificate, err := grpcutils.X509Certificate(node.NetworkKey)
@@ -1851,6 +2043,14 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 			builder.events = store.NewEvents(node.Metrics.Cache, node.ProtocolDB)
 			return nil
 		}).
+		Module("account transactions storage", func(node *cmd.NodeConfig) error {
+			builder.accountTransactions = store.NewAccountTransactions(node.ProtocolDB)
+			return nil
+		}).
+		Module("contract updates storage", func(node *cmd.NodeConfig) error {
+			builder.contractUpdates = store.NewContractUpdates(node.ProtocolDB)
+			return nil
+		}).
 		Module("reporter", func(node *cmd.NodeConfig) error {
 			builder.Reporter = index.NewReporter()
 			return nil
@@ -1863,6 +2063,14 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 			builder.TxResultsIndex = index.NewTransactionResultsIndex(builder.Reporter, builder.lightTransactionResults)
 			return nil
 		}).
+		Module("account transactions index", func(node *cmd.NodeConfig) error {
+			builder.AccountTransactionsIndex = index.NewAccountTransactionsIndex(builder.Reporter, builder.accountTransactions)
+			return nil
+		}).
+		Module("contract updates index", func(node *cmd.NodeConfig) error {
+			builder.ContractUpdatesIndex = index.NewContractUpdatesIndex(builder.Reporter, builder.contractUpdates)
+			return nil
+		}).
 		Module("processed finalized block height consumer progress", func(node *cmd.NodeConfig) error {
 			processedFinalizedBlockHeight = store.NewConsumerProgress(builder.ProtocolDB, module.ConsumeProgressIngestionEngineBlockHeight)
 			return nil
@@ -2034,31 +2242,43 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 				fixedENIdentifiers,
 			)
 
+			var communicator backend.Communicator = backend.NewNodeCommunicator(backendConfig.CircuitBreakerConfig.Enabled)
+			if builder.nodeScorer != nil {
+				communicator = backend.NewScoringNodeCommunicator(
+					backendConfig.CircuitBreakerConfig.Enabled,
+					builder.nodeScorer,
+					backendConfig.HedgeDelay,
+				)
+			}
+
 			builder.nodeBackend, err = backend.New(backend.Params{
-				State:                 node.State,
-				CollectionRPC:         builder.CollectionRPC,
-				HistoricalAccessNodes: builder.HistoricalAccessRPCs,
-				Blocks:                node.Storage.Blocks,
-				Headers:               node.Storage.Headers,
-				Collections:           node.Storage.Collections,
-				Transactions:          node.Storage.Transactions,
-				ExecutionReceipts:     node.Storage.Receipts,
-				ExecutionResults:      node.Storage.Results,
-				TxResultErrorMessages: builder.transactionResultErrorMessages,
-				ChainID:               node.RootChainID,
-				AccessMetrics:         builder.AccessMetrics,
-				ConnFactory:           connFactory,
-				RetryEnabled:          builder.retryEnabled,
-				MaxHeightRange:        backendConfig.MaxHeightRange,
-				Log:                   node.Logger,
-				SnapshotHistoryLimit:  backend.DefaultSnapshotHistoryLimit,
-				Communicator:          backend.NewNodeCommunicator(backendConfig.CircuitBreakerConfig.Enabled),
-				TxResultCacheSize:     builder.TxResultCacheSize,
-				ScriptExecutor:        builder.ScriptExecutor,
-				ScriptExecutionMode:   scriptExecMode,
-				CheckPayerBalanceMode: checkPayerBalanceMode,
-				EventQueryMode:        eventQueryMode,
-				BlockTracker:          blockTracker,
+				State:                  node.State,
+				CollectionRPC:          builder.CollectionRPC,
+				CollectionPendingTxRPC: builder.CollectionPendingTxRPC,
+				HistoricalAccessNodes:  builder.HistoricalAccessRPCs,
+				Blocks:                 node.Storage.Blocks,
+				Headers:                node.Storage.Headers,
+				Collections:            node.Storage.Collections,
+				Transactions:           node.Storage.Transactions,
+				ExecutionReceipts:      node.Storage.Receipts,
+				ExecutionResults:       node.Storage.Results,
+				TxResultErrorMessages:  builder.transactionResultErrorMessages,
+				ChainID:                node.RootChainID,
+				AccessMetrics:          builder.AccessMetrics,
+				ConnFactory:            connFactory,
+				RetryEnabled:           builder.retryEnabled,
+				MaxHeightRange:         backendConfig.MaxHeightRange,
+				Log:                    node.Logger,
+				SnapshotHistoryLimit:   backend.DefaultSnapshotHistoryLimit,
+				Communicator:           communicator,
+				TxResultCacheSize:      builder.TxResultCacheSize,
+				ScriptExecutor:         builder.ScriptExecutor,
+				ScriptExecutionMode:    scriptExecMode,
+				CheckPayerBalanceMode:  checkPayerBalanceMode,
+				CheckTxAuthorization:   builder.checkTxAuthorization,
+				QueryPendingTxStatus:   builder.queryPendingTxStatus,
+				EventQueryMode:         eventQueryMode,
+				BlockTracker:           blockTracker,
 				SubscriptionHandler: subscription.NewSubscriptionHandler(
 					builder.Logger,
 					broadcaster,
@@ -2069,6 +2289,9 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 				EventsIndex:                builder.EventsIndex,
 				TxResultQueryMode:          txResultQueryMode,
 				TxResultsIndex:             builder.TxResultsIndex,
+				AccountTransactionsIndex:   builder.AccountTransactionsIndex,
+				ContractUpdatesIndex:       builder.ContractUpdatesIndex,
+				Registers:                  builder.RegistersAsyncStore,
 				LastFullBlockHeight:        lastFullBlockHeight,
 				IndexReporter:              indexReporter,
 				VersionControl:             builder.VersionControl,
@@ -2101,6 +2324,7 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 			builder.RpcEng, err = engineBuilder.
 				WithLegacy().
 				WithBlockSignerDecoder(signature.NewBlockSignerDecoder(builder.Committee)).
+				WithRestRateLimiter(builder.restRateLimiter).
 				Build()
 			if err != nil {
 				return nil, err
@@ -2157,6 +2381,9 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 			}
 			ingestionDependable.Init(builder.IngestEng)
 			builder.RequestEng.WithHandle(builder.IngestEng.OnCollection)
+			if builder.nodeScorer != nil {
+				builder.IngestEng.AddOnExecutionReceiptConsumer(builder.nodeScorer.OnExecutionReceipt)
+			}
 			builder.FollowerDistributor.AddOnBlockFinalizedConsumer(builder.IngestEng.OnFinalizedBlock)
 
 			return builder.IngestEng, nil
@@ -50,6 +50,7 @@ import (
 	epochpool "github.com/onflow/flow-go/module/mempool/epochs"
 	"github.com/onflow/flow-go/module/mempool/herocache"
 	"github.com/onflow/flow-go/module/mempool/queue"
+	"github.com/onflow/flow-go/module/mempool/stdmap"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/network/channels"
 	"github.com/onflow/flow-go/state/protocol"
@@ -64,6 +65,8 @@ func main() {
 
 	var (
 		txLimit                           uint
+		txPoolOrdering                    string
+		txPoolMaxPerPayer                 uint
 		maxCollectionSize                 uint
 		maxCollectionByteSize             uint64
 		maxCollectionTotalGas             uint64
@@ -86,7 +89,8 @@ func main() {
 		rpcConf                 rpc.Config
 		clusterComplianceConfig modulecompliance.Config
 
-		pools               *epochpool.TransactionPools // epoch-scoped transaction pools
+		pools               *epochpool.TransactionPools  // epoch-scoped transaction pools
+		rejectedTxs         *stdmap.RejectedTransactions // rejected and expired transactions, to report their status
 		followerDistributor *pubsub.FollowerDistributor
 		addressRateLimiter  *ingest.AddressRateLimiter
 
@@ -116,6 +120,11 @@ func main() {
 	nodeBuilder.ExtraFlags(func(flags *pflag.FlagSet) {
 		flags.UintVar(&txLimit, "tx-limit", 50_000,
 			"maximum number of transactions in the memory pool")
+		flags.StringVar(&txPoolOrdering, "tx-pool-ordering", stdmap.TransactionOrderingFIFO,
+			fmt.Sprintf("order in which transactions of the memory pool are included in collections. one of [%s(default), %s, %s, %s]",
+				stdmap.TransactionOrderingFIFO, stdmap.TransactionOrderingInclusionEffort, stdmap.TransactionOrderingGasLimit, stdmap.TransactionOrderingRoundRobin))
+		flags.UintVar(&txPoolMaxPerPayer, "tx-pool-max-per-payer", 0,
+			"maximum number of transactions of a single payer in the memory pool. when the memory pool is full, transactions of the payer with the most transactions are ejected first. 0 means no limit")
 		flags.StringVarP(&rpcConf.ListenAddr, "ingress-addr", "i", "localhost:9000",
 			"the address the ingress server listens on")
 		flags.UintVar(&rpcConf.MaxMsgSize, "rpc-max-message-size", grpcutils.DefaultMaxMsgSize,
@@ -249,7 +258,18 @@ func main() {
 			return err
 		}).
 		Module("transactions mempool", func(node *cmd.NodeConfig) error {
+			ordering, err := stdmap.ParseTransactionOrdering(txPoolOrdering)
+			if err != nil {
+				return fmt.Errorf("could not parse transaction pool ordering: %w", err)
+			}
+
 			create := func(epoch uint64) mempool.Transactions {
+				// the default configuration uses the HeroCache based mempool, which returns
+				// transactions in insertion order and ejects the least recently used transactions
+				if txPoolOrdering != stdmap.TransactionOrderingFIFO || txPoolMaxPerPayer > 0 {
+					return stdmap.NewOrderedTransactions(txLimit, txPoolMaxPerPayer, ordering, node.Logger)
+				}
+
 				var heroCacheMetricsCollector module.HeroCacheMetrics = metrics.NewNoopCollector()
 				if node.BaseConfig.HeroCacheMetricsEnable {
 					heroCacheMetricsCollector = metrics.CollectionNodeTransactionsCacheMetrics(node.MetricsRegisterer, epoch)
@@ -261,7 +281,12 @@ func main() {
 			}
 
 			pools = epochpool.NewTransactionPools(create)
-			err := node.Metrics.Mempool.Register(metrics.ResourceTransaction, pools.CombinedSize)
+			err = node.Metrics.Mempool.Register(metrics.ResourceTransaction, pools.CombinedSize)
+			if err != nil {
+				return err
+			}
+
+			rejectedTxs, err = stdmap.NewRejectedTransactions(stdmap.DefaultRejectedTransactionsLimit)
 			return err
 		}).
 		Module("machine account config", func(node *cmd.NodeConfig) error {
@@ -431,6 +456,7 @@ func main() {
 				node.Me,
 				node.RootChainID.Chain(),
 				pools,
+				rejectedTxs,
 				ingestConf,
 				addressRateLimiter,
 			)
@@ -516,6 +542,7 @@ func main() {
 				builder.WithRateLimitDryRun(builderPayerRateLimitDryRun),
 				builder.WithMaxPayerTransactionRate(builderPayerRateLimit),
 				builder.WithUnlimitedPayers(unlimitedPayers...),
+				builder.WithRejectedTransactions(rejectedTxs),
 			)
 			if err != nil {
 				return nil, err
@@ -625,6 +652,7 @@ func main() {
 				node.Me,
 				node.State,
 				pools,
+				rejectedTxs,
 				rootQCVoter,
 				factory,
 				heightEvents,
@@ -68,6 +68,7 @@ import (
 	ledgerpkg "github.com/onflow/flow-go/ledger"
 	"github.com/onflow/flow-go/ledger/common/pathfinder"
 	ledger "github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/shipping"
 	"github.com/onflow/flow-go/ledger/complete/wal"
 	bootstrapFilenames "github.com/onflow/flow-go/model/bootstrap"
 	modelbootstrap "github.com/onflow/flow-go/model/bootstrap"
@@ -138,6 +139,7 @@ type ExecutionNode struct {
 	committee      hotstuff.DynamicCommittee
 	ledgerStorage  *ledger.Ledger
 	registerStore  *storehouse.RegisterStore
+	registers      *storagepebble.Registers
 
 	// storage
 	events          storageerr.Events
@@ -162,14 +164,17 @@ type ExecutionNode struct {
 	followerCore           *hotstuff.FollowerLoop        // follower hotstuff logic
 	followerEng            *followereng.ComplianceEngine // to sync blocks from consensus nodes
 	computationManager     *computation.Manager
+	vmCtx                  fvm.Context
 	collectionRequester    ingestion.CollectionRequester
 	scriptsEng             *scripts.Engine
 	followerDistributor    *pubsub.FollowerDistributor
 	checkAuthorizedAtBlock func(blockID flow.Identifier) (bool, error)
 	diskWAL                *wal.DiskWAL
+	ledgerShipper          *shipping.Shipper
 	blockDataUploader      *uploader.Manager
 	executionDataStore     execution_data.ExecutionDataStore
 	toTriggerCheckpoint    *atomic.Bool      // create the checkpoint trigger to be controlled by admin tool, and listened by the compactor
+	triggeredCheckpoint    *atomic.Uint32    // kind of checkpoint requested by admin tool
 	stopControl            *stop.StopControl // stop the node at given block height
 	executionDataDatastore *badgerds.Datastore
 	executionDataPruner    *pruner.Pruner
@@ -186,6 +191,7 @@ func (builder *ExecutionNodeBuilder) LoadComponentsAndModules() {
 		builder:             builder.FlowNodeBuilder,
 		exeConf:             builder.exeConf,
 		toTriggerCheckpoint: atomic.NewBool(false),
+		triggeredCheckpoint: atomic.NewUint32(uint32(ledger.CheckpointKindDefault)),
 		ingestionUnit:       engine.NewUnit(),
 	}
 
@@ -194,7 +200,7 @@ func (builder *ExecutionNodeBuilder) LoadComponentsAndModules() {
 			return stateSyncCommands.NewReadExecutionDataCommand(exeNode.executionDataStore)
 		}).
 		AdminCommand("trigger-checkpoint", func(config *NodeConfig) commands.AdminCommand {
-			return executionCommands.NewTriggerCheckpointCommand(exeNode.toTriggerCheckpoint)
+			return executionCommands.NewTriggerCheckpointCommand(exeNode.toTriggerCheckpoint, exeNode.triggeredCheckpoint)
 		}).
 		AdminCommand("stop-at-height", func(config *NodeConfig) commands.AdminCommand {
 			return executionCommands.NewStopAtHeightCommand(exeNode.stopControl)
@@ -222,6 +228,7 @@ func (builder *ExecutionNodeBuilder) LoadComponentsAndModules() {
 		Module("execution data datastore", exeNode.LoadExecutionDataDatastore).
 		Module("execution data getter", exeNode.LoadExecutionDataGetter).
 		Module("blobservice peer manager dependencies", exeNode.LoadBlobservicePeerManagerDependencies).
+		Module("ledger restore", exeNode.LoadLedgerRestore).
 		Module("bootstrap", exeNode.LoadBootstrapper).
 		Module("register store", exeNode.LoadRegisterStore).
 		Module("migrate last executed block", exeNode.MigrateLastSealedExecutedResultToPebble).
@@ -243,6 +250,7 @@ func (builder *ExecutionNodeBuilder) LoadComponentsAndModules() {
 				"chunk_data_pack", exeNode.chunkDataPackDB)
 		}).
 		Component("stop control", exeNode.LoadStopControl).
+		Component("ledger shipper", exeNode.LoadLedgerShipper).
 		Component("execution state ledger WAL compactor", exeNode.LoadExecutionStateLedgerWALCompactor).
 		// disable execution data pruner for now, since storehouse is going to need the execution data
 		// for recovery,
@@ -250,6 +258,8 @@ func (builder *ExecutionNodeBuilder) LoadComponentsAndModules() {
 		// payloadless trie.
 		// Component("execution data pruner", exeNode.LoadExecutionDataPruner).
 		Component("execution db pruner", exeNode.LoadExecutionDBPruner).
+		Component("execution storage pruner", exeNode.LoadExecutionStoragePruner).
+		Component("register db pruner", exeNode.LoadRegisterDBPruner).
 		Component("blob service", exeNode.LoadBlobService).
 		Component("block data upload manager", exeNode.LoadBlockUploaderManager).
 		Component("GCP block data uploader", exeNode.LoadGCPBlockDataUploader).
@@ -594,6 +604,7 @@ func (exeNode *ExecutionNode) LoadProviderEngine(
 		exeNode.exeConf.computationConfig.CadenceTracing,
 		exeNode.exeConf.computationConfig.ExtensiveTracing)...)
 	vmCtx := fvm.NewContext(opts...)
+	exeNode.vmCtx = vmCtx
 
 	var collector module.ExecutionMetrics
 	collector = exeNode.collector
@@ -931,10 +942,11 @@ func (exeNode *ExecutionNode) LoadRegisterStore(
 			return fmt.Errorf("could not import registers from checkpoint: %w", err)
 		}
 	}
-	diskStore, err := storagepebble.NewRegisters(pebbledb, storagepebble.PruningDisabled)
+	diskStore, err := storagepebble.NewRegisters(pebbledb, exeNode.exeConf.registerDBPruneThreshold)
 	if err != nil {
 		return fmt.Errorf("could not create registers storage: %w", err)
 	}
+	exeNode.registers = diskStore
 
 	reader := finalizedreader.NewFinalizedReader(node.Storage.Headers, node.LastFinalizedHeader.Height)
 	node.ProtocolEvents.AddConsumer(reader)
@@ -984,6 +996,14 @@ func (exeNode *ExecutionNode) LoadExecutionStateLedgerWALCompactor(
 	module.ReadyDoneAware,
 	error,
 ) {
+	opts := []ledger.CompactorOption{
+		ledger.WithDeltaCheckpoints(exeNode.exeConf.maxDeltaCheckpoints),
+		ledger.WithTriggeredCheckpointKind(exeNode.triggeredCheckpoint),
+	}
+	if exeNode.ledgerShipper != nil {
+		opts = append(opts, ledger.WithWALShipper(exeNode.ledgerShipper))
+	}
+
 	return ledger.NewCompactor(
 		exeNode.ledgerStorage,
 		exeNode.diskWAL,
@@ -993,9 +1013,87 @@ func (exeNode *ExecutionNode) LoadExecutionStateLedgerWALCompactor(
 		exeNode.exeConf.checkpointsToKeep,
 		exeNode.toTriggerCheckpoint, // compactor will listen to the signal from admin tool for force triggering checkpointing
 		exeNode.collector,
+		opts...,
 	)
 }
 
+// ledgerObjectStore returns the object store the ledger checkpoints and WAL segments are shipped to.
+func (exeNode *ExecutionNode) ledgerObjectStore(ctx context.Context) (*shipping.S3ObjectStore, error) {
+	config, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+
+	client := s3.NewFromConfig(config, func(o *s3.Options) {
+		if exeNode.exeConf.ledgerShippingS3Endpoint != "" {
+			// S3-compatible stores, such as MinIO, are usually served with path-style addressing
+			o.EndpointResolver = s3.EndpointResolverFromURL(exeNode.exeConf.ledgerShippingS3Endpoint)
+			o.UsePathStyle = true
+		}
+	})
+
+	return shipping.NewS3ObjectStore(client, exeNode.exeConf.ledgerShippingS3Bucket, exeNode.exeConf.ledgerShippingS3Prefix), nil
+}
+
+// LoadLedgerRestore restores the trie directory from the latest shipped checkpoint and WAL segments,
+// if enabled and the trie directory has no checkpoint nor segment yet.
+func (exeNode *ExecutionNode) LoadLedgerRestore(node *NodeConfig) error {
+	if !exeNode.exeConf.ledgerRestoreFromShipping {
+		return nil
+	}
+	if exeNode.exeConf.ledgerShippingS3Bucket == "" {
+		return fmt.Errorf("--ledger-restore-from-shipping requires --ledger-shipping-s3-bucket to be set")
+	}
+
+	err := os.MkdirAll(exeNode.exeConf.triedir, 0700)
+	if err != nil {
+		return fmt.Errorf("could not create trie dir: %w", err)
+	}
+
+	ctx := context.Background()
+	store, err := exeNode.ledgerObjectStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger := node.Logger.With().Str("module", "ledger_restore").Logger()
+	checkpointNum, lastSegment, err := shipping.Restore(ctx, logger, store, exeNode.exeConf.triedir)
+	if errors.Is(err, shipping.ErrDirNotEmpty) {
+		logger.Info().Msg("trie dir is not empty, skip restoring the ledger from the shipped files")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not restore ledger from shipped files: %w", err)
+	}
+
+	logger.Info().
+		Int("checkpoint", checkpointNum).
+		Int("last_segment", lastSegment).
+		Msg("ledger restored from shipped files")
+
+	return nil
+}
+
+func (exeNode *ExecutionNode) LoadLedgerShipper(
+	node *NodeConfig,
+) (
+	module.ReadyDoneAware,
+	error,
+) {
+	if exeNode.exeConf.ledgerShippingS3Bucket == "" {
+		// ledgerShipper stays nil and the compactor doesn't ship any file
+		return &module.NoopReadyDoneAware{}, nil
+	}
+
+	store, err := exeNode.ledgerObjectStore(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	exeNode.ledgerShipper = shipping.NewShipper(node.Logger, store, exeNode.exeConf.triedir, shipping.DefaultRetryInterval)
+	return exeNode.ledgerShipper, nil
+}
+
 func (exeNode *ExecutionNode) LoadExecutionDataPruner(
 	node *NodeConfig,
 ) (
@@ -1065,6 +1163,70 @@ func (exeNode *ExecutionNode) LoadExecutionDBPruner(node *NodeConfig) (module.Re
 	), nil
 }
 
+// LoadExecutionStoragePruner creates the component pruning the data indexed by block in the protocol database,
+// each with its own threshold. The data with a threshold of 0 isn't pruned.
+func (exeNode *ExecutionNode) LoadExecutionStoragePruner(node *NodeConfig) (module.ReadyDoneAware, error) {
+	configWithThreshold := func(threshold uint64) exepruner.PruningConfig {
+		return exepruner.PruningConfig{
+			Threshold:                 threshold,
+			BatchSize:                 exeNode.exeConf.pruningConfigBatchSize,
+			SleepAfterEachBatchCommit: exeNode.exeConf.pruningConfigSleepAfterCommit,
+			SleepAfterEachIteration:   exeNode.exeConf.pruningConfigSleepAfterIteration,
+		}
+	}
+
+	db := node.ProtocolDB
+	var data []exepruner.PrunableData
+	if threshold := exeNode.exeConf.eventsPruningThreshold; threshold > 0 {
+		data = append(data, exepruner.NewEventsData(db, exeNode.events, configWithThreshold(threshold)))
+	}
+	if threshold := exeNode.exeConf.serviceEventsPruningThreshold; threshold > 0 {
+		data = append(data, exepruner.NewServiceEventsData(db, exeNode.serviceEvents, configWithThreshold(threshold)))
+	}
+	if threshold := exeNode.exeConf.transactionResultsPruningThreshold; threshold > 0 {
+		data = append(data, exepruner.NewTransactionResultsData(db, exeNode.txResults, configWithThreshold(threshold)))
+	}
+	if threshold := exeNode.exeConf.computationResultsPruningThreshold; threshold > 0 {
+		data = append(data, exepruner.NewComputationResultsData(db, configWithThreshold(threshold)))
+	}
+	if threshold := exeNode.exeConf.chunkLocatorsPruningThreshold; threshold > 0 {
+		data = append(data, exepruner.NewChunkLocatorsData(db, exeNode.results, configWithThreshold(threshold)))
+	}
+
+	if len(data) == 0 {
+		node.Logger.Info().Msg("execution storage pruner disabled")
+		return &module.NoopReadyDoneAware{}, nil
+	}
+
+	return exepruner.NewExecutionDataPruningEngine(
+		node.Logger,
+		exeNode.collector,
+		node.State,
+		node.ProtocolDB,
+		node.Storage.Headers,
+		data...,
+	), nil
+}
+
+func (exeNode *ExecutionNode) LoadRegisterDBPruner(node *NodeConfig) (module.ReadyDoneAware, error) {
+	if !exeNode.exeConf.enableStorehouse {
+		return &module.NoopReadyDoneAware{}, nil
+	}
+
+	registers := exeNode.registers
+	err := node.ConfigManager.RegisterUintConfig("registerdb-pruning-threshold",
+		func() uint { return uint(registers.PruneThreshold()) },
+		func(threshold uint) error {
+			registers.SetPruneThreshold(uint64(threshold))
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return storagepebble.NewRegistersPruner(node.Logger, registers, exeNode.exeConf.registerDBPrunerConfig)
+}
+
 func (exeNode *ExecutionNode) LoadCheckerEngine(
 	node *NodeConfig,
 ) (
@@ -1078,10 +1240,26 @@ func (exeNode *ExecutionNode) LoadCheckerEngine(
 
 	node.Logger.Info().Msgf("checker engine is enabled")
 
+	var forensics *checker.Forensics
+	if exeNode.exeConf.forensicsDir != "" {
+		forensics = checker.NewForensics(
+			node.Logger,
+			exeNode.exeConf.forensicsDir,
+			exeNode.computationManager.VM(),
+			exeNode.vmCtx,
+			node.State,
+			node.Storage.Blocks,
+			exeNode.collections,
+			exeNode.resultsReader,
+			exeNode.chunkDataPacks,
+		)
+	}
+
 	core := checker.NewCore(
 		node.Logger,
 		node.State,
 		exeNode.executionState,
+		forensics,
 	)
 	exeNode.checkerEng = checker.NewEngine(core)
 	return exeNode.checkerEng, nil
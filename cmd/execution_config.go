@@ -22,6 +22,7 @@ import (
 	"github.com/onflow/flow-go/engine/execution/rpc"
 	"github.com/onflow/flow-go/fvm/storage/derived"
 	storage "github.com/onflow/flow-go/storage/badger"
+	storagepebble "github.com/onflow/flow-go/storage/pebble"
 )
 
 // ExecutionConfig contains the configs for starting up execution nodes
@@ -34,6 +35,11 @@ type ExecutionConfig struct {
 	transactionResultsCacheSize           uint
 	checkpointDistance                    uint
 	checkpointsToKeep                     uint
+	maxDeltaCheckpoints                   uint
+	ledgerShippingS3Bucket                string
+	ledgerShippingS3Prefix                string
+	ledgerShippingS3Endpoint              string
+	ledgerRestoreFromShipping             bool
 	chunkDataPackDir                      string
 	chunkDataPackCheckpointsDir           string
 	chunkDataPackCacheSize                uint
@@ -70,12 +76,23 @@ type ExecutionConfig struct {
 	onflowOnlyLNs    bool
 	enableStorehouse bool
 	enableChecker    bool
+	forensicsDir     string
 	publicAccessID   string
 
 	pruningConfigThreshold           uint64
 	pruningConfigBatchSize           uint
 	pruningConfigSleepAfterCommit    time.Duration
 	pruningConfigSleepAfterIteration time.Duration
+
+	// thresholds of the data pruned in addition to chunk data packs, 0 disables pruning of the data
+	eventsPruningThreshold             uint64
+	serviceEventsPruningThreshold      uint64
+	transactionResultsPruningThreshold uint64
+	computationResultsPruningThreshold uint64
+	chunkLocatorsPruningThreshold      uint64
+
+	registerDBPruneThreshold uint64
+	registerDBPrunerConfig   storagepebble.RegistersPrunerConfig
 }
 
 func (exeConf *ExecutionConfig) SetupFlags(flags *pflag.FlagSet) {
@@ -90,6 +107,11 @@ func (exeConf *ExecutionConfig) SetupFlags(flags *pflag.FlagSet) {
 	flags.Uint32Var(&exeConf.mTrieCacheSize, "mtrie-cache-size", 500, "cache size for MTrie")
 	flags.UintVar(&exeConf.checkpointDistance, "checkpoint-distance", 20, "number of WAL segments between checkpoints")
 	flags.UintVar(&exeConf.checkpointsToKeep, "checkpoints-to-keep", 5, "number of recent checkpoints to keep (0 to keep all)")
+	flags.UintVar(&exeConf.maxDeltaCheckpoints, "checkpoint-max-deltas", 0, "number of delta checkpoints to create between full checkpoints (0 to only create full checkpoints)")
+	flags.StringVar(&exeConf.ledgerShippingS3Bucket, "ledger-shipping-s3-bucket", "", "S3 bucket to ship the ledger checkpoints and WAL segments to (empty to disable shipping)")
+	flags.StringVar(&exeConf.ledgerShippingS3Prefix, "ledger-shipping-s3-prefix", "", "prefix of the shipped ledger files in the S3 bucket")
+	flags.StringVar(&exeConf.ledgerShippingS3Endpoint, "ledger-shipping-s3-endpoint", "", "URL of an S3-compatible endpoint, such as a MinIO server (empty to use AWS S3)")
+	flags.BoolVar(&exeConf.ledgerRestoreFromShipping, "ledger-restore-from-shipping", false, "restore the ledger from the latest shipped checkpoint and WAL segments when the trie directory is empty")
 	flags.UintVar(&exeConf.computationConfig.DerivedDataCacheSize, "cadence-execution-cache", derived.DefaultDerivedDataCacheSize,
 		"cache size for Cadence execution")
 	flags.BoolVar(&exeConf.computationConfig.ExtensiveTracing, "extensive-tracing", false, "adds high-overhead tracing to execution")
@@ -133,6 +155,7 @@ func (exeConf *ExecutionConfig) SetupFlags(flags *pflag.FlagSet) {
 	flags.BoolVar(&exeConf.onflowOnlyLNs, "temp-onflow-only-lns", false, "do not use unless required. forces node to only request collections from onflow collection nodes")
 	flags.BoolVar(&exeConf.enableStorehouse, "enable-storehouse", false, "enable storehouse to store registers on disk, default is false")
 	flags.BoolVar(&exeConf.enableChecker, "enable-checker", true, "enable checker to check the correctness of the execution result, default is true")
+	flags.StringVar(&exeConf.forensicsDir, "checker-forensics-dir", "", "directory to write a forensic bundle to when the checker detects an execution result mismatch, the bundle is not collected if empty")
 	// deprecated. Retain it to prevent nodes that previously had this configuration from crashing.
 	var deprecatedEnableNewIngestionEngine bool
 	flags.BoolVar(&deprecatedEnableNewIngestionEngine, "enable-new-ingestion-engine", true, "enable new ingestion engine, default is true")
@@ -142,6 +165,16 @@ func (exeConf *ExecutionConfig) SetupFlags(flags *pflag.FlagSet) {
 	flags.UintVar(&exeConf.pruningConfigBatchSize, "pruning-config-batch-size", exepruner.DefaultConfig.BatchSize, "the batch size is the number of blocks that we want to delete in one batch, default 1200")
 	flags.DurationVar(&exeConf.pruningConfigSleepAfterCommit, "pruning-config-sleep-after-commit", exepruner.DefaultConfig.SleepAfterEachBatchCommit, "sleep time after each batch commit, default 1s")
 	flags.DurationVar(&exeConf.pruningConfigSleepAfterIteration, "pruning-config-sleep-after-iteration", exepruner.DefaultConfig.SleepAfterEachIteration, "sleep time after each iteration, default max int64")
+	flags.Uint64Var(&exeConf.eventsPruningThreshold, "pruning-config-events-threshold", 0, "the number of blocks for which events are kept in the database, 0 disables pruning of events, default 0")
+	flags.Uint64Var(&exeConf.serviceEventsPruningThreshold, "pruning-config-service-events-threshold", 0, "the number of blocks for which service events are kept in the database, 0 disables pruning of service events, default 0")
+	flags.Uint64Var(&exeConf.transactionResultsPruningThreshold, "pruning-config-transaction-results-threshold", 0, "the number of blocks for which transaction results are kept in the database, 0 disables pruning of transaction results, default 0")
+	flags.Uint64Var(&exeConf.computationResultsPruningThreshold, "pruning-config-computation-results-threshold", 0, "the number of blocks for which computation result upload statuses are kept in the database, 0 disables pruning of computation results, default 0")
+	flags.Uint64Var(&exeConf.chunkLocatorsPruningThreshold, "pruning-config-chunk-locators-threshold", 0, "the number of blocks for which chunk locators are kept in the database, 0 disables pruning of chunk locators, default 0")
+
+	flags.Uint64Var(&exeConf.registerDBPruneThreshold, "registerdb-pruning-threshold", 0, "the number of blocks below the latest stored block height to keep in register db when storehouse is enabled, 0 disables pruning, default 0")
+	flags.UintVar(&exeConf.registerDBPrunerConfig.BatchSize, "registerdb-pruning-batch-size", storagepebble.DefaultRegistersPrunerConfig.BatchSize, "number of register values deleted in one batch when pruning the register db")
+	flags.DurationVar(&exeConf.registerDBPrunerConfig.SleepAfterEachBatchCommit, "registerdb-pruning-sleep-after-commit", storagepebble.DefaultRegistersPrunerConfig.SleepAfterEachBatchCommit, "sleep time after each batch commit when pruning the register db")
+	flags.DurationVar(&exeConf.registerDBPrunerConfig.PruningInterval, "registerdb-pruning-interval", storagepebble.DefaultRegistersPrunerConfig.PruningInterval, "interval between two pruning iterations of the register db, 0 disables pruning of the register db")
 }
 
 func (exeConf *ExecutionConfig) ValidateFlags() error {
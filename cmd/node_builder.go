@@ -23,8 +23,11 @@ import (
 	"github.com/onflow/flow-go/module/profiler"
 	"github.com/onflow/flow-go/module/updatable_configs"
 	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/alsp"
 	"github.com/onflow/flow-go/network/codec/cbor"
 	"github.com/onflow/flow-go/network/p2p"
+	"github.com/onflow/flow-go/network/p2p/introspection"
+	"github.com/onflow/flow-go/network/recorder"
 	"github.com/onflow/flow-go/state/protocol"
 	"github.com/onflow/flow-go/state/protocol/events"
 	"github.com/onflow/flow-go/storage"
@@ -189,6 +192,18 @@ type BaseConfig struct {
 	// BitswapReprovideEnabled configures whether the Bitswap reprovide mechanism is enabled.
 	// This is only meaningful to Access and Execution nodes.
 	BitswapReprovideEnabled bool
+
+	// GossipSubIntrospectionAddr is the address to bind on for the http debug server that serves the live GossipSub
+	// snapshot of the node. The server is disabled if it is empty.
+	GossipSubIntrospectionAddr string
+
+	// NetworkRecorderDir is the directory the messages received and sent by the engines are recorded to.
+	// Recording is disabled if it is empty.
+	NetworkRecorderDir string
+	// NetworkRecorderMaxFileSize is the size in bytes after which a recording file is rotated.
+	NetworkRecorderMaxFileSize int64
+	// NetworkRecorderMaxFiles is the number of recording files kept in the recording directory.
+	NetworkRecorderMaxFiles int
 }
 
 // NodeConfig contains all the derived parameters such the NodeID, private keys etc. and initialized instances of
@@ -214,6 +229,7 @@ type NodeConfig struct {
 	Resolver          madns.BasicResolver
 	EngineRegistry    network.EngineRegistry
 	NetworkUnderlay   network.Underlay
+	SpamRecordAdmin   alsp.SpamRecordAdmin
 	ConduitFactory    network.ConduitFactory
 	PingService       network.PingService
 	MsgValidators     []network.MessageValidator
@@ -221,6 +237,9 @@ type NodeConfig struct {
 	StakingKey        crypto.PrivateKey
 	NetworkKey        crypto.PrivateKey
 
+	// GossipSubIntrospector takes the live snapshots of the GossipSub state of the node; nil if not available.
+	GossipSubIntrospector *introspection.Introspector
+
 	// list of dependencies for network peer manager startup
 	PeerManagerDependencies *DependencyList
 	// ReadyDoneAware implementation of the network middleware for DependableComponents
@@ -310,6 +329,12 @@ func DefaultBaseConfig() *BaseConfig {
 		ComplianceConfig:        compliance.DefaultConfig(),
 		DhtSystemEnabled:        true,
 		BitswapReprovideEnabled: true,
+
+		GossipSubIntrospectionAddr: "",
+
+		NetworkRecorderDir:         "",
+		NetworkRecorderMaxFileSize: recorder.DefaultMaxFileSize,
+		NetworkRecorderMaxFiles:    recorder.DefaultMaxFiles,
 	}
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/spf13/pflag"
 	"google.golang.org/grpc/credentials"
 
+	"github.com/onflow/flow-go/access/ratelimit"
 	"github.com/onflow/flow-go/admin/commands"
 	stateSyncCommands "github.com/onflow/flow-go/admin/commands/state_synchronization"
 	"github.com/onflow/flow-go/cmd"
@@ -42,6 +43,8 @@ import (
 	"github.com/onflow/flow-go/engine/access/rest"
 	restapiproxy "github.com/onflow/flow-go/engine/access/rest/apiproxy"
 	commonrest "github.com/onflow/flow-go/engine/access/rest/common"
+	restmiddleware "github.com/onflow/flow-go/engine/access/rest/common/middleware"
+	"github.com/onflow/flow-go/engine/access/rest/graphql"
 	"github.com/onflow/flow-go/engine/access/rest/router"
 	"github.com/onflow/flow-go/engine/access/rest/websockets"
 	"github.com/onflow/flow-go/engine/access/rpc"
@@ -138,6 +141,9 @@ type ObserverServiceConfig struct {
 	bootstrapIdentities                  flow.IdentitySkeletonList // the identity list of bootstrap peers the node uses to discover other nodes
 	apiRatelimits                        map[string]int
 	apiBurstlimits                       map[string]int
+	restClientLimits                     ratelimit.ClientLimits
+	restRouteRateLimits                  map[string]int
+	restRouteBurstLimits                 map[string]int
 	rpcConf                              rpc.Config
 	rpcMetricsEnabled                    bool
 	registersDBPath                      string
@@ -171,6 +177,7 @@ type ObserverServiceConfig struct {
 	registerCacheSize                    uint
 	programCacheSize                     uint
 	registerDBPruneThreshold             uint64
+	registerDBPrunerConfig               pstorage.RegistersPrunerConfig
 }
 
 // DefaultObserverServiceConfig defines all the default values for the ObserverServiceConfig
@@ -195,11 +202,14 @@ func DefaultObserverServiceConfig() *ObserverServiceConfig {
 				TxResultQueryMode:         backend.IndexQueryModeExecutionNodesOnly.String(), // default to ENs only for now
 			},
 			RestConfig: rest.Config{
-				ListenAddress:  "",
-				WriteTimeout:   rest.DefaultWriteTimeout,
-				ReadTimeout:    rest.DefaultReadTimeout,
-				IdleTimeout:    rest.DefaultIdleTimeout,
-				MaxRequestSize: commonrest.DefaultMaxRequestSize,
+				ListenAddress:   "",
+				WriteTimeout:    rest.DefaultWriteTimeout,
+				ReadTimeout:     rest.DefaultReadTimeout,
+				IdleTimeout:     rest.DefaultIdleTimeout,
+				MaxRequestSize:  commonrest.DefaultMaxRequestSize,
+				GraphQLConfig:   graphql.NewDefaultConfig(),
+				ClientKeySource: restmiddleware.ClientKeySourceIP,
+				ClientKeyHeader: restmiddleware.DefaultClientKeyHeader,
 			},
 			MaxMsgSize:                grpcutils.DefaultMaxMsgSize,
 			CompressorName:            grpcutils.NoCompressor,
@@ -221,6 +231,9 @@ func DefaultObserverServiceConfig() *ObserverServiceConfig {
 		rpcMetricsEnabled:                    false,
 		apiRatelimits:                        nil,
 		apiBurstlimits:                       nil,
+		restClientLimits:                     ratelimit.ClientLimits{},
+		restRouteRateLimits:                  nil,
+		restRouteBurstLimits:                 nil,
 		observerNetworkingKeyPath:            cmd.NotSet,
 		apiTimeout:                           3 * time.Second,
 		upstreamNodeAddresses:                []string{},
@@ -257,6 +270,7 @@ func DefaultObserverServiceConfig() *ObserverServiceConfig {
 		registerCacheSize:        0,
 		programCacheSize:         0,
 		registerDBPruneThreshold: pruner.DefaultThreshold,
+		registerDBPrunerConfig:   pstorage.DefaultRegistersPrunerConfig,
 	}
 }
 
@@ -272,6 +286,7 @@ type ObserverServiceBuilder struct {
 	FollowerState        stateprotocol.FollowerState
 	SyncCore             *chainsync.Core
 	RpcEng               *rpc.Engine
+	restRateLimiter      *ratelimit.ClientLimiter
 	TransactionTimings   *stdmap.TransactionTimings
 	FollowerDistributor  *pubsub.FollowerDistributor
 	Committee            hotstuff.DynamicCommittee
@@ -281,6 +296,8 @@ type ObserverServiceBuilder struct {
 	ExecutionIndexer     *indexer.Indexer
 	ExecutionIndexerCore *indexer.IndexerCore
 	TxResultsIndex       *index.TransactionResultsIndex
+	AccountTxsIndex      *index.AccountTransactionsIndex
+	ContractUpdatesIndex *index.ContractUpdatesIndex
 	IndexerDependencies  *cmd.DependencyList
 	VersionControl       *version.VersionControl
 	StopControl          *stop.StopControl
@@ -301,6 +318,8 @@ type ObserverServiceBuilder struct {
 	// storage
 	events                  storage.Events
 	lightTransactionResults storage.LightTransactionResults
+	accountTransactions     storage.AccountTransactions
+	contractUpdates         storage.ContractUpdates
 
 	// available until after the network has started. Hence, a factory function that needs to be called just before
 	// creating the sync engine
@@ -636,6 +655,50 @@ func (builder *ObserverServiceBuilder) extraFlags() {
 			"rest-max-request-size",
 			defaultConfig.rpcConf.RestConfig.MaxRequestSize,
 			"the maximum request size in bytes for payload sent over REST server")
+		flags.BoolVar(&builder.rpcConf.RestConfig.GraphQLConfig.Enabled,
+			"rest-graphql-enabled",
+			defaultConfig.rpcConf.RestConfig.GraphQLConfig.Enabled,
+			"whether to serve the GraphQL endpoint on the REST server")
+		flags.IntVar(&builder.rpcConf.RestConfig.GraphQLConfig.MaxQueryDepth,
+			"rest-graphql-max-query-depth",
+			defaultConfig.rpcConf.RestConfig.GraphQLConfig.MaxQueryDepth,
+			"maximum depth of nested selections in a GraphQL query")
+		flags.Uint64Var(&builder.rpcConf.RestConfig.GraphQLConfig.MaxQueryCost,
+			"rest-graphql-max-query-cost",
+			defaultConfig.rpcConf.RestConfig.GraphQLConfig.MaxQueryCost,
+			"maximum cost of a GraphQL query, where each Access API call made while resolving the query costs 1")
+		flags.StringVar(&builder.rpcConf.RestConfig.ClientKeySource,
+			"rest-client-key-source",
+			defaultConfig.rpcConf.RestConfig.ClientKeySource,
+			"source used to identify REST clients for rate limiting: ip, header (API key header with one of rest-client-api-keys, falls back to ip) or mtls (TLS client certificate, falls back to ip)")
+		flags.StringVar(&builder.rpcConf.RestConfig.ClientKeyHeader,
+			"rest-client-key-header",
+			defaultConfig.rpcConf.RestConfig.ClientKeyHeader,
+			"API key header used to identify REST clients if rest-client-key-source is header")
+		flags.StringSliceVar(&builder.rpcConf.RestConfig.ClientAPIKeys,
+			"rest-client-api-keys",
+			defaultConfig.rpcConf.RestConfig.ClientAPIKeys,
+			"comma separated list of API keys used to identify REST clients if rest-client-key-source is header. requests with other keys are identified by their IP address")
+		flags.UintVar(&builder.restClientLimits.Default.Rate,
+			"rest-client-rate-limit",
+			defaultConfig.restClientLimits.Default.Rate,
+			"per second rate limit for each REST client, shared by all routes without a route specific limit. 0 means unlimited")
+		flags.UintVar(&builder.restClientLimits.Default.Burst,
+			"rest-client-burst-limit",
+			defaultConfig.restClientLimits.Default.Burst,
+			"burst limit for each REST client, shared by all routes without a route specific limit. defaults to rest-client-rate-limit if 0")
+		flags.StringToIntVar(&builder.restRouteRateLimits,
+			"rest-client-route-rate-limits",
+			defaultConfig.restRouteRateLimits,
+			"per second rate limits for each REST client on specific routes e.g. getBlocksByHeight=10,getAccount=20. 0 means unlimited")
+		flags.StringToIntVar(&builder.restRouteBurstLimits,
+			"rest-client-route-burst-limits",
+			defaultConfig.restRouteBurstLimits,
+			"burst limits for each REST client on specific routes e.g. getBlocksByHeight=20. defaults to the route rate limit")
+		flags.Uint64Var(&builder.restClientLimits.DailyQuota,
+			"rest-client-daily-quota",
+			defaultConfig.restClientLimits.DailyQuota,
+			"maximum number of requests each REST client can make per UTC day across all routes. 0 means unlimited")
 		flags.UintVar(&builder.rpcConf.MaxMsgSize,
 			"rpc-max-message-size",
 			defaultConfig.rpcConf.MaxMsgSize,
@@ -820,6 +883,22 @@ func (builder *ObserverServiceBuilder) extraFlags() {
 			"registerdb-pruning-threshold",
 			defaultConfig.registerDBPruneThreshold,
 			fmt.Sprintf("specifies the number of blocks below the latest stored block height to keep in register db. default: %d", defaultConfig.registerDBPruneThreshold))
+		flags.UintVar(&builder.registerDBPrunerConfig.BatchSize,
+			"registerdb-pruning-batch-size",
+			defaultConfig.registerDBPrunerConfig.BatchSize,
+			"number of register values deleted in one batch when pruning the register db")
+		flags.UintVar(&builder.registerDBPrunerConfig.ScanLimit,
+			"registerdb-pruning-scan-limit",
+			defaultConfig.registerDBPrunerConfig.ScanLimit,
+			"number of register values scanned in one pruning iteration of the register db. the next iteration continues where the previous one stopped")
+		flags.DurationVar(&builder.registerDBPrunerConfig.SleepAfterEachBatchCommit,
+			"registerdb-pruning-sleep-after-commit",
+			defaultConfig.registerDBPrunerConfig.SleepAfterEachBatchCommit,
+			"sleep time after each batch commit when pruning the register db")
+		flags.DurationVar(&builder.registerDBPrunerConfig.PruningInterval,
+			"registerdb-pruning-interval",
+			defaultConfig.registerDBPrunerConfig.PruningInterval,
+			"interval between two pruning iterations of the register db. 0 disables pruning of the register db")
 
 		// websockets config
 		flags.DurationVar(
@@ -895,6 +974,28 @@ func (builder *ObserverServiceBuilder) extraFlags() {
 		if builder.rpcConf.RestConfig.MaxRequestSize <= 0 {
 			return errors.New("rest-max-request-size must be greater than 0")
 		}
+		if builder.rpcConf.RestConfig.GraphQLConfig.MaxQueryDepth <= 0 {
+			return errors.New("rest-graphql-max-query-depth must be greater than 0")
+		}
+		if builder.rpcConf.RestConfig.GraphQLConfig.MaxQueryCost == 0 {
+			return errors.New("rest-graphql-max-query-cost must be greater than 0")
+		}
+		if _, err := restmiddleware.NewClientKeyFunc(builder.rpcConf.RestConfig.ClientKeySource, builder.rpcConf.RestConfig.ClientKeyHeader, builder.rpcConf.RestConfig.ClientAPIKeys); err != nil {
+			return fmt.Errorf("invalid rest-client-key-source: %w", err)
+		}
+		for route, limit := range builder.restRouteRateLimits {
+			if limit < 0 {
+				return fmt.Errorf("rest-client-route-rate-limits for %s must be greater than or equal to 0", route)
+			}
+		}
+		for route, limit := range builder.restRouteBurstLimits {
+			if limit < 0 {
+				return fmt.Errorf("rest-client-route-burst-limits for %s must be greater than or equal to 0", route)
+			}
+			if _, ok := builder.restRouteRateLimits[route]; !ok {
+				return fmt.Errorf("rest-client-route-burst-limits for %s requires a rest-client-route-rate-limits for the route", route)
+			}
+		}
 
 		return nil
 	})
@@ -1096,6 +1197,11 @@ func (builder *ObserverServiceBuilder) BuildExecutionSyncComponents() *ObserverS
 	requesterDependable := module.NewProxiedReadyDoneAware()
 	builder.IndexerDependencies.Add(requesterDependable)
 
+	// setup dependency chain to ensure the register db pruner starts after the registers are bootstrapped
+	var registers *pstorage.Registers
+	indexerDependable := module.NewProxiedReadyDoneAware()
+	registersPrunerDependencies := cmd.NewDependencyList(indexerDependable)
+
 	executionDataPrunerEnabled := builder.executionDataPrunerHeightRangeTarget != 0
 
 	builder.
@@ -1404,7 +1510,7 @@ func (builder *ObserverServiceBuilder) BuildExecutionSyncComponents() *ObserverS
 				}
 			}
 
-			registers, err := pstorage.NewRegisters(pdb, builder.registerDBPruneThreshold)
+			registers, err = pstorage.NewRegisters(pdb, builder.registerDBPruneThreshold)
 			if err != nil {
 				return nil, fmt.Errorf("could not create registers storage: %w", err)
 			}
@@ -1440,6 +1546,8 @@ func (builder *ObserverServiceBuilder) BuildExecutionSyncComponents() *ObserverS
 				builder.Storage.Collections,
 				builder.Storage.Transactions,
 				builder.lightTransactionResults,
+				builder.accountTransactions,
+				builder.contractUpdates,
 				builder.RootChainID.Chain(),
 				indexerDerivedChainData,
 				collectionExecutedMetric,
@@ -1503,8 +1611,22 @@ func (builder *ObserverServiceBuilder) BuildExecutionSyncComponents() *ObserverS
 				builder.StopControl.RegisterHeightRecorder(builder.ExecutionIndexer)
 			}
 
+			indexerDependable.Init(builder.ExecutionIndexer)
+
 			return builder.ExecutionIndexer, nil
-		}, builder.IndexerDependencies)
+		}, builder.IndexerDependencies).DependableComponent("register db pruner", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+			err := node.ConfigManager.RegisterUintConfig("registerdb-pruning-threshold",
+				func() uint { return uint(registers.PruneThreshold()) },
+				func(threshold uint) error {
+					registers.SetPruneThreshold(uint64(threshold))
+					return nil
+				})
+			if err != nil {
+				return nil, err
+			}
+
+			return pstorage.NewRegistersPruner(node.Logger, registers, builder.registerDBPrunerConfig)
+		}, registersPrunerDependencies)
 	}
 
 	if builder.stateStreamConf.ListenAddr != "" {
@@ -1733,6 +1855,29 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 		builder.RestMetrics = m
 		return nil
 	})
+	builder.Module("rest rate limiter", func(node *cmd.NodeConfig) error {
+		limits := builder.restClientLimits
+		limits.Routes = make(map[string]ratelimit.ClientLimit, len(builder.restRouteRateLimits))
+		for route, limit := range builder.restRouteRateLimits {
+			limits.Routes[route] = ratelimit.ClientLimit{
+				Rate:  uint(limit),
+				Burst: uint(builder.restRouteBurstLimits[route]),
+			}
+		}
+
+		var err error
+		builder.restRateLimiter, err = ratelimit.NewClientLimiter(limits, ratelimit.DefaultMaxTrackedClients)
+		if err != nil {
+			return fmt.Errorf("could not create REST rate limiter: %w", err)
+		}
+
+		// register the limits for dynamic configuration via admin command
+		err = ratelimit.RegisterClientLimitConfigs(node.ConfigManager, builder.restRateLimiter)
+		if err != nil {
+			return fmt.Errorf("failed to register REST rate limits with config manager: %w", err)
+		}
+		return nil
+	})
 	builder.Module("access metrics", func(node *cmd.NodeConfig) error {
 		builder.AccessMetrics = metrics.NewAccessCollector(
 			metrics.WithTransactionMetrics(builder.TransactionMetrics),
@@ -1790,6 +1935,14 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 		builder.events = store.NewEvents(node.Metrics.Cache, node.ProtocolDB)
 		return nil
 	})
+	builder.Module("account transactions storage", func(node *cmd.NodeConfig) error {
+		builder.accountTransactions = store.NewAccountTransactions(node.ProtocolDB)
+		return nil
+	})
+	builder.Module("contract updates storage", func(node *cmd.NodeConfig) error {
+		builder.contractUpdates = store.NewContractUpdates(node.ProtocolDB)
+		return nil
+	})
 	builder.Module("reporter", func(node *cmd.NodeConfig) error {
 		builder.Reporter = index.NewReporter()
 		return nil
@@ -1802,6 +1955,14 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 		builder.TxResultsIndex = index.NewTransactionResultsIndex(builder.Reporter, builder.lightTransactionResults)
 		return nil
 	})
+	builder.Module("account transactions index", func(node *cmd.NodeConfig) error {
+		builder.AccountTxsIndex = index.NewAccountTransactionsIndex(builder.Reporter, builder.accountTransactions)
+		return nil
+	})
+	builder.Module("contract updates index", func(node *cmd.NodeConfig) error {
+		builder.ContractUpdatesIndex = index.NewContractUpdatesIndex(builder.Reporter, builder.contractUpdates)
+		return nil
+	})
 	builder.Module("script executor", func(node *cmd.NodeConfig) error {
 		builder.ScriptExecutor = backend.NewScriptExecutor(builder.Logger, builder.scriptExecMinBlock, builder.scriptExecMaxBlock)
 		return nil
@@ -1966,6 +2127,9 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 			backendParams.EventQueryMode = backend.IndexQueryModeLocalOnly
 			backendParams.TxResultsIndex = builder.TxResultsIndex
 			backendParams.EventsIndex = builder.EventsIndex
+			backendParams.AccountTransactionsIndex = builder.AccountTxsIndex
+			backendParams.ContractUpdatesIndex = builder.ContractUpdatesIndex
+			backendParams.Registers = builder.RegistersAsyncStore
 			backendParams.ScriptExecutor = builder.ScriptExecutor
 		}
 
@@ -2024,6 +2188,7 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 		builder.RpcEng, err = engineBuilder.
 			WithRpcHandler(rpcHandler).
 			WithLegacy().
+			WithRestRateLimiter(builder.restRateLimiter).
 			Build()
 		if err != nil {
 			return nil, err
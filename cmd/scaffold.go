@@ -31,6 +31,7 @@ import (
 	"github.com/onflow/flow-go/admin"
 	"github.com/onflow/flow-go/admin/commands"
 	"github.com/onflow/flow-go/admin/commands/common"
+	networkCommands "github.com/onflow/flow-go/admin/commands/network"
 	storageCommands "github.com/onflow/flow-go/admin/commands/storage"
 	"github.com/onflow/flow-go/cmd/build"
 	"github.com/onflow/flow-go/cmd/scaffold"
@@ -62,9 +63,11 @@ import (
 	p2pbuilderconfig "github.com/onflow/flow-go/network/p2p/builder/config"
 	"github.com/onflow/flow-go/network/p2p/cache"
 	"github.com/onflow/flow-go/network/p2p/conduit"
+	p2pconfig "github.com/onflow/flow-go/network/p2p/config"
 	"github.com/onflow/flow-go/network/p2p/connection"
 	p2pdht "github.com/onflow/flow-go/network/p2p/dht"
 	"github.com/onflow/flow-go/network/p2p/dns"
+	"github.com/onflow/flow-go/network/p2p/introspection"
 	"github.com/onflow/flow-go/network/p2p/keyutils"
 	"github.com/onflow/flow-go/network/p2p/ping"
 	"github.com/onflow/flow-go/network/p2p/subscription"
@@ -73,6 +76,7 @@ import (
 	"github.com/onflow/flow-go/network/p2p/unicast/ratelimit"
 	"github.com/onflow/flow-go/network/p2p/utils"
 	"github.com/onflow/flow-go/network/p2p/utils/ratelimiter"
+	"github.com/onflow/flow-go/network/recorder"
 	"github.com/onflow/flow-go/network/slashing"
 	"github.com/onflow/flow-go/network/topology"
 	"github.com/onflow/flow-go/network/underlay"
@@ -214,6 +218,25 @@ func (fnb *FlowNodeBuilder) BaseFlags() {
 		defaultConfig.BitswapReprovideEnabled,
 		"[experimental] whether to enable bitswap reproviding. This is an experimental feature. Use with caution.")
 
+	fnb.flags.StringVar(&fnb.BaseConfig.GossipSubIntrospectionAddr,
+		"gossipsub-introspection-addr",
+		defaultConfig.GossipSubIntrospectionAddr,
+		"address to bind on for the http debug server that serves the live gossipsub mesh and peer score snapshot of the node. The server is disabled if empty")
+
+	// network recorder flags
+	fnb.flags.StringVar(&fnb.BaseConfig.NetworkRecorderDir,
+		"network-recorder-dir",
+		defaultConfig.NetworkRecorderDir,
+		"directory to record the messages received and sent by the engines to, for debugging purposes. Recording is disabled if empty")
+	fnb.flags.Int64Var(&fnb.BaseConfig.NetworkRecorderMaxFileSize,
+		"network-recorder-max-file-size",
+		defaultConfig.NetworkRecorderMaxFileSize,
+		"size in bytes after which a network recording file is rotated")
+	fnb.flags.IntVar(&fnb.BaseConfig.NetworkRecorderMaxFiles,
+		"network-recorder-max-files",
+		defaultConfig.NetworkRecorderMaxFiles,
+		"number of network recording files kept, the oldest files are removed")
+
 	// dynamic node startup flags
 	fnb.flags.StringVar(&fnb.BaseConfig.DynamicStartupANPubkey,
 		"dynamic-startup-access-publickey",
@@ -415,9 +438,13 @@ func (fnb *FlowNodeBuilder) EnqueueNetworkInit() {
 		RateLimiterDistributor: fnb.UnicastRateLimiterDistributor,
 	}
 
+	// gossipSubRecorder records the recent rpc inspector violations and connection gater decisions for the gossipsub introspection.
+	gossipSubRecorder := introspection.NewRecorder(introspection.DefaultRecentEventsCapacity)
+
 	connGaterCfg := &p2pbuilderconfig.ConnectionGaterConfig{
 		InterceptPeerDialFilters: connGaterPeerDialFilters,
 		InterceptSecuredFilters:  connGaterInterceptSecureFilters,
+		DecisionConsumer:         gossipSubRecorder,
 	}
 
 	peerManagerCfg := &p2pbuilderconfig.PeerManagerConfig{
@@ -478,15 +505,31 @@ func (fnb *FlowNodeBuilder) EnqueueNetworkInit() {
 		if err != nil {
 			return nil, fmt.Errorf("could not create libp2p node builder: %w", err)
 		}
+		builder.AddGossipSubInvCtrlMsgNotifConsumer(gossipSubRecorder)
 
 		libp2pNode, err := builder.Build()
 		if err != nil {
 			return nil, fmt.Errorf("could not build libp2p node: %w", err)
 		}
 
+		var scoreThresholds *p2pconfig.InternalScoringThresholds
+		if fnb.FlowConfig.NetworkConfig.GossipSub.PeerScoringEnabled {
+			scoreThresholds = &fnb.FlowConfig.NetworkConfig.GossipSub.ScoringParameters.PeerScoring.Internal.Thresholds
+		}
+		fnb.GossipSubIntrospector = introspection.NewIntrospector(libp2pNode, gossipSubRecorder, fnb.IdentityProvider, scoreThresholds)
+
 		fnb.LibP2PNode = libp2pNode
 		return libp2pNode, nil
 	})
+	if fnb.GossipSubIntrospectionAddr != "" {
+		fnb.Component("gossipsub introspection server", func(node *NodeConfig) (module.ReadyDoneAware, error) {
+			if fnb.GossipSubIntrospector == nil {
+				fnb.Logger.Warn().Msg("gossipsub introspection is not available on this node, introspection server is disabled")
+				return &module.NoopReadyDoneAware{}, nil
+			}
+			return introspection.NewServer(fnb.Logger, fnb.GossipSubIntrospectionAddr, fnb.GossipSubIntrospector), nil
+		})
+	}
 	fnb.Component(NetworkComponent, func(node *NodeConfig) (module.ReadyDoneAware, error) {
 		fnb.Logger.Info().Hex("node_id", logging.ID(fnb.NodeID)).Msg("default conduit factory initiated")
 		return fnb.InitFlowNetworkWithConduitFactory(
@@ -621,9 +664,14 @@ func (fnb *FlowNodeBuilder) InitFlowNetworkWithConduitFactory(
 	// noop rate limiter will be used.
 	networkOptions = append(networkOptions, underlay.WithUnicastRateLimiters(unicastRateLimiters))
 
-	networkOptions = append(networkOptions,
-		underlay.WithPreferredUnicastProtocols(protocols.ToProtocolNames(fnb.FlowConfig.NetworkConfig.PreferredUnicastProtocols)...),
-	)
+	preferredUnicasts := protocols.ToProtocolNames(fnb.FlowConfig.NetworkConfig.PreferredUnicastProtocols)
+	networkOptions = append(networkOptions, underlay.WithPreferredUnicastProtocols(preferredUnicasts...))
+
+	compressionPolicy, err := underlay.ToUnicastCompressionPolicy(fnb.FlowConfig.NetworkConfig.UnicastCompressionPolicy, preferredUnicasts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create unicast compression policy: %w", err)
+	}
+	networkOptions = append(networkOptions, underlay.WithUnicastCompressionPolicy(compressionPolicy))
 
 	// peerManagerFilters are used by the peerManager via the network to filter peers from the topology.
 	if len(peerManagerFilters) > 0 {
@@ -634,7 +682,7 @@ func (fnb *FlowNodeBuilder) InitFlowNetworkWithConduitFactory(
 		fnb.Logger,
 		metrics.NetworkReceiveCacheMetricsFactory(fnb.HeroCacheMetricsFactory(), network.PrivateNetwork))
 
-	err := node.Metrics.Mempool.Register(metrics.ResourceNetworkingReceiveCache, receiveCache.Size)
+	err = node.Metrics.Mempool.Register(metrics.ResourceNetworkingReceiveCache, receiveCache.Size)
 	if err != nil {
 		return nil, fmt.Errorf("could not register networking receive cache metric: %w", err)
 	}
@@ -669,6 +717,8 @@ func (fnb *FlowNodeBuilder) InitFlowNetworkWithConduitFactory(
 			AlspMetrics:             fnb.Metrics.Network,
 			HeroCacheMetricsFactory: fnb.HeroCacheMetricsFactory(),
 			NetworkType:             networkType,
+			SpamRecordStore:         alspmgr.NewPersistentSpamRecordStore(node.ProtocolDB, networkType),
+			SnapshotInterval:        fnb.FlowConfig.NetworkConfig.AlspConfig.SnapshotInterval,
 		},
 		SlashingViolationConsumerFactory: func(adapter network.ConduitAdapter) network.ViolationsConsumer {
 			return slashing.NewSlashingViolationsConsumer(fnb.Logger, fnb.Metrics.Network, adapter)
@@ -683,7 +733,19 @@ func (fnb *FlowNodeBuilder) InitFlowNetworkWithConduitFactory(
 	} else {
 		fnb.EngineRegistry = net // setting network as the fnb.Network for the engine-level components
 	}
+	if fnb.NetworkRecorderDir != "" {
+		writer, err := recorder.NewWriter(fnb.NetworkRecorderDir, fnb.NetworkRecorderMaxFileSize, fnb.NetworkRecorderMaxFiles)
+		if err != nil {
+			return nil, fmt.Errorf("could not initialize network recorder: %w", err)
+		}
+		fnb.ShutdownFunc(writer.Close)
+		fnb.EngineRegistry = recorder.NewRecordingNetwork(fnb.Logger, fnb.EngineRegistry, fnb.NodeID, writer)
+		fnb.Logger.Warn().Str("dir", fnb.NetworkRecorderDir).Msg("recording network messages")
+	}
 	fnb.NetworkUnderlay = net // setting network as the fnb.Underlay for the lower-level components
+	if spamRecordAdmin, ok := net.SpamRecordAdmin(); ok {
+		fnb.SpamRecordAdmin = spamRecordAdmin // exposes the alsp spam records to the admin commands
+	}
 
 	// register network ReadyDoneAware interface so other components can depend on it for startup
 	if fnb.networkUnderlayDependable != nil {
@@ -2106,6 +2168,16 @@ func (fnb *FlowNodeBuilder) RegisterDefaultAdminCommands() {
 	}).AdminCommand("create-pebble-checkpoint", func(config *NodeConfig) commands.AdminCommand {
 		// by default checkpoints will be created under "/data/protocol_pebble_checkpoints"
 		return storageCommands.NewPebbleDBCheckpointCommand(config.pebbleCheckpointsDir, "protocol", config.PebbleDB)
+	}).AdminCommand("list-alsp-spam-records", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewListAlspSpamRecordsCommand(config.SpamRecordAdmin)
+	}).AdminCommand("alsp-disallow-list-node", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewAlspDisallowListNodeCommand(config.SpamRecordAdmin)
+	}).AdminCommand("alsp-allow-list-node", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewAlspAllowListNodeCommand(config.SpamRecordAdmin)
+	}).AdminCommand("alsp-reset-spam-record", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewAlspResetSpamRecordCommand(config.SpamRecordAdmin)
+	}).AdminCommand("get-gossipsub-snapshot", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewGetGossipSubSnapshotCommand(config.GossipSubIntrospector)
 	})
 }
 
@@ -0,0 +1,51 @@
+package checkpoint_merge_deltas
+
+import (
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+)
+
+var (
+	flagCheckpoint string
+	flagNWorker    uint
+)
+
+// # merge the delta checkpoint 00001234 and its base checkpoints into a full checkpoint
+// ./util checkpoint-merge-deltas --checkpoint /var/flow/data/execution/checkpoint.00001234
+var Cmd = &cobra.Command{
+	Use:   "checkpoint-merge-deltas",
+	Short: "Replaces a delta checkpoint by a full checkpoint holding the same tries",
+	Long: `Loads a delta checkpoint and the chain of its base checkpoints, and replaces the delta checkpoint
+by a full checkpoint holding the same tries, so that the base checkpoints can be removed.`,
+	Run: run,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&flagCheckpoint, "checkpoint", "",
+		"delta checkpoint file to merge")
+	_ = Cmd.MarkFlagRequired("checkpoint")
+
+	Cmd.Flags().UintVar(&flagNWorker, "n-worker", 16,
+		"number of workers to write the full checkpoint, valid range [1,16]")
+}
+
+func run(*cobra.Command, []string) {
+	dir, fileName := filepath.Split(flagCheckpoint)
+
+	chain, err := wal.CheckpointChain(dir, fileName)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not read base checkpoints")
+	}
+	log.Info().Strs("chain", chain).Msgf("merging checkpoint %v", flagCheckpoint)
+
+	err = wal.MergeDeltaCheckpoint(dir, fileName, log.Logger, flagNWorker)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not merge delta checkpoint")
+	}
+
+	log.Info().Msgf("checkpoint %v is a full checkpoint, the base checkpoints %v can be removed if not used by other checkpoints", flagCheckpoint, chain[1:])
+}
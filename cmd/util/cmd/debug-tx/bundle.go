@@ -0,0 +1,131 @@
+package debug_tx
+
+import (
+	"bytes"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/onflow/flow-go/engine/execution/checker"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/debug"
+)
+
+// runBundle replays the transactions of a forensic bundle written by the execution checker,
+// using the registers included in the bundle, and reports the register writes which differ
+// from the ones recorded by the execution node.
+//
+// NOTE: the system chunk transaction is replayed with the debugger's context, which differs
+// from the context used for the system chunk, so it might fail.
+func runBundle() {
+
+	log.Info().Msgf("Reading forensic bundle %s ...", flagBundle)
+
+	bundle, err := checker.ReadForensicBundle(flagBundle)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to read forensic bundle")
+	}
+
+	header := bundle.Block.Header
+
+	log.Info().Msgf(
+		"Read forensic bundle: block %s (height %d), chunk %d, %d transactions",
+		header.ID(),
+		header.Height,
+		bundle.ChunkIndex,
+		len(bundle.Transactions),
+	)
+
+	if len(bundle.MissingRegisters) > 0 {
+		log.Warn().Msgf("%d registers read during re-execution are missing from the chunk data pack", len(bundle.MissingRegisters))
+	}
+
+	var txID flow.Identifier
+	if flagTx != "" {
+		txID, err = flow.HexStringToIdentifier(flagTx)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to parse transaction ID")
+		}
+	}
+
+	registers := make(snapshot.MapStorageSnapshot, len(bundle.Registers))
+	for _, register := range bundle.Registers {
+		id, err := register.RegisterID()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to decode register")
+		}
+		registers[id] = register.Value
+	}
+
+	blockSnapshot := newBlockSnapshot(registers)
+
+	debugger := debug.NewRemoteDebugger(bundle.ChainID.Chain(), log.Logger)
+
+	for _, trace := range bundle.Transactions {
+		isDebuggedTx := trace.TransactionID == txID
+
+		log.Info().Msgf("Debugging transaction %s (index %d) ...", trace.TransactionID, trace.Index)
+
+		if trace.Error != "" {
+			log.Info().Msgf("Transaction failed on the execution node: %s", trace.Error)
+		}
+
+		resultSnapshot, txErr, processErr := debugger.RunTransaction(
+			trace.Transaction,
+			blockSnapshot,
+			header,
+		)
+		if processErr == nil {
+			compareWrites(trace, resultSnapshot)
+		}
+
+		applyResult(
+			resultSnapshot,
+			txErr,
+			processErr,
+			blockSnapshot,
+			flagDumpRegisters && (flagTx == "" || isDebuggedTx),
+		)
+
+		if isDebuggedTx {
+			break
+		}
+	}
+}
+
+// compareWrites logs the registers whose replayed value differs from the value written by the
+// execution node.
+func compareWrites(trace checker.TransactionTrace, resultSnapshot *snapshot.ExecutionSnapshot) {
+	recorded := make(map[flow.RegisterID]flow.RegisterValue, len(trace.RegisterWrites))
+	for _, write := range trace.RegisterWrites {
+		id, err := write.RegisterID()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to decode register")
+		}
+		recorded[id] = write.New
+	}
+
+	replayed := make(map[flow.RegisterID]flow.RegisterValue)
+	for _, entry := range resultSnapshot.UpdatedRegisters() {
+		replayed[entry.Key] = entry.Value
+	}
+
+	differences := 0
+	for id, value := range replayed {
+		recordedValue, ok := recorded[id]
+		if !ok || !bytes.Equal(recordedValue, value) {
+			log.Warn().Msgf("\tregister %s written with a different value than on the execution node", id)
+			differences++
+		}
+	}
+	for id := range recorded {
+		if _, ok := replayed[id]; !ok {
+			log.Warn().Msgf("\tregister %s was written on the execution node, but not when replayed", id)
+			differences++
+		}
+	}
+
+	if differences == 0 {
+		log.Info().Msg("Replayed register writes match the execution node")
+	}
+}
@@ -34,6 +34,7 @@ var (
 	flagProposalKeySeq      uint64
 	flagUseExecutionDataAPI bool
 	flagDumpRegisters       bool
+	flagBundle              string
 )
 
 var Cmd = &cobra.Command{
@@ -48,18 +49,14 @@ func init() {
 		&flagChain,
 		"chain",
 		"",
-		"Chain name",
+		"Chain name (required unless --bundle is used)",
 	)
-	_ = Cmd.MarkFlagRequired("chain")
 
-	Cmd.Flags().StringVar(&flagAccessAddress, "access-address", "", "address of the access node")
-	_ = Cmd.MarkFlagRequired("access-address")
+	Cmd.Flags().StringVar(&flagAccessAddress, "access-address", "", "address of the access node (required unless --bundle is used)")
 
-	Cmd.Flags().StringVar(&flagExecutionAddress, "execution-address", "", "address of the execution node")
-	_ = Cmd.MarkFlagRequired("execution-address")
+	Cmd.Flags().StringVar(&flagExecutionAddress, "execution-address", "", "address of the execution node (required unless --bundle is used)")
 
-	Cmd.Flags().StringVar(&flagTx, "tx", "", "transaction ID")
-	_ = Cmd.MarkFlagRequired("tx")
+	Cmd.Flags().StringVar(&flagTx, "tx", "", "transaction ID (required unless --bundle is used, in which case all transactions of the bundle are replayed if not set)")
 
 	Cmd.Flags().Uint64Var(&flagComputeLimit, "compute-limit", 9999, "transaction compute limit")
 
@@ -68,10 +65,28 @@ func init() {
 	Cmd.Flags().BoolVar(&flagUseExecutionDataAPI, "use-execution-data-api", false, "use the execution data API")
 
 	Cmd.Flags().BoolVar(&flagDumpRegisters, "dump-registers", false, "dump registers")
+
+	Cmd.Flags().StringVar(&flagBundle, "bundle", "", "path of a forensic bundle written by the execution checker, to replay the transactions of the bundle instead of fetching them from the network")
 }
 
 func run(*cobra.Command, []string) {
 
+	if flagBundle != "" {
+		runBundle()
+		return
+	}
+
+	for name, value := range map[string]string{
+		"chain":             flagChain,
+		"access-address":    flagAccessAddress,
+		"execution-address": flagExecutionAddress,
+		"tx":                flagTx,
+	} {
+		if value == "" {
+			log.Fatal().Msgf("--%s is required", name)
+		}
+	}
+
 	chainID := flow.ChainID(flagChain)
 	chain := chainID.Chain()
 
@@ -244,6 +259,17 @@ func runTransaction(
 		blockSnapshot,
 		header,
 	)
+	applyResult(resultSnapshot, txErr, processErr, blockSnapshot, dumpRegisters)
+}
+
+// applyResult logs the result of a transaction, and applies its register updates to the block snapshot.
+func applyResult(
+	resultSnapshot *snapshot.ExecutionSnapshot,
+	txErr error,
+	processErr error,
+	blockSnapshot *blockSnapshot,
+	dumpRegisters bool,
+) {
 	if processErr != nil {
 		log.Fatal().Err(processErr).Msg("Failed to process transaction")
 	}
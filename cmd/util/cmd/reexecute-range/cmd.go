@@ -0,0 +1,240 @@
+package reexecute
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/onflow/crypto"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/cmd/util/cmd/common"
+	"github.com/onflow/flow-go/engine/execution/computation"
+	"github.com/onflow/flow-go/engine/execution/computation/committer"
+	"github.com/onflow/flow-go/engine/execution/computation/computer"
+	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/initialize"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/executiondatasync/execution_data"
+	"github.com/onflow/flow-go/module/local"
+	"github.com/onflow/flow-go/module/trace"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+)
+
+var (
+	flagChain               string
+	flagDatadir             string
+	flagCheckpoint          string
+	flagFrom                uint64
+	flagTo                  uint64
+	flagWorkers             uint
+	flagMaxConcurrency      int
+	flagOutputDir           string
+	flagWriteRegisterDeltas bool
+)
+
+// # re-execute the blocks from height 2000 to 3000 on top of a checkpoint containing the state of height 1999
+// ./util reexecute-range --chain flow-mainnet --datadir /var/flow/data/protocol --checkpoint /var/flow/data/execution/checkpoint.00001234 --from 2000 --to 3000 --output-dir ./report
+var Cmd = &cobra.Command{
+	Use:   "reexecute-range",
+	Short: "re-execute a range of blocks on top of a checkpoint and check all chunks against the stored execution results",
+	Long: `Re-executes the blocks of a height range on top of the execution state of a checkpoint,
+using the protocol database of an execution node, and checks the end state and events of every chunk
+against the stored execution results.
+
+The checkpoint must contain the state of the stored execution result of the parent of the first block.
+
+The report is written to the output directory: mismatching chunks are written to mismatches.jsonl,
+the execution time and computation of each transaction to transactions.jsonl, and, if enabled, the
+registers updated by each chunk to register_deltas.jsonl.`,
+	Run: run,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&flagChain, "chain", "", "Chain name")
+	_ = Cmd.MarkFlagRequired("chain")
+
+	Cmd.Flags().StringVar(&flagDatadir, "datadir", "/var/flow/data/protocol",
+		"directory that stores the protocol state")
+
+	Cmd.Flags().StringVar(&flagCheckpoint, "checkpoint", "",
+		"checkpoint file containing the start state")
+	_ = Cmd.MarkFlagRequired("checkpoint")
+
+	Cmd.Flags().Uint64Var(&flagFrom, "from", 0, "first height to re-execute")
+	_ = Cmd.MarkFlagRequired("from")
+
+	Cmd.Flags().Uint64Var(&flagTo, "to", 0, "last height to re-execute (inclusive)")
+	_ = Cmd.MarkFlagRequired("to")
+
+	Cmd.Flags().UintVar(&flagWorkers, "workers", 1,
+		"number of blocks to execute in parallel, where state dependencies allow it")
+
+	Cmd.Flags().IntVar(&flagMaxConcurrency, "max-concurrency", 1,
+		"number of transactions of a block to execute concurrently")
+
+	Cmd.Flags().StringVar(&flagOutputDir, "output-dir", "./reexecute-range",
+		"directory to write the report to")
+
+	Cmd.Flags().BoolVar(&flagWriteRegisterDeltas, "write-register-deltas", false,
+		"write the registers updated by each chunk to the report")
+}
+
+func run(*cobra.Command, []string) {
+	chainID := flow.ChainID(flagChain)
+	chain := chainID.Chain()
+
+	lg := log.With().
+		Str("chain", string(chainID)).
+		Str("datadir", flagDatadir).
+		Str("checkpoint", flagCheckpoint).
+		Uint64("from", flagFrom).
+		Uint64("to", flagTo).
+		Uint("workers", flagWorkers).
+		Logger()
+
+	if flagFrom > flagTo {
+		lg.Fatal().Msg("--from must be less than or equal to --to")
+	}
+	if flagWorkers < 1 {
+		lg.Fatal().Msg("--workers must be at least 1")
+	}
+
+	db := common.InitStorage(flagDatadir)
+	defer db.Close()
+
+	storages := common.InitStorages(db)
+	state, err := common.InitProtocolState(db, storages)
+	if err != nil {
+		lg.Fatal().Err(err).Msg("could not init protocol state")
+	}
+
+	lg.Info().Msg("loading checkpoint")
+
+	ldg, closeLedger, err := openLedger(flagCheckpoint)
+	if err != nil {
+		lg.Fatal().Err(err).Msg("could not load checkpoint")
+	}
+	defer closeLedger()
+
+	stats := newTransactionStats()
+
+	blockComputer, err := newBlockComputer(chain, storages, state, stats)
+	if err != nil {
+		lg.Fatal().Err(err).Msg("could not create block computer")
+	}
+
+	report, err := newReportWriter(flagOutputDir, flagWriteRegisterDeltas)
+	if err != nil {
+		lg.Fatal().Err(err).Msg("could not create report")
+	}
+
+	r, err := newReexecutor(
+		lg,
+		blockComputer,
+		ldg,
+		storages.Blocks,
+		storages.Collections,
+		storages.Results,
+		stats,
+		report,
+		int(flagWorkers),
+	)
+	if err != nil {
+		lg.Fatal().Err(err).Msg("could not create reexecutor")
+	}
+
+	sum, runErr := r.Run(context.Background(), flagFrom, flagTo)
+
+	err = report.Close()
+	if err != nil {
+		lg.Error().Err(err).Msg("could not close report")
+	}
+	if runErr != nil {
+		lg.Fatal().Err(runErr).Msg("could not re-execute range")
+	}
+
+	lg.Info().
+		Uint64("blocks", sum.Blocks).
+		Uint64("transactions", sum.Transactions).
+		Uint64("chunks", sum.Chunks).
+		Uint64("mismatched_chunks", sum.MismatchedChunks).
+		Uint64("missing_results", sum.MissingResults).
+		Uint64("reexecutions", sum.Reexecutions).
+		Dur("execution_time", sum.ExecutionDuration).
+		Str("output_dir", flagOutputDir).
+		Msg("re-execution finished")
+
+	if sum.MismatchedChunks > 0 {
+		lg.Fatal().Msgf("found %d mismatched chunks", sum.MismatchedChunks)
+	}
+}
+
+// newBlockComputer creates a block computer which does not commit the execution state or store
+// the execution data. Chunks are committed by the reexecutor instead.
+func newBlockComputer(
+	chain flow.Chain,
+	storages *storage.All,
+	state protocol.State,
+	stats *transactionStats,
+) (computer.BlockComputer, error) {
+	vm := fvm.NewVirtualMachine()
+	fvmOptions := initialize.InitFvmOptions(chain.ChainID(), storages.Headers)
+	fvmOptions = append(
+		[]fvm.Option{fvm.WithLogger(log.Logger)},
+		fvmOptions...,
+	)
+	fvmOptions = append(fvmOptions, computation.DefaultFVMOptions(chain.ChainID(), false, false)...)
+	vmCtx := fvm.NewContext(fvmOptions...)
+
+	// spocks and receipts are signed with an ephemeral key, they are not checked
+	me, err := ephemeralLocal()
+	if err != nil {
+		return nil, err
+	}
+
+	return computer.NewBlockComputer(
+		vm,
+		vmCtx,
+		stats,
+		trace.NewNoopTracer(),
+		log.Logger.With().Str("component", "block_computer").Logger(),
+		committer.NewNoopViewCommitter(),
+		me,
+		discardExecutionData{},
+		nil,
+		computation.NewProtocolStateWrapper(state),
+		flagMaxConcurrency,
+	)
+}
+
+func ephemeralLocal() (*local.Local, error) {
+	seed := make([]byte, crypto.KeyGenSeedMinLen)
+	_, err := rand.Read(seed)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate seed: %w", err)
+	}
+	sk, err := crypto.GeneratePrivateKey(crypto.BLSBLS12381, seed)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate staking key: %w", err)
+	}
+	return local.New(flow.IdentitySkeleton{StakingPubKey: sk.PublicKey()}, sk)
+}
+
+// discardExecutionData is an execution data provider which neither stores the execution data nor
+// computes its ID.
+type discardExecutionData struct{}
+
+func (discardExecutionData) Provide(
+	_ context.Context,
+	_ uint64,
+	executionData *execution_data.BlockExecutionData,
+) (flow.Identifier, *flow.BlockExecutionDataRoot, error) {
+	if executionData == nil {
+		return flow.ZeroID, nil, errors.New("missing execution data")
+	}
+	return flow.ZeroID, &flow.BlockExecutionDataRoot{BlockID: executionData.BlockID}, nil
+}
@@ -0,0 +1,106 @@
+package reexecute
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/module/metrics"
+)
+
+// checkpointWAL is a write-ahead log which only loads the tries of a single checkpoint file, and
+// does not record any updates, so the checkpoint directory is left untouched.
+type checkpointWAL struct {
+	checkpoint string
+}
+
+var _ wal.LedgerWAL = (*checkpointWAL)(nil)
+
+func (w *checkpointWAL) Ready() <-chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}
+
+func (w *checkpointWAL) Done() <-chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}
+
+func (w *checkpointWAL) NewCheckpointer() (*wal.Checkpointer, error) {
+	return nil, fmt.Errorf("checkpointing is not supported")
+}
+
+func (w *checkpointWAL) PauseRecord() {}
+
+func (w *checkpointWAL) UnpauseRecord() {}
+
+func (w *checkpointWAL) RecordUpdate(*ledger.TrieUpdate) (int, bool, error) { return 0, false, nil }
+
+func (w *checkpointWAL) RecordDelete(ledger.RootHash) error { return nil }
+
+func (w *checkpointWAL) ReplayOnForest(forest *mtrie.Forest) error {
+	tries, err := wal.LoadCheckpoint(w.checkpoint, log.Logger)
+	if err != nil {
+		return fmt.Errorf("could not load checkpoint %v: %w", w.checkpoint, err)
+	}
+	return forest.AddTries(tries)
+}
+
+func (w *checkpointWAL) Segments() (int, int, error) { return 0, 0, nil }
+
+func (w *checkpointWAL) Replay(
+	checkpointFn func(tries []*trie.MTrie) error,
+	_ func(update *ledger.TrieUpdate) error,
+	_ func(ledger.RootHash) error,
+) error {
+	tries, err := wal.LoadCheckpoint(w.checkpoint, log.Logger)
+	if err != nil {
+		return fmt.Errorf("could not load checkpoint %v: %w", w.checkpoint, err)
+	}
+	return checkpointFn(tries)
+}
+
+func (w *checkpointWAL) ReplayLogsOnly(
+	func(tries []*trie.MTrie) error,
+	func(update *ledger.TrieUpdate) error,
+	func(rootHash ledger.RootHash) error,
+) error {
+	return nil
+}
+
+// openLedger returns an in-memory ledger holding the tries of the given checkpoint file.
+func openLedger(checkpoint string) (ledger.Ledger, func(), error) {
+	ldg, err := complete.NewLedger(
+		&checkpointWAL{checkpoint: checkpoint},
+		complete.DefaultCacheSize,
+		metrics.NewNoopCollector(),
+		log.Logger,
+		complete.DefaultPathFinderVersion,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	<-ldg.Ready()
+
+	// the ledger sends each update to the write-ahead log through the compactor, which is replaced
+	// by a loop acknowledging the updates without recording them.
+	updatesDone := make(chan struct{})
+	go func() {
+		defer close(updatesDone)
+		for update := range ldg.TrieUpdateChan() {
+			update.ResultCh <- nil
+		}
+	}()
+
+	return ldg, func() {
+		<-ldg.Done()
+		<-updatesDone
+	}, nil
+}
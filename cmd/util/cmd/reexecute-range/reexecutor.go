@@ -0,0 +1,462 @@
+package reexecute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/engine/execution"
+	"github.com/onflow/flow-go/engine/execution/computation/computer"
+	executionState "github.com/onflow/flow-go/engine/execution/state"
+	"github.com/onflow/flow-go/fvm/storage/derived"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/module/mempool/entity"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
+)
+
+// summary summarizes the re-execution of a range of blocks.
+type summary struct {
+	Blocks            uint64
+	Transactions      uint64
+	Chunks            uint64
+	MismatchedChunks  uint64
+	MissingResults    uint64
+	Reexecutions      uint64
+	ExecutionDuration time.Duration
+}
+
+// reexecutor re-executes a range of finalized blocks on top of a ledger, and checks the end state
+// and events of every chunk against the execution results stored in the protocol database.
+//
+// Blocks are executed speculatively in parallel: each block is executed on top of the most recent
+// state available when its execution starts, which might not include the updates of all preceding
+// blocks yet. Blocks are committed in order. When a block was executed on top of a stale state, and
+// any register it read was changed by the blocks it missed, the block is executed again on top of
+// the correct state. Blocks which do not depend on the state written by their predecessors are thus
+// executed in parallel.
+type reexecutor struct {
+	log         zerolog.Logger
+	computer    computer.BlockComputer
+	ledger      ledger.Ledger
+	blocks      storage.Blocks
+	collections storage.Collections
+	results     storage.ExecutionResultsReader
+	stats       *transactionStats
+	report      *reportWriter
+	workers     int
+}
+
+// newReexecutor creates a new reexecutor, which executes up to the given number of blocks in
+// parallel. The stats must be the metrics collector used by the block computer.
+func newReexecutor(
+	log zerolog.Logger,
+	blockComputer computer.BlockComputer,
+	ldg ledger.Ledger,
+	blocks storage.Blocks,
+	collections storage.Collections,
+	results storage.ExecutionResultsReader,
+	stats *transactionStats,
+	report *reportWriter,
+	workers int,
+) (*reexecutor, error) {
+	if workers < 1 {
+		return nil, fmt.Errorf("invalid number of workers: %d", workers)
+	}
+
+	return &reexecutor{
+		log:         log.With().Str("component", "reexecutor").Logger(),
+		computer:    blockComputer,
+		ledger:      ldg,
+		blocks:      blocks,
+		collections: collections,
+		results:     results,
+		stats:       stats,
+		report:      report,
+		workers:     workers,
+	}, nil
+}
+
+// speculation is the outcome of executing a block on top of a given state.
+type speculation struct {
+	block     *flow.Block
+	baseState flow.StateCommitment
+	result    *execution.ComputationResult
+	duration  time.Duration
+	err       error
+}
+
+// Run re-executes all blocks in the given height range (inclusive), starting from the state of the
+// stored execution result of the parent of the first block. The ledger must contain that state.
+//
+// Mismatching chunks are reported, but do not stop the re-execution: the following blocks are
+// executed on top of the re-executed state.
+//
+// No errors are expected during normal operations.
+func (r *reexecutor) Run(ctx context.Context, from uint64, to uint64) (*summary, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid height range [%d, %d]", from, to)
+	}
+
+	first, err := r.blocks.ByHeight(from)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block at height %d: %w", from, err)
+	}
+	parentResult, err := r.results.ByBlockID(first.Header.ParentID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get execution result of parent block %v: %w", first.Header.ParentID, err)
+	}
+	state, err := parentResult.FinalStateCommitment()
+	if err != nil {
+		return nil, fmt.Errorf("could not get final state of parent block %v: %w", first.Header.ParentID, err)
+	}
+	if !r.ledger.HasState(ledger.State(state)) {
+		return nil, fmt.Errorf("ledger does not contain the start state %v of height %d", state, from)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sum := &summary{}
+	inFlight := make([]chan *speculation, 0, r.workers)
+	next := from
+	for height := from; height <= to; height++ {
+		// keep up to r.workers blocks executing on top of the latest committed state
+		for next <= to && len(inFlight) < r.workers {
+			ch := make(chan *speculation, 1)
+			nextHeight, baseState := next, state
+			go func() {
+				ch <- r.speculate(ctx, nextHeight, baseState)
+			}()
+			inFlight = append(inFlight, ch)
+			next++
+		}
+
+		spec := <-inFlight[0]
+		inFlight = inFlight[1:]
+
+		state, err = r.commit(ctx, height, state, spec, sum)
+		if err != nil {
+			return nil, fmt.Errorf("could not re-execute block at height %d: %w", height, err)
+		}
+	}
+
+	return sum, nil
+}
+
+// speculate executes the block at the given height on top of the given state.
+func (r *reexecutor) speculate(ctx context.Context, height uint64, baseState flow.StateCommitment) *speculation {
+	spec := &speculation{baseState: baseState}
+
+	block, err := r.blocks.ByHeight(height)
+	if err != nil {
+		spec.err = fmt.Errorf("could not get block: %w", err)
+		return spec
+	}
+	spec.block = block
+
+	executableBlock, parentResultID, err := r.executableBlock(block, baseState)
+	if err != nil {
+		spec.err = err
+		return spec
+	}
+
+	// every execution uses its own derived data, because the derived data of the parent block might
+	// have been computed on top of a stale state
+	start := time.Now()
+	spec.result, spec.err = r.computer.ExecuteBlock(
+		ctx,
+		parentResultID,
+		executableBlock,
+		executionState.NewLedgerStorageSnapshot(r.ledger, baseState),
+		derived.NewEmptyDerivedBlockData(0),
+	)
+	spec.duration = time.Since(start)
+
+	return spec
+}
+
+// executableBlock returns the executable block for the given block, and the ID of the stored
+// execution result of its parent.
+func (r *reexecutor) executableBlock(block *flow.Block, startState flow.StateCommitment) (*entity.ExecutableBlock, flow.Identifier, error) {
+	collections := make(map[flow.Identifier]*entity.CompleteCollection, len(block.Payload.Guarantees))
+	for _, guarantee := range block.Payload.Guarantees {
+		collection, err := r.collections.ByID(guarantee.CollectionID)
+		if err != nil {
+			return nil, flow.ZeroID, fmt.Errorf("could not get collection %v: %w", guarantee.CollectionID, err)
+		}
+		collections[guarantee.CollectionID] = &entity.CompleteCollection{
+			Guarantee:    guarantee,
+			Transactions: collection.Transactions,
+		}
+	}
+
+	parentResult, err := r.results.ByBlockID(block.Header.ParentID)
+	if err != nil {
+		return nil, flow.ZeroID, fmt.Errorf("could not get execution result of parent block: %w", err)
+	}
+
+	return &entity.ExecutableBlock{
+		Block:               block,
+		CompleteCollections: collections,
+		StartState:          &startState,
+	}, parentResult.ID(), nil
+}
+
+// commit validates the speculative execution of the block at the given height against the
+// committed state, re-executes the block if needed, applies the register updates of each chunk to
+// the ledger, and reports the outcome. It returns the end state of the block.
+func (r *reexecutor) commit(
+	ctx context.Context,
+	height uint64,
+	state flow.StateCommitment,
+	spec *speculation,
+	sum *summary,
+) (flow.StateCommitment, error) {
+	if spec.baseState != state {
+		valid := false
+		if spec.err == nil {
+			var err error
+			valid, err = r.unchangedReads(spec, state)
+			if err != nil {
+				return flow.DummyStateCommitment, err
+			}
+		}
+
+		// the speculative execution failed, for example because its base state was evicted from
+		// the ledger, or read registers which were changed since
+		if !valid {
+			sum.Reexecutions++
+			spec = r.speculate(ctx, height, state)
+		}
+	}
+	if spec.err != nil {
+		return flow.DummyStateCommitment, fmt.Errorf("could not execute block: %w", spec.err)
+	}
+
+	blockID := spec.block.ID()
+	lg := r.log.With().
+		Uint64("height", height).
+		Hex("block_id", blockID[:]).
+		Logger()
+
+	var storedChunks flow.ChunkList
+	storedResult, err := r.results.ByBlockID(blockID)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			return flow.DummyStateCommitment, fmt.Errorf("could not get stored execution result: %w", err)
+		}
+		lg.Warn().Msg("no stored execution result, chunks are not checked")
+		sum.MissingResults++
+	} else {
+		storedChunks = storedResult.Chunks
+	}
+
+	blockResult := spec.result.BlockExecutionResult
+	if storedResult != nil && len(storedChunks) != blockResult.Size() {
+		return flow.DummyStateCommitment, fmt.Errorf("stored execution result has %d chunks, but block has %d chunks",
+			len(storedChunks), blockResult.Size())
+	}
+
+	chunks := make([]chunkReport, 0, blockResult.Size())
+	for i := 0; i < blockResult.Size(); i++ {
+		collectionResult := blockResult.CollectionExecutionResultAt(i)
+		updates := collectionResult.ExecutionSnapshot().UpdatedRegisters()
+
+		keys, values := executionState.RegisterEntriesToKeysValues(updates)
+		update, err := ledger.NewUpdate(ledger.State(state), keys, values)
+		if err != nil {
+			return flow.DummyStateCommitment, fmt.Errorf("could not create ledger update for chunk %d: %w", i, err)
+		}
+		newState, _, err := r.ledger.Set(update)
+		if err != nil {
+			return flow.DummyStateCommitment, fmt.Errorf("could not update ledger for chunk %d: %w", i, err)
+		}
+
+		eventCollection, err := flow.EventsMerkleRootHash(collectionResult.Events())
+		if err != nil {
+			return flow.DummyStateCommitment, fmt.Errorf("could not hash events of chunk %d: %w", i, err)
+		}
+
+		chunk := chunkReport{
+			Height:          height,
+			BlockID:         blockID,
+			ChunkIndex:      i,
+			StartState:      state,
+			EndState:        flow.StateCommitment(newState),
+			EventCollection: eventCollection,
+			Updates:         updates,
+		}
+		if storedResult != nil {
+			stored := storedChunks[i]
+			chunk.Checked = true
+			chunk.ExpectedEndState = stored.EndState
+			chunk.ExpectedEventCollection = stored.EventCollection
+		}
+		if chunk.Mismatch() {
+			sum.MismatchedChunks++
+			lg.Error().
+				Int("chunk_index", i).
+				Hex("end_state", chunk.EndState[:]).
+				Hex("expected_end_state", chunk.ExpectedEndState[:]).
+				Str("event_collection", chunk.EventCollection.String()).
+				Str("expected_event_collection", chunk.ExpectedEventCollection.String()).
+				Msg("chunk mismatch")
+		}
+		chunks = append(chunks, chunk)
+
+		state = flow.StateCommitment(newState)
+	}
+
+	transactions := r.transactionReports(height, blockID, blockResult)
+	err = r.report.WriteBlock(chunks, transactions)
+	if err != nil {
+		return flow.DummyStateCommitment, fmt.Errorf("could not write report: %w", err)
+	}
+
+	sum.Blocks++
+	sum.Chunks += uint64(len(chunks))
+	sum.Transactions += uint64(len(transactions))
+	sum.ExecutionDuration += spec.duration
+
+	lg.Info().
+		Int("transactions", len(transactions)).
+		Dur("execution_time", spec.duration).
+		Msg("block re-executed")
+
+	return state, nil
+}
+
+// unchangedReads returns true if all registers read by the speculative execution have the same
+// value at the given state as at the base state of the speculation, in which case executing the
+// block on top of the given state yields the same result.
+//
+// No errors are expected during normal operations.
+func (r *reexecutor) unchangedReads(spec *speculation, state flow.StateCommitment) (bool, error) {
+	reads := make(map[flow.RegisterID]struct{})
+	blockResult := spec.result.BlockExecutionResult
+	for i := 0; i < blockResult.Size(); i++ {
+		for id := range blockResult.CollectionExecutionResultAt(i).ExecutionSnapshot().ReadSet {
+			reads[id] = struct{}{}
+		}
+	}
+	if len(reads) == 0 {
+		return true, nil
+	}
+
+	keys := make([]ledger.Key, 0, len(reads))
+	for id := range reads {
+		keys = append(keys, convert.RegisterIDToLedgerKey(id))
+	}
+
+	speculated, err := r.read(spec.baseState, keys)
+	if err != nil {
+		// the base state was evicted from the ledger
+		return false, nil
+	}
+	actual, err := r.read(state, keys)
+	if err != nil {
+		return false, fmt.Errorf("could not read registers at committed state: %w", err)
+	}
+
+	for i := range keys {
+		if !speculated[i].Equals(actual[i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (r *reexecutor) read(state flow.StateCommitment, keys []ledger.Key) ([]ledger.Value, error) {
+	query, err := ledger.NewQuery(ledger.State(state), keys)
+	if err != nil {
+		return nil, err
+	}
+	return r.ledger.Get(query)
+}
+
+// transactionReports returns the report of each transaction of the block.
+func (r *reexecutor) transactionReports(height uint64, blockID flow.Identifier, blockResult *execution.BlockExecutionResult) []transactionReport {
+	stats := r.stats.remove(blockID)
+
+	results := blockResult.AllTransactionResults()
+	transactions := make([]transactionReport, 0, len(results))
+	for i, result := range results {
+		transaction := transactionReport{
+			Height:          height,
+			BlockID:         blockID,
+			TransactionID:   result.TransactionID,
+			Index:           i,
+			ComputationUsed: result.ComputationUsed,
+			MemoryUsed:      result.MemoryUsed,
+			Error:           result.ErrorMessage,
+		}
+		if s, ok := stats[result.TransactionID]; ok {
+			transaction.ExecutionTime = s.duration
+			transaction.SystemTransaction = s.system
+		}
+		transactions = append(transactions, transaction)
+	}
+	return transactions
+}
+
+// transactionStats is an execution metrics collector which records the execution time of each
+// transaction, so it can be included in the report.
+type transactionStats struct {
+	*metrics.NoopCollector
+	mu     sync.Mutex
+	blocks map[flow.Identifier]map[flow.Identifier]transactionStat
+}
+
+var _ module.ExecutionMetrics = (*transactionStats)(nil)
+
+type transactionStat struct {
+	duration time.Duration
+	system   bool
+}
+
+// newTransactionStats creates a new transactionStats.
+func newTransactionStats() *transactionStats {
+	return &transactionStats{
+		NoopCollector: metrics.NewNoopCollector(),
+		blocks:        make(map[flow.Identifier]map[flow.Identifier]transactionStat),
+	}
+}
+
+// ExecutionTransactionExecuted records the execution time of the transaction. When a block is
+// executed more than once, the latest execution is recorded.
+func (s *transactionStats) ExecutionTransactionExecuted(
+	dur time.Duration,
+	stats module.TransactionExecutionResultStats,
+	info module.TransactionExecutionResultInfo,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	block, ok := s.blocks[info.BlockID]
+	if !ok {
+		block = make(map[flow.Identifier]transactionStat)
+		s.blocks[info.BlockID] = block
+	}
+	block[info.TransactionID] = transactionStat{
+		duration: dur,
+		system:   stats.SystemTransaction,
+	}
+}
+
+// remove returns and removes the stats recorded for the given block.
+func (s *transactionStats) remove(blockID flow.Identifier) map[flow.Identifier]transactionStat {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	block := s.blocks[blockID]
+	delete(s.blocks, blockID)
+	return block
+}
@@ -0,0 +1,229 @@
+package reexecute
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/engine/execution"
+	executionState "github.com/onflow/flow-go/engine/execution/state"
+	"github.com/onflow/flow-go/fvm/storage/derived"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/wal/fixtures"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/mempool/entity"
+	"github.com/onflow/flow-go/module/metrics"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// incrementComputer is a block computer which executes each block as a single system chunk. The
+// chunk appends a byte to the value of the register assigned to the block.
+type incrementComputer struct {
+	mu        sync.Mutex
+	registers map[flow.Identifier]flow.RegisterID
+	calls     map[flow.Identifier]int
+}
+
+func (c *incrementComputer) ExecuteBlock(
+	_ context.Context,
+	_ flow.Identifier,
+	block *entity.ExecutableBlock,
+	storage snapshot.StorageSnapshot,
+	_ *derived.DerivedBlockData,
+) (*execution.ComputationResult, error) {
+	c.mu.Lock()
+	register := c.registers[block.ID()]
+	c.calls[block.ID()]++
+	c.mu.Unlock()
+
+	value, err := storage.Get(register)
+	if err != nil {
+		return nil, err
+	}
+
+	result := execution.NewPopulatedBlockExecutionResult(block)
+	collectionResult := result.CollectionExecutionResultAt(0)
+	collectionResult.UpdateExecutionSnapshot(&snapshot.ExecutionSnapshot{
+		ReadSet:  map[flow.RegisterID]struct{}{register: {}},
+		WriteSet: map[flow.RegisterID]flow.RegisterValue{register: append(value, 'x')},
+	})
+	collectionResult.AppendTransactionResults(nil, nil, nil, flow.TransactionResult{
+		TransactionID:   unittest.IdentifierFixture(),
+		ComputationUsed: 10,
+	})
+
+	return &execution.ComputationResult{BlockExecutionResult: result}, nil
+}
+
+func TestReexecuteRange(t *testing.T) {
+	registerA := flow.NewRegisterID(unittest.RandomAddressFixture(), "a")
+	registerB := flow.NewRegisterID(unittest.RandomAddressFixture(), "b")
+
+	l, err := complete.NewLedger(&fixtures.NoopWAL{}, 100, metrics.NewNoopCollector(), zerolog.Nop(), complete.DefaultPathFinderVersion)
+	require.NoError(t, err)
+	compactor := fixtures.NewNoopCompactor(l)
+	<-compactor.Ready()
+	defer func() {
+		<-l.Done()
+		<-compactor.Done()
+	}()
+
+	set := func(state flow.StateCommitment, entries flow.RegisterEntries) flow.StateCommitment {
+		keys, values := executionState.RegisterEntriesToKeysValues(entries)
+		update, err := ledger.NewUpdate(ledger.State(state), keys, values)
+		require.NoError(t, err)
+		newState, _, err := l.Set(update)
+		require.NoError(t, err)
+		return flow.StateCommitment(newState)
+	}
+
+	startState := set(flow.StateCommitment(l.InitialState()), flow.RegisterEntries{
+		{Key: registerA, Value: []byte{'1'}},
+		{Key: registerB, Value: []byte{'1'}},
+	})
+
+	// block 1 and 3 increment register A, block 2 increments register B, so block 2 does not
+	// depend on block 1, but block 3 depends on block 1
+	parent := unittest.BlockFixture()
+	block1 := unittest.BlockWithParentFixture(parent.Header)
+	block2 := unittest.BlockWithParentFixture(block1.Header)
+	block3 := unittest.BlockWithParentFixture(block2.Header)
+	blocks := []*flow.Block{block1, block2, block3}
+
+	computer := &incrementComputer{
+		registers: map[flow.Identifier]flow.RegisterID{
+			block1.ID(): registerA,
+			block2.ID(): registerB,
+			block3.ID(): registerA,
+		},
+		calls: make(map[flow.Identifier]int),
+	}
+
+	endStates := []flow.StateCommitment{
+		set(startState, flow.RegisterEntries{{Key: registerA, Value: []byte("1x")}}),
+	}
+	endStates = append(endStates, set(endStates[0], flow.RegisterEntries{{Key: registerB, Value: []byte("1x")}}))
+	endStates = append(endStates, set(endStates[1], flow.RegisterEntries{{Key: registerA, Value: []byte("1xx")}}))
+
+	eventCollection, err := flow.EventsMerkleRootHash(nil)
+	require.NoError(t, err)
+
+	blockStorage := storagemock.NewBlocks(t)
+	results := storagemock.NewExecutionResults(t)
+	results.On("ByBlockID", parent.ID()).Return(unittest.ExecutionResultFixture(
+		unittest.WithBlock(&parent),
+		unittest.WithFinalState(startState),
+	), nil)
+
+	storedResults := make([]*flow.ExecutionResult, len(blocks))
+	for i, block := range blocks {
+		storedResults[i] = unittest.ExecutionResultFixture(unittest.WithBlock(block))
+		storedResults[i].Chunks[0].EndState = endStates[i]
+		storedResults[i].Chunks[0].EventCollection = eventCollection
+
+		blockStorage.On("ByHeight", block.Header.Height).Return(block, nil)
+		results.On("ByBlockID", block.ID()).Return(storedResults[i], nil)
+	}
+
+	run := func(t *testing.T, workers int, writeDeltas bool) (*summary, string) {
+		dir := t.TempDir()
+		report, err := newReportWriter(dir, writeDeltas)
+		require.NoError(t, err)
+
+		r, err := newReexecutor(
+			unittest.Logger(),
+			computer,
+			l,
+			blockStorage,
+			storagemock.NewCollections(t),
+			results,
+			newTransactionStats(),
+			report,
+			workers,
+		)
+		require.NoError(t, err)
+
+		sum, err := r.Run(context.Background(), block1.Header.Height, block3.Header.Height)
+		require.NoError(t, err)
+		require.NoError(t, report.Close())
+		return sum, dir
+	}
+
+	t.Run("sequential", func(t *testing.T) {
+		sum, dir := run(t, 1, false)
+
+		assert.Equal(t, uint64(3), sum.Blocks)
+		assert.Equal(t, uint64(3), sum.Chunks)
+		assert.Equal(t, uint64(3), sum.Transactions)
+		assert.Equal(t, uint64(0), sum.MismatchedChunks)
+		assert.Equal(t, uint64(0), sum.Reexecutions)
+
+		assert.Empty(t, readLines(t, filepath.Join(dir, mismatchesFileName)))
+		assert.Len(t, readLines(t, filepath.Join(dir, transactionsFileName)), 3)
+		assert.NoFileExists(t, filepath.Join(dir, deltasFileName))
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		computer.calls = make(map[flow.Identifier]int)
+
+		sum, dir := run(t, 3, true)
+
+		assert.Equal(t, uint64(3), sum.Blocks)
+		assert.Equal(t, uint64(0), sum.MismatchedChunks)
+
+		// block 2 is executed on top of the start state, but does not read the register written
+		// by block 1. block 3 reads the register written by block 1, so it is executed again.
+		assert.Equal(t, uint64(1), sum.Reexecutions)
+		assert.Equal(t, 1, computer.calls[block1.ID()])
+		assert.Equal(t, 1, computer.calls[block2.ID()])
+		assert.Equal(t, 2, computer.calls[block3.ID()])
+
+		deltas := readLines(t, filepath.Join(dir, deltasFileName))
+		require.Len(t, deltas, 3)
+		var last registerDeltas
+		require.NoError(t, json.Unmarshal(deltas[2], &last))
+		assert.Equal(t, []byte("1xx"), last.Registers[0].Value)
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		storedResults[1].Chunks[0].EndState = unittest.StateCommitmentFixture()
+
+		sum, dir := run(t, 2, false)
+
+		assert.Equal(t, uint64(3), sum.Blocks)
+		assert.Equal(t, uint64(1), sum.MismatchedChunks)
+
+		mismatches := readLines(t, filepath.Join(dir, mismatchesFileName))
+		require.Len(t, mismatches, 1)
+		var chunk chunkReport
+		require.NoError(t, json.Unmarshal(mismatches[0], &chunk))
+		assert.Equal(t, block2.ID(), chunk.BlockID)
+		assert.Equal(t, endStates[1], chunk.EndState)
+		assert.Equal(t, storedResults[1].Chunks[0].EndState, chunk.ExpectedEndState)
+	})
+}
+
+func readLines(t *testing.T, path string) [][]byte {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var lines [][]byte
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, append([]byte(nil), scanner.Bytes()...))
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
@@ -0,0 +1,163 @@
+package reexecute
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/onflow/flow-go/engine/execution/checker"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const (
+	mismatchesFileName   = "mismatches.jsonl"
+	transactionsFileName = "transactions.jsonl"
+	deltasFileName       = "register_deltas.jsonl"
+)
+
+// chunkReport is the outcome of re-executing a chunk.
+type chunkReport struct {
+	Height          uint64               `json:"height"`
+	BlockID         flow.Identifier      `json:"block_id"`
+	ChunkIndex      int                  `json:"chunk_index"`
+	StartState      flow.StateCommitment `json:"start_state"`
+	EndState        flow.StateCommitment `json:"end_state"`
+	EventCollection flow.Identifier      `json:"event_collection"`
+
+	// Checked indicates whether an execution result was stored for the block, in which case the
+	// expected end state and event collection are set.
+	Checked                 bool                 `json:"checked"`
+	ExpectedEndState        flow.StateCommitment `json:"expected_end_state"`
+	ExpectedEventCollection flow.Identifier      `json:"expected_event_collection"`
+
+	// Updates are the registers updated by the chunk.
+	Updates flow.RegisterEntries `json:"-"`
+}
+
+// Mismatch returns true if the re-executed chunk differs from the stored chunk.
+func (c chunkReport) Mismatch() bool {
+	return c.Checked && (c.EndState != c.ExpectedEndState || c.EventCollection != c.ExpectedEventCollection)
+}
+
+// transactionReport is the outcome of re-executing a transaction.
+type transactionReport struct {
+	Height            uint64          `json:"height"`
+	BlockID           flow.Identifier `json:"block_id"`
+	TransactionID     flow.Identifier `json:"transaction_id"`
+	Index             int             `json:"index"`
+	SystemTransaction bool            `json:"system_transaction"`
+	ExecutionTime     time.Duration   `json:"execution_time_ns"`
+	ComputationUsed   uint64          `json:"computation_used"`
+	MemoryUsed        uint64          `json:"memory_used"`
+	Error             string          `json:"error,omitempty"`
+}
+
+// registerDeltas are the registers updated by a chunk.
+type registerDeltas struct {
+	Height     uint64                   `json:"height"`
+	BlockID    flow.Identifier          `json:"block_id"`
+	ChunkIndex int                      `json:"chunk_index"`
+	Registers  []checker.BundleRegister `json:"registers"`
+}
+
+// reportWriter writes the re-execution report into a directory, as one JSON document per line:
+//   - mismatches.jsonl holds the chunks which differ from the stored execution results.
+//   - transactions.jsonl holds the execution time and computation of each transaction.
+//   - register_deltas.jsonl holds the registers updated by each chunk, if enabled.
+type reportWriter struct {
+	files        []*os.File
+	writers      []*bufio.Writer
+	mismatches   *json.Encoder
+	transactions *json.Encoder
+	deltas       *json.Encoder
+}
+
+// newReportWriter creates the report files in the given directory. Register deltas are only
+// written if writeDeltas is true.
+func newReportWriter(dir string, writeDeltas bool) (*reportWriter, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("could not create report directory: %w", err)
+	}
+
+	w := &reportWriter{}
+	w.mismatches, err = w.create(filepath.Join(dir, mismatchesFileName))
+	if err != nil {
+		return nil, errors.Join(err, w.Close())
+	}
+	w.transactions, err = w.create(filepath.Join(dir, transactionsFileName))
+	if err != nil {
+		return nil, errors.Join(err, w.Close())
+	}
+	if writeDeltas {
+		w.deltas, err = w.create(filepath.Join(dir, deltasFileName))
+		if err != nil {
+			return nil, errors.Join(err, w.Close())
+		}
+	}
+
+	return w, nil
+}
+
+func (w *reportWriter) create(path string) (*json.Encoder, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not create report file: %w", err)
+	}
+	writer := bufio.NewWriter(file)
+
+	w.files = append(w.files, file)
+	w.writers = append(w.writers, writer)
+	return json.NewEncoder(writer), nil
+}
+
+// WriteBlock writes the report of the chunks and transactions of a block.
+func (w *reportWriter) WriteBlock(chunks []chunkReport, transactions []transactionReport) error {
+	for _, chunk := range chunks {
+		if chunk.Mismatch() {
+			err := w.mismatches.Encode(chunk)
+			if err != nil {
+				return fmt.Errorf("could not write chunk mismatch: %w", err)
+			}
+		}
+
+		if w.deltas == nil {
+			continue
+		}
+		deltas := registerDeltas{
+			Height:     chunk.Height,
+			BlockID:    chunk.BlockID,
+			ChunkIndex: chunk.ChunkIndex,
+			Registers:  make([]checker.BundleRegister, 0, len(chunk.Updates)),
+		}
+		for _, update := range chunk.Updates {
+			deltas.Registers = append(deltas.Registers, checker.NewBundleRegister(update.Key, update.Value))
+		}
+		err := w.deltas.Encode(deltas)
+		if err != nil {
+			return fmt.Errorf("could not write register deltas: %w", err)
+		}
+	}
+
+	for _, transaction := range transactions {
+		err := w.transactions.Encode(transaction)
+		if err != nil {
+			return fmt.Errorf("could not write transaction report: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Close flushes and closes the report files.
+func (w *reportWriter) Close() error {
+	var errs []error
+	for i, file := range w.files {
+		errs = append(errs, w.writers[i].Flush(), file.Close())
+	}
+	return errors.Join(errs...)
+}
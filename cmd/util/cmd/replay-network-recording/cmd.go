@@ -0,0 +1,93 @@
+package replay_network_recording
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/message"
+	"github.com/onflow/flow-go/network/recorder"
+)
+
+var (
+	flagRecordingDir string
+	flagChannel      string
+	flagSpeed        float64
+)
+
+// replay the inbound messages of a network recording, made with the --network-recorder-dir node flag,
+// to the engine of a single channel through a stub network.
+// the messages are delivered in original time, accelerated by the speed factor, and logged with their
+// decoded payload, which is useful to reproduce the sequence of messages an engine received.
+var Cmd = &cobra.Command{
+	Use:   "replay-network-recording",
+	Short: "replay the inbound messages of a network recording to the engine of a channel",
+	Run:   run,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&flagRecordingDir, "recording-dir", "",
+		"directory of the network recording")
+	_ = Cmd.MarkFlagRequired("recording-dir")
+
+	Cmd.Flags().StringVar(&flagChannel, "channel", "",
+		"channel of the engine to replay the messages to, e.g. sync-committee")
+	_ = Cmd.MarkFlagRequired("channel")
+
+	Cmd.Flags().Float64Var(&flagSpeed, "speed", 1,
+		"speed factor of the replay, 1 replays in original time, 0 replays without delay")
+}
+
+func run(*cobra.Command, []string) {
+	channel := channels.Channel(flagChannel)
+	if !channels.ChannelExists(channel) {
+		log.Fatal().Str("channel", flagChannel).Msg("unknown channel")
+	}
+
+	reader, err := recorder.NewReader(flagRecordingDir)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not open recording")
+	}
+	defer reader.Close()
+
+	net := recorder.NewReplayNetwork(log.Logger, nil)
+	_, err = net.Register(channel, &loggingEngine{})
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not register engine")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	log.Info().
+		Str("recording_dir", flagRecordingDir).
+		Str("channel", flagChannel).
+		Float64("speed", flagSpeed).
+		Msg("replaying network recording")
+
+	replayed, err := net.Replay(ctx, reader, flagSpeed)
+	if err != nil {
+		log.Fatal().Err(err).Uint64("replayed", replayed).Msg("could not replay recording")
+	}
+
+	log.Info().Uint64("replayed", replayed).Msg("network recording replayed")
+}
+
+// loggingEngine logs the messages it receives.
+type loggingEngine struct{}
+
+func (e *loggingEngine) Process(channel channels.Channel, originID flow.Identifier, msg interface{}) error {
+	log.Info().
+		Str("channel", channel.String()).
+		Str("origin_id", originID.String()).
+		Str("message_type", message.MessageType(msg)).
+		Interface("message", msg).
+		Msg("message delivered")
+	return nil
+}
@@ -19,11 +19,13 @@ import (
 	"github.com/onflow/flow-go/engine/access/rest/websockets"
 	"github.com/onflow/flow-go/engine/access/state_stream/backend"
 	"github.com/onflow/flow-go/engine/access/subscription"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
 	"github.com/onflow/flow-go/engine/execution/computation"
 	"github.com/onflow/flow-go/fvm"
 	"github.com/onflow/flow-go/fvm/storage/snapshot"
 	"github.com/onflow/flow-go/ledger"
 	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/events"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/irrecoverable"
 	"github.com/onflow/flow-go/module/metrics"
@@ -188,6 +190,7 @@ func run(*cobra.Command, []string) {
 			backend.Config{},
 			false,
 			websockets.NewDefaultWebsocketConfig(),
+			nil,
 		)
 		if err != nil {
 			log.Fatal().Err(err).Msg("failed to create server")
@@ -354,6 +357,13 @@ func (*api) GetSystemTransactionResult(
 	return nil, errors.New("unimplemented")
 }
 
+func (*api) GetPendingTransactionDetails(
+	_ context.Context,
+	_ flow.Identifier,
+) (*accessmodel.PendingTransactionDetails, error) {
+	return nil, errors.New("unimplemented")
+}
+
 func (*api) GetAccount(_ context.Context, _ flow.Address) (*flow.Account, error) {
 	return nil, errors.New("unimplemented")
 }
@@ -410,6 +420,61 @@ func (*api) GetAccountKeysAtBlockHeight(
 	return nil, errors.New("unimplemented")
 }
 
+// GetTransactionsByAddress is not supported, since the account transaction index is built by the
+// execution state indexer, and only the registers of a single state are loaded here.
+func (*api) GetTransactionsByAddress(
+	_ context.Context,
+	_ flow.Address,
+	_ uint32,
+	_ *accessmodel.AccountTransactionCursor,
+) (*accessmodel.AccountTransactionsPage, error) {
+	return nil, errors.New("unimplemented")
+}
+
+func (*api) GetAccountStateDiff(
+	_ context.Context,
+	_ flow.Address,
+	_ uint64,
+	_ uint64,
+) (*accessmodel.AccountStateDiff, error) {
+	return nil, errors.New("unimplemented")
+}
+
+// GetContractHistory and GetContractAtBlockHeight are not supported, since the contract update index is built by
+// the execution state indexer, and only the registers of a single state are loaded here.
+func (*api) GetContractHistory(
+	_ context.Context,
+	_ flow.Address,
+	_ string,
+) ([]accessmodel.ContractVersion, error) {
+	return nil, errors.New("unimplemented")
+}
+
+func (*api) GetContractAtBlockHeight(
+	_ context.Context,
+	_ flow.Address,
+	_ string,
+	_ uint64,
+) (*accessmodel.ContractVersion, error) {
+	return nil, errors.New("unimplemented")
+}
+
+func (*api) GetRegisterProofsAtBlockID(
+	_ context.Context,
+	_ flow.Identifier,
+	_ []flow.RegisterID,
+) (*accessmodel.RegisterProofs, error) {
+	return nil, errors.New("unimplemented")
+}
+
+func (*api) GetRegisterProofsAtStateCommitment(
+	_ context.Context,
+	_ flow.StateCommitment,
+	_ []flow.RegisterID,
+) (*accessmodel.RegisterProofs, error) {
+	return nil, errors.New("unimplemented")
+}
+
 func (a *api) ExecuteScriptAtLatestBlock(
 	_ context.Context,
 	script []byte,
@@ -442,6 +507,93 @@ func (*api) ExecuteScriptAtBlockID(
 	return nil, errors.New("unimplemented")
 }
 
+func (*api) ExecuteScriptAtLatestBlockWithReport(
+	_ context.Context,
+	_ []byte,
+	_ [][]byte,
+) (*accessmodel.ScriptExecutionResult, error) {
+	return nil, errors.New("unimplemented")
+}
+
+func (*api) ExecuteScriptAtBlockHeightWithReport(
+	_ context.Context,
+	_ uint64,
+	_ []byte,
+	_ [][]byte,
+) (*accessmodel.ScriptExecutionResult, error) {
+	return nil, errors.New("unimplemented")
+}
+
+func (*api) ExecuteScriptAtBlockIDWithReport(
+	_ context.Context,
+	_ flow.Identifier,
+	_ []byte,
+	_ [][]byte,
+) (*accessmodel.ScriptExecutionResult, error) {
+	return nil, errors.New("unimplemented")
+}
+
+// DryRunTransactionAtLatestBlock executes the transaction against the loaded state. The changes made by the
+// transaction are discarded, and no fees are reported since transaction fees are disabled.
+func (a *api) DryRunTransactionAtLatestBlock(
+	_ context.Context,
+	tx *flow.TransactionBody,
+	options accessmodel.TransactionDryRunOptions,
+	requiredEventEncodingVersion entities.EventEncodingVersion,
+) (*accessmodel.TransactionDryRunResult, error) {
+	ctx := fvm.NewContextFromParent(
+		a.ctx,
+		fvm.WithAuthorizationChecksEnabled(options.VerifySignatures),
+		fvm.WithSequenceNumberCheckAndIncrementEnabled(options.CheckSequenceNumber),
+	)
+
+	_, output, err := a.vm.Run(ctx, fvm.Transaction(tx, 0), a.storageSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	// events are encoded in CCF format by the FVM. convert to JSON-CDC if requested
+	txEvents := []flow.Event(output.Events)
+	if requiredEventEncodingVersion == entities.EventEncodingVersion_JSON_CDC_V0 {
+		txEvents, err = convert.CcfEventsToJsonEvents(txEvents)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert event payload: %w", err)
+		}
+	}
+
+	result := &accessmodel.TransactionDryRunResult{
+		ComputationUsed: output.ComputationUsed,
+		MemoryEstimate:  output.MemoryEstimate,
+		Events:          txEvents,
+	}
+	if output.Err != nil {
+		result.StatusCode = 1
+		result.ErrorMessage = output.Err.Error()
+	}
+
+	return result, nil
+}
+
+func (*api) DryRunTransactionAtBlockHeight(
+	_ context.Context,
+	_ uint64,
+	_ *flow.TransactionBody,
+	_ accessmodel.TransactionDryRunOptions,
+	_ entities.EventEncodingVersion,
+) (*accessmodel.TransactionDryRunResult, error) {
+	return nil, errors.New("unimplemented")
+}
+
+func (*api) DryRunTransactionAtBlockID(
+	_ context.Context,
+	_ flow.Identifier,
+	_ *flow.TransactionBody,
+	_ accessmodel.TransactionDryRunOptions,
+	_ entities.EventEncodingVersion,
+) (*accessmodel.TransactionDryRunResult, error) {
+	return nil, errors.New("unimplemented")
+}
+
 func (a *api) GetEventsForHeightRange(
 	_ context.Context,
 	_ string,
@@ -460,6 +612,20 @@ func (a *api) GetEventsForBlockIDs(
 	return nil, errors.New("unimplemented")
 }
 
+// GetEventsForHeightRangeWithFieldFilters is not supported, since only the registers of a single state are
+// loaded here, without the events index.
+func (a *api) GetEventsForHeightRangeWithFieldFilters(
+	_ context.Context,
+	_ string,
+	_, _ uint64,
+	_ events.FieldFilters,
+	_ uint32,
+	_ *accessmodel.EventCursor,
+	_ entities.EventEncodingVersion,
+) (*accessmodel.EventsPage, error) {
+	return nil, errors.New("unimplemented")
+}
+
 func (*api) GetLatestProtocolStateSnapshot(_ context.Context) ([]byte, error) {
 	return nil, errors.New("unimplemented")
 }
@@ -0,0 +1,60 @@
+package index
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// AccountTransactionsIndex implements a wrapper around `storage.AccountTransactions` ensuring that needed data has been synced and is available to the client.
+// Note: read detail how `Reporter` is working
+type AccountTransactionsIndex struct {
+	*Reporter
+	accountTxs storage.AccountTransactionsReader
+}
+
+func NewAccountTransactionsIndex(reporter *Reporter, accountTxs storage.AccountTransactionsReader) *AccountTransactionsIndex {
+	return &AccountTransactionsIndex{
+		Reporter:   reporter,
+		accountTxs: accountTxs,
+	}
+}
+
+// ByAddress checks data availability and returns up to `limit` transactions which touched the given
+// account, ordered by descending block height and transaction index. The lookup starts with the entry
+// at the given height and transaction index (inclusive) and continues with older entries.
+// Entries above the highest indexed height are never returned.
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the `AccountTransactionsIndex` has not been initialized
+//   - storage.ErrHeightNotIndexed if the start height is below the lowest indexed height
+func (a *AccountTransactionsIndex) ByAddress(
+	address flow.Address,
+	startHeight uint64,
+	startTxIndex uint32,
+	limit uint32,
+) ([]flow.AccountTransaction, error) {
+	highestHeight, err := a.HighestIndexedHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	// data above the highest indexed height may be partially indexed, so start at the highest
+	// indexed height instead
+	if startHeight > highestHeight {
+		startHeight = highestHeight
+		startTxIndex = math.MaxUint32
+	}
+
+	if err := a.checkDataAvailability(startHeight); err != nil {
+		return nil, err
+	}
+
+	txs, err := a.accountTxs.ByAddress(address, startHeight, startTxIndex, limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not get account transactions: %w", err)
+	}
+
+	return txs, nil
+}
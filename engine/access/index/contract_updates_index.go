@@ -0,0 +1,57 @@
+package index
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// ContractUpdatesIndex implements a wrapper around `storage.ContractUpdates` ensuring that needed data has been synced and is available to the client.
+// Note: read detail how `Reporter` is working
+type ContractUpdatesIndex struct {
+	*Reporter
+	contractUpdates storage.ContractUpdatesReader
+}
+
+func NewContractUpdatesIndex(reporter *Reporter, contractUpdates storage.ContractUpdatesReader) *ContractUpdatesIndex {
+	return &ContractUpdatesIndex{
+		Reporter:        reporter,
+		contractUpdates: contractUpdates,
+	}
+}
+
+// ByContract checks data availability and returns the updates of the given contract up to the given height
+// (inclusive), ordered by ascending block height, transaction index and event index. Only updates within
+// the indexed height range are returned. Entries above the highest indexed height are never returned.
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the `ContractUpdatesIndex` has not been initialized
+//   - storage.ErrHeightNotIndexed if the height is below the lowest indexed height
+func (c *ContractUpdatesIndex) ByContract(address flow.Address, name string, height uint64) ([]flow.ContractUpdate, error) {
+	highestHeight, err := c.HighestIndexedHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	// data above the highest indexed height may be partially indexed
+	if height > highestHeight {
+		height = highestHeight
+	}
+
+	if err := c.checkDataAvailability(height); err != nil {
+		return nil, err
+	}
+
+	updates, err := c.contractUpdates.ByContract(address, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not get contract updates: %w", err)
+	}
+
+	for i, update := range updates {
+		if update.BlockHeight > height {
+			return updates[:i], nil
+		}
+	}
+
+	return updates, nil
+}
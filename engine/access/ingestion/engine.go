@@ -105,6 +105,9 @@ type Engine struct {
 	collectionExecutedMetric module.CollectionExecutedMetric
 
 	txErrorMessagesCore *tx_error_messages.TxErrorMessagesCore
+
+	// receiptConsumers are notified of every execution receipt stored by the engine
+	receiptConsumers []func(*flow.ExecutionReceipt)
 }
 
 var _ network.MessageProcessor = (*Engine)(nil)
@@ -483,9 +486,19 @@ func (e *Engine) handleExecutionReceipt(_ flow.Identifier, r *flow.ExecutionRece
 	}
 
 	e.collectionExecutedMetric.ExecutionReceiptReceived(r)
+	for _, consumer := range e.receiptConsumers {
+		consumer(r)
+	}
 	return nil
 }
 
+// AddOnExecutionReceiptConsumer adds a consumer, which is notified of every execution receipt received and
+// stored by the engine. Consumers must be non-blocking.
+// Not concurrency safe: must be called before the engine is started.
+func (e *Engine) AddOnExecutionReceiptConsumer(consumer func(*flow.ExecutionReceipt)) {
+	e.receiptConsumers = append(e.receiptConsumers, consumer)
+}
+
 // OnCollection handles the response of the collection request made earlier when a block was received.
 // No errors expected during normal operations.
 func (e *Engine) OnCollection(originID flow.Identifier, entity flow.Entity) {
@@ -0,0 +1,128 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/access/ratelimit"
+	"github.com/onflow/flow-go/engine/access/rest/common/models"
+)
+
+const (
+	// ClientKeySourceIP identifies clients by their IP address.
+	ClientKeySourceIP = "ip"
+	// ClientKeySourceHeader identifies clients by the value of an API key header, if the key is one of the
+	// configured API keys. Requests without the header or with an unknown key are identified by their
+	// IP address, so that clients cannot bypass their limits by sending a new key with each request.
+	ClientKeySourceHeader = "header"
+	// ClientKeySourceMTLS identifies clients by the fingerprint of their TLS client certificate.
+	// Requests without a client certificate are identified by their IP address.
+	ClientKeySourceMTLS = "mtls"
+
+	// DefaultClientKeyHeader is the default header used to identify clients by API key.
+	DefaultClientKeyHeader = "X-Api-Key"
+)
+
+// ClientKeyFunc returns the key identifying the client of a request.
+type ClientKeyFunc func(req *http.Request) string
+
+// NewClientKeyFunc returns a ClientKeyFunc for the given client key source. The header and API keys are
+// only used with ClientKeySourceHeader.
+// Expected errors during normal operations:
+//   - error if the source is unknown, or the header or API keys are empty for ClientKeySourceHeader.
+func NewClientKeyFunc(source string, header string, apiKeys []string) (ClientKeyFunc, error) {
+	switch source {
+	case ClientKeySourceIP:
+		return clientIP, nil
+	case ClientKeySourceHeader:
+		if header == "" {
+			return nil, fmt.Errorf("client key header must not be empty")
+		}
+		if len(apiKeys) == 0 {
+			return nil, fmt.Errorf("at least one API key must be configured")
+		}
+		allowed := make(map[string]struct{}, len(apiKeys))
+		for _, key := range apiKeys {
+			allowed[key] = struct{}{}
+		}
+		return func(req *http.Request) string {
+			key := req.Header.Get(header)
+			if _, ok := allowed[key]; ok && key != "" {
+				return "key:" + key
+			}
+			return clientIP(req)
+		}, nil
+	case ClientKeySourceMTLS:
+		return func(req *http.Request) string {
+			if req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+				fingerprint := sha256.Sum256(req.TLS.PeerCertificates[0].Raw)
+				return "cert:" + hex.EncodeToString(fingerprint[:])
+			}
+			return clientIP(req)
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid client key source %q: must be one of %s, %s, %s",
+			source, ClientKeySourceIP, ClientKeySourceHeader, ClientKeySourceMTLS)
+	}
+}
+
+// clientIP returns the key identifying the client of a request by its IP address.
+func clientIP(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// RateLimitMiddleware creates a middleware which applies the per client limits of the given limiter to
+// each request, using the name of the matched route as the bucket. Requests exceeding the limits are
+// rejected with a 429 response and a Retry-After header.
+func RateLimitMiddleware(logger zerolog.Logger, limiter *ratelimit.ClientLimiter, clientKey ClientKeyFunc) mux.MiddlewareFunc {
+	return func(inner http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			var route string
+			if current := mux.CurrentRoute(req); current != nil {
+				route = current.GetName()
+			}
+
+			client := clientKey(req)
+			allowed, retryAfter := limiter.Allow(client, route)
+			if allowed {
+				inner.ServeHTTP(w, req)
+				return
+			}
+
+			logger.Debug().
+				Str("client", client).
+				Str("route", route).
+				Dur("retry_after", retryAfter).
+				Msg("rate limit exceeded")
+
+			seconds := int64(math.Ceil(retryAfter.Seconds()))
+			if seconds < 1 {
+				seconds = 1
+			}
+			w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+			w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			err := json.NewEncoder(w).Encode(models.ModelError{
+				Code:    http.StatusTooManyRequests,
+				Message: "rate limit reached, please retry later",
+			})
+			if err != nil {
+				logger.Error().Err(err).Msg("failed to write rate limit response")
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/access/ratelimit"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestRateLimitMiddleware tests that requests exceeding the per client limits are rejected with a 429
+// response, using the route name as the bucket.
+func TestRateLimitMiddleware(t *testing.T) {
+	limiter, err := ratelimit.NewClientLimiter(ratelimit.ClientLimits{
+		Default: ratelimit.ClientLimit{Rate: 1},
+		Routes: map[string]ratelimit.ClientLimit{
+			"ping": {},
+		},
+	}, ratelimit.DefaultMaxTrackedClients)
+	require.NoError(t, err)
+
+	clientKey, err := NewClientKeyFunc(ClientKeySourceHeader, DefaultClientKeyHeader, []string{"a", "b"})
+	require.NoError(t, err)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	r := mux.NewRouter()
+	r.Handle("/blocks", handler).Name("getBlocks")
+	r.Handle("/ping", handler).Name("ping")
+	r.Use(RateLimitMiddleware(unittest.Logger(), limiter, clientKey))
+
+	request := func(path string, apiKey string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if apiKey != "" {
+			req.Header.Set(DefaultClientKeyHeader, apiKey)
+		}
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := request("/blocks", "a")
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	rr = request("/blocks", "a")
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	assert.Equal(t, "1", rr.Header().Get("Retry-After"))
+	assert.JSONEq(t, `{"code": 429, "message": "rate limit reached, please retry later"}`, rr.Body.String())
+
+	// other clients and unlimited routes are not affected
+	rr = request("/blocks", "b")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	rr = request("/ping", "a")
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	// clients without an API key are identified by their IP address
+	rr = request("/blocks", "")
+	assert.Equal(t, http.StatusOK, rr.Code)
+	rr = request("/blocks", "")
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+
+	// rotating unknown API keys does not bypass the limit of the IP address
+	for i := 0; i < 3; i++ {
+		rr = request("/blocks", fmt.Sprintf("rotated-%d", i))
+		assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+	}
+}
+
+// TestNewClientKeyFunc tests identifying clients by their IP address, API key and client certificate.
+func TestNewClientKeyFunc(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+
+	ipKey, err := NewClientKeyFunc(ClientKeySourceIP, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ip:10.0.0.1", ipKey(req))
+
+	headerKey, err := NewClientKeyFunc(ClientKeySourceHeader, "X-Key", []string{"secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "ip:10.0.0.1", headerKey(req))
+	req.Header.Set("X-Key", "unknown")
+	assert.Equal(t, "ip:10.0.0.1", headerKey(req))
+	req.Header.Set("X-Key", "secret")
+	assert.Equal(t, "key:secret", headerKey(req))
+
+	mtlsKey, err := NewClientKeyFunc(ClientKeySourceMTLS, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ip:10.0.0.1", mtlsKey(req))
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Raw: []byte("certificate")}}}
+	assert.Regexp(t, "^cert:[0-9a-f]{64}$", mtlsKey(req))
+
+	_, err = NewClientKeyFunc(ClientKeySourceHeader, "", []string{"secret"})
+	assert.Error(t, err)
+	_, err = NewClientKeyFunc(ClientKeySourceHeader, "X-Key", nil)
+	assert.Error(t, err)
+	_, err = NewClientKeyFunc("unknown", "", nil)
+	assert.Error(t, err)
+}
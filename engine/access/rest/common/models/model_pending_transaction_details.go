@@ -0,0 +1,21 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type PendingTransactionDetails struct {
+	Reason          string                            `json:"reason"`
+	Message         string                            `json:"message"`
+	CollectionNodes []CollectionNodeTransactionStatus `json:"collection_nodes"`
+}
+
+type CollectionNodeTransactionStatus struct {
+	NodeId  string `json:"node_id"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
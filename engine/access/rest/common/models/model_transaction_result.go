@@ -19,4 +19,6 @@ type TransactionResult struct {
 	ComputationUsed string  `json:"computation_used"`
 	Events          []Event `json:"events"`
 	Links           *Links  `json:"_links,omitempty"`
+	// Status reported by the collection nodes in case the transaction is pending.
+	PendingDetails *PendingTransactionDetails `json:"pending_details,omitempty"`
 }
@@ -109,10 +109,29 @@ func (t *TransactionResult) Build(txr *accessmodel.TransactionResult, txID flow.
 	t.ComputationUsed = util.FromUint(uint64(0)) // todo: define this
 	t.Events = events
 
+	if txr.PendingDetails != nil {
+		var pending PendingTransactionDetails
+		pending.Build(txr.PendingDetails)
+		t.PendingDetails = &pending
+	}
+
 	self, _ := SelfLink(txID, link.TransactionResultLink)
 	t.Links = self
 }
 
+func (p *PendingTransactionDetails) Build(details *accessmodel.PendingTransactionDetails) {
+	p.Reason = details.Reason.String()
+	p.Message = details.Message
+	p.CollectionNodes = make([]CollectionNodeTransactionStatus, len(details.Nodes))
+	for i, node := range details.Nodes {
+		p.CollectionNodes[i] = CollectionNodeTransactionStatus{
+			NodeId:  node.NodeID.String(),
+			Reason:  node.Reason.String(),
+			Message: node.Message,
+		}
+	}
+}
+
 func (t *TransactionStatus) Build(status flow.TransactionStatus) {
 	switch status {
 	case flow.TransactionStatusExpired:
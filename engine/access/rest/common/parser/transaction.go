@@ -15,7 +15,18 @@ const maxAuthorizers = 100
 
 type Transaction flow.TransactionBody
 
+// Parse parses a transaction which is going to be sent to the network, so it must be signed.
 func (t *Transaction) Parse(raw io.Reader, chain flow.Chain) error {
+	return t.parse(raw, chain, true)
+}
+
+// ParseUnsigned parses a transaction which is not required to carry any signatures,
+// e.g. a transaction which is only dry-run.
+func (t *Transaction) ParseUnsigned(raw io.Reader, chain flow.Chain) error {
+	return t.parse(raw, chain, false)
+}
+
+func (t *Transaction) parse(raw io.Reader, chain flow.Chain, signatureRequired bool) error {
 	var tx models.TransactionsBody
 	err := common.ParseBody(raw, &tx)
 	if err != nil {
@@ -40,7 +51,7 @@ func (t *Transaction) Parse(raw io.Reader, chain flow.Chain) error {
 	if tx.ReferenceBlockId == "" {
 		return fmt.Errorf("reference block not provided")
 	}
-	if len(tx.EnvelopeSignatures) == 0 {
+	if signatureRequired && len(tx.EnvelopeSignatures) == 0 {
 		return fmt.Errorf("envelope signatures not provided")
 	}
 
@@ -0,0 +1,30 @@
+package graphql
+
+const (
+	// DefaultMaxQueryDepth is the default maximum depth of nested selections in a query.
+	DefaultMaxQueryDepth = 10
+
+	// DefaultMaxQueryCost is the default maximum cost of a query.
+	DefaultMaxQueryCost = 500
+)
+
+// Config defines the configurable options of the GraphQL endpoint.
+type Config struct {
+	// Enabled determines whether the GraphQL endpoint is served by the REST server.
+	Enabled bool
+	// MaxQueryDepth is the maximum depth of nested selections in a query.
+	// Queries exceeding the depth are rejected before they are executed.
+	MaxQueryDepth int
+	// MaxQueryCost is the maximum cost of a query. Each Access API call made while resolving a query
+	// costs 1, and the query fails as soon as its cost exceeds the limit.
+	MaxQueryCost uint64
+}
+
+// NewDefaultConfig returns the default configuration of the GraphQL endpoint.
+func NewDefaultConfig() Config {
+	return Config{
+		Enabled:       false,
+		MaxQueryDepth: DefaultMaxQueryDepth,
+		MaxQueryCost:  DefaultMaxQueryCost,
+	}
+}
@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"context"
+
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+)
+
+// queryCost tracks the cost of a query while it is resolved.
+// Fields are resolved concurrently, so the cost is updated atomically.
+type queryCost struct {
+	limit uint64
+	spent *atomic.Uint64
+}
+
+type queryCostKey struct{}
+
+// withQueryCost returns a context which tracks the cost of a query resolved with it.
+func withQueryCost(ctx context.Context, limit uint64) context.Context {
+	return context.WithValue(ctx, queryCostKey{}, &queryCost{
+		limit: limit,
+		spent: atomic.NewUint64(0),
+	})
+}
+
+// chargeQueryCost must be called before each Access API call made while resolving a query.
+// It adds the cost of the call to the cost of the query, and returns an error if the query
+// exceeded its cost limit, in which case the call must not be made.
+// Queries resolved with a context without a cost limit are not limited.
+//
+// Expected errors during normal operations:
+//   - *queryError with codes.ResourceExhausted if the query exceeded its cost limit
+func chargeQueryCost(ctx context.Context) error {
+	cost, ok := ctx.Value(queryCostKey{}).(*queryCost)
+	if !ok {
+		return nil
+	}
+
+	if cost.spent.Inc() > cost.limit {
+		return newQueryError(codes.ResourceExhausted, "query cost limit of %d exceeded", cost.limit)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// queryError is an error reported to clients in the errors of a GraphQL response.
+// The code is returned in the error extensions, which allows clients to handle errors without parsing messages.
+type queryError struct {
+	code    codes.Code
+	message string
+}
+
+var _ error = (*queryError)(nil)
+
+func newQueryError(code codes.Code, format string, args ...interface{}) *queryError {
+	return &queryError{
+		code:    code,
+		message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (e *queryError) Error() string {
+	return e.message
+}
+
+// Extensions returns the extensions of the error included in the GraphQL response.
+func (e *queryError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": e.code.String(),
+	}
+}
+
+// convertError converts an error returned by the Access API to an error reported to clients.
+// Similar to the REST handlers, messages of expected gRPC status errors are forwarded to the client,
+// and all other errors are logged and reported as internal errors.
+func convertError(log zerolog.Logger, err error) error {
+	if se, ok := status.FromError(err); ok {
+		switch se.Code() {
+		case codes.NotFound:
+			return newQueryError(se.Code(), "Flow resource not found: %s", se.Message())
+		case codes.InvalidArgument, codes.OutOfRange:
+			return newQueryError(se.Code(), "Invalid Flow argument: %s", se.Message())
+		case codes.Internal:
+			return newQueryError(se.Code(), "Invalid Flow request: %s", se.Message())
+		case codes.Unavailable:
+			return newQueryError(se.Code(), "Failed to process request: %s", se.Message())
+		case codes.ResourceExhausted:
+			return newQueryError(se.Code(), "%s", se.Message())
+		}
+	}
+
+	log.Error().Err(err).Msg("failed to resolve graphql query")
+	return newQueryError(codes.Internal, "internal server error")
+}
@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// request is a GraphQL request, sent either as the JSON body of a POST request,
+// or as the query parameters of a GET request.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Handler serves GraphQL queries, which are resolved using the Access API.
+//
+// Queries are validated against the schema, and queries with selections nested deeper than
+// the configured maximum depth are rejected before they are executed. While a query is resolved,
+// each Access API call is charged to the cost of the query, and the query fails once its cost
+// exceeds the configured maximum cost.
+type Handler struct {
+	*common.HttpHandler
+	schema       *gql.Schema
+	maxQueryCost uint64
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+// NewHandler returns a new GraphQL handler backed by the given Access API.
+func NewHandler(
+	logger zerolog.Logger,
+	backend access.API,
+	chain flow.Chain,
+	config Config,
+	maxRequestSize int64,
+) *Handler {
+	log := logger.With().Str("component", "graphql").Logger()
+	root := &resolver{
+		log:     log,
+		backend: backend,
+		chain:   chain,
+	}
+
+	return &Handler{
+		HttpHandler: common.NewHttpHandler(log, chain, maxRequestSize),
+		schema: gql.MustParseSchema(
+			schema,
+			root,
+			gql.UseStringDescriptions(),
+			gql.MaxDepth(config.MaxQueryDepth),
+		),
+		maxQueryCost: config.MaxQueryCost,
+	}
+}
+
+// ServeHTTP executes the GraphQL query of the request. Errors of the query are returned in the
+// errors of the GraphQL response, and only malformed requests are rejected with an HTTP error.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	errLog := h.Logger.With().Str("request_url", r.URL.String()).Logger()
+
+	err := h.VerifyRequest(w, r)
+	if err != nil {
+		return
+	}
+
+	req, err := parseRequest(r)
+	if err != nil {
+		h.ErrorHandler(w, common.NewBadRequestError(err), errLog)
+		return
+	}
+
+	ctx := withQueryCost(r.Context(), h.maxQueryCost)
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	h.JsonResponse(w, http.StatusOK, response, errLog)
+}
+
+// parseRequest parses the GraphQL request from the query parameters of a GET request,
+// or the JSON body of a POST request.
+//
+// All errors indicate the request is invalid.
+func parseRequest(r *http.Request) (request, error) {
+	var req request
+
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		req.Query = query.Get("query")
+		req.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			err := json.Unmarshal([]byte(variables), &req.Variables)
+			if err != nil {
+				return request{}, fmt.Errorf("invalid variables: %w", err)
+			}
+		}
+	case http.MethodPost:
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil {
+			return request{}, fmt.Errorf("invalid request body: %w", err)
+		}
+	default:
+		return request{}, fmt.Errorf("unsupported method %s", r.Method)
+	}
+
+	if req.Query == "" {
+		return request{}, fmt.Errorf("query must not be empty")
+	}
+
+	return req, nil
+}
@@ -0,0 +1,268 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accessmock "github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// graphQLResponse is the decoded body of a GraphQL response.
+type graphQLResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func postQuery(t *testing.T, handler *Handler, query string, variables map[string]interface{}) (int, graphQLResponse) {
+	body, err := json.Marshal(request{Query: query, Variables: variables})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader(body))
+	return serve(t, handler, req)
+}
+
+func serve(t *testing.T, handler *Handler, req *http.Request) (int, graphQLResponse) {
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	var response graphQLResponse
+	if rr.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response), rr.Body.String())
+	}
+	return rr.Code, response
+}
+
+func newTestHandler(backend *accessmock.API, config Config) *Handler {
+	return NewHandler(unittest.Logger(), backend, flow.Testnet.Chain(), config, common.DefaultMaxRequestSize)
+}
+
+// TestGraphQL_NestedQuery tests resolving a block along with its collections, transactions, results and events.
+func TestGraphQL_NestedQuery(t *testing.T) {
+	backend := accessmock.NewAPI(t)
+	handler := newTestHandler(backend, NewDefaultConfig())
+
+	collection := unittest.CollectionFixture(2)
+	light := collection.Light()
+	guarantee := unittest.CollectionGuaranteeFixture(func(g *flow.CollectionGuarantee) {
+		g.CollectionID = light.ID()
+	})
+	block := unittest.BlockFixture()
+	block.SetPayload(unittest.PayloadFixture(unittest.WithGuarantees(guarantee)))
+
+	backend.On("GetBlockByHeight", mock.Anything, block.Header.Height).
+		Return(&block, flow.BlockStatusSealed, nil).Once()
+	backend.On("GetCollectionByID", mock.Anything, light.ID()).
+		Return(&light, nil).Once()
+
+	for i, tx := range collection.Transactions {
+		backend.On("GetTransaction", mock.Anything, tx.ID()).
+			Return(tx, nil).Once()
+		backend.On("GetTransactionResult", mock.Anything, tx.ID(), block.ID(), light.ID(), entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(&accessmodel.TransactionResult{
+				Status:        flow.TransactionStatusSealed,
+				TransactionID: tx.ID(),
+				BlockID:       block.ID(),
+				BlockHeight:   block.Header.Height,
+				CollectionID:  light.ID(),
+				Events:        []flow.Event{unittest.EventFixture(flow.EventAccountCreated, uint32(i), 0, tx.ID(), 0)},
+			}, nil).Once()
+	}
+
+	query := `query($height: Uint64!) {
+		block(height: $height) {
+			id
+			height
+			status
+			collections {
+				id
+				transactions {
+					id
+					result {
+						status
+						blockHeight
+						events { type transactionIndex }
+					}
+				}
+			}
+		}
+	}`
+
+	code, response := postQuery(t, handler, query, map[string]interface{}{
+		"height": fmt.Sprintf("%d", block.Header.Height),
+	})
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, response.Errors)
+
+	resolvedBlock := response.Data["block"].(map[string]interface{})
+	require.Equal(t, block.ID().String(), resolvedBlock["id"])
+	require.Equal(t, fmt.Sprintf("%d", block.Header.Height), resolvedBlock["height"])
+	require.Equal(t, flow.BlockStatusSealed.String(), resolvedBlock["status"])
+
+	collections := resolvedBlock["collections"].([]interface{})
+	require.Len(t, collections, 1)
+	resolvedCollection := collections[0].(map[string]interface{})
+	require.Equal(t, light.ID().String(), resolvedCollection["id"])
+
+	transactions := resolvedCollection["transactions"].([]interface{})
+	require.Len(t, transactions, len(collection.Transactions))
+	for i, tx := range collection.Transactions {
+		resolvedTx := transactions[i].(map[string]interface{})
+		require.Equal(t, tx.ID().String(), resolvedTx["id"])
+
+		result := resolvedTx["result"].(map[string]interface{})
+		require.Equal(t, flow.TransactionStatusSealed.String(), result["status"])
+		require.Equal(t, fmt.Sprintf("%d", block.Header.Height), result["blockHeight"])
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				"type":             string(flow.EventAccountCreated),
+				"transactionIndex": float64(i),
+			},
+		}, result["events"])
+	}
+}
+
+// TestGraphQL_GetRequest tests that queries and variables can be provided as query parameters.
+func TestGraphQL_GetRequest(t *testing.T) {
+	backend := accessmock.NewAPI(t)
+	handler := newTestHandler(backend, NewDefaultConfig())
+
+	block := unittest.BlockFixture()
+	backend.On("GetBlockByID", mock.Anything, block.ID()).
+		Return(&block, flow.BlockStatusFinalized, nil).Once()
+
+	params := url.Values{}
+	params.Set("query", `query($id: ID!) { block(id: $id) { parentId } }`)
+	params.Set("variables", fmt.Sprintf(`{"id": "%s"}`, block.ID()))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/graphql?"+params.Encode(), nil)
+	code, response := serve(t, handler, req)
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, response.Errors)
+	require.Equal(t, map[string]interface{}{
+		"block": map[string]interface{}{"parentId": block.Header.ParentID.String()},
+	}, response.Data)
+}
+
+// TestGraphQL_Errors tests that errors are returned in the GraphQL response along with their code.
+func TestGraphQL_Errors(t *testing.T) {
+	backend := accessmock.NewAPI(t)
+	handler := newTestHandler(backend, NewDefaultConfig())
+
+	t.Run("not found", func(t *testing.T) {
+		txID := unittest.IdentifierFixture()
+		backend.On("GetTransaction", mock.Anything, txID).
+			Return(nil, status.Error(codes.NotFound, "transaction not found")).Once()
+
+		code, response := postQuery(t, handler, fmt.Sprintf(`{ transaction(id: "%s") { id } }`, txID), nil)
+		require.Equal(t, http.StatusOK, code)
+		require.Nil(t, response.Data["transaction"])
+		require.Len(t, response.Errors, 1)
+		require.Equal(t, "Flow resource not found: transaction not found", response.Errors[0].Message)
+		require.Equal(t, codes.NotFound.String(), response.Errors[0].Extensions["code"])
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		code, response := postQuery(t, handler, `{ block(id: "abc", height: "1") { id } }`, nil)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, response.Errors, 1)
+		require.Equal(t, codes.InvalidArgument.String(), response.Errors[0].Extensions["code"])
+
+		code, response = postQuery(t, handler, `{ collection(id: "abc") { id } }`, nil)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, response.Errors, 1)
+		require.Equal(t, codes.InvalidArgument.String(), response.Errors[0].Extensions["code"])
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		code, response := postQuery(t, handler, `{ block { unknownField } }`, nil)
+		require.Equal(t, http.StatusOK, code)
+		require.NotEmpty(t, response.Errors)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader([]byte(`{"query": ""}`)))
+		code, _ := serve(t, handler, req)
+		require.Equal(t, http.StatusBadRequest, code)
+
+		req = httptest.NewRequest(http.MethodPost, "/v1/graphql", bytes.NewReader([]byte(`not json`)))
+		code, _ = serve(t, handler, req)
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+}
+
+// TestGraphQL_Limits tests that queries exceeding the depth or cost limits are rejected.
+func TestGraphQL_Limits(t *testing.T) {
+	t.Run("max depth", func(t *testing.T) {
+		backend := accessmock.NewAPI(t)
+		handler := newTestHandler(backend, Config{MaxQueryDepth: 2, MaxQueryCost: DefaultMaxQueryCost})
+
+		// the query is rejected before any Access API call is made
+		code, response := postQuery(t, handler, `{ latestBlock { collections { transactions { id } } } }`, nil)
+		require.Equal(t, http.StatusOK, code)
+		require.Nil(t, response.Data)
+		require.NotEmpty(t, response.Errors)
+		require.Contains(t, response.Errors[0].Message, "exceeds max depth 2")
+	})
+
+	t.Run("max cost", func(t *testing.T) {
+		backend := accessmock.NewAPI(t)
+		handler := newTestHandler(backend, Config{MaxQueryDepth: DefaultMaxQueryDepth, MaxQueryCost: 2})
+
+		collection := unittest.CollectionFixture(2)
+		light := collection.Light()
+		block := unittest.BlockFixture()
+		block.SetPayload(unittest.PayloadFixture(unittest.WithGuarantees(
+			unittest.CollectionGuaranteeFixture(func(g *flow.CollectionGuarantee) {
+				g.CollectionID = light.ID()
+			}),
+		)))
+
+		backend.On("GetLatestBlock", mock.Anything, true).
+			Return(&block, flow.BlockStatusSealed, nil).Once()
+		backend.On("GetCollectionByID", mock.Anything, light.ID()).
+			Return(&light, nil).Once()
+
+		// resolving the transactions would exceed the cost limit, so they are not requested
+		code, response := postQuery(t, handler, `{ latestBlock(sealed: true) { collections { transactions { id } } } }`, nil)
+		require.Equal(t, http.StatusOK, code)
+		require.Len(t, response.Errors, 1)
+		require.Equal(t, "query cost limit of 2 exceeded", response.Errors[0].Message)
+		require.Equal(t, codes.ResourceExhausted.String(), response.Errors[0].Extensions["code"])
+	})
+}
+
+// TestUint64_UnmarshalGraphQL tests decoding Uint64 arguments.
+func TestUint64_UnmarshalGraphQL(t *testing.T) {
+	for _, input := range []interface{}{"42", int32(42), float64(42)} {
+		var value Uint64
+		require.NoError(t, value.UnmarshalGraphQL(input))
+		require.Equal(t, Uint64(42), value)
+	}
+
+	for _, input := range []interface{}{"-1", "abc", int32(-1), float64(-1), float64(1.5), true} {
+		var value Uint64
+		require.Error(t, value.UnmarshalGraphQL(input), "input: %v", input)
+	}
+
+	encoded, err := json.Marshal(Uint64(18446744073709551615))
+	require.NoError(t, err)
+	require.Equal(t, `"18446744073709551615"`, string(encoded))
+}
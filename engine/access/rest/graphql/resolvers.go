@@ -0,0 +1,579 @@
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/common/parser"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// resolver is the root resolver of the GraphQL schema, which resolves the fields of the Query type.
+// Every Access API call made while resolving a query is charged to the cost of the query.
+type resolver struct {
+	log     zerolog.Logger
+	backend access.API
+	chain   flow.Chain
+}
+
+type blockArgs struct {
+	ID     *gql.ID
+	Height *Uint64
+}
+
+type idArgs struct {
+	ID gql.ID
+}
+
+type accountArgs struct {
+	Address string
+	Height  *Uint64
+}
+
+type eventsArgs struct {
+	Type        string
+	StartHeight Uint64
+	EndHeight   Uint64
+}
+
+// LatestBlock resolves the latest finalized or sealed block.
+func (r *resolver) LatestBlock(ctx context.Context, args struct{ Sealed bool }) (*blockResolver, error) {
+	if err := chargeQueryCost(ctx); err != nil {
+		return nil, err
+	}
+
+	block, status, err := r.backend.GetLatestBlock(ctx, args.Sealed)
+	if err != nil {
+		return nil, convertError(r.log, err)
+	}
+	return &blockResolver{root: r, block: block, status: status}, nil
+}
+
+// Block resolves the block with the given ID or height.
+func (r *resolver) Block(ctx context.Context, args blockArgs) (*blockResolver, error) {
+	if (args.ID == nil) == (args.Height == nil) {
+		return nil, newQueryError(codes.InvalidArgument, "exactly one of 'id' or 'height' must be provided")
+	}
+
+	if args.ID != nil {
+		blockID, err := parseID(*args.ID)
+		if err != nil {
+			return nil, err
+		}
+		return r.blockByID(ctx, blockID)
+	}
+
+	if err := chargeQueryCost(ctx); err != nil {
+		return nil, err
+	}
+
+	block, status, err := r.backend.GetBlockByHeight(ctx, uint64(*args.Height))
+	if err != nil {
+		return nil, convertError(r.log, err)
+	}
+	return &blockResolver{root: r, block: block, status: status}, nil
+}
+
+// Collection resolves the collection with the given ID.
+func (r *resolver) Collection(ctx context.Context, args idArgs) (*collectionResolver, error) {
+	collectionID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := chargeQueryCost(ctx); err != nil {
+		return nil, err
+	}
+
+	collection, err := r.backend.GetCollectionByID(ctx, collectionID)
+	if err != nil {
+		return nil, convertError(r.log, err)
+	}
+	return &collectionResolver{root: r, collection: collection}, nil
+}
+
+// Transaction resolves the transaction with the given ID.
+func (r *resolver) Transaction(ctx context.Context, args idArgs) (*transactionResolver, error) {
+	txID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	return r.transactionByID(ctx, txID, flow.ZeroID, flow.ZeroID)
+}
+
+// TransactionResult resolves the result of the transaction with the given ID.
+func (r *resolver) TransactionResult(ctx context.Context, args idArgs) (*transactionResultResolver, error) {
+	txID, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	return r.transactionResult(ctx, txID, flow.ZeroID, flow.ZeroID)
+}
+
+// Account resolves the account with the given address, at the given height or at the latest sealed block.
+func (r *resolver) Account(ctx context.Context, args accountArgs) (*accountResolver, error) {
+	address, err := parser.ParseAddress(args.Address, r.chain)
+	if err != nil {
+		return nil, newQueryError(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	if err := chargeQueryCost(ctx); err != nil {
+		return nil, err
+	}
+
+	var account *flow.Account
+	if args.Height != nil {
+		account, err = r.backend.GetAccountAtBlockHeight(ctx, address, uint64(*args.Height))
+	} else {
+		account, err = r.backend.GetAccountAtLatestBlock(ctx, address)
+	}
+	if err != nil {
+		return nil, convertError(r.log, err)
+	}
+	return &accountResolver{account: account}, nil
+}
+
+// Events resolves the events of the given type emitted in the given height range.
+func (r *resolver) Events(ctx context.Context, args eventsArgs) ([]*blockEventsResolver, error) {
+	if args.StartHeight > args.EndHeight {
+		return nil, newQueryError(codes.InvalidArgument, "'startHeight' must not be greater than 'endHeight'")
+	}
+
+	if err := chargeQueryCost(ctx); err != nil {
+		return nil, err
+	}
+
+	blocksEvents, err := r.backend.GetEventsForHeightRange(
+		ctx,
+		args.Type,
+		uint64(args.StartHeight),
+		uint64(args.EndHeight),
+		entities.EventEncodingVersion_JSON_CDC_V0,
+	)
+	if err != nil {
+		return nil, convertError(r.log, err)
+	}
+
+	resolvers := make([]*blockEventsResolver, len(blocksEvents))
+	for i, blockEvents := range blocksEvents {
+		resolvers[i] = &blockEventsResolver{root: r, blockEvents: blockEvents}
+	}
+	return resolvers, nil
+}
+
+func (r *resolver) blockByID(ctx context.Context, blockID flow.Identifier) (*blockResolver, error) {
+	if err := chargeQueryCost(ctx); err != nil {
+		return nil, err
+	}
+
+	block, status, err := r.backend.GetBlockByID(ctx, blockID)
+	if err != nil {
+		return nil, convertError(r.log, err)
+	}
+	return &blockResolver{root: r, block: block, status: status}, nil
+}
+
+// transactionByID resolves the transaction with the given ID. The block and collection IDs are
+// optional, and are used to look up the transaction result if they are known.
+func (r *resolver) transactionByID(
+	ctx context.Context,
+	txID flow.Identifier,
+	blockID flow.Identifier,
+	collectionID flow.Identifier,
+) (*transactionResolver, error) {
+	if err := chargeQueryCost(ctx); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.backend.GetTransaction(ctx, txID)
+	if err != nil {
+		return nil, convertError(r.log, err)
+	}
+	return &transactionResolver{root: r, tx: tx, blockID: blockID, collectionID: collectionID}, nil
+}
+
+func (r *resolver) transactionResult(
+	ctx context.Context,
+	txID flow.Identifier,
+	blockID flow.Identifier,
+	collectionID flow.Identifier,
+) (*transactionResultResolver, error) {
+	if err := chargeQueryCost(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := r.backend.GetTransactionResult(ctx, txID, blockID, collectionID, entities.EventEncodingVersion_JSON_CDC_V0)
+	if err != nil {
+		return nil, convertError(r.log, err)
+	}
+	return &transactionResultResolver{root: r, result: result}, nil
+}
+
+// blockResolver resolves the fields of the Block type.
+type blockResolver struct {
+	root   *resolver
+	block  *flow.Block
+	status flow.BlockStatus
+}
+
+func (b *blockResolver) ID() gql.ID {
+	return toID(b.block.ID())
+}
+
+func (b *blockResolver) ParentID() gql.ID {
+	return toID(b.block.Header.ParentID)
+}
+
+func (b *blockResolver) Height() Uint64 {
+	return Uint64(b.block.Header.Height)
+}
+
+func (b *blockResolver) Timestamp() string {
+	return formatTime(b.block.Header.Timestamp)
+}
+
+func (b *blockResolver) Status() string {
+	return b.status.String()
+}
+
+// Collections resolves the collections guaranteed in the block.
+func (b *blockResolver) Collections(ctx context.Context) ([]*collectionResolver, error) {
+	blockID := b.block.ID()
+
+	collections := make([]*collectionResolver, len(b.block.Payload.Guarantees))
+	for i, guarantee := range b.block.Payload.Guarantees {
+		if err := chargeQueryCost(ctx); err != nil {
+			return nil, err
+		}
+
+		collection, err := b.root.backend.GetCollectionByID(ctx, guarantee.CollectionID)
+		if err != nil {
+			return nil, convertError(b.root.log, err)
+		}
+		collections[i] = &collectionResolver{root: b.root, collection: collection, blockID: blockID}
+	}
+	return collections, nil
+}
+
+// collectionResolver resolves the fields of the Collection type.
+type collectionResolver struct {
+	root       *resolver
+	collection *flow.LightCollection
+	// blockID is the ID of the block the collection was resolved from, or flow.ZeroID if unknown.
+	blockID flow.Identifier
+}
+
+func (c *collectionResolver) ID() gql.ID {
+	return toID(c.collection.ID())
+}
+
+// Transactions resolves the transactions of the collection.
+func (c *collectionResolver) Transactions(ctx context.Context) ([]*transactionResolver, error) {
+	collectionID := c.collection.ID()
+
+	transactions := make([]*transactionResolver, len(c.collection.Transactions))
+	for i, txID := range c.collection.Transactions {
+		tx, err := c.root.transactionByID(ctx, txID, c.blockID, collectionID)
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = tx
+	}
+	return transactions, nil
+}
+
+// transactionResolver resolves the fields of the Transaction type.
+type transactionResolver struct {
+	root *resolver
+	tx   *flow.TransactionBody
+	// blockID and collectionID are the IDs of the block and collection the transaction was resolved
+	// from, or flow.ZeroID if unknown.
+	blockID      flow.Identifier
+	collectionID flow.Identifier
+}
+
+func (t *transactionResolver) ID() gql.ID {
+	return toID(t.tx.ID())
+}
+
+func (t *transactionResolver) Script() string {
+	return string(t.tx.Script)
+}
+
+func (t *transactionResolver) Arguments() []string {
+	arguments := make([]string, len(t.tx.Arguments))
+	for i, argument := range t.tx.Arguments {
+		arguments[i] = string(argument)
+	}
+	return arguments
+}
+
+func (t *transactionResolver) ReferenceBlockID() gql.ID {
+	return toID(t.tx.ReferenceBlockID)
+}
+
+func (t *transactionResolver) GasLimit() Uint64 {
+	return Uint64(t.tx.GasLimit)
+}
+
+func (t *transactionResolver) Payer() string {
+	return t.tx.Payer.Hex()
+}
+
+func (t *transactionResolver) ProposalKey() *proposalKeyResolver {
+	return &proposalKeyResolver{key: t.tx.ProposalKey}
+}
+
+func (t *transactionResolver) Authorizers() []string {
+	authorizers := make([]string, len(t.tx.Authorizers))
+	for i, authorizer := range t.tx.Authorizers {
+		authorizers[i] = authorizer.Hex()
+	}
+	return authorizers
+}
+
+// Result resolves the result of the transaction.
+func (t *transactionResolver) Result(ctx context.Context) (*transactionResultResolver, error) {
+	return t.root.transactionResult(ctx, t.tx.ID(), t.blockID, t.collectionID)
+}
+
+// proposalKeyResolver resolves the fields of the ProposalKey type.
+type proposalKeyResolver struct {
+	key flow.ProposalKey
+}
+
+func (p *proposalKeyResolver) Address() string {
+	return p.key.Address.Hex()
+}
+
+func (p *proposalKeyResolver) KeyIndex() Uint64 {
+	return Uint64(p.key.KeyIndex)
+}
+
+func (p *proposalKeyResolver) SequenceNumber() Uint64 {
+	return Uint64(p.key.SequenceNumber)
+}
+
+// transactionResultResolver resolves the fields of the TransactionResult type.
+type transactionResultResolver struct {
+	root   *resolver
+	result *accessmodel.TransactionResult
+}
+
+func (t *transactionResultResolver) TransactionID() gql.ID {
+	return toID(t.result.TransactionID)
+}
+
+func (t *transactionResultResolver) Status() string {
+	return t.result.Status.String()
+}
+
+func (t *transactionResultResolver) StatusCode() int32 {
+	return int32(t.result.StatusCode)
+}
+
+func (t *transactionResultResolver) ErrorMessage() string {
+	return t.result.ErrorMessage
+}
+
+func (t *transactionResultResolver) BlockID() gql.ID {
+	return toID(t.result.BlockID)
+}
+
+func (t *transactionResultResolver) BlockHeight() Uint64 {
+	return Uint64(t.result.BlockHeight)
+}
+
+func (t *transactionResultResolver) CollectionID() gql.ID {
+	return toID(t.result.CollectionID)
+}
+
+func (t *transactionResultResolver) Events() []*eventResolver {
+	return newEventResolvers(t.root, t.result.Events)
+}
+
+// Block resolves the block the transaction was included in, or nil if it is not known yet.
+func (t *transactionResultResolver) Block(ctx context.Context) (*blockResolver, error) {
+	if t.result.BlockID == flow.ZeroID {
+		return nil, nil
+	}
+	return t.root.blockByID(ctx, t.result.BlockID)
+}
+
+// eventResolver resolves the fields of the Event type.
+type eventResolver struct {
+	root  *resolver
+	event flow.Event
+}
+
+func newEventResolvers(root *resolver, events []flow.Event) []*eventResolver {
+	resolvers := make([]*eventResolver, len(events))
+	for i, event := range events {
+		resolvers[i] = &eventResolver{root: root, event: event}
+	}
+	return resolvers
+}
+
+func (e *eventResolver) Type() string {
+	return string(e.event.Type)
+}
+
+func (e *eventResolver) TransactionID() gql.ID {
+	return toID(e.event.TransactionID)
+}
+
+func (e *eventResolver) TransactionIndex() int32 {
+	return int32(e.event.TransactionIndex)
+}
+
+func (e *eventResolver) EventIndex() int32 {
+	return int32(e.event.EventIndex)
+}
+
+func (e *eventResolver) Payload() string {
+	return string(e.event.Payload)
+}
+
+// Transaction resolves the transaction which emitted the event.
+func (e *eventResolver) Transaction(ctx context.Context) (*transactionResolver, error) {
+	return e.root.transactionByID(ctx, e.event.TransactionID, flow.ZeroID, flow.ZeroID)
+}
+
+// blockEventsResolver resolves the fields of the BlockEvents type.
+type blockEventsResolver struct {
+	root        *resolver
+	blockEvents flow.BlockEvents
+}
+
+func (b *blockEventsResolver) BlockID() gql.ID {
+	return toID(b.blockEvents.BlockID)
+}
+
+func (b *blockEventsResolver) BlockHeight() Uint64 {
+	return Uint64(b.blockEvents.BlockHeight)
+}
+
+func (b *blockEventsResolver) BlockTimestamp() string {
+	return formatTime(b.blockEvents.BlockTimestamp)
+}
+
+func (b *blockEventsResolver) Events() []*eventResolver {
+	return newEventResolvers(b.root, b.blockEvents.Events)
+}
+
+// Block resolves the block the events were emitted in.
+func (b *blockEventsResolver) Block(ctx context.Context) (*blockResolver, error) {
+	return b.root.blockByID(ctx, b.blockEvents.BlockID)
+}
+
+// accountResolver resolves the fields of the Account type.
+type accountResolver struct {
+	account *flow.Account
+}
+
+func (a *accountResolver) Address() string {
+	return a.account.Address.Hex()
+}
+
+func (a *accountResolver) Balance() Uint64 {
+	return Uint64(a.account.Balance)
+}
+
+func (a *accountResolver) Keys() []*accountKeyResolver {
+	keys := make([]*accountKeyResolver, len(a.account.Keys))
+	for i, key := range a.account.Keys {
+		keys[i] = &accountKeyResolver{key: key}
+	}
+	return keys
+}
+
+// Contracts resolves the contracts deployed to the account, sorted by name.
+func (a *accountResolver) Contracts() []*contractResolver {
+	contracts := make([]*contractResolver, 0, len(a.account.Contracts))
+	for name, code := range a.account.Contracts {
+		contracts = append(contracts, &contractResolver{name: name, code: code})
+	}
+	sort.Slice(contracts, func(i, j int) bool {
+		return contracts[i].name < contracts[j].name
+	})
+	return contracts
+}
+
+// accountKeyResolver resolves the fields of the AccountKey type.
+type accountKeyResolver struct {
+	key flow.AccountPublicKey
+}
+
+func (k *accountKeyResolver) Index() Uint64 {
+	return Uint64(k.key.Index)
+}
+
+func (k *accountKeyResolver) PublicKey() string {
+	return hex.EncodeToString(k.key.PublicKey.Encode())
+}
+
+func (k *accountKeyResolver) SigningAlgorithm() string {
+	return k.key.SignAlgo.String()
+}
+
+func (k *accountKeyResolver) HashingAlgorithm() string {
+	return k.key.HashAlgo.String()
+}
+
+func (k *accountKeyResolver) Weight() int32 {
+	return int32(k.key.Weight)
+}
+
+func (k *accountKeyResolver) SequenceNumber() Uint64 {
+	return Uint64(k.key.SeqNumber)
+}
+
+func (k *accountKeyResolver) Revoked() bool {
+	return k.key.Revoked
+}
+
+// contractResolver resolves the fields of the Contract type.
+type contractResolver struct {
+	name string
+	code []byte
+}
+
+func (c *contractResolver) Name() string {
+	return c.name
+}
+
+func (c *contractResolver) Code() string {
+	return string(c.code)
+}
+
+// parseID parses a flow.Identifier argument.
+//
+// Expected errors during normal operations:
+//   - *queryError with codes.InvalidArgument if the ID is invalid
+func parseID(id gql.ID) (flow.Identifier, error) {
+	var parsed parser.ID
+	if err := parsed.Parse(string(id)); err != nil {
+		return flow.ZeroID, newQueryError(codes.InvalidArgument, "invalid ID %q: %v", id, err)
+	}
+	if parsed.Flow() == flow.ZeroID {
+		return flow.ZeroID, newQueryError(codes.InvalidArgument, "invalid ID %q: must not be empty", id)
+	}
+	return parsed.Flow(), nil
+}
+
+func toID(id flow.Identifier) gql.ID {
+	return gql.ID(id.String())
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339Nano)
+}
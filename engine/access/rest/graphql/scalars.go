@@ -0,0 +1,50 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Uint64 is the GraphQL scalar for unsigned 64-bit integers, such as block heights.
+// GraphQL integers are limited to 32 bits, so values are encoded as decimal strings,
+// matching the encoding of uint64 values in the REST API.
+type Uint64 uint64
+
+// ImplementsGraphQLType returns true if the given GraphQL type name is the Uint64 scalar.
+func (Uint64) ImplementsGraphQLType(name string) bool {
+	return name == "Uint64"
+}
+
+// UnmarshalGraphQL decodes a Uint64 argument, which can be provided either as a decimal string,
+// or as an integer literal or variable.
+func (u *Uint64) UnmarshalGraphQL(input interface{}) error {
+	switch value := input.(type) {
+	case string:
+		parsed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Uint64 value %q: %w", value, err)
+		}
+		*u = Uint64(parsed)
+	case int32:
+		if value < 0 {
+			return fmt.Errorf("invalid Uint64 value %d: must not be negative", value)
+		}
+		*u = Uint64(value)
+	case float64:
+		// variables are decoded from JSON numbers
+		if value < 0 || value != math.Trunc(value) || value > math.MaxUint64 {
+			return fmt.Errorf("invalid Uint64 value %v: must be an unsigned integer", value)
+		}
+		*u = Uint64(value)
+	default:
+		return fmt.Errorf("invalid Uint64 value of type %T", input)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the value as a decimal string.
+func (u Uint64) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(u), 10))
+}
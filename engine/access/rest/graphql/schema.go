@@ -0,0 +1,155 @@
+package graphql
+
+// schema is the GraphQL schema of the Access API. Queries are resolved using the same access.API
+// implementation as the REST API, and nested fields are resolved with additional Access API calls.
+const schema = `
+schema {
+	query: Query
+}
+
+"""
+Unsigned 64-bit integer, encoded as a decimal string.
+"""
+scalar Uint64
+
+type Query {
+	"""
+	Returns the latest finalized block, or the latest sealed block if sealed is true.
+	"""
+	latestBlock(sealed: Boolean = false): Block
+	"""
+	Returns the block with the given ID or height. Exactly one of them must be provided.
+	"""
+	block(id: ID, height: Uint64): Block
+	"""
+	Returns the collection with the given ID.
+	"""
+	collection(id: ID!): Collection
+	"""
+	Returns the transaction with the given ID.
+	"""
+	transaction(id: ID!): Transaction
+	"""
+	Returns the result of the transaction with the given ID.
+	"""
+	transactionResult(id: ID!): TransactionResult
+	"""
+	Returns the account with the given address at the given block height, or at the latest sealed block if no height is provided.
+	"""
+	account(address: String!, height: Uint64): Account
+	"""
+	Returns the events of the given type emitted in the blocks of the given height range, inclusive.
+	"""
+	events(type: String!, startHeight: Uint64!, endHeight: Uint64!): [BlockEvents!]!
+}
+
+type Block {
+	id: ID!
+	parentId: ID!
+	height: Uint64!
+	"""
+	Time at which the block was proposed, in RFC 3339 format.
+	"""
+	timestamp: String!
+	"""
+	Status of the block: BLOCK_FINALIZED or BLOCK_SEALED.
+	"""
+	status: String!
+	collections: [Collection!]!
+}
+
+type Collection {
+	id: ID!
+	transactions: [Transaction!]!
+}
+
+type Transaction {
+	id: ID!
+	"""
+	Cadence source code of the transaction.
+	"""
+	script: String!
+	"""
+	JSON-Cadence encoded arguments of the transaction.
+	"""
+	arguments: [String!]!
+	referenceBlockId: ID!
+	gasLimit: Uint64!
+	payer: String!
+	proposalKey: ProposalKey!
+	authorizers: [String!]!
+	result: TransactionResult
+}
+
+type ProposalKey {
+	address: String!
+	keyIndex: Uint64!
+	sequenceNumber: Uint64!
+}
+
+type TransactionResult {
+	transactionId: ID!
+	"""
+	Status of the transaction: UNKNOWN, PENDING, FINALIZED, EXECUTED, SEALED or EXPIRED.
+	"""
+	status: String!
+	statusCode: Int!
+	errorMessage: String!
+	blockId: ID!
+	blockHeight: Uint64!
+	collectionId: ID!
+	events: [Event!]!
+	"""
+	Block the transaction was included in, or null if the transaction was not executed yet.
+	"""
+	block: Block
+}
+
+type Event {
+	type: String!
+	transactionId: ID!
+	transactionIndex: Int!
+	eventIndex: Int!
+	"""
+	JSON-Cadence encoded payload of the event.
+	"""
+	payload: String!
+	transaction: Transaction
+}
+
+type BlockEvents {
+	blockId: ID!
+	blockHeight: Uint64!
+	blockTimestamp: String!
+	events: [Event!]!
+	block: Block
+}
+
+type Account {
+	address: String!
+	balance: Uint64!
+	keys: [AccountKey!]!
+	contracts: [Contract!]!
+}
+
+type AccountKey {
+	index: Uint64!
+	"""
+	Hex encoded public key.
+	"""
+	publicKey: String!
+	signingAlgorithm: String!
+	hashingAlgorithm: String!
+	weight: Int!
+	sequenceNumber: Uint64!
+	revoked: Boolean!
+}
+
+type Contract {
+	name: String!
+	"""
+	Cadence source code of the contract.
+	"""
+	code: String!
+}
+`
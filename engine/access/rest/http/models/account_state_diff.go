@@ -0,0 +1,52 @@
+package models
+
+import (
+	"strconv"
+
+	"github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	accessmodel "github.com/onflow/flow-go/model/access"
+)
+
+// Build function use model AccountStateDiff type for GetAccountStateDiff call
+// AccountStateDiff is an auto-generated type from the openapi spec
+func (a *AccountStateDiff) Build(diff *accessmodel.AccountStateDiff) {
+	var keysAdded, keysRevoked AccountKeys
+	keysAdded.Build(diff.KeysAdded)
+	keysRevoked.Build(diff.KeysRevoked)
+
+	registers := make([]string, len(diff.ChangedRegisters))
+	for i, id := range diff.ChangedRegisters {
+		registers[i] = id.String()
+	}
+
+	var events models.Events
+	events.Build(diff.Events)
+
+	a.Address = diff.Address.String()
+	a.StartHeight = util.FromUint(diff.StartHeight)
+	a.EndHeight = util.FromUint(diff.EndHeight)
+	a.ExistedBefore = diff.ExistedBefore
+	a.ExistsAfter = diff.ExistsAfter
+	a.BalanceBefore = util.FromUint(diff.BalanceBefore)
+	a.BalanceAfter = util.FromUint(diff.BalanceAfter)
+	a.BalanceDelta = strconv.FormatInt(diff.BalanceDelta(), 10)
+	a.StorageUsedBefore = util.FromUint(diff.StorageUsedBefore)
+	a.StorageUsedAfter = util.FromUint(diff.StorageUsedAfter)
+	a.StorageUsedDelta = strconv.FormatInt(diff.StorageUsedDelta(), 10)
+	a.KeysAdded = keysAdded
+	a.KeysRevoked = keysRevoked
+	a.ContractsAdded = nonNil(diff.ContractsAdded)
+	a.ContractsUpdated = nonNil(diff.ContractsUpdated)
+	a.ContractsRemoved = nonNil(diff.ContractsRemoved)
+	a.ChangedRegisters = registers
+	a.Events = events
+}
+
+// nonNil returns an empty slice if the given slice is nil, so it is encoded as an empty JSON array.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func (a *AccountTransaction) Build(tx flow.AccountTransaction, link models.LinkGenerator) error {
+	a.BlockHeight = util.FromUint(tx.BlockHeight)
+	a.TransactionId = tx.TransactionID.String()
+	a.TransactionIndex = util.FromUint(tx.TransactionIndex)
+
+	roles := make([]string, len(tx.Roles))
+	for i, role := range tx.Roles {
+		roles[i] = role.String()
+	}
+	a.Roles = roles
+
+	var self models.Links
+	err := self.Build(link.TransactionLink(tx.TransactionID))
+	if err != nil {
+		return err
+	}
+	a.Links = &self
+
+	return nil
+}
+
+// Build function use model AccountTransactions type for GetAccountTransactions call
+// AccountTransactions is an auto-generated type from the openapi spec
+func (a *AccountTransactions) Build(txs []flow.AccountTransaction, nextCursor string, link models.LinkGenerator) error {
+	transactions := make([]AccountTransaction, len(txs))
+	for i, tx := range txs {
+		var transaction AccountTransaction
+		err := transaction.Build(tx, link)
+		if err != nil {
+			return err
+		}
+		transactions[i] = transaction
+	}
+
+	a.Transactions = transactions
+	a.NextCursor = nextCursor
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/hex"
+
+	"github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func (c *ContractUpdate) Build(update flow.ContractUpdate, link models.LinkGenerator) error {
+	c.Type = update.Type.String()
+	c.BlockHeight = util.FromUint(update.BlockHeight)
+	c.TransactionId = update.TransactionID.String()
+	c.TransactionIndex = util.FromUint(update.TransactionIndex)
+	c.EventIndex = util.FromUint(update.EventIndex)
+	c.CodeHash = hex.EncodeToString(update.CodeHash)
+
+	var self models.Links
+	err := self.Build(link.TransactionLink(update.TransactionID))
+	if err != nil {
+		return err
+	}
+	c.Links = &self
+
+	return nil
+}
+
+// Build function use model ContractVersion type for GetContract call
+// ContractVersion is an auto-generated type from the openapi spec
+func (c *ContractVersion) Build(version *accessmodel.ContractVersion, link models.LinkGenerator) error {
+	c.Address = version.Address.String()
+	c.Name = version.Name
+	if len(version.Code) > 0 {
+		c.Code = util.ToBase64(version.Code)
+	}
+
+	if version.Update != nil {
+		var update ContractUpdate
+		err := update.Build(*version.Update, link)
+		if err != nil {
+			return err
+		}
+		c.Update = &update
+	}
+
+	return nil
+}
+
+type ContractVersions []ContractVersion
+
+func (c *ContractVersions) Build(versions []accessmodel.ContractVersion, link models.LinkGenerator) error {
+	contractVersions := make([]ContractVersion, len(versions))
+	for i := range versions {
+		var version ContractVersion
+		err := version.Build(&versions[i], link)
+		if err != nil {
+			return err
+		}
+		contractVersions[i] = version
+	}
+
+	*c = contractVersions
+	return nil
+}
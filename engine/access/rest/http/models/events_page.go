@@ -0,0 +1,16 @@
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// Build function use model EventsPage type for GetEvents calls with field filters or pagination
+// EventsPage is an auto-generated type from the openapi spec
+func (e *EventsPage) Build(blocksEvents []flow.BlockEvents, nextCursor string) {
+	var events models.BlocksEvents
+	events.Build(blocksEvents)
+
+	e.Events = events
+	e.NextCursor = nextCursor
+}
@@ -0,0 +1,41 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+import "github.com/onflow/flow-go/engine/access/rest/common/models"
+
+type AccountStateDiff struct {
+	Address     string `json:"address"`
+	StartHeight string `json:"start_height"`
+	EndHeight   string `json:"end_height"`
+	// Whether the account existed at the start height.
+	ExistedBefore bool `json:"existed_before"`
+	// Whether the account exists at the end height.
+	ExistsAfter bool `json:"exists_after"`
+	// Balance of the account at the start height, in UFix64 units.
+	BalanceBefore string `json:"balance_before"`
+	// Balance of the account at the end height, in UFix64 units.
+	BalanceAfter string `json:"balance_after"`
+	// Change of the balance within the range, in UFix64 units.
+	BalanceDelta string `json:"balance_delta"`
+	// Storage used by the account at the start height, in bytes.
+	StorageUsedBefore string `json:"storage_used_before"`
+	// Storage used by the account at the end height, in bytes.
+	StorageUsedAfter string `json:"storage_used_after"`
+	// Change of the storage used within the range, in bytes.
+	StorageUsedDelta string             `json:"storage_used_delta"`
+	KeysAdded        []AccountPublicKey `json:"keys_added"`
+	KeysRevoked      []AccountPublicKey `json:"keys_revoked"`
+	ContractsAdded   []string           `json:"contracts_added"`
+	ContractsUpdated []string           `json:"contracts_updated"`
+	ContractsRemoved []string           `json:"contracts_removed"`
+	// Changed account registers, formatted as `<owner>/#<key>` with hex encoded owner and key.
+	ChangedRegisters []string       `json:"changed_registers"`
+	Events           []models.Event `json:"events"`
+}
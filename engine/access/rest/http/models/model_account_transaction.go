@@ -0,0 +1,23 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+import "github.com/onflow/flow-go/engine/access/rest/common/models"
+
+type AccountTransaction struct {
+	// Height of the block containing the transaction.
+	BlockHeight string `json:"block_height"`
+	// ID of the transaction.
+	TransactionId string `json:"transaction_id"`
+	// Index of the transaction within its block.
+	TransactionIndex string `json:"transaction_index"`
+	// Roles the account had in the transaction.
+	Roles []string      `json:"roles"`
+	Links *models.Links `json:"_links,omitempty"`
+}
@@ -0,0 +1,15 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type AccountTransactions struct {
+	Transactions []AccountTransaction `json:"transactions"`
+	// Cursor to request the next page of transactions. Omitted if there are no more transactions.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
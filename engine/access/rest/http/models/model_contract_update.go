@@ -0,0 +1,27 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+import "github.com/onflow/flow-go/engine/access/rest/common/models"
+
+type ContractUpdate struct {
+	// Kind of change, one of `added`, `updated` or `removed`.
+	Type string `json:"type"`
+	// Height of the block containing the transaction which changed the contract.
+	BlockHeight string `json:"block_height"`
+	// ID of the transaction which changed the contract.
+	TransactionId string `json:"transaction_id"`
+	// Index of the transaction within its block.
+	TransactionIndex string `json:"transaction_index"`
+	// Index of the contract event within the transaction.
+	EventIndex string `json:"event_index"`
+	// Hex encoded SHA3-256 hash of the contract code.
+	CodeHash string        `json:"code_hash"`
+	Links    *models.Links `json:"_links,omitempty"`
+}
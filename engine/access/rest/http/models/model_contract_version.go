@@ -0,0 +1,17 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type ContractVersion struct {
+	Address string `json:"address"`
+	Name    string `json:"name"`
+	// Base64 encoded code of the contract. Omitted if the contract was removed.
+	Code   string          `json:"code,omitempty"`
+	Update *ContractUpdate `json:"update,omitempty"`
+}
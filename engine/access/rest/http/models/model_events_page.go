@@ -0,0 +1,19 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/common/models"
+)
+
+type EventsPage struct {
+	Events models.BlocksEvents `json:"events"`
+	// Cursor to request the next page of events. Omitted if there are no more events.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
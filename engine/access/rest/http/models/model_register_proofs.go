@@ -0,0 +1,18 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type RegisterProofs struct {
+	// ID of the block the state commitment is the final state of. Omitted if the proofs were requested for a state commitment.
+	BlockId string `json:"block_id,omitempty"`
+	// Hex encoded state commitment the registers are proven against.
+	StateCommitment string `json:"state_commitment"`
+	// Base64 encoded batch proof of the registers.
+	Proof string `json:"proof"`
+}
@@ -0,0 +1,22 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type ScriptExecutionReport struct {
+	ComputationUsed string `json:"computation_used"`
+	MemoryEstimate  string `json:"memory_estimate"`
+	// Number of distinct registers read by the script.
+	RegisterReads string `json:"register_reads"`
+	// Total size of the registers read by the script.
+	BytesRead string `json:"bytes_read"`
+	// Location IDs of the contracts loaded by the script.
+	ContractsLoaded []string `json:"contracts_loaded"`
+	// Whether the script was executed using the local execution state. Only the computation used is reported for scripts executed on execution nodes.
+	ExecutedLocally bool `json:"executed_locally"`
+}
@@ -0,0 +1,26 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+import "github.com/onflow/flow-go/engine/access/rest/common/models"
+
+type TransactionDryRunResult struct {
+	// ID of the block the transaction was executed against.
+	BlockId string `json:"block_id"`
+	// Height of the block the transaction was executed against.
+	BlockHeight string `json:"block_height"`
+	StatusCode  int32  `json:"status_code"`
+	// Provided transaction error in case the transaction wasn't successful.
+	ErrorMessage    string `json:"error_message"`
+	ComputationUsed string `json:"computation_used"`
+	MemoryEstimate  string `json:"memory_estimate"`
+	// Fees that would be charged to the payer, in UFix64 units.
+	Fees   string         `json:"fees"`
+	Events []models.Event `json:"events"`
+}
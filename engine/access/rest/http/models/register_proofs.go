@@ -0,0 +1,19 @@
+package models
+
+import (
+	"encoding/hex"
+
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// Build function use model RegisterProofs type for GetRegisterProofs call
+// RegisterProofs is an auto-generated type from the openapi spec
+func (p *RegisterProofs) Build(proofs *accessmodel.RegisterProofs) {
+	if proofs.BlockID != flow.ZeroID {
+		p.BlockId = proofs.BlockID.String()
+	}
+	p.StateCommitment = hex.EncodeToString(proofs.StateCommitment[:])
+	p.Proof = util.ToBase64(proofs.Proof)
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	accessmodel "github.com/onflow/flow-go/model/access"
+)
+
+func (s *ScriptExecutionResult) Build(result *accessmodel.ScriptExecutionResult) {
+	var report ScriptExecutionReport
+	report.Build(&result.Report)
+
+	s.Value = util.ToBase64(result.Value)
+	s.Report = &report
+}
+
+func (s *ScriptExecutionReport) Build(report *accessmodel.ScriptExecutionReport) {
+	s.ComputationUsed = util.FromUint(report.ComputationUsed)
+	s.MemoryEstimate = util.FromUint(report.MemoryEstimate)
+	s.RegisterReads = util.FromUint(report.RegisterReads)
+	s.BytesRead = util.FromUint(report.BytesRead)
+	s.ContractsLoaded = report.ContractsLoaded
+	if s.ContractsLoaded == nil {
+		s.ContractsLoaded = []string{}
+	}
+	s.ExecutedLocally = report.ExecutedLocally
+}
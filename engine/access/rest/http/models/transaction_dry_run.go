@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	accessmodel "github.com/onflow/flow-go/model/access"
+)
+
+// Build function use model TransactionDryRunResult type for DryRunTransaction call
+// TransactionDryRunResult is an auto-generated type from the openapi spec
+func (t *TransactionDryRunResult) Build(result *accessmodel.TransactionDryRunResult) {
+	var events models.Events
+	events.Build(result.Events)
+
+	t.BlockId = result.BlockID.String()
+	t.BlockHeight = util.FromUint(result.BlockHeight)
+	t.StatusCode = int32(result.StatusCode)
+	t.ErrorMessage = result.ErrorMessage
+	t.ComputationUsed = util.FromUint(result.ComputationUsed)
+	t.MemoryEstimate = util.FromUint(result.MemoryEstimate)
+	t.Fees = util.FromUint(result.Fees)
+	t.Events = events
+}
@@ -0,0 +1,93 @@
+package request
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	"github.com/onflow/flow-go/engine/access/rest/common/parser"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const verifySignaturesQuery = "verify_signatures"
+const checkSequenceNumberQuery = "check_sequence_number"
+
+type DryRunTransaction struct {
+	BlockID     flow.Identifier
+	BlockHeight uint64
+	Transaction flow.TransactionBody
+	Options     accessmodel.TransactionDryRunOptions
+}
+
+// DryRunTransactionRequest extracts necessary variables and query parameters from the provided request,
+// builds a DryRunTransaction instance, and validates it.
+//
+// No errors are expected during normal operation.
+func DryRunTransactionRequest(r *common.Request) (DryRunTransaction, error) {
+	var req DryRunTransaction
+	err := req.Build(r)
+	return req, err
+}
+
+func (d *DryRunTransaction) Build(r *common.Request) error {
+	return d.Parse(
+		r.GetQueryParam(blockHeightQuery),
+		r.GetQueryParam(blockIDQuery),
+		r.GetQueryParam(verifySignaturesQuery),
+		r.GetQueryParam(checkSequenceNumberQuery),
+		r.Body,
+		r.Chain,
+	)
+}
+
+func (d *DryRunTransaction) Parse(
+	rawHeight string,
+	rawID string,
+	rawVerifySignatures string,
+	rawCheckSequenceNumber string,
+	rawTransaction io.Reader,
+	chain flow.Chain,
+) error {
+	var height Height
+	err := height.Parse(rawHeight)
+	if err != nil {
+		return err
+	}
+	d.BlockHeight = height.Flow()
+
+	var id parser.ID
+	err = id.Parse(rawID)
+	if err != nil {
+		return err
+	}
+	d.BlockID = id.Flow()
+
+	// default to last sealed block
+	if d.BlockHeight == EmptyHeight && d.BlockID == flow.ZeroID {
+		d.BlockHeight = SealedHeight
+	}
+
+	if d.BlockID != flow.ZeroID && d.BlockHeight != EmptyHeight {
+		return fmt.Errorf("can not provide both block ID and block height")
+	}
+
+	d.Options.VerifySignatures, err = parseOptionalBool(verifySignaturesQuery, rawVerifySignatures)
+	if err != nil {
+		return err
+	}
+
+	d.Options.CheckSequenceNumber, err = parseOptionalBool(checkSequenceNumberQuery, rawCheckSequenceNumber)
+	if err != nil {
+		return err
+	}
+
+	var tx parser.Transaction
+	err = tx.ParseUnsigned(rawTransaction, chain)
+	if err != nil {
+		return err
+	}
+	d.Transaction = tx.Flow()
+
+	return nil
+}
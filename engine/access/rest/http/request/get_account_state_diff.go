@@ -0,0 +1,70 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	"github.com/onflow/flow-go/engine/access/rest/common/parser"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+type GetAccountStateDiff struct {
+	Address     flow.Address
+	StartHeight uint64
+	EndHeight   uint64
+}
+
+// GetAccountStateDiffRequest extracts necessary variables and query parameters from the provided request,
+// builds a GetAccountStateDiff instance, and validates it.
+//
+// No errors are expected during normal operation.
+func GetAccountStateDiffRequest(r *common.Request) (GetAccountStateDiff, error) {
+	var req GetAccountStateDiff
+	err := req.Build(r)
+	return req, err
+}
+
+func (g *GetAccountStateDiff) Build(r *common.Request) error {
+	return g.Parse(
+		r.GetVar(addressVar),
+		r.GetQueryParam(startHeightQuery),
+		r.GetQueryParam(endHeightQuery),
+		r.Chain,
+	)
+}
+
+func (g *GetAccountStateDiff) Parse(
+	rawAddress string,
+	rawStart string,
+	rawEnd string,
+	chain flow.Chain,
+) error {
+	address, err := parser.ParseAddress(rawAddress, chain)
+	if err != nil {
+		return err
+	}
+	g.Address = address
+
+	var height Height
+	err = height.Parse(rawStart)
+	if err != nil {
+		return fmt.Errorf("invalid start height: %w", err)
+	}
+	g.StartHeight = height.Flow()
+	err = height.Parse(rawEnd)
+	if err != nil {
+		return fmt.Errorf("invalid end height: %w", err)
+	}
+	g.EndHeight = height.Flow()
+
+	// the special sealed and final heights are not supported since the diff is computed from the register index
+	if g.StartHeight >= EmptyHeight || g.EndHeight >= EmptyHeight {
+		return fmt.Errorf("must provide numeric start and end heights")
+	}
+
+	if g.StartHeight > g.EndHeight {
+		return fmt.Errorf("start height must be less than or equal to end height")
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package request
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	"github.com/onflow/flow-go/engine/access/rest/common/parser"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const limitQuery = "limit"
+const cursorQuery = "cursor"
+
+type GetAccountTransactions struct {
+	Address flow.Address
+	Limit   uint32
+	Cursor  *accessmodel.AccountTransactionCursor
+}
+
+// GetAccountTransactionsRequest extracts necessary variables and query parameters from the provided request,
+// builds a GetAccountTransactions instance, and validates it.
+//
+// No errors are expected during normal operation.
+func GetAccountTransactionsRequest(r *common.Request) (GetAccountTransactions, error) {
+	var req GetAccountTransactions
+	err := req.Build(r)
+	return req, err
+}
+
+func (g *GetAccountTransactions) Build(r *common.Request) error {
+	return g.Parse(
+		r.GetVar(addressVar),
+		r.GetQueryParam(limitQuery),
+		r.GetQueryParam(cursorQuery),
+		r.Chain,
+	)
+}
+
+func (g *GetAccountTransactions) Parse(
+	rawAddress string,
+	rawLimit string,
+	rawCursor string,
+	chain flow.Chain,
+) error {
+	address, err := parser.ParseAddress(rawAddress, chain)
+	if err != nil {
+		return err
+	}
+	g.Address = address
+
+	if rawLimit != "" {
+		limit, err := strconv.ParseUint(rawLimit, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid limit: %w", err)
+		}
+		g.Limit = uint32(limit)
+	}
+
+	if rawCursor != "" {
+		cursor, err := ParseAccountTransactionCursor(rawCursor)
+		if err != nil {
+			return err
+		}
+		g.Cursor = cursor
+	}
+
+	return nil
+}
+
+// ParseAccountTransactionCursor parses a cursor in the `<block_height>:<transaction_index>` format
+// as returned by FormatAccountTransactionCursor.
+func ParseAccountTransactionCursor(raw string) (*accessmodel.AccountTransactionCursor, error) {
+	parts := strings.Split(raw, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid cursor format, must be <block_height>:<transaction_index>")
+	}
+
+	height, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor block height: %w", err)
+	}
+
+	txIndex, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor transaction index: %w", err)
+	}
+
+	return &accessmodel.AccountTransactionCursor{
+		BlockHeight:      height,
+		TransactionIndex: uint32(txIndex),
+	}, nil
+}
+
+// FormatAccountTransactionCursor encodes a cursor in the format accepted by ParseAccountTransactionCursor.
+func FormatAccountTransactionCursor(cursor *accessmodel.AccountTransactionCursor) string {
+	return fmt.Sprintf("%d:%d", cursor.BlockHeight, cursor.TransactionIndex)
+}
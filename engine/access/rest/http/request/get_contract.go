@@ -0,0 +1,112 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	"github.com/onflow/flow-go/engine/access/rest/common/parser"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const contractNameVar = "name"
+
+type GetContract struct {
+	Address flow.Address
+	Name    string
+	Height  uint64
+}
+
+// GetContractRequest extracts necessary variables and query parameters from the provided request,
+// builds a GetContract instance, and validates it.
+//
+// No errors are expected during normal operation.
+func GetContractRequest(r *common.Request) (GetContract, error) {
+	var req GetContract
+	err := req.Build(r)
+	return req, err
+}
+
+func (g *GetContract) Build(r *common.Request) error {
+	return g.Parse(
+		r.GetVar(addressVar),
+		r.GetVar(contractNameVar),
+		r.GetQueryParam(blockHeightQuery),
+		r.Chain,
+	)
+}
+
+func (g *GetContract) Parse(rawAddress string, rawName string, rawHeight string, chain flow.Chain) error {
+	address, err := parser.ParseAddress(rawAddress, chain)
+	if err != nil {
+		return err
+	}
+
+	name, err := parseContractName(rawName)
+	if err != nil {
+		return err
+	}
+
+	var height Height
+	err = height.Parse(rawHeight)
+	if err != nil {
+		return err
+	}
+
+	g.Address = address
+	g.Name = name
+	g.Height = height.Flow()
+
+	// default to last block
+	if g.Height == EmptyHeight {
+		g.Height = SealedHeight
+	}
+
+	return nil
+}
+
+type GetContractHistory struct {
+	Address flow.Address
+	Name    string
+}
+
+// GetContractHistoryRequest extracts necessary variables from the provided request,
+// builds a GetContractHistory instance, and validates it.
+//
+// No errors are expected during normal operation.
+func GetContractHistoryRequest(r *common.Request) (GetContractHistory, error) {
+	var req GetContractHistory
+	err := req.Build(r)
+	return req, err
+}
+
+func (g *GetContractHistory) Build(r *common.Request) error {
+	return g.Parse(
+		r.GetVar(addressVar),
+		r.GetVar(contractNameVar),
+		r.Chain,
+	)
+}
+
+func (g *GetContractHistory) Parse(rawAddress string, rawName string, chain flow.Chain) error {
+	address, err := parser.ParseAddress(rawAddress, chain)
+	if err != nil {
+		return err
+	}
+
+	name, err := parseContractName(rawName)
+	if err != nil {
+		return err
+	}
+
+	g.Address = address
+	g.Name = name
+
+	return nil
+}
+
+func parseContractName(raw string) (string, error) {
+	if raw == "" {
+		return "", fmt.Errorf("contract name must be provided")
+	}
+	return raw, nil
+}
@@ -2,21 +2,29 @@ package request
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/onflow/flow-go/engine/access/rest/common"
 	"github.com/onflow/flow-go/engine/access/rest/common/parser"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/events"
 	"github.com/onflow/flow-go/model/flow"
 )
 
 const eventTypeQuery = "type"
 const blockQuery = "block_ids"
+const fieldFilterQuery = "field_filter"
 const MaxEventRequestHeightRange = 250
 
 type GetEvents struct {
-	StartHeight uint64
-	EndHeight   uint64
-	Type        string
-	BlockIDs    []flow.Identifier
+	StartHeight  uint64
+	EndHeight    uint64
+	Type         string
+	BlockIDs     []flow.Identifier
+	FieldFilters events.FieldFilters
+	Limit        uint32
+	Cursor       *accessmodel.EventCursor
 }
 
 // GetEventsRequest extracts necessary variables from the provided request,
@@ -35,10 +43,22 @@ func (g *GetEvents) Build(r *common.Request) error {
 		r.GetQueryParam(startHeightQuery),
 		r.GetQueryParam(endHeightQuery),
 		r.GetQueryParams(blockQuery),
+		// field filter values may contain commas, so each filter is provided as a separate query parameter
+		r.URL.Query()[fieldFilterQuery],
+		r.GetQueryParam(limitQuery),
+		r.GetQueryParam(cursorQuery),
 	)
 }
 
-func (g *GetEvents) Parse(rawType string, rawStart string, rawEnd string, rawBlockIDs []string) error {
+func (g *GetEvents) Parse(
+	rawType string,
+	rawStart string,
+	rawEnd string,
+	rawBlockIDs []string,
+	rawFieldFilters []string,
+	rawLimit string,
+	rawCursor string,
+) error {
 	var height Height
 	err := height.Parse(rawStart)
 	if err != nil {
@@ -89,5 +109,72 @@ func (g *GetEvents) Parse(rawType string, rawStart string, rawEnd string, rawBlo
 		}
 	}
 
+	fieldFilters, err := events.ParseFieldFilters(rawFieldFilters)
+	if err != nil {
+		return err
+	}
+	g.FieldFilters = fieldFilters
+
+	if rawLimit != "" {
+		limit, err := strconv.ParseUint(rawLimit, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid limit: %w", err)
+		}
+		g.Limit = uint32(limit)
+	}
+
+	if rawCursor != "" {
+		cursor, err := ParseEventCursor(rawCursor)
+		if err != nil {
+			return err
+		}
+		g.Cursor = cursor
+	}
+
+	if g.Paginated() && len(g.BlockIDs) > 0 {
+		return fmt.Errorf("field filters, limit and cursor can only be used with a start and end height range")
+	}
+
 	return nil
 }
+
+// Paginated returns true if the request uses field filters or pagination, in which case a single
+// page of events is returned along with the cursor of the next page.
+func (g *GetEvents) Paginated() bool {
+	return len(g.FieldFilters) > 0 || g.Limit > 0 || g.Cursor != nil
+}
+
+// ParseEventCursor parses a cursor in the `<block_height>:<transaction_index>:<event_index>` format
+// as returned by FormatEventCursor.
+func ParseEventCursor(raw string) (*accessmodel.EventCursor, error) {
+	parts := strings.Split(raw, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid cursor format, must be <block_height>:<transaction_index>:<event_index>")
+	}
+
+	height, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor block height: %w", err)
+	}
+
+	txIndex, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor transaction index: %w", err)
+	}
+
+	eventIndex, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor event index: %w", err)
+	}
+
+	return &accessmodel.EventCursor{
+		BlockHeight:      height,
+		TransactionIndex: uint32(txIndex),
+		EventIndex:       uint32(eventIndex),
+	}, nil
+}
+
+// FormatEventCursor encodes a cursor in the format accepted by ParseEventCursor.
+func FormatEventCursor(cursor *accessmodel.EventCursor) string {
+	return fmt.Sprintf("%d:%d:%d", cursor.BlockHeight, cursor.TransactionIndex, cursor.EventIndex)
+}
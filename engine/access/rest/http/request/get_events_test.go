@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	accessmodel "github.com/onflow/flow-go/model/access"
 )
 
 func TestGetEvents_InvalidParse(t *testing.T) {
@@ -29,7 +32,7 @@ func TestGetEvents_InvalidParse(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		err := getEvents.Parse(test.eventType, test.start, test.end, test.ids)
+		err := getEvents.Parse(test.eventType, test.start, test.end, test.ids, nil, "", "")
 		assert.EqualError(t, err, test.err, fmt.Sprintf("test #%d failed", i))
 	}
 }
@@ -38,7 +41,7 @@ func TestGetEvents_ValidParse(t *testing.T) {
 	var getEvents GetEvents
 
 	event := "A.f8d6e0586b0a20c7.Foo.Bar"
-	err := getEvents.Parse(event, "5", "10", nil)
+	err := getEvents.Parse(event, "5", "10", nil, nil, "", "")
 	assert.NoError(t, err)
 	assert.Equal(t, getEvents.Type, event)
 	assert.Equal(t, getEvents.StartHeight, uint64(5))
@@ -50,7 +53,7 @@ func TestGetEvents_ValidParse(t *testing.T) {
 		"7bc42fe85d32ca513769a74f97f7e1a7bad6c9407f0d934c2aa645ef9cf613c7",
 		"7bc42fe85d32ca513769a74f97f7e1a7bad6c9407f0d934c2aa645ef9cf613c7", // intentional duplication
 		"2ab81061b12d95fb81f2923001e340bc808e67e1eaae3c62479057cc14eb57fd",
-	})
+	}, nil, "", "")
 	assert.NoError(t, err)
 	assert.Equal(t, getEvents.Type, event)
 	assert.Equal(t, getEvents.StartHeight, EmptyHeight)
@@ -58,5 +61,48 @@ func TestGetEvents_ValidParse(t *testing.T) {
 	assert.Equal(t, len(getEvents.BlockIDs), 2)
 	assert.Equal(t, getEvents.BlockIDs[0].String(), "7bc42fe85d32ca513769a74f97f7e1a7bad6c9407f0d934c2aa645ef9cf613c7")
 	assert.Equal(t, getEvents.BlockIDs[1].String(), "2ab81061b12d95fb81f2923001e340bc808e67e1eaae3c62479057cc14eb57fd")
+}
+
+func TestGetEvents_ParseFieldFilters(t *testing.T) {
+	event := "A.f8d6e0586b0a20c7.Foo.Bar"
 
+	t.Run("valid", func(t *testing.T) {
+		var getEvents GetEvents
+		err := getEvents.Parse(event, "5", "10", nil, []string{"amount:gte:10.5", "memo:eq:a:b,c"}, "20", "7:1:2")
+		require.NoError(t, err)
+		require.True(t, getEvents.Paginated())
+		require.Len(t, getEvents.FieldFilters, 2)
+		assert.Equal(t, "amount:gte:10.5", getEvents.FieldFilters[0].String())
+		assert.Equal(t, "memo", getEvents.FieldFilters[1].Field)
+		assert.Equal(t, "a:b,c", getEvents.FieldFilters[1].Value)
+		assert.Equal(t, uint32(20), getEvents.Limit)
+		assert.Equal(t, &accessmodel.EventCursor{BlockHeight: 7, TransactionIndex: 1, EventIndex: 2}, getEvents.Cursor)
+		assert.Equal(t, "7:1:2", FormatEventCursor(getEvents.Cursor))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := []struct {
+			ids     []string
+			filters []string
+			limit   string
+			cursor  string
+			err     string
+		}{
+			{nil, []string{"amount"}, "", "", "invalid field filter \"amount\": must be in the format field:operator:value"},
+			{nil, []string{"amount:gt:abc"}, "", "", "invalid field filter \"amount:gt:abc\": invalid value \"abc\" for operator gt: must be a number"},
+			{nil, nil, "abc", "", "invalid limit: strconv.ParseUint: parsing \"abc\": invalid syntax"},
+			{nil, nil, "", "1:2", "invalid cursor format, must be <block_height>:<transaction_index>:<event_index>"},
+			{[]string{"7bc42fe85d32ca513769a74f97f7e1a7bad6c9407f0d934c2aa645ef9cf613c7"}, []string{"amount:eq:1"}, "", "", "field filters, limit and cursor can only be used with a start and end height range"},
+		}
+
+		for i, test := range tests {
+			var getEvents GetEvents
+			start, end := "5", "10"
+			if len(test.ids) > 0 {
+				start, end = "", ""
+			}
+			err := getEvents.Parse(event, start, end, test.ids, test.filters, test.limit, test.cursor)
+			assert.EqualError(t, err, test.err, fmt.Sprintf("test #%d failed", i))
+		}
+	})
 }
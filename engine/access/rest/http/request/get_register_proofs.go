@@ -0,0 +1,104 @@
+package request
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	"github.com/onflow/flow-go/engine/access/rest/common/parser"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const stateCommitmentQuery = "state_commitment"
+
+type registerBody struct {
+	Owner string `json:"owner,omitempty"`
+	Key   string `json:"key"`
+}
+
+type registerProofsBody struct {
+	Registers []registerBody `json:"registers"`
+}
+
+type GetRegisterProofs struct {
+	BlockID         flow.Identifier
+	StateCommitment *flow.StateCommitment
+	RegisterIDs     []flow.RegisterID
+}
+
+// GetRegisterProofsRequest extracts necessary variables from the provided request,
+// builds a GetRegisterProofs instance, and validates it.
+//
+// No errors are expected during normal operation.
+func GetRegisterProofsRequest(r *common.Request) (GetRegisterProofs, error) {
+	var req GetRegisterProofs
+	err := req.Build(r)
+	return req, err
+}
+
+func (g *GetRegisterProofs) Build(r *common.Request) error {
+	return g.Parse(
+		r.GetQueryParam(blockIDQuery),
+		r.GetQueryParam(stateCommitmentQuery),
+		r.Body,
+		r.Chain,
+	)
+}
+
+func (g *GetRegisterProofs) Parse(rawID string, rawCommit string, rawBody io.Reader, chain flow.Chain) error {
+	var id parser.ID
+	err := id.Parse(rawID)
+	if err != nil {
+		return err
+	}
+	g.BlockID = id.Flow()
+
+	if rawCommit != "" {
+		if g.BlockID != flow.ZeroID {
+			return fmt.Errorf("can not provide both block ID and state commitment")
+		}
+
+		commitBytes, err := hex.DecodeString(rawCommit)
+		if err != nil {
+			return fmt.Errorf("invalid state commitment format")
+		}
+		commit, err := flow.ToStateCommitment(commitBytes)
+		if err != nil {
+			return fmt.Errorf("invalid state commitment: %w", err)
+		}
+		g.StateCommitment = &commit
+	}
+
+	var body registerProofsBody
+	err = common.ParseBody(rawBody, &body)
+	if err != nil {
+		return err
+	}
+	if len(body.Registers) == 0 {
+		return fmt.Errorf("at least one register must be provided")
+	}
+
+	g.RegisterIDs = make([]flow.RegisterID, len(body.Registers))
+	for i, register := range body.Registers {
+		var owner string
+		// global registers have no owner
+		if register.Owner != "" {
+			address, err := parser.ParseAddress(register.Owner, chain)
+			if err != nil {
+				return err
+			}
+			owner = string(address.Bytes())
+		}
+
+		key, err := util.FromBase64(register.Key)
+		if err != nil {
+			return fmt.Errorf("invalid register key encoding")
+		}
+
+		g.RegisterIDs[i] = flow.RegisterID{Owner: owner, Key: string(key)}
+	}
+
+	return nil
+}
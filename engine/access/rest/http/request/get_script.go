@@ -10,11 +10,14 @@ import (
 )
 
 const blockIDQuery = "block_id"
+const includeReportQuery = "include_report"
 
 type GetScript struct {
 	BlockID     flow.Identifier
 	BlockHeight uint64
 	Script      Script
+	// IncludeReport is true if the resources used by the script should be returned along with its result.
+	IncludeReport bool
 }
 
 // GetScriptRequest extracts necessary variables from the provided request,
@@ -28,11 +31,17 @@ func GetScriptRequest(r *common.Request) (GetScript, error) {
 }
 
 func (g *GetScript) Build(r *common.Request) error {
-	return g.Parse(
+	err := g.Parse(
 		r.GetQueryParam(blockHeightQuery),
 		r.GetQueryParam(blockIDQuery),
 		r.Body,
 	)
+	if err != nil {
+		return err
+	}
+
+	g.IncludeReport, err = parseOptionalBool(includeReportQuery, r.GetQueryParam(includeReportQuery))
+	return err
 }
 
 func (g *GetScript) Parse(rawHeight string, rawID string, rawScript io.Reader) error {
@@ -1,6 +1,9 @@
 package request
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/onflow/flow-go/engine/access/rest/common"
 	"github.com/onflow/flow-go/engine/access/rest/common/parser"
 	"github.com/onflow/flow-go/model/flow"
@@ -26,3 +29,17 @@ func (g *GetByIDRequest) Parse(rawID string) error {
 
 	return nil
 }
+
+// parseOptionalBool parses a boolean query parameter, which defaults to false if not provided.
+func parseOptionalBool(name string, raw string) (bool, error) {
+	if raw == "" {
+		return false, nil
+	}
+
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for %s: %s", name, raw)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	commonmodels "github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/request"
+)
+
+// GetAccountStateDiff handler retrieves the changes to the state of an account between two block heights and returns the response
+func GetAccountStateDiff(r *common.Request, backend access.API, _ commonmodels.LinkGenerator) (interface{}, error) {
+	req, err := request.GetAccountStateDiffRequest(r)
+	if err != nil {
+		return nil, common.NewBadRequestError(err)
+	}
+
+	diff, err := backend.GetAccountStateDiff(r.Context(), req.Address, req.StartHeight, req.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.AccountStateDiff
+	response.Build(diff)
+
+	return response, nil
+}
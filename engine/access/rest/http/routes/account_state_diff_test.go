@@ -0,0 +1,121 @@
+package routes_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mocktestify "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/router"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetAccountStateDiff tests local getAccountStateDiff request.
+//
+// Runs the following tests:
+// 1. Get the state diff of an account between two heights.
+// 2. Get invalid account state diff requests.
+func TestGetAccountStateDiff(t *testing.T) {
+	backend := mock.NewAPI(t)
+	address := unittest.AddressFixture()
+
+	t.Run("get account state diff", func(t *testing.T) {
+		req := getAccountStateDiffRequest(t, address.String(), "10", "20")
+
+		diff := &accessmodel.AccountStateDiff{
+			Address:           address,
+			StartHeight:       10,
+			EndHeight:         20,
+			ExistedBefore:     true,
+			ExistsAfter:       true,
+			BalanceBefore:     1000,
+			BalanceAfter:      400,
+			StorageUsedBefore: 100,
+			StorageUsedAfter:  250,
+			ContractsAdded:    []string{"Foo"},
+			ChangedRegisters: []flow.RegisterID{
+				flow.ContractNamesRegisterID(address),
+				flow.ContractRegisterID(address, "Foo"),
+			},
+		}
+
+		backend.Mock.
+			On("GetAccountStateDiff", mocktestify.Anything, address, uint64(10), uint64(20)).
+			Return(diff, nil).
+			Once()
+
+		expected := fmt.Sprintf(`{
+			"address": "%s",
+			"start_height": "10",
+			"end_height": "20",
+			"existed_before": true,
+			"exists_after": true,
+			"balance_before": "1000",
+			"balance_after": "400",
+			"balance_delta": "-600",
+			"storage_used_before": "100",
+			"storage_used_after": "250",
+			"storage_used_delta": "150",
+			"keys_added": [],
+			"keys_revoked": [],
+			"contracts_added": ["Foo"],
+			"contracts_updated": [],
+			"contracts_removed": [],
+			"changed_registers": ["%s", "%s"],
+			"events": []
+		}`, address, diff.ChangedRegisters[0], diff.ChangedRegisters[1])
+
+		router.AssertOKResponse(t, req, expected, backend)
+		mocktestify.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get invalid", func(t *testing.T) {
+		tests := []struct {
+			url string
+			out string
+		}{
+			{accountStateDiffURL(t, "123", "10", "20"), `{"code":400, "message":"invalid address"}`},
+			{accountStateDiffURL(t, address.String(), "", "20"), `{"code":400, "message":"must provide numeric start and end heights"}`},
+			{accountStateDiffURL(t, address.String(), "10", "sealed"), `{"code":400, "message":"must provide numeric start and end heights"}`},
+			{accountStateDiffURL(t, address.String(), "foo", "20"), `{"code":400, "message":"invalid start height: invalid height format"}`},
+			{accountStateDiffURL(t, address.String(), "20", "10"), `{"code":400, "message":"start height must be less than or equal to end height"}`},
+		}
+
+		for i, test := range tests {
+			req, _ := http.NewRequest("GET", test.url, nil)
+			rr := router.ExecuteRequest(req, backend)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, test.out, rr.Body.String(), fmt.Sprintf("test #%d failed: %v", i, test))
+		}
+	})
+}
+
+func accountStateDiffURL(t *testing.T, address string, startHeight string, endHeight string) string {
+	u, err := url.ParseRequestURI(fmt.Sprintf("/v1/accounts/%s/diff", address))
+	require.NoError(t, err)
+	q := u.Query()
+
+	if startHeight != "" {
+		q.Add("start_height", startHeight)
+	}
+	if endHeight != "" {
+		q.Add("end_height", endHeight)
+	}
+
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func getAccountStateDiffRequest(t *testing.T, address string, startHeight string, endHeight string) *http.Request {
+	req, err := http.NewRequest("GET", accountStateDiffURL(t, address, startHeight, endHeight), nil)
+	require.NoError(t, err)
+	return req
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	commonmodels "github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/request"
+)
+
+// GetAccountTransactions handler retrieves a page of the transactions which touched an account and returns the response
+func GetAccountTransactions(r *common.Request, backend access.API, link commonmodels.LinkGenerator) (interface{}, error) {
+	req, err := request.GetAccountTransactionsRequest(r)
+	if err != nil {
+		return nil, common.NewBadRequestError(err)
+	}
+
+	page, err := backend.GetTransactionsByAddress(r.Context(), req.Address, req.Limit, req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	nextCursor := ""
+	if page.NextCursor != nil {
+		nextCursor = request.FormatAccountTransactionCursor(page.NextCursor)
+	}
+
+	var response models.AccountTransactions
+	err = response.Build(page.Transactions, nextCursor, link)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,144 @@
+package routes_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mocktestify "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/router"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetAccountTransactions tests local getAccountTransactions request.
+//
+// Runs the following tests:
+// 1. Get the latest account transactions with default limit.
+// 2. Get account transactions starting at a cursor, with a limit.
+// 3. Get invalid account transactions requests.
+func TestGetAccountTransactions(t *testing.T) {
+	backend := mock.NewAPI(t)
+	address := unittest.AddressFixture()
+
+	txs := []flow.AccountTransaction{
+		{
+			Address:          address,
+			BlockHeight:      20,
+			TransactionID:    unittest.IdentifierFixture(),
+			TransactionIndex: 2,
+			Roles:            []flow.TransactionRole{flow.TransactionRolePayer, flow.TransactionRoleProposer},
+		},
+		{
+			Address:          address,
+			BlockHeight:      10,
+			TransactionID:    unittest.IdentifierFixture(),
+			TransactionIndex: 0,
+			Roles:            []flow.TransactionRole{flow.TransactionRoleEventEmitter},
+		},
+	}
+
+	t.Run("get latest account transactions", func(t *testing.T) {
+		req := getAccountTransactionsRequest(t, address.String(), "", "")
+
+		backend.Mock.
+			On("GetTransactionsByAddress", mocktestify.Anything, address, uint32(0), (*accessmodel.AccountTransactionCursor)(nil)).
+			Return(&accessmodel.AccountTransactionsPage{Transactions: txs}, nil).
+			Once()
+
+		expected := fmt.Sprintf(`{
+			"transactions": [%s, %s]
+		}`, expectedAccountTransactionResponse(txs[0]), expectedAccountTransactionResponse(txs[1]))
+
+		router.AssertOKResponse(t, req, expected, backend)
+		mocktestify.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get account transactions from cursor", func(t *testing.T) {
+		req := getAccountTransactionsRequest(t, address.String(), "1", "20:2")
+
+		cursor := &accessmodel.AccountTransactionCursor{BlockHeight: 20, TransactionIndex: 2}
+		backend.Mock.
+			On("GetTransactionsByAddress", mocktestify.Anything, address, uint32(1), cursor).
+			Return(&accessmodel.AccountTransactionsPage{
+				Transactions: txs[:1],
+				NextCursor:   &accessmodel.AccountTransactionCursor{BlockHeight: 10, TransactionIndex: 0},
+			}, nil).
+			Once()
+
+		expected := fmt.Sprintf(`{
+			"transactions": [%s],
+			"next_cursor": "10:0"
+		}`, expectedAccountTransactionResponse(txs[0]))
+
+		router.AssertOKResponse(t, req, expected, backend)
+		mocktestify.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get invalid", func(t *testing.T) {
+		tests := []struct {
+			url string
+			out string
+		}{
+			{accountTransactionsURL(t, "123", "", ""), `{"code":400, "message":"invalid address"}`},
+			{accountTransactionsURL(t, address.String(), "foo", ""), `{"code":400, "message":"invalid limit: strconv.ParseUint: parsing \"foo\": invalid syntax"}`},
+			{accountTransactionsURL(t, address.String(), "", "10"), `{"code":400, "message":"invalid cursor format, must be <block_height>:<transaction_index>"}`},
+		}
+
+		for i, test := range tests {
+			req, _ := http.NewRequest("GET", test.url, nil)
+			rr := router.ExecuteRequest(req, backend)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, test.out, rr.Body.String(), fmt.Sprintf("test #%d failed: %v", i, test))
+		}
+	})
+}
+
+func accountTransactionsURL(t *testing.T, address string, limit string, cursor string) string {
+	u, err := url.ParseRequestURI(fmt.Sprintf("/v1/accounts/%s/transactions", address))
+	require.NoError(t, err)
+	q := u.Query()
+
+	if limit != "" {
+		q.Add("limit", limit)
+	}
+	if cursor != "" {
+		q.Add("cursor", cursor)
+	}
+
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func getAccountTransactionsRequest(t *testing.T, address string, limit string, cursor string) *http.Request {
+	req, err := http.NewRequest("GET", accountTransactionsURL(t, address, limit, cursor), nil)
+	require.NoError(t, err)
+	return req
+}
+
+func expectedAccountTransactionResponse(tx flow.AccountTransaction) string {
+	roles := ""
+	for i, role := range tx.Roles {
+		if i > 0 {
+			roles += ","
+		}
+		roles += fmt.Sprintf(`"%s"`, role.String())
+	}
+
+	return fmt.Sprintf(`{
+		"block_height": "%d",
+		"transaction_id": "%s",
+		"transaction_index": "%d",
+		"roles": [%s],
+		"_links": {
+			"_self": "/v1/transactions/%s"
+		}
+	}`, tx.BlockHeight, tx.TransactionID, tx.TransactionIndex, roles, tx.TransactionID)
+}
@@ -0,0 +1,52 @@
+package routes
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	commonmodels "github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/request"
+)
+
+// GetContract handler retrieves the version of a contract at a block height and returns the response
+func GetContract(r *common.Request, backend access.API, link commonmodels.LinkGenerator) (interface{}, error) {
+	req, err := request.GetContractRequest(r)
+	if err != nil {
+		return nil, common.NewBadRequestError(err)
+	}
+
+	// in case we receive special height values 'final' and 'sealed', fetch that height and overwrite request with it
+	if req.Height == request.FinalHeight || req.Height == request.SealedHeight {
+		header, _, err := backend.GetLatestBlockHeader(r.Context(), req.Height == request.SealedHeight)
+		if err != nil {
+			return nil, err
+		}
+		req.Height = header.Height
+	}
+
+	version, err := backend.GetContractAtBlockHeight(r.Context(), req.Address, req.Name, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ContractVersion
+	err = response.Build(version, link)
+	return response, err
+}
+
+// GetContractHistory handler retrieves all versions of a contract and returns the response
+func GetContractHistory(r *common.Request, backend access.API, link commonmodels.LinkGenerator) (interface{}, error) {
+	req, err := request.GetContractHistoryRequest(r)
+	if err != nil {
+		return nil, common.NewBadRequestError(err)
+	}
+
+	versions, err := backend.GetContractHistory(r.Context(), req.Address, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.ContractVersions
+	err = response.Build(versions, link)
+	return response, err
+}
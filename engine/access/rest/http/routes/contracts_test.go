@@ -0,0 +1,170 @@
+package routes_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mocktestify "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/router"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetContract tests local getContract request.
+//
+// Runs the following tests:
+// 1. Get a contract at a block height.
+// 2. Get a contract at the latest sealed block.
+// 3. Get invalid contract requests.
+func TestGetContract(t *testing.T) {
+	backend := mock.NewAPI(t)
+	address := unittest.AddressFixture()
+
+	update := contractUpdateFixture(address, "Foo", flow.ContractUpdateTypeUpdated, 90)
+	version := &accessmodel.ContractVersion{
+		Address: address,
+		Name:    "Foo",
+		Code:    []byte("access(all) contract Foo {}"),
+		Update:  &update,
+	}
+
+	t.Run("get contract at height", func(t *testing.T) {
+		req := getContractRequest(t, address.String(), "Foo", "100")
+
+		backend.Mock.
+			On("GetContractAtBlockHeight", mocktestify.Anything, address, "Foo", uint64(100)).
+			Return(version, nil).
+			Once()
+
+		router.AssertOKResponse(t, req, expectedContractVersionResponse(version), backend)
+		mocktestify.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get contract at latest sealed block", func(t *testing.T) {
+		req := getContractRequest(t, address.String(), "Foo", "")
+
+		header := unittest.BlockHeaderFixture()
+		backend.Mock.
+			On("GetLatestBlockHeader", mocktestify.Anything, true).
+			Return(header, flow.BlockStatusSealed, nil).
+			Once()
+		backend.Mock.
+			On("GetContractAtBlockHeight", mocktestify.Anything, address, "Foo", header.Height).
+			Return(version, nil).
+			Once()
+
+		router.AssertOKResponse(t, req, expectedContractVersionResponse(version), backend)
+		mocktestify.AssertExpectationsForObjects(t, backend)
+	})
+
+	t.Run("get invalid", func(t *testing.T) {
+		tests := []struct {
+			url string
+			out string
+		}{
+			{contractURL(t, "123", "Foo", "", ""), `{"code":400, "message":"invalid address"}`},
+			{contractURL(t, address.String(), "Foo", "", "foo"), `{"code":400, "message":"invalid height format"}`},
+		}
+
+		for i, test := range tests {
+			req, _ := http.NewRequest("GET", test.url, nil)
+			rr := router.ExecuteRequest(req, backend)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, test.out, rr.Body.String(), fmt.Sprintf("test #%d failed: %v", i, test))
+		}
+	})
+}
+
+// TestGetContractHistory tests local getContractHistory request.
+func TestGetContractHistory(t *testing.T) {
+	backend := mock.NewAPI(t)
+	address := unittest.AddressFixture()
+
+	added := contractUpdateFixture(address, "Foo", flow.ContractUpdateTypeAdded, 10)
+	removed := contractUpdateFixture(address, "Foo", flow.ContractUpdateTypeRemoved, 20)
+	versions := []accessmodel.ContractVersion{
+		{Address: address, Name: "Foo", Code: []byte("access(all) contract Foo {}"), Update: &added},
+		{Address: address, Name: "Foo", Update: &removed},
+	}
+
+	req, err := http.NewRequest("GET", contractURL(t, address.String(), "Foo", "/history", ""), nil)
+	require.NoError(t, err)
+
+	backend.Mock.
+		On("GetContractHistory", mocktestify.Anything, address, "Foo").
+		Return(versions, nil).
+		Once()
+
+	expected := fmt.Sprintf(`[%s, %s]`, expectedContractVersionResponse(&versions[0]), expectedContractVersionResponse(&versions[1]))
+
+	router.AssertOKResponse(t, req, expected, backend)
+	mocktestify.AssertExpectationsForObjects(t, backend)
+}
+
+func contractUpdateFixture(address flow.Address, name string, updateType flow.ContractUpdateType, height uint64) flow.ContractUpdate {
+	return flow.ContractUpdate{
+		Address:          address,
+		ContractName:     name,
+		Type:             updateType,
+		BlockHeight:      height,
+		TransactionID:    unittest.IdentifierFixture(),
+		TransactionIndex: 1,
+		EventIndex:       2,
+		CodeHash:         unittest.RandomBytes(32),
+	}
+}
+
+func contractURL(t *testing.T, address string, name string, suffix string, height string) string {
+	u, err := url.ParseRequestURI(fmt.Sprintf("/v1/accounts/%s/contracts/%s%s", address, name, suffix))
+	require.NoError(t, err)
+	q := u.Query()
+
+	if height != "" {
+		q.Add("block_height", height)
+	}
+
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func getContractRequest(t *testing.T, address string, name string, height string) *http.Request {
+	req, err := http.NewRequest("GET", contractURL(t, address, name, "", height), nil)
+	require.NoError(t, err)
+	return req
+}
+
+func expectedContractVersionResponse(version *accessmodel.ContractVersion) string {
+	code := ""
+	if len(version.Code) > 0 {
+		code = fmt.Sprintf(`"code": "%s",`, util.ToBase64(version.Code))
+	}
+
+	update := version.Update
+	return fmt.Sprintf(`{
+		"address": "%s",
+		"name": "%s",
+		%s
+		"update": {
+			"type": "%s",
+			"block_height": "%d",
+			"transaction_id": "%s",
+			"transaction_index": "%d",
+			"event_index": "%d",
+			"code_hash": "%s",
+			"_links": {
+				"_self": "/v1/transactions/%s"
+			}
+		}
+	}`, version.Address, version.Name, code, update.Type, update.BlockHeight, update.TransactionID,
+		update.TransactionIndex, update.EventIndex, hex.EncodeToString(update.CodeHash), update.TransactionID)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/onflow/flow-go/access"
 	"github.com/onflow/flow-go/engine/access/rest/common"
 	commonmodels "github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/models"
 	"github.com/onflow/flow-go/engine/access/rest/http/request"
 )
 
@@ -15,6 +16,8 @@ const BlockQueryParam = "block_ids"
 const EventTypeQuery = "type"
 
 // GetEvents for the provided block range or list of block IDs filtered by type.
+// Events of a block range can also be filtered by their field values and paginated, in which case
+// a single page of events is returned along with the cursor of the next page.
 func GetEvents(r *common.Request, backend access.API, _ commonmodels.LinkGenerator) (interface{}, error) {
 	req, err := request.GetEventsRequest(r)
 	if err != nil {
@@ -52,6 +55,32 @@ func GetEvents(r *common.Request, backend access.API, _ commonmodels.LinkGenerat
 		}
 	}
 
+	// if field filters or pagination were requested then return a single page of events for that range
+	if req.Paginated() {
+		page, err := backend.GetEventsForHeightRangeWithFieldFilters(
+			r.Context(),
+			req.Type,
+			req.StartHeight,
+			req.EndHeight,
+			req.FieldFilters,
+			req.Limit,
+			req.Cursor,
+			entitiesproto.EventEncodingVersion_JSON_CDC_V0,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		nextCursor := ""
+		if page.NextCursor != nil {
+			nextCursor = request.FormatEventCursor(page.NextCursor)
+		}
+
+		var response models.EventsPage
+		response.Build(page.BlockEvents, nextCursor)
+		return response, nil
+	}
+
 	// if request provided block height range then return events for that range
 	events, err := backend.GetEventsForHeightRange(
 		r.Context(),
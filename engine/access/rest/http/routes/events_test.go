@@ -18,6 +18,8 @@ import (
 	"github.com/onflow/flow-go/engine/access/rest/http/routes"
 	"github.com/onflow/flow-go/engine/access/rest/router"
 	"github.com/onflow/flow-go/engine/access/rest/util"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/events"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/utils/unittest"
 
@@ -134,6 +136,80 @@ func TestGetEvents(t *testing.T) {
 
 }
 
+// TestGetEvents_FieldFilters tests that events of a height range filtered by field values are returned in pages.
+func TestGetEvents_FieldFilters(t *testing.T) {
+	backend := mock.NewAPI(t)
+
+	header := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(5))
+	blockEvents := unittest.BlockEventsFixture(header, 2)
+	eventType := "A.179b6b1cb6755e31.Foo.Bar"
+
+	fieldFilters, err := events.ParseFieldFilters([]string{"amount:gt:10", "to:eq:0x179b6b1cb6755e31"})
+	require.NoError(t, err)
+
+	t.Run("page with next cursor", func(t *testing.T) {
+		backend.On(
+			"GetEventsForHeightRangeWithFieldFilters",
+			mocks.Anything,
+			eventType,
+			uint64(0),
+			uint64(10),
+			fieldFilters,
+			uint32(2),
+			&accessmodel.EventCursor{BlockHeight: 4, TransactionIndex: 1, EventIndex: 0},
+			entities.EventEncodingVersion_JSON_CDC_V0,
+		).Return(&accessmodel.EventsPage{
+			BlockEvents: []flow.BlockEvents{blockEvents},
+			NextCursor:  &accessmodel.EventCursor{BlockHeight: 7, TransactionIndex: 0, EventIndex: 3},
+		}, nil).Once()
+
+		req := getEventReq(t, eventType, "0", "10", nil)
+		q := req.URL.Query()
+		q.Add("field_filter", "amount:gt:10")
+		q.Add("field_filter", "to:eq:0x179b6b1cb6755e31")
+		q.Add("limit", "2")
+		q.Add("cursor", "4:1:0")
+		req.URL.RawQuery = q.Encode()
+
+		expected := fmt.Sprintf(`{"events":%s,"next_cursor":"7:0:3"}`, testBlockEventResponse(t, []flow.BlockEvents{blockEvents}))
+		router.AssertOKResponse(t, req, expected, backend)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		backend.On(
+			"GetEventsForHeightRangeWithFieldFilters",
+			mocks.Anything,
+			eventType,
+			uint64(0),
+			uint64(10),
+			events.FieldFilters{},
+			uint32(10),
+			(*accessmodel.EventCursor)(nil),
+			entities.EventEncodingVersion_JSON_CDC_V0,
+		).Return(&accessmodel.EventsPage{
+			BlockEvents: []flow.BlockEvents{},
+		}, nil).Once()
+
+		req := getEventReq(t, eventType, "0", "10", nil)
+		q := req.URL.Query()
+		q.Add("limit", "10")
+		req.URL.RawQuery = q.Encode()
+
+		router.AssertOKResponse(t, req, `{"events":[]}`, backend)
+	})
+
+	t.Run("invalid field filter", func(t *testing.T) {
+		req := getEventReq(t, eventType, "0", "10", nil)
+		q := req.URL.Query()
+		q.Add("field_filter", "amount:between:1")
+		req.URL.RawQuery = q.Encode()
+
+		router.AssertResponse(t, req, http.StatusBadRequest,
+			`{"code":400,"message":"invalid field filter \"amount:between:1\": invalid operator \"between\": must be one of eq, ne, lt, lte, gt, gte"}`,
+			backend)
+	})
+}
+
 func getEventReq(t *testing.T, eventType string, start string, end string, blockIDs []string) *http.Request {
 	u, _ := url.Parse("/v1/events")
 	q := u.Query()
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/common"
+	commonmodels "github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/request"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// GetRegisterProofs handler retrieves a batch proof of the requested registers, at the given block or state
+// commitment, and defaults to the latest sealed block.
+func GetRegisterProofs(r *common.Request, backend access.API, _ commonmodels.LinkGenerator) (interface{}, error) {
+	req, err := request.GetRegisterProofsRequest(r)
+	if err != nil {
+		return nil, common.NewBadRequestError(err)
+	}
+
+	var proofs *accessmodel.RegisterProofs
+	switch {
+	case req.StateCommitment != nil:
+		proofs, err = backend.GetRegisterProofsAtStateCommitment(r.Context(), *req.StateCommitment, req.RegisterIDs)
+
+	default:
+		// default to last sealed block
+		if req.BlockID == flow.ZeroID {
+			sealed, _, err := backend.GetLatestBlockHeader(r.Context(), true)
+			if err != nil {
+				return nil, err
+			}
+			req.BlockID = sealed.ID()
+		}
+		proofs, err = backend.GetRegisterProofsAtBlockID(r.Context(), req.BlockID, req.RegisterIDs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.RegisterProofs
+	response.Build(proofs)
+	return response, nil
+}
@@ -0,0 +1,150 @@
+package routes_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mocktestify "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/router"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetRegisterProofs tests local getRegisterProofs request.
+//
+// Runs the following tests:
+// 1. Get register proofs at a block ID.
+// 2. Get register proofs at a state commitment.
+// 3. Get register proofs at the latest sealed block.
+// 4. Get invalid register proofs requests.
+func TestGetRegisterProofs(t *testing.T) {
+	backend := mock.NewAPI(t)
+	address := flow.Testnet.Chain().ServiceAddress()
+	blockID := unittest.IdentifierFixture()
+	commit := unittest.StateCommitmentFixture()
+	proof := []byte("proof")
+
+	registerIDs := []flow.RegisterID{
+		flow.AccountStatusRegisterID(address),
+		flow.NewRegisterID(flow.EmptyAddress, "global"),
+	}
+	body := map[string]interface{}{
+		"registers": []map[string]string{
+			{"owner": address.String(), "key": util.ToBase64([]byte(registerIDs[0].Key))},
+			{"key": util.ToBase64([]byte("global"))},
+		},
+	}
+
+	t.Run("get register proofs at block ID", func(t *testing.T) {
+		backend.Mock.
+			On("GetRegisterProofsAtBlockID", mocktestify.Anything, blockID, registerIDs).
+			Return(&accessmodel.RegisterProofs{BlockID: blockID, StateCommitment: commit, Proof: proof}, nil).
+			Once()
+
+		expected := fmt.Sprintf(`{
+			"block_id": "%s",
+			"state_commitment": "%s",
+			"proof": "%s"
+		}`, blockID, hex.EncodeToString(commit[:]), util.ToBase64(proof))
+
+		router.AssertOKResponse(t, registerProofsRequest(t, blockID.String(), "", body), expected, backend)
+	})
+
+	t.Run("get register proofs at state commitment", func(t *testing.T) {
+		backend.Mock.
+			On("GetRegisterProofsAtStateCommitment", mocktestify.Anything, commit, registerIDs).
+			Return(&accessmodel.RegisterProofs{StateCommitment: commit, Proof: proof}, nil).
+			Once()
+
+		expected := fmt.Sprintf(`{
+			"state_commitment": "%s",
+			"proof": "%s"
+		}`, hex.EncodeToString(commit[:]), util.ToBase64(proof))
+
+		router.AssertOKResponse(t, registerProofsRequest(t, "", hex.EncodeToString(commit[:]), body), expected, backend)
+	})
+
+	t.Run("get register proofs at latest sealed block", func(t *testing.T) {
+		sealed := unittest.BlockHeaderFixture()
+		backend.Mock.
+			On("GetLatestBlockHeader", mocktestify.Anything, true).
+			Return(sealed, flow.BlockStatusSealed, nil).
+			Once()
+		backend.Mock.
+			On("GetRegisterProofsAtBlockID", mocktestify.Anything, sealed.ID(), registerIDs).
+			Return(&accessmodel.RegisterProofs{BlockID: sealed.ID(), StateCommitment: commit, Proof: proof}, nil).
+			Once()
+
+		expected := fmt.Sprintf(`{
+			"block_id": "%s",
+			"state_commitment": "%s",
+			"proof": "%s"
+		}`, sealed.ID(), hex.EncodeToString(commit[:]), util.ToBase64(proof))
+
+		router.AssertOKResponse(t, registerProofsRequest(t, "", "", body), expected, backend)
+	})
+
+	t.Run("get invalid", func(t *testing.T) {
+		tests := []struct {
+			req *http.Request
+			out string
+		}{
+			{
+				registerProofsRequest(t, blockID.String(), hex.EncodeToString(commit[:]), body),
+				`{"code":400, "message":"can not provide both block ID and state commitment"}`,
+			},
+			{
+				registerProofsRequest(t, "", "zz", body),
+				`{"code":400, "message":"invalid state commitment format"}`,
+			},
+			{
+				registerProofsRequest(t, "", "", map[string]interface{}{"registers": []string{}}),
+				`{"code":400, "message":"at least one register must be provided"}`,
+			},
+			{
+				registerProofsRequest(t, "", "", map[string]interface{}{
+					"registers": []map[string]string{{"owner": address.String(), "key": "!"}},
+				}),
+				`{"code":400, "message":"invalid register key encoding"}`,
+			},
+		}
+
+		for i, test := range tests {
+			rr := router.ExecuteRequest(test.req, backend)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, test.out, rr.Body.String(), fmt.Sprintf("test #%d failed: %v", i, test))
+		}
+	})
+}
+
+func registerProofsRequest(t *testing.T, blockID string, commit string, body interface{}) *http.Request {
+	u, err := url.ParseRequestURI("/v1/register_proofs")
+	require.NoError(t, err)
+	q := u.Query()
+
+	if blockID != "" {
+		q.Add("block_id", blockID)
+	}
+	if commit != "" {
+		q.Add("state_commitment", commit)
+	}
+	u.RawQuery = q.Encode()
+
+	jsonBody, err := json.Marshal(body)
+	require.NoError(t, err)
+	req, err := http.NewRequest("POST", u.String(), bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	return req
+}
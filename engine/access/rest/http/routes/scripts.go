@@ -4,7 +4,9 @@ import (
 	"github.com/onflow/flow-go/access"
 	"github.com/onflow/flow-go/engine/access/rest/common"
 	"github.com/onflow/flow-go/engine/access/rest/common/models"
+	httpmodels "github.com/onflow/flow-go/engine/access/rest/http/models"
 	"github.com/onflow/flow-go/engine/access/rest/http/request"
+	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 )
 
@@ -15,6 +17,10 @@ func ExecuteScript(r *common.Request, backend access.API, _ models.LinkGenerator
 		return nil, common.NewBadRequestError(err)
 	}
 
+	if req.IncludeReport {
+		return executeScriptWithReport(r, req, backend)
+	}
+
 	if req.BlockID != flow.ZeroID {
 		return backend.ExecuteScriptAtBlockID(r.Context(), req.BlockID, req.Script.Source, req.Script.Args)
 	}
@@ -34,3 +40,36 @@ func ExecuteScript(r *common.Request, backend access.API, _ models.LinkGenerator
 
 	return backend.ExecuteScriptAtBlockHeight(r.Context(), req.BlockHeight, req.Script.Source, req.Script.Args)
 }
+
+// executeScriptWithReport executes the script from the request and returns its result along with
+// the resources used to execute it.
+func executeScriptWithReport(r *common.Request, req request.GetScript, backend access.API) (interface{}, error) {
+	var result *accessmodel.ScriptExecutionResult
+	var err error
+
+	switch {
+	case req.BlockID != flow.ZeroID:
+		result, err = backend.ExecuteScriptAtBlockIDWithReport(r.Context(), req.BlockID, req.Script.Source, req.Script.Args)
+
+	// default to sealed height
+	case req.BlockHeight == request.SealedHeight || req.BlockHeight == request.EmptyHeight:
+		result, err = backend.ExecuteScriptAtLatestBlockWithReport(r.Context(), req.Script.Source, req.Script.Args)
+
+	default:
+		if req.BlockHeight == request.FinalHeight {
+			finalBlock, _, err := backend.GetLatestBlockHeader(r.Context(), false)
+			if err != nil {
+				return nil, err
+			}
+			req.BlockHeight = finalBlock.Height
+		}
+		result, err = backend.ExecuteScriptAtBlockHeightWithReport(r.Context(), req.BlockHeight, req.Script.Source, req.Script.Args)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var response httpmodels.ScriptExecutionResult
+	response.Build(result)
+	return response, nil
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/onflow/flow-go/access/mock"
 	"github.com/onflow/flow-go/engine/access/rest/router"
 	"github.com/onflow/flow-go/engine/access/rest/util"
+	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 )
 
@@ -89,6 +90,40 @@ func TestScripts(t *testing.T) {
 		), backend)
 	})
 
+	t.Run("get with report", func(t *testing.T) {
+		backend := &mock.API{}
+		height := uint64(1337)
+
+		backend.Mock.
+			On("ExecuteScriptAtBlockHeightWithReport", mocks.Anything, height, validCode, [][]byte{validArgs}).
+			Return(&accessmodel.ScriptExecutionResult{
+				Value: []byte("hello world"),
+				Report: accessmodel.ScriptExecutionReport{
+					ComputationUsed: 10,
+					MemoryEstimate:  2048,
+					RegisterReads:   5,
+					BytesRead:       300,
+					ContractsLoaded: []string{"A.0000000000000001.Foo"},
+					ExecutedLocally: true,
+				},
+			}, nil)
+
+		req := scriptReq("", fmt.Sprintf("%d", height), validBody)
+		req.URL.RawQuery += "&include_report=true"
+
+		router.AssertOKResponse(t, req, fmt.Sprintf(`{
+			"value": "%s",
+			"report": {
+				"computation_used": "10",
+				"memory_estimate": "2048",
+				"register_reads": "5",
+				"bytes_read": "300",
+				"contracts_loaded": ["A.0000000000000001.Foo"],
+				"executed_locally": true
+			}
+		}`, base64.StdEncoding.EncodeToString([]byte(`hello world`))), backend)
+	})
+
 	t.Run("get error", func(t *testing.T) {
 		backend := &mock.API{}
 		backend.Mock.
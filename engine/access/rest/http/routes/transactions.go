@@ -6,8 +6,10 @@ import (
 	"github.com/onflow/flow-go/access"
 	"github.com/onflow/flow-go/engine/access/rest/common"
 	commonmodels "github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/http/models"
 	"github.com/onflow/flow-go/engine/access/rest/http/request"
 	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
 )
 
 // GetTransactionByID gets a transaction by requested ID.
@@ -81,3 +83,38 @@ func CreateTransaction(r *common.Request, backend access.API, link commonmodels.
 	response.Build(&req.Transaction, nil, link)
 	return response, nil
 }
+
+// DryRunTransaction executes the transaction from the provided payload without submitting it.
+func DryRunTransaction(r *common.Request, backend access.API, _ commonmodels.LinkGenerator) (interface{}, error) {
+	req, err := request.DryRunTransactionRequest(r)
+	if err != nil {
+		return nil, common.NewBadRequestError(err)
+	}
+
+	var result *accessmodel.TransactionDryRunResult
+	switch {
+	case req.BlockID != flow.ZeroID:
+		result, err = backend.DryRunTransactionAtBlockID(r.Context(), req.BlockID, &req.Transaction, req.Options, entitiesproto.EventEncodingVersion_JSON_CDC_V0)
+
+	// default to sealed height
+	case req.BlockHeight == request.SealedHeight || req.BlockHeight == request.EmptyHeight:
+		result, err = backend.DryRunTransactionAtLatestBlock(r.Context(), &req.Transaction, req.Options, entitiesproto.EventEncodingVersion_JSON_CDC_V0)
+
+	default:
+		if req.BlockHeight == request.FinalHeight {
+			finalBlock, _, err := backend.GetLatestBlockHeader(r.Context(), false)
+			if err != nil {
+				return nil, err
+			}
+			req.BlockHeight = finalBlock.Height
+		}
+		result, err = backend.DryRunTransactionAtBlockHeight(r.Context(), req.BlockHeight, &req.Transaction, req.Options, entitiesproto.EventEncodingVersion_JSON_CDC_V0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.TransactionDryRunResult
+	response.Build(result)
+	return response, nil
+}
@@ -72,6 +72,25 @@ func createTransactionReq(body interface{}) *http.Request {
 	return req
 }
 
+func dryRunTransactionReq(body interface{}, height string, id string) *http.Request {
+	u, _ := url.Parse("/v1/transactions/dry_run")
+	q := u.Query()
+
+	if height != "" {
+		q.Add("block_height", height)
+	}
+
+	if id != "" {
+		q.Add("block_id", id)
+	}
+
+	u.RawQuery = q.Encode()
+
+	jsonBody, _ := json.Marshal(body)
+	req, _ := http.NewRequest("POST", u.String(), bytes.NewBuffer(jsonBody))
+	return req
+}
+
 func TestGetTransactions(t *testing.T) {
 	t.Run("get by ID without results", func(t *testing.T) {
 		backend := &mock.API{}
@@ -331,6 +350,50 @@ func TestGetTransactionResult(t *testing.T) {
 		}
 	})
 
+	t.Run("get pending details", func(t *testing.T) {
+		backend := &mock.API{}
+		nodeID := unittest.IdentifierFixture()
+		txResult := &accessmodel.TransactionResult{
+			Status: flow.TransactionStatusPending,
+			PendingDetails: accessmodel.NewPendingTransactionDetails([]accessmodel.CollectionNodeTransactionStatus{{
+				NodeID:  nodeID,
+				Reason:  accessmodel.PendingReasonInMempool,
+				Message: "in mempool",
+			}}),
+		}
+
+		req := getTransactionResultReq(id.String(), "", "")
+		backend.Mock.
+			On("GetTransactionResult", mocks.Anything, id, flow.ZeroID, flow.ZeroID, entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(txResult, nil)
+
+		expected := fmt.Sprintf(`{
+			"block_id": "",
+			"collection_id": "",
+			"execution": "Pending",
+			"status": "Pending",
+			"status_code": 0,
+			"error_message": "",
+			"computation_used": "0",
+			"events": [],
+			"pending_details": {
+				"reason": "IN_MEMPOOL",
+				"message": "in mempool",
+				"collection_nodes": [
+					{
+						"node_id": "%s",
+						"reason": "IN_MEMPOOL",
+						"message": "in mempool"
+					}
+				]
+			},
+			"_links": {
+				"_self": "/v1/transaction_results/%s"
+			}
+		}`, nodeID.String(), id.String())
+		router.AssertOKResponse(t, req, expected, backend)
+	})
+
 	t.Run("get by ID Invalid", func(t *testing.T) {
 		backend := &mock.API{}
 
@@ -425,6 +488,89 @@ func TestCreateTransaction(t *testing.T) {
 	})
 }
 
+func TestDryRunTransaction(t *testing.T) {
+	backend := mock.NewAPI(t)
+
+	tx := unittest.TransactionBodyFixture()
+	tx.PayloadSignatures = []flow.TransactionSignature{unittest.TransactionSignatureFixture()}
+	tx.Arguments = [][]uint8{}
+
+	block := unittest.BlockHeaderFixture()
+	result := &accessmodel.TransactionDryRunResult{
+		BlockID:         block.ID(),
+		BlockHeight:     block.Height,
+		StatusCode:      1,
+		ErrorMessage:    "failed",
+		ComputationUsed: 42,
+		MemoryEstimate:  1024,
+		Fees:            100,
+		Events:          []flow.Event{},
+	}
+	expected := fmt.Sprintf(`{
+		"block_id": "%s",
+		"block_height": "%d",
+		"status_code": 1,
+		"error_message": "failed",
+		"computation_used": "42",
+		"memory_estimate": "1024",
+		"fees": "100",
+		"events": []
+	}`, block.ID(), block.Height)
+
+	t.Run("dry run at latest block", func(t *testing.T) {
+		req := dryRunTransactionReq(unittest.CreateSendTxHttpPayload(tx), "", "")
+
+		backend.
+			On("DryRunTransactionAtLatestBlock", mocks.Anything, &tx, accessmodel.TransactionDryRunOptions{}, entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(result, nil).
+			Once()
+
+		router.AssertOKResponse(t, req, expected, backend)
+	})
+
+	t.Run("dry run at block height", func(t *testing.T) {
+		req := dryRunTransactionReq(unittest.CreateSendTxHttpPayload(tx), fmt.Sprintf("%d", block.Height), "")
+		req.URL.RawQuery += "&verify_signatures=true&check_sequence_number=true"
+
+		options := accessmodel.TransactionDryRunOptions{
+			VerifySignatures:    true,
+			CheckSequenceNumber: true,
+		}
+		backend.
+			On("DryRunTransactionAtBlockHeight", mocks.Anything, block.Height, &tx, options, entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(result, nil).
+			Once()
+
+		router.AssertOKResponse(t, req, expected, backend)
+	})
+
+	t.Run("dry run at block ID", func(t *testing.T) {
+		req := dryRunTransactionReq(unittest.CreateSendTxHttpPayload(tx), "", block.ID().String())
+
+		backend.
+			On("DryRunTransactionAtBlockID", mocks.Anything, block.ID(), &tx, accessmodel.TransactionDryRunOptions{}, entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(result, nil).
+			Once()
+
+		router.AssertOKResponse(t, req, expected, backend)
+	})
+
+	t.Run("dry run with invalid options", func(t *testing.T) {
+		req := dryRunTransactionReq(unittest.CreateSendTxHttpPayload(tx), "", "")
+		req.URL.RawQuery = "verify_signatures=yes"
+
+		expected := `{"code":400, "message":"invalid value for verify_signatures: yes"}`
+		router.AssertResponse(t, req, http.StatusBadRequest, expected, backend)
+	})
+
+	t.Run("dry run with both block ID and height", func(t *testing.T) {
+		req := dryRunTransactionReq(unittest.CreateSendTxHttpPayload(tx), "1", block.ID().String())
+
+		expected := `{"code":400, "message":"can not provide both block ID and block height"}`
+		router.AssertResponse(t, req, http.StatusBadRequest, expected, backend)
+	})
+}
+
 func transactionResultFixture(tx flow.Transaction) *accessmodel.TransactionResult {
 	cid := unittest.IdentifierFixture()
 	return &accessmodel.TransactionResult{
@@ -24,6 +24,11 @@ var Routes = []route{{
 	Pattern: "/transactions",
 	Name:    "createTransaction",
 	Handler: routes.CreateTransaction,
+}, {
+	Method:  http.MethodPost,
+	Pattern: "/transactions/dry_run",
+	Name:    "dryRunTransaction",
+	Handler: routes.DryRunTransaction,
 }, {
 	Method:  http.MethodGet,
 	Pattern: "/transaction_results/{id}",
@@ -64,6 +69,11 @@ var Routes = []route{{
 	Pattern: "/scripts",
 	Name:    "executeScript",
 	Handler: routes.ExecuteScript,
+}, {
+	Method:  http.MethodPost,
+	Pattern: "/register_proofs",
+	Name:    "getRegisterProofs",
+	Handler: routes.GetRegisterProofs,
 }, {
 	Method:  http.MethodGet,
 	Pattern: "/accounts/{address}",
@@ -84,6 +94,26 @@ var Routes = []route{{
 	Pattern: "/accounts/{address}/keys",
 	Name:    "getAccountKeys",
 	Handler: routes.GetAccountKeys,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/accounts/{address}/transactions",
+	Name:    "getAccountTransactions",
+	Handler: routes.GetAccountTransactions,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/accounts/{address}/diff",
+	Name:    "getAccountStateDiff",
+	Handler: routes.GetAccountStateDiff,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/accounts/{address}/contracts/{name}",
+	Name:    "getContract",
+	Handler: routes.GetContract,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/accounts/{address}/contracts/{name}/history",
+	Name:    "getContractHistory",
+	Handler: routes.GetContractHistory,
 }, {
 	Method:  http.MethodGet,
 	Pattern: "/events",
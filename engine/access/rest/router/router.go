@@ -5,13 +5,16 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/access/ratelimit"
 	"github.com/onflow/flow-go/engine/access/rest/common/middleware"
 	"github.com/onflow/flow-go/engine/access/rest/common/models"
+	"github.com/onflow/flow-go/engine/access/rest/graphql"
 	flowhttp "github.com/onflow/flow-go/engine/access/rest/http"
 	"github.com/onflow/flow-go/engine/access/rest/websockets"
 	dp "github.com/onflow/flow-go/engine/access/rest/websockets/data_providers"
@@ -23,12 +26,21 @@ import (
 	"github.com/onflow/flow-go/module/irrecoverable"
 )
 
+// websocketsRouteName is the name of the websockets route, used as the rate limiting bucket of both the
+// connection requests and the messages received over the connections.
+const websocketsRouteName = "ws"
+
 // RouterBuilder is a utility for building HTTP routers with common middleware and routes.
 type RouterBuilder struct {
 	logger      zerolog.Logger
 	router      *mux.Router
 	v1SubRouter *mux.Router
 
+	// rateLimiter and clientKey are set by AddRateLimitMiddleware, and also limit the messages of
+	// websocket connections.
+	rateLimiter *ratelimit.ClientLimiter
+	clientKey   middleware.ClientKeyFunc
+
 	LinkGenerator models.LinkGenerator
 }
 
@@ -53,6 +65,21 @@ func NewRouterBuilder(
 	}
 }
 
+// AddRateLimitMiddleware applies the per client limits of the given limiter to all requests, including
+// websocket connection requests. Clients are identified using the given client key function, and each
+// route is limited using its name as the bucket.
+// It must be called before AddWebsocketsRoute, so that each message received over a websocket connection
+// is also counted against the limits of the "ws" route.
+func (b *RouterBuilder) AddRateLimitMiddleware(
+	limiter *ratelimit.ClientLimiter,
+	clientKey middleware.ClientKeyFunc,
+) *RouterBuilder {
+	b.rateLimiter = limiter
+	b.clientKey = clientKey
+	b.v1SubRouter.Use(middleware.RateLimitMiddleware(b.logger, limiter, clientKey))
+	return b
+}
+
 // AddRestRoutes adds rest routes to the router.
 func (b *RouterBuilder) AddRestRoutes(
 	backend access.API,
@@ -100,11 +127,39 @@ func (b *RouterBuilder) AddWebsocketsRoute(
 	maxRequestSize int64,
 	dataProviderFactory dp.DataProviderFactory,
 ) *RouterBuilder {
-	handler := websockets.NewWebSocketHandler(ctx, b.logger, config, chain, maxRequestSize, dataProviderFactory)
+	var messageLimiter func(r *http.Request) websockets.MessageLimiter
+	if b.rateLimiter != nil {
+		limiter, clientKey := b.rateLimiter, b.clientKey
+		messageLimiter = func(r *http.Request) websockets.MessageLimiter {
+			client := clientKey(r)
+			return func(string) (bool, time.Duration) {
+				return limiter.Allow(client, websocketsRouteName)
+			}
+		}
+	}
+
+	handler := websockets.NewWebSocketHandler(ctx, b.logger, config, chain, maxRequestSize, dataProviderFactory, messageLimiter)
 	b.v1SubRouter.
 		Methods(http.MethodGet).
 		Path("/ws").
-		Name("ws").
+		Name(websocketsRouteName).
+		Handler(handler)
+
+	return b
+}
+
+// AddGraphQLRoute adds the GraphQL route to the router. Queries are resolved using the given backend.
+func (b *RouterBuilder) AddGraphQLRoute(
+	backend access.API,
+	chain flow.Chain,
+	config graphql.Config,
+	maxRequestSize int64,
+) *RouterBuilder {
+	handler := graphql.NewHandler(b.logger, backend, chain, config, maxRequestSize)
+	b.v1SubRouter.
+		Methods(http.MethodGet, http.MethodPost).
+		Path(graphQLRoutePattern).
+		Name(graphQLRouteName).
 		Handler(handler)
 
 	return b
@@ -114,8 +169,13 @@ func (b *RouterBuilder) Build() *mux.Router {
 	return b.router
 }
 
+const (
+	graphQLRoutePattern = "/graphql"
+	graphQLRouteName    = "graphql"
+)
+
 var routeUrlMap = map[string]string{}
-var routeRE = regexp.MustCompile(`(?i)/v1/(\w+)(/(\w+))?(/(\w+))?(/(\w+))?`)
+var routeRE = regexp.MustCompile(`(?i)/v1/(\w+)(/(\w+))?(/(\w+))?(/(\w+))?(/(\w+))?`)
 
 func init() {
 	for _, r := range Routes {
@@ -124,6 +184,7 @@ func init() {
 	for _, r := range WSLegacyRoutes {
 		routeUrlMap[r.Pattern] = r.Name
 	}
+	routeUrlMap[graphQLRoutePattern] = graphQLRouteName
 }
 
 func URLToRoute(url string) (string, error) {
@@ -141,7 +202,7 @@ func URLToRoute(url string) (string, error) {
 
 func normalizeURL(url string) (string, error) {
 	matches := routeRE.FindAllStringSubmatch(url, -1)
-	if len(matches) != 1 || len(matches[0]) != 8 {
+	if len(matches) != 1 || len(matches[0]) != 10 {
 		return "", fmt.Errorf("invalid url")
 	}
 
@@ -166,6 +227,12 @@ func normalizeURL(url string) (string, error) {
 		parts = append(parts, "{address}")
 		if matches[0][5] == "keys" && matches[0][7] != "" {
 			parts = append(parts, "keys", "{index}")
+		} else if matches[0][5] == "contracts" && matches[0][7] != "" {
+			// contract based resource. e.g. /v1/accounts/1234567890abcdef/contracts/FungibleToken/history
+			parts = append(parts, "contracts", "{name}")
+			if matches[0][9] != "" {
+				parts = append(parts, matches[0][9])
+			}
 		} else if matches[0][5] != "" {
 			parts = append(parts, matches[0][5])
 		}
@@ -19,6 +19,16 @@ func TestParseURL(t *testing.T) {
 			url:      "/v1/transactions",
 			expected: "createTransaction",
 		},
+		{
+			name:     "/v1/transactions/dry_run",
+			url:      "/v1/transactions/dry_run",
+			expected: "dryRunTransaction",
+		},
+		{
+			name:     "/v1/graphql",
+			url:      "/v1/graphql",
+			expected: "graphql",
+		},
 		{
 			name:     "/v1/transactions/{id}",
 			url:      "/v1/transactions/53730d3f3d2d2f46cb910b16db817d3a62adaaa72fdb3a92ee373c37c5b55a76",
@@ -64,6 +74,11 @@ func TestParseURL(t *testing.T) {
 			url:      "/v1/scripts",
 			expected: "executeScript",
 		},
+		{
+			name:     "/v1/register_proofs",
+			url:      "/v1/register_proofs",
+			expected: "getRegisterProofs",
+		},
 		{
 			name:     "/v1/accounts/{address}",
 			url:      "/v1/accounts/6a587be304c1224c",
@@ -84,6 +99,26 @@ func TestParseURL(t *testing.T) {
 			url:      "/v1/accounts/6a587be304c1224c/keys",
 			expected: "getAccountKeys",
 		},
+		{
+			name:     "/v1/accounts/{address}/transactions",
+			url:      "/v1/accounts/6a587be304c1224c/transactions",
+			expected: "getAccountTransactions",
+		},
+		{
+			name:     "/v1/accounts/{address}/diff",
+			url:      "/v1/accounts/6a587be304c1224c/diff",
+			expected: "getAccountStateDiff",
+		},
+		{
+			name:     "/v1/accounts/{address}/contracts/{name}",
+			url:      "/v1/accounts/6a587be304c1224c/contracts/FungibleToken",
+			expected: "getContract",
+		},
+		{
+			name:     "/v1/accounts/{address}/contracts/{name}/history",
+			url:      "/v1/accounts/6a587be304c1224c/contracts/FungibleToken/history",
+			expected: "getContractHistory",
+		},
 		{
 			name:     "/v1/events",
 			url:      "/v1/events",
@@ -126,6 +161,16 @@ func TestBenchmarkParseURL(t *testing.T) {
 			url:      "/v1/transactions",
 			expected: "createTransaction",
 		},
+		{
+			name:     "/v1/transactions/dry_run",
+			url:      "/v1/transactions/dry_run",
+			expected: "dryRunTransaction",
+		},
+		{
+			name:     "/v1/graphql",
+			url:      "/v1/graphql",
+			expected: "graphql",
+		},
 		{
 			name:     "/v1/transactions/{id}",
 			url:      "/v1/transactions/53730d3f3d2d2f46cb910b16db817d3a62adaaa72fdb3a92ee373c37c5b55a76",
@@ -171,6 +216,11 @@ func TestBenchmarkParseURL(t *testing.T) {
 			url:      "/v1/scripts",
 			expected: "executeScript",
 		},
+		{
+			name:     "/v1/register_proofs",
+			url:      "/v1/register_proofs",
+			expected: "getRegisterProofs",
+		},
 		{
 			name:     "/v1/accounts/{address}",
 			url:      "/v1/accounts/6a587be304c1224c",
@@ -191,6 +241,26 @@ func TestBenchmarkParseURL(t *testing.T) {
 			url:      "/v1/accounts/6a587be304c1224c/keys",
 			expected: "getAccountKeys",
 		},
+		{
+			name:     "/v1/accounts/{address}/transactions",
+			url:      "/v1/accounts/6a587be304c1224c/transactions",
+			expected: "getAccountTransactions",
+		},
+		{
+			name:     "/v1/accounts/{address}/diff",
+			url:      "/v1/accounts/6a587be304c1224c/diff",
+			expected: "getAccountStateDiff",
+		},
+		{
+			name:     "/v1/accounts/{address}/contracts/{name}",
+			url:      "/v1/accounts/6a587be304c1224c/contracts/FungibleToken",
+			expected: "getContract",
+		},
+		{
+			name:     "/v1/accounts/{address}/contracts/{name}/history",
+			url:      "/v1/accounts/6a587be304c1224c/contracts/FungibleToken/history",
+			expected: "getContractHistory",
+		},
 		{
 			name:     "/v1/events",
 			url:      "/v1/events",
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
@@ -8,6 +9,9 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/access/ratelimit"
+	"github.com/onflow/flow-go/engine/access/rest/common/middleware"
+	"github.com/onflow/flow-go/engine/access/rest/graphql"
 	"github.com/onflow/flow-go/engine/access/rest/router"
 	"github.com/onflow/flow-go/engine/access/rest/websockets"
 	dp "github.com/onflow/flow-go/engine/access/rest/websockets/data_providers"
@@ -35,6 +39,14 @@ type Config struct {
 	ReadTimeout    time.Duration
 	IdleTimeout    time.Duration
 	MaxRequestSize int64
+	GraphQLConfig  graphql.Config
+	// ClientKeySource is the source used to identify clients for rate limiting: ip, header or mtls.
+	ClientKeySource string
+	// ClientKeyHeader is the API key header used to identify clients if ClientKeySource is header.
+	ClientKeyHeader string
+	// ClientAPIKeys are the API keys used to identify clients if ClientKeySource is header. Requests with
+	// other keys are identified by their IP address.
+	ClientAPIKeys []string
 }
 
 // NewServer returns an HTTP server initialized with the REST API handler
@@ -49,12 +61,26 @@ func NewServer(
 	stateStreamConfig backend.Config,
 	enableNewWebsocketsStreamAPI bool,
 	wsConfig websockets.Config,
+	rateLimiter *ratelimit.ClientLimiter,
 ) (*http.Server, error) {
-	builder := router.NewRouterBuilder(logger, restCollector).AddRestRoutes(serverAPI, chain, config.MaxRequestSize)
+	builder := router.NewRouterBuilder(logger, restCollector)
+	if rateLimiter != nil {
+		clientKey, err := middleware.NewClientKeyFunc(config.ClientKeySource, config.ClientKeyHeader, config.ClientAPIKeys)
+		if err != nil {
+			return nil, fmt.Errorf("could not create client key function: %w", err)
+		}
+		builder.AddRateLimitMiddleware(rateLimiter, clientKey)
+	}
+
+	builder.AddRestRoutes(serverAPI, chain, config.MaxRequestSize)
 	if stateStreamApi != nil {
 		builder.AddLegacyWebsocketsRoutes(stateStreamApi, chain, stateStreamConfig, config.MaxRequestSize)
 	}
 
+	if config.GraphQLConfig.Enabled {
+		builder.AddGraphQLRoute(serverAPI, chain, config.GraphQLConfig, config.MaxRequestSize)
+	}
+
 	dataProviderFactory := dp.NewDataProviderFactory(
 		logger,
 		stateStreamApi,
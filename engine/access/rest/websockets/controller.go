@@ -60,7 +60,7 @@
 //     conn := /* a WebsocketConnection implementation */
 //     factory := /* a DataProviderFactory implementation */
 //
-//     controller := websockets.NewWebSocketController(logger, config, conn, factory)
+//     controller := websockets.NewWebSocketController(logger, config, conn, factory, nil)
 //     ctx := context.Background()
 //     controller.HandleConnection(ctx)
 //
@@ -95,6 +95,10 @@ import (
 // ErrMaxSubscriptionsReached is returned when the maximum number of active subscriptions per connection is exceeded.
 var ErrMaxSubscriptionsReached = errors.New("maximum number of subscriptions reached")
 
+// MessageLimiter limits the messages a client sends over a websocket connection. It returns true if the
+// message with the given action is allowed, and otherwise the duration after which the client may retry.
+type MessageLimiter func(action string) (bool, time.Duration)
+
 type Controller struct {
 	logger zerolog.Logger
 	config Config
@@ -136,6 +140,8 @@ type Controller struct {
 	dataProviderFactory dp.DataProviderFactory
 	dataProvidersGroup  *sync.WaitGroup
 	limiter             *rate.Limiter
+	// messageLimiter limits the messages received from the client. nil means unlimited.
+	messageLimiter MessageLimiter
 }
 
 func NewWebSocketController(
@@ -143,6 +149,7 @@ func NewWebSocketController(
 	config Config,
 	conn WebsocketConnection,
 	dataProviderFactory dp.DataProviderFactory,
+	messageLimiter MessageLimiter,
 ) *Controller {
 	var limiter *rate.Limiter
 	if config.MaxResponsesPerSecond > 0 {
@@ -158,6 +165,7 @@ func NewWebSocketController(
 		dataProviderFactory: dataProviderFactory,
 		dataProvidersGroup:  &sync.WaitGroup{},
 		limiter:             limiter,
+		messageLimiter:      messageLimiter,
 	}
 }
 
@@ -392,6 +400,18 @@ func (c *Controller) handleMessage(ctx context.Context, message json.RawMessage)
 		return fmt.Errorf("error unmarshalling base message: %w", err)
 	}
 
+	if c.messageLimiter != nil {
+		if allowed, retryAfter := c.messageLimiter(baseMsg.Action); !allowed {
+			err := fmt.Errorf("rate limit reached, please retry in %s", retryAfter.Round(time.Millisecond))
+			c.writeErrorResponse(
+				ctx,
+				err,
+				wrapErrorMessage(http.StatusTooManyRequests, err.Error(), baseMsg.Action, baseMsg.SubscriptionID),
+			)
+			return nil
+		}
+	}
+
 	switch baseMsg.Action {
 	case models.SubscribeAction:
 		var subscribeMsg models.SubscribeMessageRequest
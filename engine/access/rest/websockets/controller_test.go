@@ -51,7 +51,7 @@ func (s *WsControllerSuite) TestSubscribeRequest() {
 		t.Parallel()
 
 		conn, dataProviderFactory, dataProvider := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		dataProviderFactory.
 			On("NewDataProvider", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -115,7 +115,7 @@ func (s *WsControllerSuite) TestSubscribeRequest() {
 		t.Parallel()
 
 		conn, dataProviderFactory, _ := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		type Request struct {
 			Action string `json:"action"`
@@ -164,7 +164,7 @@ func (s *WsControllerSuite) TestSubscribeRequest() {
 		t.Parallel()
 
 		conn, dataProviderFactory, _ := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		dataProviderFactory.
 			On("NewDataProvider", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -201,7 +201,7 @@ func (s *WsControllerSuite) TestSubscribeRequest() {
 		t.Parallel()
 
 		conn, dataProviderFactory, dataProvider := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		// data provider might finish on its own or controller will close it via Close()
 		dataProvider.On("Close").Return(nil).Maybe()
@@ -250,7 +250,7 @@ func (s *WsControllerSuite) TestUnsubscribeRequest() {
 		t.Parallel()
 
 		conn, dataProviderFactory, dataProvider := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		dataProviderFactory.
 			On("NewDataProvider", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -318,7 +318,7 @@ func (s *WsControllerSuite) TestUnsubscribeRequest() {
 		t.Parallel()
 
 		conn, dataProviderFactory, dataProvider := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		dataProviderFactory.
 			On("NewDataProvider", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -388,7 +388,7 @@ func (s *WsControllerSuite) TestUnsubscribeRequest() {
 		t.Parallel()
 
 		conn, dataProviderFactory, dataProvider := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		dataProviderFactory.
 			On("NewDataProvider", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -461,7 +461,7 @@ func (s *WsControllerSuite) TestListSubscriptions() {
 	s.T().Run("Happy path", func(t *testing.T) {
 
 		conn, dataProviderFactory, dataProvider := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		dataProviderFactory.
 			On("NewDataProvider", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -543,7 +543,7 @@ func (s *WsControllerSuite) TestSubscribeBlocks() {
 		t.Parallel()
 
 		conn, dataProviderFactory, dataProvider := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		dataProviderFactory.
 			On("NewDataProvider", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -597,7 +597,7 @@ func (s *WsControllerSuite) TestSubscribeBlocks() {
 		t.Parallel()
 
 		conn, dataProviderFactory, dataProvider := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		dataProviderFactory.
 			On("NewDataProvider", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -682,7 +682,7 @@ func (s *WsControllerSuite) TestRateLimiter() {
 	config := NewDefaultWebsocketConfig()
 	config.MaxResponsesPerSecond = 2
 
-	controller := NewWebSocketController(s.logger, config, conn, nil)
+	controller := NewWebSocketController(s.logger, config, conn, nil, nil)
 
 	// Step 3: Simulate sending messages to the controller's `multiplexedStream`.
 	go func() {
@@ -725,6 +725,66 @@ func (s *WsControllerSuite) TestRateLimiter() {
 	}
 }
 
+// TestMessageLimiter ensures that messages rejected by the message limiter are answered with a rate limit
+// error, without being handled.
+func (s *WsControllerSuite) TestMessageLimiter() {
+	t := s.T()
+
+	conn, dataProviderFactory, _ := newControllerMocks(t)
+
+	var limitedActions []string
+	messageLimiter := func(action string) (bool, time.Duration) {
+		limitedActions = append(limitedActions, action)
+		return false, time.Second
+	}
+	controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, messageLimiter)
+
+	request := models.ListSubscriptionsMessageRequest{
+		BaseMessageRequest: models.BaseMessageRequest{
+			SubscriptionID: "dummy-id",
+			Action:         models.ListSubscriptionsAction,
+		},
+	}
+	requestJson, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	conn.
+		On("ReadJSON", mock.Anything).
+		Run(func(args mock.Arguments) {
+			msg, ok := args.Get(0).(*json.RawMessage)
+			require.True(t, ok)
+			*msg = requestJson
+		}).
+		Return(nil).
+		Once()
+
+	done := make(chan struct{})
+	conn.
+		On("WriteJSON", mock.Anything).
+		Return(func(msg interface{}) error {
+			defer close(done)
+
+			response, ok := msg.(models.BaseMessageResponse)
+			require.True(t, ok)
+			require.Equal(t, request.SubscriptionID, response.SubscriptionID)
+			require.Equal(t, models.ListSubscriptionsAction, response.Action)
+
+			require.NotEmpty(t, response.Error)
+			require.Equal(t, http.StatusTooManyRequests, response.Error.Code)
+
+			return &websocket.CloseError{Code: websocket.CloseNormalClosure}
+		}).
+		Once()
+
+	s.expectCloseConnection(conn, done)
+
+	controller.HandleConnection(context.Background())
+
+	require.Equal(t, []string{models.ListSubscriptionsAction}, limitedActions)
+	conn.AssertExpectations(t)
+	dataProviderFactory.AssertExpectations(t)
+}
+
 // TestConfigureKeepaliveConnection ensures that the WebSocket connection is configured correctly.
 func (s *WsControllerSuite) TestConfigureKeepaliveConnection() {
 	s.T().Run("Happy path", func(t *testing.T) {
@@ -733,7 +793,7 @@ func (s *WsControllerSuite) TestConfigureKeepaliveConnection() {
 		conn.On("SetReadDeadline", mock.Anything).Return(nil)
 
 		factory := dpmock.NewDataProviderFactory(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory, nil)
 
 		err := controller.configureKeepalive()
 		s.Require().NoError(err, "configureKeepalive should not return an error")
@@ -752,7 +812,7 @@ func (s *WsControllerSuite) TestControllerShutdown() {
 		conn.On("SetPongHandler", mock.AnythingOfType("func(string) error")).Return(nil).Once()
 
 		factory := dpmock.NewDataProviderFactory(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory, nil)
 
 		// Mock keepalive to return an error
 		done := make(chan struct{}, 1)
@@ -786,7 +846,7 @@ func (s *WsControllerSuite) TestControllerShutdown() {
 		conn.On("SetPongHandler", mock.AnythingOfType("func(string) error")).Return(nil).Once()
 
 		factory := dpmock.NewDataProviderFactory(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory, nil)
 
 		conn.
 			On("ReadJSON", mock.Anything).
@@ -803,7 +863,7 @@ func (s *WsControllerSuite) TestControllerShutdown() {
 		t.Parallel()
 
 		conn, dataProviderFactory, dataProvider := newControllerMocks(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, dataProviderFactory, nil)
 
 		dataProviderFactory.
 			On("NewDataProvider", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
@@ -852,7 +912,7 @@ func (s *WsControllerSuite) TestControllerShutdown() {
 		conn.On("SetPongHandler", mock.AnythingOfType("func(string) error")).Return(nil).Once()
 
 		factory := dpmock.NewDataProviderFactory(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory, nil)
 
 		ctx, cancel := context.WithCancel(context.Background())
 
@@ -875,7 +935,7 @@ func (s *WsControllerSuite) TestControllerShutdown() {
 		wsConfig := s.wsConfig
 
 		wsConfig.InactivityTimeout = 50 * time.Millisecond
-		controller := NewWebSocketController(s.logger, wsConfig, conn, factory)
+		controller := NewWebSocketController(s.logger, wsConfig, conn, factory, nil)
 
 		conn.
 			On("ReadJSON", mock.Anything).
@@ -922,7 +982,7 @@ func (s *WsControllerSuite) TestKeepaliveRoutine() {
 		})
 
 		factory := dpmock.NewDataProviderFactory(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory, nil)
 		controller.HandleConnection(context.Background())
 
 		conn.AssertExpectations(t)
@@ -937,7 +997,7 @@ func (s *WsControllerSuite) TestKeepaliveRoutine() {
 			Once()
 
 		factory := dpmock.NewDataProviderFactory(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory, nil)
 
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
@@ -957,7 +1017,7 @@ func (s *WsControllerSuite) TestKeepaliveRoutine() {
 			Once()
 
 		factory := dpmock.NewDataProviderFactory(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory, nil)
 
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
@@ -972,7 +1032,7 @@ func (s *WsControllerSuite) TestKeepaliveRoutine() {
 	s.T().Run("Context cancelled", func(t *testing.T) {
 		conn := connmock.NewWebsocketConnection(t)
 		factory := dpmock.NewDataProviderFactory(t)
-		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory)
+		controller := NewWebSocketController(s.logger, s.wsConfig, conn, factory, nil)
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel() // Immediately cancel the context
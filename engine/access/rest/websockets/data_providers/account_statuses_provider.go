@@ -1,8 +1,10 @@
 package data_providers
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/rs/zerolog"
 
@@ -22,6 +24,7 @@ type accountStatusesArguments struct {
 	StartBlockHeight  uint64                           // Height of the block to start subscription from
 	Filter            state_stream.AccountStatusFilter // Filter applied to events for a given subscription
 	HeartbeatInterval uint64                           // Maximum number of blocks message won't be sent
+	Cursor            *cursor                          // Cursor of the last message delivered before resuming the subscription
 }
 
 type AccountStatusesDataProvider struct {
@@ -94,6 +97,20 @@ func (p *AccountStatusesDataProvider) Run() error {
 //
 // No errors are expected during normal operations
 func (p *AccountStatusesDataProvider) sendResponse(response *backend.AccountStatusesResponse) error {
+	// Skip the events delivered before the subscription was resumed.
+	delivered, resumed := p.arguments.Cursor.delivered(response.Height)
+	accountEvents, position := skipDeliveredAccountEvents(response.AccountEvents, delivered)
+	if resumed {
+		if len(accountEvents) == 0 {
+			return nil
+		}
+		response = &backend.AccountStatusesResponse{
+			BlockID:       response.BlockID,
+			Height:        response.Height,
+			AccountEvents: accountEvents,
+		}
+	}
+
 	// Only send a response if there's meaningful data to send
 	// or the heartbeat interval limit is reached
 	p.blocksSinceLastMessage += 1
@@ -108,6 +125,7 @@ func (p *AccountStatusesDataProvider) sendResponse(response *backend.AccountStat
 		SubscriptionID: p.ID(),
 		Topic:          p.Topic(),
 		Payload:        accountStatusesPayload,
+		Cursor:         cursor{BlockHeight: response.Height, Position: position}.String(),
 	}
 	p.send <- &resp
 
@@ -146,6 +164,7 @@ func parseAccountStatusesArguments(
 		"event_types":        {},
 		"account_addresses":  {},
 		"heartbeat_interval": {},
+		"cursor":             {},
 	}
 	err := ensureAllowedFields(arguments, allowedFields)
 	if err != nil {
@@ -162,6 +181,15 @@ func parseAccountStatusesArguments(
 	args.StartBlockID = startBlockID
 	args.StartBlockHeight = startBlockHeight
 
+	// Parse 'cursor', which resumes the subscription from the block of the cursor
+	args.Cursor, err = parseCursorArgument(arguments)
+	if err != nil {
+		return accountStatusesArguments{}, err
+	}
+	if args.Cursor != nil {
+		args.StartBlockHeight = args.Cursor.BlockHeight
+	}
+
 	// Parse 'heartbeat_interval' argument
 	heartbeatInterval, err := extractHeartbeatInterval(arguments, defaultHeartbeatInterval)
 	if err != nil {
@@ -189,3 +217,58 @@ func parseAccountStatusesArguments(
 
 	return args, nil
 }
+
+// skipDeliveredAccountEvents removes the first `delivered` events of the block from the account events.
+// The same event may be listed for multiple accounts, so events are identified by their transaction and
+// event indexes, and ordered the way they were emitted in the block.
+//
+// It returns the remaining account events, omitting accounts without events, and the position of the
+// cursor after the remaining events are delivered, which is the number of distinct events in the block.
+func skipDeliveredAccountEvents(accountEvents map[string]flow.EventsList, delivered uint64) (map[string]flow.EventsList, uint64) {
+	type eventKey struct {
+		transactionIndex uint32
+		eventIndex       uint32
+	}
+
+	unique := make(map[eventKey]struct{})
+	for _, events := range accountEvents {
+		for _, event := range events {
+			unique[eventKey{event.TransactionIndex, event.EventIndex}] = struct{}{}
+		}
+	}
+	position := max(uint64(len(unique)), delivered)
+	if delivered == 0 {
+		return accountEvents, position
+	}
+
+	keys := make([]eventKey, 0, len(unique))
+	for key := range unique {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b eventKey) int {
+		if a.transactionIndex != b.transactionIndex {
+			return cmp.Compare(a.transactionIndex, b.transactionIndex)
+		}
+		return cmp.Compare(a.eventIndex, b.eventIndex)
+	})
+
+	skipped := make(map[eventKey]struct{}, delivered)
+	for _, key := range keys[:min(delivered, uint64(len(keys)))] {
+		skipped[key] = struct{}{}
+	}
+
+	remaining := make(map[string]flow.EventsList)
+	for address, events := range accountEvents {
+		var kept flow.EventsList
+		for _, event := range events {
+			if _, ok := skipped[eventKey{event.TransactionIndex, event.EventIndex}]; !ok {
+				kept = append(kept, event)
+			}
+		}
+		if len(kept) > 0 {
+			remaining[address] = kept
+		}
+	}
+
+	return remaining, position
+}
@@ -328,6 +328,43 @@ func (s *AccountStatusesProviderSuite) backendAccountStatusesResponses(events []
 	return responses
 }
 
+// TestSkipDeliveredAccountEvents tests that the events delivered before a subscription was resumed are
+// removed from all accounts, counting events listed for several accounts only once.
+func TestSkipDeliveredAccountEvents(t *testing.T) {
+	t.Parallel()
+
+	event := func(txIndex, eventIndex uint32) flow.Event {
+		return unittest.EventFixture(state_stream.CoreEventAccountCreated, txIndex, eventIndex, unittest.IdentifierFixture(), 0)
+	}
+	e0, e1, e2 := event(0, 0), event(0, 1), event(1, 0)
+
+	accountEvents := map[string]flow.EventsList{
+		"0x01": {e0, e2},
+		"0x02": {e1, e2},
+	}
+
+	t.Run("nothing delivered", func(t *testing.T) {
+		remaining, position := skipDeliveredAccountEvents(accountEvents, 0)
+		require.Equal(t, accountEvents, remaining)
+		require.Equal(t, uint64(3), position)
+	})
+
+	t.Run("some events delivered", func(t *testing.T) {
+		remaining, position := skipDeliveredAccountEvents(accountEvents, 2)
+		require.Equal(t, map[string]flow.EventsList{
+			"0x01": {e2},
+			"0x02": {e2},
+		}, remaining)
+		require.Equal(t, uint64(3), position)
+	})
+
+	t.Run("all events delivered", func(t *testing.T) {
+		remaining, position := skipDeliveredAccountEvents(accountEvents, 3)
+		require.Empty(t, remaining)
+		require.Equal(t, uint64(3), position)
+	})
+}
+
 func invalidAccountStatusesArgumentsTestCases() []testErrType {
 	return []testErrType{
 		{
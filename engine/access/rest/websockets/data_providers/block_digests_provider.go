@@ -82,11 +82,17 @@ func (p *BlockDigestsDataProvider) createAndStartSubscription(
 }
 
 func (p *BlockDigestsDataProvider) sendResponse(b *flow.BlockDigest) error {
+	if _, resumed := p.arguments.Cursor.delivered(b.Height); resumed {
+		// the block digest was delivered before the subscription was resumed
+		return nil
+	}
+
 	blockDigest := models.NewBlockDigest(b)
 	response := models.BaseDataProvidersResponse{
 		SubscriptionID: p.ID(),
 		Topic:          p.Topic(),
 		Payload:        blockDigest,
+		Cursor:         cursor{BlockHeight: b.Height, Position: 1}.String(),
 	}
 	p.send <- &response
 
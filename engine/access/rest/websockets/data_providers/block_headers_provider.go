@@ -83,11 +83,17 @@ func (p *BlockHeadersDataProvider) createAndStartSubscription(
 }
 
 func (p *BlockHeadersDataProvider) sendResponse(header *flow.Header) error {
+	if _, resumed := p.arguments.Cursor.delivered(header.Height); resumed {
+		// the block header was delivered before the subscription was resumed
+		return nil
+	}
+
 	headerPayload := commonmodels.NewBlockHeader(header)
 	response := models.BaseDataProvidersResponse{
 		SubscriptionID: p.ID(),
 		Topic:          p.Topic(),
 		Payload:        headerPayload,
+		Cursor:         cursor{BlockHeight: header.Height, Position: 1}.String(),
 	}
 	p.send <- &response
 
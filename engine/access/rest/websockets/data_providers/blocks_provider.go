@@ -22,6 +22,7 @@ type blocksArguments struct {
 	StartBlockID     flow.Identifier  // ID of the block to start subscription from
 	StartBlockHeight uint64           // Height of the block to start subscription from
 	BlockStatus      flow.BlockStatus // Status of blocks to subscribe to
+	Cursor           *cursor          // Cursor of the last message delivered before resuming the subscription
 }
 
 // BlocksDataProvider is responsible for providing blocks
@@ -95,6 +96,11 @@ func (p *BlocksDataProvider) createAndStartSubscription(
 }
 
 func (p *BlocksDataProvider) sendResponse(block *flow.Block) error {
+	if _, resumed := p.arguments.Cursor.delivered(block.Header.Height); resumed {
+		// the block was delivered before the subscription was resumed
+		return nil
+	}
+
 	expandPayload := map[string]bool{commonmodels.ExpandableFieldPayload: true}
 	blockPayload, err := commonmodels.NewBlock(
 		block,
@@ -111,6 +117,7 @@ func (p *BlocksDataProvider) sendResponse(block *flow.Block) error {
 		SubscriptionID: p.ID(),
 		Topic:          p.Topic(),
 		Payload:        blockPayload,
+		Cursor:         cursor{BlockHeight: block.Header.Height, Position: 1}.String(),
 	}
 	p.send <- &response
 
@@ -123,6 +130,7 @@ func parseBlocksArguments(arguments wsmodels.Arguments) (blocksArguments, error)
 		"start_block_id":     {},
 		"start_block_height": {},
 		"block_status":       {},
+		"cursor":             {},
 	}
 	err := ensureAllowedFields(arguments, allowedFields)
 	if err != nil {
@@ -139,6 +147,15 @@ func parseBlocksArguments(arguments wsmodels.Arguments) (blocksArguments, error)
 	args.StartBlockID = startBlockID
 	args.StartBlockHeight = startBlockHeight
 
+	// Parse 'cursor', which resumes the subscription from the block of the cursor
+	args.Cursor, err = parseCursorArgument(arguments)
+	if err != nil {
+		return blocksArguments{}, err
+	}
+	if args.Cursor != nil {
+		args.StartBlockHeight = args.Cursor.BlockHeight
+	}
+
 	// Parse 'block_status'
 	rawBlockStatus, exists := arguments["block_status"]
 	if !exists {
@@ -112,6 +112,56 @@ func (s *BlocksProviderSuite) TestBlocksDataProvider_HappyPath() {
 	)
 }
 
+// TestBlocksDataProvider_ResumeFromCursor tests that a subscription resumed from a cursor restarts
+// from the block of the cursor, skips the block which was already delivered, and returns a cursor
+// with every message.
+func (s *BlocksProviderSuite) TestBlocksDataProvider_ResumeFromCursor() {
+	s.linkGenerator.On("BlockLink", mock.AnythingOfType("flow.Identifier")).Return(
+		func(id flow.Identifier) (string, error) {
+			return fmt.Sprintf("/v1/blocks/%s", id), nil
+		},
+	)
+
+	resumeHeight := s.blocks[0].Header.Height
+	expectedResponses := s.expectedBlockResponses(s.blocks[1:], map[string]bool{commonmodels.ExpandableFieldPayload: true}, flow.BlockStatusFinalized)
+	for i, block := range s.blocks[1:] {
+		expectedResponses[i].(*models.BaseDataProvidersResponse).Cursor = cursor{BlockHeight: block.Header.Height, Position: 1}.String()
+	}
+
+	testHappyPath(
+		s.T(),
+		BlocksTopic,
+		s.factory,
+		[]testType{
+			{
+				name: "happy path with cursor argument",
+				arguments: wsmodels.Arguments{
+					"cursor":       cursor{BlockHeight: resumeHeight, Position: 1}.String(),
+					"block_status": parser.Finalized,
+				},
+				setupBackend: func(sub *statestreamsmock.Subscription) {
+					s.api.On(
+						"SubscribeBlocksFromStartHeight",
+						mock.Anything,
+						resumeHeight,
+						flow.BlockStatusFinalized,
+					).Return(sub).Once()
+				},
+				expectedResponses: expectedResponses,
+			},
+		},
+		func(dataChan chan interface{}) {
+			for _, block := range s.blocks {
+				dataChan <- block
+			}
+		},
+		func(actual interface{}, expected interface{}) {
+			s.requireBlock(actual, expected)
+			s.Require().Equal(expected.(*models.BaseDataProvidersResponse).Cursor, actual.(*models.BaseDataProvidersResponse).Cursor)
+		},
+	)
+}
+
 // validBlockArgumentsTestCases defines test happy cases for block data providers.
 // Each test case specifies input arguments, and setup functions for the mock API used in the test.
 func (s *BlocksProviderSuite) validBlockArgumentsTestCases() []testType {
@@ -255,6 +305,15 @@ func (s *BlocksProviderSuite) invalidArgumentsTestCases() []testErrType {
 			},
 			expectedErrorMsg: "can only provide either 'start_block_id' or 'start_block_height'",
 		},
+		{
+			name: "provide both 'cursor' and 'start_block_id' arguments",
+			arguments: wsmodels.Arguments{
+				"block_status":   parser.Finalized,
+				"start_block_id": s.rootBlock.ID().String(),
+				"cursor":         cursor{BlockHeight: s.rootBlock.Header.Height}.String(),
+			},
+			expectedErrorMsg: "can not provide 'cursor' with either 'start_block_id' or 'start_block_height'",
+		},
 		{
 			name: "unexpected argument",
 			arguments: map[string]interface{}{
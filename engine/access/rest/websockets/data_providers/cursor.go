@@ -0,0 +1,98 @@
+package data_providers
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+
+	wsmodels "github.com/onflow/flow-go/engine/access/rest/websockets/models"
+)
+
+// cursorVersion is the version of the cursor encoding. It allows changing the encoding without
+// misinterpreting cursors issued by previous versions.
+const cursorVersion byte = 1
+
+// encodedCursorLength is the length of the binary encoding of a cursor: version, block height and position.
+const encodedCursorLength = 1 + 8 + 8
+
+// cursor identifies the last message delivered to a client within a subscription. It is sent to clients
+// as an opaque string with every message, and can be passed back in the 'cursor' argument of a subscribe
+// request to resume the subscription right after that message.
+//
+// A cursor consists of the height of the block the message was built from, and the position within the
+// block, which is the number of items of the block (events, or the block itself) delivered so far.
+// Cursors are only meaningful when resuming a subscription with the same topic and arguments.
+type cursor struct {
+	BlockHeight uint64
+	Position    uint64
+}
+
+// String returns the opaque encoding of the cursor sent to clients.
+func (c cursor) String() string {
+	encoded := make([]byte, encodedCursorLength)
+	encoded[0] = cursorVersion
+	binary.BigEndian.PutUint64(encoded[1:9], c.BlockHeight)
+	binary.BigEndian.PutUint64(encoded[9:], c.Position)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// delivered returns the number of items of the block at the given height which were delivered before the
+// subscription was resumed, and true if the cursor points to a block at that height.
+// It is safe to call on a nil cursor, in which case false is returned.
+func (c *cursor) delivered(height uint64) (uint64, bool) {
+	if c == nil || c.BlockHeight != height {
+		return 0, false
+	}
+	return c.Position, true
+}
+
+// parseCursor decodes a cursor from its opaque string encoding.
+//
+// All errors indicate the cursor is invalid.
+func parseCursor(raw string) (cursor, error) {
+	encoded, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return cursor{}, fmt.Errorf("invalid cursor encoding: %w", err)
+	}
+
+	if len(encoded) != encodedCursorLength {
+		return cursor{}, fmt.Errorf("invalid cursor length: %d", len(encoded))
+	}
+
+	if encoded[0] != cursorVersion {
+		return cursor{}, fmt.Errorf("unsupported cursor version: %d", encoded[0])
+	}
+
+	return cursor{
+		BlockHeight: binary.BigEndian.Uint64(encoded[1:9]),
+		Position:    binary.BigEndian.Uint64(encoded[9:]),
+	}, nil
+}
+
+// parseCursorArgument parses the optional 'cursor' argument, which resumes a subscription right after
+// the message the cursor was issued with. It returns nil if no cursor was provided.
+// A cursor can not be combined with 'start_block_id' or 'start_block_height'.
+func parseCursorArgument(arguments wsmodels.Arguments) (*cursor, error) {
+	rawCursor, exists := arguments["cursor"]
+	if !exists {
+		return nil, nil
+	}
+
+	_, hasStartBlockID := arguments["start_block_id"]
+	_, hasStartBlockHeight := arguments["start_block_height"]
+	if hasStartBlockID || hasStartBlockHeight {
+		return nil, fmt.Errorf("can not provide 'cursor' with either 'start_block_id' or 'start_block_height'")
+	}
+
+	cursorString, ok := rawCursor.(string)
+	if !ok {
+		return nil, fmt.Errorf("'cursor' must be a string")
+	}
+
+	c, err := parseCursor(cursorString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid 'cursor': %w", err)
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,119 @@
+package data_providers
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wsmodels "github.com/onflow/flow-go/engine/access/rest/websockets/models"
+)
+
+// TestCursor_RoundTrip tests that an encoded cursor is decoded to the same cursor.
+func TestCursor_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []cursor{
+		{BlockHeight: 0, Position: 0},
+		{BlockHeight: 42, Position: 3},
+		{BlockHeight: ^uint64(0), Position: ^uint64(0)},
+	} {
+		decoded, err := parseCursor(c.String())
+		require.NoError(t, err)
+		require.Equal(t, c, decoded)
+	}
+}
+
+// TestCursor_Delivered tests the number of delivered items reported for a block height.
+func TestCursor_Delivered(t *testing.T) {
+	t.Parallel()
+
+	var nilCursor *cursor
+	delivered, resumed := nilCursor.delivered(10)
+	require.False(t, resumed)
+	require.Zero(t, delivered)
+
+	c := &cursor{BlockHeight: 10, Position: 2}
+
+	delivered, resumed = c.delivered(10)
+	require.True(t, resumed)
+	require.Equal(t, uint64(2), delivered)
+
+	delivered, resumed = c.delivered(11)
+	require.False(t, resumed)
+	require.Zero(t, delivered)
+}
+
+// TestParseCursor_Invalid tests that malformed cursors are rejected.
+func TestParseCursor_Invalid(t *testing.T) {
+	t.Parallel()
+
+	unsupportedVersion := make([]byte, encodedCursorLength)
+	unsupportedVersion[0] = cursorVersion + 1
+
+	tests := []struct {
+		name             string
+		raw              string
+		expectedErrorMsg string
+	}{
+		{
+			name:             "invalid encoding",
+			raw:              "not a cursor!",
+			expectedErrorMsg: "invalid cursor encoding",
+		},
+		{
+			name:             "invalid length",
+			raw:              base64.RawURLEncoding.EncodeToString([]byte{cursorVersion, 1, 2}),
+			expectedErrorMsg: "invalid cursor length",
+		},
+		{
+			name:             "unsupported version",
+			raw:              base64.RawURLEncoding.EncodeToString(unsupportedVersion),
+			expectedErrorMsg: "unsupported cursor version",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseCursor(test.raw)
+			require.ErrorContains(t, err, test.expectedErrorMsg)
+		})
+	}
+}
+
+// TestParseCursorArgument tests parsing of the 'cursor' subscription argument.
+func TestParseCursorArgument(t *testing.T) {
+	t.Parallel()
+
+	encoded := cursor{BlockHeight: 5, Position: 1}.String()
+
+	t.Run("no cursor", func(t *testing.T) {
+		c, err := parseCursorArgument(wsmodels.Arguments{"start_block_height": "5"})
+		require.NoError(t, err)
+		require.Nil(t, c)
+	})
+
+	t.Run("valid cursor", func(t *testing.T) {
+		c, err := parseCursorArgument(wsmodels.Arguments{"cursor": encoded})
+		require.NoError(t, err)
+		require.Equal(t, &cursor{BlockHeight: 5, Position: 1}, c)
+	})
+
+	t.Run("cursor with start block", func(t *testing.T) {
+		_, err := parseCursorArgument(wsmodels.Arguments{"cursor": encoded, "start_block_height": "5"})
+		require.ErrorContains(t, err, "can not provide 'cursor' with either 'start_block_id' or 'start_block_height'")
+
+		_, err = parseCursorArgument(wsmodels.Arguments{"cursor": encoded, "start_block_id": "abc"})
+		require.ErrorContains(t, err, "can not provide 'cursor' with either 'start_block_id' or 'start_block_height'")
+	})
+
+	t.Run("cursor is not a string", func(t *testing.T) {
+		_, err := parseCursorArgument(wsmodels.Arguments{"cursor": 5})
+		require.ErrorContains(t, err, "'cursor' must be a string")
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, err := parseCursorArgument(wsmodels.Arguments{"cursor": "invalid"})
+		require.ErrorContains(t, err, "invalid 'cursor'")
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/onflow/flow-go/engine/access/state_stream"
 	"github.com/onflow/flow-go/engine/access/state_stream/backend"
 	"github.com/onflow/flow-go/engine/access/subscription"
+	"github.com/onflow/flow-go/model/events"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/counters"
 )
@@ -22,6 +23,7 @@ type eventsArguments struct {
 	StartBlockHeight  uint64                   // Height of the block to start subscription from
 	Filter            state_stream.EventFilter // Filter applied to events for a given subscription
 	HeartbeatInterval uint64                   // Maximum number of blocks message won't be sent
+	Cursor            *cursor                  // Cursor of the last message delivered before resuming the subscription
 }
 
 // EventsDataProvider is responsible for providing events
@@ -93,6 +95,21 @@ func (p *EventsDataProvider) Run() error {
 //
 // No errors are expected during normal operations.
 func (p *EventsDataProvider) sendResponse(eventsResponse *backend.EventsResponse) error {
+	// Skip the events delivered before the subscription was resumed. Events are ordered within the block,
+	// so the cursor position is the number of events of the block which were already delivered.
+	delivered, resumed := p.arguments.Cursor.delivered(eventsResponse.Height)
+	if resumed {
+		if delivered >= uint64(len(eventsResponse.Events)) {
+			return nil
+		}
+		eventsResponse = &backend.EventsResponse{
+			BlockID:        eventsResponse.BlockID,
+			Height:         eventsResponse.Height,
+			Events:         eventsResponse.Events[delivered:],
+			BlockTimestamp: eventsResponse.BlockTimestamp,
+		}
+	}
+
 	// Only send a response if there's meaningful data to send
 	// or the heartbeat interval limit is reached
 	p.blocksSinceLastMessage += 1
@@ -107,6 +124,10 @@ func (p *EventsDataProvider) sendResponse(eventsResponse *backend.EventsResponse
 		SubscriptionID: p.ID(),
 		Topic:          p.Topic(),
 		Payload:        eventsPayload,
+		Cursor: cursor{
+			BlockHeight: eventsResponse.Height,
+			Position:    delivered + uint64(len(eventsResponse.Events)),
+		}.String(),
 	}
 	p.send <- &response
 
@@ -142,7 +163,9 @@ func parseEventsArguments(
 		"event_types":        {},
 		"addresses":          {},
 		"contracts":          {},
+		"field_filters":      {},
 		"heartbeat_interval": {},
+		"cursor":             {},
 	}
 	err := ensureAllowedFields(arguments, allowedFields)
 	if err != nil {
@@ -159,6 +182,15 @@ func parseEventsArguments(
 	args.StartBlockID = startBlockID
 	args.StartBlockHeight = startBlockHeight
 
+	// Parse 'cursor', which resumes the subscription from the block of the cursor
+	args.Cursor, err = parseCursorArgument(arguments)
+	if err != nil {
+		return eventsArguments{}, err
+	}
+	if args.Cursor != nil {
+		args.StartBlockHeight = args.Cursor.BlockHeight
+	}
+
 	// Parse 'heartbeat_interval' argument
 	heartbeatInterval, err := extractHeartbeatInterval(arguments, defaultHeartbeatInterval)
 	if err != nil {
@@ -184,11 +216,22 @@ func parseEventsArguments(
 		return eventsArguments{}, err
 	}
 
+	// Parse 'field_filters' as []string{} of "field:operator:value" filters
+	rawFieldFilters, err := extractArrayOfStrings(arguments, "field_filters", false)
+	if err != nil {
+		return eventsArguments{}, err
+	}
+
 	// Initialize the event filter with the parsed arguments
 	args.Filter, err = state_stream.NewEventFilter(eventFilterConfig, chain, eventTypes, addresses, contracts)
 	if err != nil {
 		return eventsArguments{}, fmt.Errorf("error creating event filter: %w", err)
 	}
 
+	args.Filter.FieldFilters, err = events.ParseFieldFilters(rawFieldFilters)
+	if err != nil {
+		return eventsArguments{}, fmt.Errorf("error creating event filter: %w", err)
+	}
+
 	return args, nil
 }
@@ -146,6 +146,26 @@ func (s *EventsProviderSuite) subscribeEventsDataProviderTestCases(backendRespon
 			},
 			expectedResponses: expectedResponses,
 		},
+		{
+			name: "SubscribeEventsFromLatest with field filters happy path",
+			arguments: wsmodels.Arguments{
+				"event_types":        []string{string(flow.EventAccountCreated)},
+				"field_filters":      []string{"address:eq:0x0000000000000001", "index:gte:10"},
+				"heartbeat_interval": "3",
+			},
+			setupBackend: func(sub *ssmock.Subscription) {
+				s.api.On(
+					"SubscribeEventsFromLatest",
+					mock.Anything,
+					mock.MatchedBy(func(filter state_stream.EventFilter) bool {
+						return len(filter.FieldFilters) == 2 &&
+							filter.FieldFilters[0].String() == "address:eq:0x0000000000000001" &&
+							filter.FieldFilters[1].String() == "index:gte:10"
+					}),
+				).Return(sub).Once()
+			},
+			expectedResponses: expectedResponses,
+		},
 	}
 }
 
@@ -191,6 +211,69 @@ func (s *EventsProviderSuite) expectedEventsResponses(
 	return expectedResponses
 }
 
+// TestEventsDataProvider_ResumeFromCursor tests that a subscription resumed from a cursor restarts
+// from the block of the cursor, skips the events which were already delivered, and returns a cursor
+// with every message.
+func (s *EventsProviderSuite) TestEventsDataProvider_ResumeFromCursor() {
+	height := s.rootBlock.Header.Height
+	firstBlockEvents := unittest.EventsFixture(3)
+	secondBlockEvents := unittest.EventsFixture(2)
+
+	backendResponses := []*backend.EventsResponse{
+		{Height: height, BlockID: s.rootBlock.ID(), Events: firstBlockEvents},
+		{Height: height + 1, BlockID: unittest.IdentifierFixture(), Events: secondBlockEvents},
+	}
+
+	// the first two events of the first block were delivered before resuming
+	expectedResponses := []interface{}{
+		&models.BaseDataProvidersResponse{
+			Topic:   EventsTopic,
+			Payload: models.NewEventResponse(&backend.EventsResponse{Height: height, Events: firstBlockEvents[2:]}, 0),
+			Cursor:  cursor{BlockHeight: height, Position: 3}.String(),
+		},
+		&models.BaseDataProvidersResponse{
+			Topic:   EventsTopic,
+			Payload: models.NewEventResponse(backendResponses[1], 1),
+			Cursor:  cursor{BlockHeight: height + 1, Position: 2}.String(),
+		},
+	}
+
+	testHappyPath(
+		s.T(),
+		EventsTopic,
+		s.factory,
+		[]testType{
+			{
+				name: "SubscribeEventsFromStartHeight with cursor",
+				arguments: wsmodels.Arguments{
+					"cursor": cursor{BlockHeight: height, Position: 2}.String(),
+				},
+				setupBackend: func(sub *ssmock.Subscription) {
+					s.api.On(
+						"SubscribeEventsFromStartHeight",
+						mock.Anything,
+						height,
+						mock.Anything,
+					).Return(sub).Once()
+				},
+				expectedResponses: expectedResponses,
+			},
+		},
+		func(dataChan chan interface{}) {
+			for _, resp := range backendResponses {
+				dataChan <- resp
+			}
+		},
+		func(actual interface{}, expected interface{}) {
+			s.requireEvents(actual, expected)
+
+			expectedResponse, _ := extractPayload[*models.EventResponse](s.T(), expected)
+			actualResponse, _ := extractPayload[*models.EventResponse](s.T(), actual)
+			s.Require().Equal(expectedResponse.Cursor, actualResponse.Cursor)
+		},
+	)
+}
+
 // TestMessageIndexEventProviderResponse_HappyPath tests that MessageIndex values in response are strictly increasing.
 func (s *EventsProviderSuite) TestMessageIndexEventProviderResponse_HappyPath() {
 	send := make(chan interface{}, 10)
@@ -378,6 +461,31 @@ func invalidEventsArgumentsTestCases() []testErrType {
 			},
 			expectedErrorMsg: "'heartbeat_interval' must be convertible to uint64",
 		},
+		{
+			name: "provide both 'cursor' and 'start_block_height' arguments",
+			arguments: map[string]interface{}{
+				"start_block_height": "1",
+				"cursor":             cursor{BlockHeight: 1}.String(),
+				"event_types":        []string{state_stream.CoreEventAccountCreated},
+			},
+			expectedErrorMsg: "can not provide 'cursor' with either 'start_block_id' or 'start_block_height'",
+		},
+		{
+			name: "invalid 'cursor' argument",
+			arguments: map[string]interface{}{
+				"cursor":      "invalid_cursor",
+				"event_types": []string{state_stream.CoreEventAccountCreated},
+			},
+			expectedErrorMsg: "invalid 'cursor'",
+		},
+		{
+			name: "invalid 'field_filters' argument",
+			arguments: map[string]interface{}{
+				"event_types":   []string{state_stream.CoreEventAccountCreated},
+				"field_filters": []string{"address"},
+			},
+			expectedErrorMsg: "invalid field filter",
+		},
 		{
 			name: "unexpected argument",
 			arguments: map[string]interface{}{
@@ -5,4 +5,7 @@ type BaseDataProvidersResponse struct {
 	SubscriptionID string      `json:"subscription_id"` // Unique subscriptionID
 	Topic          string      `json:"topic"`           // Topic of the subscription
 	Payload        interface{} `json:"payload"`         // Payload that's being returned within a subscription.
+	// Cursor identifies the message within the subscription. It can be used to resume the subscription
+	// right after this message. It is empty for topics which do not support resuming.
+	Cursor string `json:"cursor,omitempty"`
 }
@@ -24,6 +24,9 @@ type Handler struct {
 	logger              zerolog.Logger
 	websocketConfig     Config
 	dataProviderFactory dp.DataProviderFactory
+	// messageLimiter returns the limiter for the messages of the client of a connection request.
+	// nil means unlimited.
+	messageLimiter func(r *http.Request) MessageLimiter
 }
 
 var _ http.Handler = (*Handler)(nil)
@@ -35,6 +38,7 @@ func NewWebSocketHandler(
 	chain flow.Chain,
 	maxRequestSize int64,
 	dataProviderFactory dp.DataProviderFactory,
+	messageLimiter func(r *http.Request) MessageLimiter,
 ) *Handler {
 	return &Handler{
 		ctx:                 ctx,
@@ -42,6 +46,7 @@ func NewWebSocketHandler(
 		websocketConfig:     config,
 		logger:              logger,
 		dataProviderFactory: dataProviderFactory,
+		messageLimiter:      messageLimiter,
 	}
 }
 
@@ -68,6 +73,11 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	controller := NewWebSocketController(logger, h.websocketConfig, NewWebsocketConnection(conn), h.dataProviderFactory)
+	var messageLimiter MessageLimiter
+	if h.messageLimiter != nil {
+		messageLimiter = h.messageLimiter(r)
+	}
+
+	controller := NewWebSocketController(logger, h.websocketConfig, NewWebsocketConnection(conn), h.dataProviderFactory, messageLimiter)
 	controller.HandleConnection(h.ctx)
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/onflow/flow-go/engine/access/subscription/tracker"
 	"github.com/onflow/flow-go/engine/common/rpc"
 	commonrpc "github.com/onflow/flow-go/engine/common/rpc"
+	"github.com/onflow/flow-go/engine/common/rpc/pendingtx"
 	"github.com/onflow/flow-go/engine/common/version"
 	"github.com/onflow/flow-go/fvm/blueprints"
 	accessmodel "github.com/onflow/flow-go/model/access"
@@ -55,6 +56,11 @@ const DefaultConnectionPoolSize = 250
 // Block details related calls are handled by backendBlockDetails.
 // Event related calls are handled by backendEvents.
 // Account related calls are handled by backendAccounts.
+// Account transaction history calls are handled by backendAccountTransactions.
+// Transaction dry run calls are handled by backendTransactionDryRun.
+// Account state diff calls are handled by backendAccountStateDiff.
+// Contract history calls are handled by backendContracts.
+// Register proof calls are handled by backendRegisterProofs.
 //
 // All remaining calls are handled by the base Backend in this file.
 type Backend struct {
@@ -64,6 +70,11 @@ type Backend struct {
 	backendBlockHeaders
 	backendBlockDetails
 	backendAccounts
+	backendAccountTransactions
+	backendTransactionDryRun
+	backendAccountStateDiff
+	backendContracts
+	backendRegisterProofs
 	backendExecutionResults
 	backendNetwork
 	backendSubscribeBlocks
@@ -81,35 +92,42 @@ type Backend struct {
 }
 
 type Params struct {
-	State                 protocol.State
-	CollectionRPC         accessproto.AccessAPIClient
-	HistoricalAccessNodes []accessproto.AccessAPIClient
-	Blocks                storage.Blocks
-	Headers               storage.Headers
-	Collections           storage.Collections
-	Transactions          storage.Transactions
-	ExecutionReceipts     storage.ExecutionReceipts
-	ExecutionResults      storage.ExecutionResults
-	TxResultErrorMessages storage.TransactionResultErrorMessages
-	ChainID               flow.ChainID
-	AccessMetrics         module.AccessMetrics
-	ConnFactory           connection.ConnectionFactory
-	RetryEnabled          bool
-	MaxHeightRange        uint
-	Log                   zerolog.Logger
-	SnapshotHistoryLimit  int
-	Communicator          Communicator
-	TxResultCacheSize     uint
-	ScriptExecutor        execution.ScriptExecutor
-	ScriptExecutionMode   IndexQueryMode
-	CheckPayerBalanceMode validator.PayerBalanceMode
-	EventQueryMode        IndexQueryMode
-	BlockTracker          tracker.BlockTracker
-	SubscriptionHandler   *subscription.SubscriptionHandler
+	State         protocol.State
+	CollectionRPC accessproto.AccessAPIClient
+	// CollectionPendingTxRPC is the pending transactions client of the collection node of CollectionRPC.
+	CollectionPendingTxRPC pendingtx.PendingTransactionsAPIClient
+	HistoricalAccessNodes  []accessproto.AccessAPIClient
+	Blocks                 storage.Blocks
+	Headers                storage.Headers
+	Collections            storage.Collections
+	Transactions           storage.Transactions
+	ExecutionReceipts      storage.ExecutionReceipts
+	ExecutionResults       storage.ExecutionResults
+	TxResultErrorMessages  storage.TransactionResultErrorMessages
+	ChainID                flow.ChainID
+	AccessMetrics          module.AccessMetrics
+	ConnFactory            connection.ConnectionFactory
+	RetryEnabled           bool
+	MaxHeightRange         uint
+	Log                    zerolog.Logger
+	SnapshotHistoryLimit   int
+	Communicator           Communicator
+	TxResultCacheSize      uint
+	ScriptExecutor         execution.ScriptExecutor
+	ScriptExecutionMode    IndexQueryMode
+	CheckPayerBalanceMode  validator.PayerBalanceMode
+	CheckTxAuthorization   bool
+	QueryPendingTxStatus   bool
+	EventQueryMode         IndexQueryMode
+	BlockTracker           tracker.BlockTracker
+	SubscriptionHandler    *subscription.SubscriptionHandler
 
 	EventsIndex                *index.EventsIndex
 	TxResultQueryMode          IndexQueryMode
 	TxResultsIndex             *index.TransactionResultsIndex
+	AccountTransactionsIndex   *index.AccountTransactionsIndex
+	ContractUpdatesIndex       *index.ContractUpdatesIndex
+	Registers                  *execution.RegistersAsyncStore
 	LastFullBlockHeight        *counters.PersistentStrictMonotonicCounter
 	IndexReporter              state_synchronization.IndexReporter
 	VersionControl             *version.VersionControl
@@ -138,6 +156,11 @@ func New(params Params) (*Backend, error) {
 		}
 	}
 
+	pendingDetailsCache, err := lru.New[flow.Identifier, pendingDetailsEntry](pendingDetailsCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to init cache for pending transaction details: %w", err)
+	}
+
 	// the system tx is hardcoded and never changes during runtime
 	systemTx, err := blueprints.SystemChunkTransaction(params.ChainID.Chain())
 	if err != nil {
@@ -191,6 +214,40 @@ func New(params Params) (*Backend, error) {
 			scriptExecMode:             params.ScriptExecutionMode,
 			execNodeIdentitiesProvider: params.ExecNodeIdentitiesProvider,
 		},
+		backendAccountTransactions: backendAccountTransactions{
+			chain:           params.ChainID.Chain(),
+			accountTxsIndex: params.AccountTransactionsIndex,
+		},
+		backendTransactionDryRun: backendTransactionDryRun{
+			log:            params.Log,
+			chain:          params.ChainID.Chain(),
+			state:          params.State,
+			headers:        params.Headers,
+			scriptExecutor: params.ScriptExecutor,
+			scriptExecMode: params.ScriptExecutionMode,
+		},
+		backendAccountStateDiff: backendAccountStateDiff{
+			log:            params.Log,
+			chain:          params.ChainID.Chain(),
+			headers:        params.Headers,
+			registers:      params.Registers,
+			scriptExecutor: params.ScriptExecutor,
+			eventsIndex:    params.EventsIndex,
+			maxHeightRange: params.MaxHeightRange,
+		},
+		backendContracts: backendContracts{
+			chain:                params.ChainID.Chain(),
+			registers:            params.Registers,
+			contractUpdatesIndex: params.ContractUpdatesIndex,
+		},
+		backendRegisterProofs: backendRegisterProofs{
+			log:                        params.Log,
+			state:                      params.State,
+			executionResults:           params.ExecutionResults,
+			connFactory:                params.ConnFactory,
+			nodeCommunicator:           params.Communicator,
+			execNodeIdentitiesProvider: params.ExecNodeIdentitiesProvider,
+		},
 		backendExecutionResults: backendExecutionResults{
 			executionResults: params.ExecutionResults,
 		},
@@ -217,7 +274,15 @@ func New(params Params) (*Backend, error) {
 		versionControl:    params.VersionControl,
 	}
 
-	txValidator, err := configureTransactionValidator(params.State, params.ChainID, params.IndexReporter, params.AccessMetrics, params.ScriptExecutor, params.CheckPayerBalanceMode)
+	txValidator, err := configureTransactionValidator(
+		params.State,
+		params.ChainID,
+		params.IndexReporter,
+		params.AccessMetrics,
+		params.ScriptExecutor,
+		params.CheckPayerBalanceMode,
+		params.CheckTxAuthorization,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("could not create transaction validator: %w", err)
 	}
@@ -232,22 +297,26 @@ func New(params Params) (*Backend, error) {
 			systemTxID:          systemTxID,
 			lastFullBlockHeight: params.LastFullBlockHeight,
 		},
-		log:                        params.Log,
-		staticCollectionRPC:        params.CollectionRPC,
-		chainID:                    params.ChainID,
-		transactions:               params.Transactions,
-		txResultErrorMessages:      params.TxResultErrorMessages,
-		transactionValidator:       txValidator,
-		transactionMetrics:         params.AccessMetrics,
-		retry:                      retry,
-		connFactory:                params.ConnFactory,
-		previousAccessNodes:        params.HistoricalAccessNodes,
-		nodeCommunicator:           params.Communicator,
-		txResultCache:              txResCache,
-		txResultQueryMode:          params.TxResultQueryMode,
-		systemTx:                   systemTx,
-		systemTxID:                 systemTxID,
-		execNodeIdentitiesProvider: params.ExecNodeIdentitiesProvider,
+		log:                          params.Log,
+		staticCollectionRPC:          params.CollectionRPC,
+		staticCollectionPendingTxRPC: params.CollectionPendingTxRPC,
+		chainID:                      params.ChainID,
+		transactions:                 params.Transactions,
+		txResultErrorMessages:        params.TxResultErrorMessages,
+		transactionValidator:         txValidator,
+		transactionMetrics:           params.AccessMetrics,
+		retry:                        retry,
+		connFactory:                  params.ConnFactory,
+		previousAccessNodes:          params.HistoricalAccessNodes,
+		nodeCommunicator:             params.Communicator,
+		txResultCache:                txResCache,
+		txResultQueryMode:            params.TxResultQueryMode,
+		queryPendingTxStatus:         params.QueryPendingTxStatus,
+		pendingDetailsCache:          pendingDetailsCache,
+		pendingDetailsLookups:        make(chan struct{}, maxConcurrentPendingDetailsLookups),
+		systemTx:                     systemTx,
+		systemTxID:                   systemTxID,
+		execNodeIdentitiesProvider:   params.ExecNodeIdentitiesProvider,
 	}
 
 	// TODO: The TransactionErrorMessage interface should be reorganized in future, as it is implemented in backendTransactions but used in TransactionsLocalDataProvider, and its initialization is somewhat quirky.
@@ -273,6 +342,7 @@ func configureTransactionValidator(
 	transactionMetrics module.TransactionValidationMetrics,
 	executor execution.ScriptExecutor,
 	checkPayerBalanceMode validator.PayerBalanceMode,
+	checkAuthorization bool,
 ) (*validator.TransactionValidator, error) {
 	return validator.NewTransactionValidator(
 		validator.NewProtocolStateBlocks(state, indexReporter),
@@ -288,6 +358,7 @@ func configureTransactionValidator(
 			MaxTransactionByteSize:       flow.DefaultMaxTransactionByteSize,
 			MaxCollectionByteSize:        flow.DefaultMaxCollectionByteSize,
 			CheckPayerBalanceMode:        checkPayerBalanceMode,
+			CheckAuthorization:           checkAuthorization,
 		},
 		executor,
 	)
@@ -0,0 +1,263 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/access/index"
+	"github.com/onflow/flow-go/engine/access/state_stream"
+	"github.com/onflow/flow-go/engine/common/rpc"
+	"github.com/onflow/flow-go/fvm/environment"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/execution"
+	"github.com/onflow/flow-go/storage"
+)
+
+// maxAccountDiffRegisters is the maximum number of registers of an account read at each height of an
+// account state diff.
+const maxAccountDiffRegisters = 10_000
+
+type backendAccountStateDiff struct {
+	log            zerolog.Logger
+	chain          flow.Chain
+	headers        storage.Headers
+	registers      *execution.RegistersAsyncStore
+	scriptExecutor execution.ScriptExecutor
+	eventsIndex    *index.EventsIndex
+	maxHeightRange uint
+}
+
+// accountState is the state of an account at a block height, read from the register index.
+type accountState struct {
+	exists      bool
+	storageUsed uint64
+	keys        []flow.AccountPublicKey
+	contracts   map[string]flow.RegisterValue
+	registers   map[flow.RegisterID]flow.RegisterValue
+}
+
+// GetAccountStateDiff returns the changes to the state of the given account between the start and end
+// heights, grouped into balance, keys, contracts and storage used changes, along with the account status
+// core events emitted for the account within the range.
+//
+// The account state is read from the register index, so both heights must have been indexed by the
+// execution state indexer.
+//
+// Expected errors:
+//   - codes.InvalidArgument if the address is invalid for the chain, or the height range is invalid.
+//   - codes.FailedPrecondition if the register index is not enabled or not yet initialized.
+//   - codes.OutOfRange if any height of the range is not indexed.
+func (b *backendAccountStateDiff) GetAccountStateDiff(
+	ctx context.Context,
+	address flow.Address,
+	startHeight uint64,
+	endHeight uint64,
+) (*accessmodel.AccountStateDiff, error) {
+	if b.registers == nil || b.eventsIndex == nil {
+		return nil, status.Error(codes.FailedPrecondition, "register index is not enabled")
+	}
+
+	if !b.chain.IsValid(address) {
+		return nil, status.Errorf(codes.InvalidArgument, "address %s is invalid for chain %s", address, b.chain.ChainID())
+	}
+
+	if startHeight > endHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d is greater than end height %d", startHeight, endHeight)
+	}
+
+	// the events of each block after the start height are read
+	if endHeight-startHeight > uint64(b.maxHeightRange) {
+		return nil, status.Errorf(codes.InvalidArgument, "requested block range (%d) exceeded maximum (%d)", endHeight-startHeight, b.maxHeightRange)
+	}
+
+	before, err := b.accountState(address, startHeight)
+	if err != nil {
+		return nil, err
+	}
+	after, err := b.accountState(address, endHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &accessmodel.AccountStateDiff{
+		Address:           address,
+		StartHeight:       startHeight,
+		EndHeight:         endHeight,
+		ExistedBefore:     before.exists,
+		ExistsAfter:       after.exists,
+		StorageUsedBefore: before.storageUsed,
+		StorageUsedAfter:  after.storageUsed,
+	}
+
+	diff.BalanceBefore, err = b.balance(ctx, address, before, startHeight)
+	if err != nil {
+		return nil, err
+	}
+	diff.BalanceAfter, err = b.balance(ctx, address, after, endHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range after.keys {
+		if i >= len(before.keys) {
+			diff.KeysAdded = append(diff.KeysAdded, key)
+			if key.Revoked {
+				diff.KeysRevoked = append(diff.KeysRevoked, key)
+			}
+			continue
+		}
+		if key.Revoked && !before.keys[i].Revoked {
+			diff.KeysRevoked = append(diff.KeysRevoked, key)
+		}
+	}
+
+	for name, code := range after.contracts {
+		previous, ok := before.contracts[name]
+		if !ok {
+			diff.ContractsAdded = append(diff.ContractsAdded, name)
+		} else if !bytes.Equal(previous, code) {
+			diff.ContractsUpdated = append(diff.ContractsUpdated, name)
+		}
+	}
+	for name := range before.contracts {
+		if _, ok := after.contracts[name]; !ok {
+			diff.ContractsRemoved = append(diff.ContractsRemoved, name)
+		}
+	}
+	sort.Strings(diff.ContractsAdded)
+	sort.Strings(diff.ContractsUpdated)
+	sort.Strings(diff.ContractsRemoved)
+
+	changed := make(flow.RegisterIDs, 0)
+	for id, value := range after.registers {
+		if !bytes.Equal(before.registers[id], value) {
+			changed = append(changed, id)
+		}
+	}
+	for id := range before.registers {
+		if _, ok := after.registers[id]; !ok {
+			changed = append(changed, id)
+		}
+	}
+	sort.Sort(changed)
+	diff.ChangedRegisters = changed
+
+	diff.Events, err = b.accountEvents(address, startHeight+1, endHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+// accountState reads all the registers of the given account at the given height, and decodes its status,
+// keys and contracts.
+//
+// Expected errors:
+//   - codes.FailedPrecondition if the register index is not yet initialized.
+//   - codes.OutOfRange if the height is not indexed.
+//   - codes.ResourceExhausted if the account has more than maxAccountDiffRegisters registers.
+func (b *backendAccountStateDiff) accountState(address flow.Address, height uint64) (*accountState, error) {
+	entries, err := b.registers.RegistersByOwner(string(address.Bytes()), height, maxAccountDiffRegisters)
+	if err != nil {
+		if errors.Is(err, storage.ErrLimitExceeded) {
+			return nil, status.Errorf(codes.ResourceExhausted, "account has more than %d registers at height %d", maxAccountDiffRegisters, height)
+		}
+		return nil, rpc.ConvertIndexError(err, height, "failed to get account registers")
+	}
+
+	state := &accountState{
+		contracts: make(map[string]flow.RegisterValue),
+		registers: make(map[flow.RegisterID]flow.RegisterValue, len(entries)),
+	}
+	for _, entry := range entries {
+		state.registers[entry.Key] = entry.Value
+	}
+
+	statusValue := state.registers[flow.AccountStatusRegisterID(address)]
+	if len(statusValue) == 0 {
+		return state, nil
+	}
+
+	accountStatus, err := environment.AccountStatusFromBytes(statusValue)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode account status at height %d: %v", height, err)
+	}
+	state.exists = true
+	state.storageUsed = accountStatus.StorageUsed()
+
+	for i := uint32(0); i < accountStatus.PublicKeyCount(); i++ {
+		keyValue := state.registers[flow.PublicKeyRegisterID(address, i)]
+		key, err := flow.DecodeAccountPublicKey(keyValue, i)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode account key %d at height %d: %v", i, height, err)
+		}
+		state.keys = append(state.keys, key)
+	}
+
+	names, err := environment.DecodeContractNames(state.registers[flow.ContractNamesRegisterID(address)])
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode contract names at height %d: %v", height, err)
+	}
+	for _, name := range names {
+		state.contracts[name] = state.registers[flow.ContractRegisterID(address, name)]
+	}
+
+	return state, nil
+}
+
+// balance returns the balance of the account at the given height, or 0 if the account does not exist.
+func (b *backendAccountStateDiff) balance(ctx context.Context, address flow.Address, state *accountState, height uint64) (uint64, error) {
+	if !state.exists {
+		return 0, nil
+	}
+
+	balance, err := b.scriptExecutor.GetAccountBalance(ctx, address, height)
+	if err != nil {
+		b.log.Debug().Err(err).Uint64("height", height).Msg("failed to get account balance")
+		return 0, convertAccountError(err, address, height)
+	}
+	return balance, nil
+}
+
+// accountEvents returns the account status core events emitted for the given account in the blocks
+// of the height range (inclusive).
+//
+// Expected errors:
+//   - codes.FailedPrecondition if the events index is not yet initialized.
+//   - codes.OutOfRange if any height of the range is not indexed.
+func (b *backendAccountStateDiff) accountEvents(address flow.Address, startHeight, endHeight uint64) ([]flow.Event, error) {
+	filter, err := state_stream.NewAccountStatusFilter(
+		state_stream.DefaultEventFilterConfig,
+		b.chain,
+		nil,
+		[]string{address.HexWithPrefix()},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create account status filter: %v", err)
+	}
+
+	var events []flow.Event
+	for height := startHeight; height <= endHeight; height++ {
+		header, err := b.headers.ByHeight(height)
+		if err != nil {
+			return nil, rpc.ConvertStorageError(err)
+		}
+
+		blockEvents, err := b.eventsIndex.ByBlockID(header.ID(), height)
+		if err != nil {
+			return nil, rpc.ConvertIndexError(err, height, "failed to get events")
+		}
+
+		events = append(events, filter.Filter(blockEvents)...)
+	}
+
+	return events, nil
+}
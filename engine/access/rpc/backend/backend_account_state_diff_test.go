@@ -0,0 +1,259 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/access/index"
+	"github.com/onflow/flow-go/fvm/environment"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/execution"
+	execmock "github.com/onflow/flow-go/module/execution/mock"
+	syncmock "github.com/onflow/flow-go/module/state_synchronization/mock"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+	"github.com/onflow/flow-go/utils/unittest/generator"
+)
+
+// TestGetAccountStateDiff tests computing the changes to an account between two heights from the register index.
+func TestGetAccountStateDiff(t *testing.T) {
+	chain := flow.Testnet.Chain()
+	address := unittest.RandomAddressFixtureForChain(chain.ChainID())
+
+	key0 := accountPublicKeyFixture(t, 0)
+	key1 := accountPublicKeyFixture(t, 1)
+	revokedKey0 := key0
+	revokedKey0.Revoked = true
+
+	// the account has a single key and the contracts A and B at height 10. Until height 12, the key is revoked,
+	// a new key is added, contract A is updated, contract B is removed and contract C is added.
+	registers := map[uint64]map[flow.RegisterID]flow.RegisterValue{
+		10: accountRegisters(t, address, 100, []flow.AccountPublicKey{key0}, map[string]string{"A": "a", "B": "b"}),
+		11: accountRegisters(t, address, 100, []flow.AccountPublicKey{key0}, map[string]string{"A": "a", "B": "b"}),
+		12: accountRegisters(t, address, 250, []flow.AccountPublicKey{revokedKey0, key1}, map[string]string{"A": "a2", "C": "c"}),
+	}
+	// the account storage is also updated until height 12
+	storageRegister := flow.NewRegisterID(address, "$\x00\x00\x00\x00\x00\x00\x00\x01")
+	unchangedStorageRegister := flow.NewRegisterID(address, "$\x00\x00\x00\x00\x00\x00\x00\x02")
+	registers[10][storageRegister] = []byte("storage")
+	registers[11][storageRegister] = []byte("storage")
+	registers[12][storageRegister] = []byte("storage2")
+	for height := range registers {
+		registers[height][unchangedStorageRegister] = []byte("unchanged")
+	}
+
+	registerIndex := storagemock.NewRegisterIndex(t)
+	registerIndex.On("FirstHeight").Return(uint64(10)).Maybe()
+	registerIndex.On("LatestHeight").Return(uint64(12)).Maybe()
+	registerIndex.On("ByOwner", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(owner string, height uint64, limit uint) (flow.RegisterEntries, error) {
+			var entries flow.RegisterEntries
+			for id, value := range registers[height] {
+				if id.Owner == owner {
+					entries = append(entries, flow.RegisterEntry{Key: id, Value: value})
+				}
+			}
+			if uint(len(entries)) > limit {
+				return nil, storage.ErrLimitExceeded
+			}
+			sort.Sort(entries)
+			return entries, nil
+		}).Maybe()
+
+	registersStore := execution.NewRegistersAsyncStore()
+	require.NoError(t, registersStore.Initialize(registerIndex))
+
+	scriptExecutor := execmock.NewScriptExecutor(t)
+	scriptExecutor.On("GetAccountBalance", mock.Anything, address, uint64(10)).Return(uint64(1000), nil).Maybe()
+	scriptExecutor.On("GetAccountBalance", mock.Anything, address, uint64(11)).Return(uint64(1000), nil).Maybe()
+	scriptExecutor.On("GetAccountBalance", mock.Anything, address, uint64(12)).Return(uint64(400), nil).Maybe()
+
+	contractAddedEvent := generator.GenerateAccountContractEvent(t, "AccountContractAdded", address)
+	otherEvent := generator.GenerateAccountCreateEvent(t, unittest.RandomAddressFixtureForChain(chain.ChainID()))
+
+	headers := storagemock.NewHeaders(t)
+	events := storagemock.NewEvents(t)
+	for height := uint64(10); height <= 12; height++ {
+		header := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(height))
+		headers.On("ByHeight", height).Return(header, nil).Maybe()
+
+		blockEvents := []flow.Event{otherEvent}
+		if height == 12 {
+			blockEvents = append(blockEvents, contractAddedEvent)
+		}
+		events.On("ByBlockID", header.ID()).Return(blockEvents, nil).Maybe()
+	}
+
+	reporter := syncmock.NewIndexReporter(t)
+	reporter.On("LowestIndexedHeight").Return(uint64(10), nil).Maybe()
+	reporter.On("HighestIndexedHeight").Return(uint64(12), nil).Maybe()
+
+	indexReporter := index.NewReporter()
+	require.NoError(t, indexReporter.Initialize(reporter))
+
+	backend := backendAccountStateDiff{
+		log:            unittest.Logger(),
+		chain:          chain,
+		headers:        headers,
+		registers:      registersStore,
+		scriptExecutor: scriptExecutor,
+		eventsIndex:    index.NewEventsIndex(indexReporter, events),
+		maxHeightRange: 10,
+	}
+
+	t.Run("diff between two heights", func(t *testing.T) {
+		diff, err := backend.GetAccountStateDiff(context.Background(), address, 10, 12)
+		require.NoError(t, err)
+
+		expectedRegisters := flow.RegisterIDs{
+			flow.AccountStatusRegisterID(address),
+			flow.PublicKeyRegisterID(address, 0),
+			flow.PublicKeyRegisterID(address, 1),
+			flow.ContractNamesRegisterID(address),
+			flow.ContractRegisterID(address, "A"),
+			flow.ContractRegisterID(address, "B"),
+			flow.ContractRegisterID(address, "C"),
+			storageRegister,
+		}
+		sort.Sort(expectedRegisters)
+
+		require.Equal(t, &accessmodel.AccountStateDiff{
+			Address:           address,
+			StartHeight:       10,
+			EndHeight:         12,
+			ExistedBefore:     true,
+			ExistsAfter:       true,
+			BalanceBefore:     1000,
+			BalanceAfter:      400,
+			StorageUsedBefore: 100,
+			StorageUsedAfter:  250,
+			KeysAdded:         []flow.AccountPublicKey{key1},
+			KeysRevoked:       []flow.AccountPublicKey{revokedKey0},
+			ContractsAdded:    []string{"C"},
+			ContractsUpdated:  []string{"A"},
+			ContractsRemoved:  []string{"B"},
+			ChangedRegisters:  expectedRegisters,
+			Events:            []flow.Event{contractAddedEvent},
+		}, diff)
+		require.Equal(t, int64(-600), diff.BalanceDelta())
+		require.Equal(t, int64(150), diff.StorageUsedDelta())
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		diff, err := backend.GetAccountStateDiff(context.Background(), address, 10, 11)
+		require.NoError(t, err)
+
+		require.Empty(t, diff.KeysAdded)
+		require.Empty(t, diff.KeysRevoked)
+		require.Empty(t, diff.ContractsAdded)
+		require.Empty(t, diff.ContractsUpdated)
+		require.Empty(t, diff.ContractsRemoved)
+		require.Empty(t, diff.ChangedRegisters)
+		require.Empty(t, diff.Events)
+		require.Zero(t, diff.StorageUsedDelta())
+	})
+
+	t.Run("account which does not exist", func(t *testing.T) {
+		other := unittest.RandomAddressFixtureForChain(chain.ChainID())
+		diff, err := backend.GetAccountStateDiff(context.Background(), other, 10, 12)
+		require.NoError(t, err)
+
+		require.False(t, diff.ExistedBefore)
+		require.False(t, diff.ExistsAfter)
+		require.Empty(t, diff.ChangedRegisters)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := backend.GetAccountStateDiff(context.Background(), address, 12, 10)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = backend.GetAccountStateDiff(context.Background(), address, 10, 21)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = backend.GetAccountStateDiff(context.Background(), flow.HexToAddress("ffffffffffffffff"), 10, 12)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("height not indexed", func(t *testing.T) {
+		_, err := backend.GetAccountStateDiff(context.Background(), address, 10, 13)
+		require.Equal(t, codes.OutOfRange, status.Code(err))
+	})
+
+	t.Run("too many registers", func(t *testing.T) {
+		for i := 0; i < maxAccountDiffRegisters; i++ {
+			registers[12][flow.NewRegisterID(address, fmt.Sprintf("key%d", i))] = []byte("value")
+		}
+		defer func() {
+			for i := 0; i < maxAccountDiffRegisters; i++ {
+				delete(registers[12], flow.NewRegisterID(address, fmt.Sprintf("key%d", i)))
+			}
+		}()
+
+		_, err := backend.GetAccountStateDiff(context.Background(), address, 10, 12)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("register index not initialized", func(t *testing.T) {
+		backend := backend
+		backend.registers = execution.NewRegistersAsyncStore()
+
+		_, err := backend.GetAccountStateDiff(context.Background(), address, 10, 12)
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func accountPublicKeyFixture(t *testing.T, index uint32) flow.AccountPublicKey {
+	privateKey, err := unittest.AccountKeyDefaultFixture()
+	require.NoError(t, err)
+
+	key := privateKey.PublicKey(1000)
+	key.Index = index
+	return key
+}
+
+// accountRegisters returns the status, key and contract registers of an account.
+func accountRegisters(
+	t *testing.T,
+	address flow.Address,
+	storageUsed uint64,
+	keys []flow.AccountPublicKey,
+	contracts map[string]string,
+) map[flow.RegisterID]flow.RegisterValue {
+	accountStatus := environment.NewAccountStatus()
+	accountStatus.SetStorageUsed(storageUsed)
+	accountStatus.SetPublicKeyCount(uint32(len(keys)))
+
+	registers := map[flow.RegisterID]flow.RegisterValue{
+		flow.AccountStatusRegisterID(address): accountStatus.ToBytes(),
+	}
+
+	for i, key := range keys {
+		encoded, err := flow.EncodeAccountPublicKey(key)
+		require.NoError(t, err)
+		registers[flow.PublicKeyRegisterID(address, uint32(i))] = encoded
+	}
+
+	names := make([]string, 0, len(contracts))
+	for name, code := range contracts {
+		names = append(names, name)
+		registers[flow.ContractRegisterID(address, name)] = []byte(code)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	require.NoError(t, cbor.NewEncoder(&buf).Encode(names))
+	registers[flow.ContractNamesRegisterID(address)] = buf.Bytes()
+
+	return registers
+}
@@ -0,0 +1,86 @@
+package backend
+
+import (
+	"context"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/access/index"
+	"github.com/onflow/flow-go/engine/common/rpc"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// DefaultAccountTransactionsPageSize is the number of account transactions returned when no limit is requested.
+const DefaultAccountTransactionsPageSize = 50
+
+// MaxAccountTransactionsPageSize is the maximum number of account transactions returned in a single page.
+const MaxAccountTransactionsPageSize = 500
+
+type backendAccountTransactions struct {
+	chain           flow.Chain
+	accountTxsIndex *index.AccountTransactionsIndex
+}
+
+// GetTransactionsByAddress returns a page of the transactions which touched the given account, ordered
+// by descending block height and transaction index. Transactions are only available for blocks which
+// were indexed by the execution state indexer.
+//
+// If cursor is nil, the most recent transactions are returned, otherwise the page starts with the entry
+// referenced by the cursor. A limit of 0 uses DefaultAccountTransactionsPageSize.
+//
+// Expected errors:
+//   - codes.InvalidArgument if the address is invalid for the chain or the limit exceeds the maximum.
+//   - codes.FailedPrecondition if the account transaction index is not enabled or not yet initialized.
+//   - codes.OutOfRange if the cursor references a height below the lowest indexed height.
+func (b *backendAccountTransactions) GetTransactionsByAddress(
+	_ context.Context,
+	address flow.Address,
+	limit uint32,
+	cursor *accessmodel.AccountTransactionCursor,
+) (*accessmodel.AccountTransactionsPage, error) {
+	if b.accountTxsIndex == nil {
+		return nil, status.Error(codes.FailedPrecondition, "account transaction index is not enabled")
+	}
+
+	if !b.chain.IsValid(address) {
+		return nil, status.Errorf(codes.InvalidArgument, "address %s is invalid for chain %s", address, b.chain.ChainID())
+	}
+
+	if limit == 0 {
+		limit = DefaultAccountTransactionsPageSize
+	}
+	if limit > MaxAccountTransactionsPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "limit (%d) exceeds maximum (%d)", limit, MaxAccountTransactionsPageSize)
+	}
+
+	startHeight := uint64(math.MaxUint64)
+	startTxIndex := uint32(math.MaxUint32)
+	if cursor != nil {
+		startHeight = cursor.BlockHeight
+		startTxIndex = cursor.TransactionIndex
+	}
+
+	// request one extra entry to find out whether there is a next page
+	txs, err := b.accountTxsIndex.ByAddress(address, startHeight, startTxIndex, limit+1)
+	if err != nil {
+		return nil, rpc.ConvertIndexError(err, startHeight, "failed to get account transactions")
+	}
+
+	page := &accessmodel.AccountTransactionsPage{
+		Transactions: txs,
+	}
+
+	if uint32(len(txs)) > limit {
+		next := txs[limit]
+		page.Transactions = txs[:limit]
+		page.NextCursor = &accessmodel.AccountTransactionCursor{
+			BlockHeight:      next.BlockHeight,
+			TransactionIndex: next.TransactionIndex,
+		}
+	}
+
+	return page, nil
+}
@@ -0,0 +1,102 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/access/index"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	syncmock "github.com/onflow/flow-go/module/state_synchronization/mock"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
+	"github.com/onflow/flow-go/storage/store"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetTransactionsByAddress tests paginating through the account transaction index.
+func TestGetTransactionsByAddress(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		chain := flow.Testnet.Chain()
+		address := unittest.RandomAddressFixtureForChain(chain.ChainID())
+
+		accountTxs := store.NewAccountTransactions(db)
+
+		// index 5 transactions at heights 10..14, and one at the height above the highest indexed height
+		expected := make([]flow.AccountTransaction, 0)
+		for height := uint64(10); height <= 15; height++ {
+			tx := flow.AccountTransaction{
+				Address:          address,
+				BlockHeight:      height,
+				TransactionID:    unittest.IdentifierFixture(),
+				TransactionIndex: 1,
+				Roles:            []flow.TransactionRole{flow.TransactionRolePayer},
+			}
+			require.NoError(t, db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+				return accountTxs.BatchStore([]flow.AccountTransaction{tx}, rw)
+			}))
+			if height < 15 {
+				expected = append([]flow.AccountTransaction{tx}, expected...)
+			}
+		}
+
+		reporter := syncmock.NewIndexReporter(t)
+		reporter.On("LowestIndexedHeight").Return(uint64(10), nil).Maybe()
+		reporter.On("HighestIndexedHeight").Return(uint64(14), nil).Maybe()
+
+		indexReporter := index.NewReporter()
+		require.NoError(t, indexReporter.Initialize(reporter))
+
+		backend := backendAccountTransactions{
+			chain:           chain,
+			accountTxsIndex: index.NewAccountTransactionsIndex(indexReporter, accountTxs),
+		}
+
+		t.Run("paginates through all transactions", func(t *testing.T) {
+			actual := make([]flow.AccountTransaction, 0)
+
+			var cursor *accessmodel.AccountTransactionCursor
+			pages := 0
+			for {
+				page, err := backend.GetTransactionsByAddress(context.Background(), address, 2, cursor)
+				require.NoError(t, err)
+				pages++
+
+				actual = append(actual, page.Transactions...)
+				if page.NextCursor == nil {
+					break
+				}
+				cursor = page.NextCursor
+			}
+
+			require.Equal(t, 3, pages)
+			require.Equal(t, expected, actual)
+		})
+
+		t.Run("cursor below lowest indexed height", func(t *testing.T) {
+			cursor := &accessmodel.AccountTransactionCursor{BlockHeight: 5}
+			_, err := backend.GetTransactionsByAddress(context.Background(), address, 2, cursor)
+			require.Equal(t, codes.OutOfRange, status.Code(err))
+		})
+
+		t.Run("limit exceeds maximum", func(t *testing.T) {
+			_, err := backend.GetTransactionsByAddress(context.Background(), address, MaxAccountTransactionsPageSize+1, nil)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+
+		t.Run("invalid address", func(t *testing.T) {
+			_, err := backend.GetTransactionsByAddress(context.Background(), flow.Address{0xff}, 2, nil)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+
+		t.Run("index not enabled", func(t *testing.T) {
+			disabled := backendAccountTransactions{chain: chain}
+			_, err := disabled.GetTransactionsByAddress(context.Background(), address, 2, nil)
+			require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+	})
+}
@@ -428,14 +428,13 @@ func (b *backendAccounts) getAccountFromAnyExeNode(
 		return nil, rpc.ConvertError(err, "failed to find execution node to query", codes.Internal)
 	}
 
-	var resp *execproto.GetAccountAtBlockIDResponse
-	errToReturn := b.nodeCommunicator.CallAvailableNode(
+	resp, errToReturn := b.nodeCommunicator.CallAvailableNodeHedged(
+		ctx,
 		execNodes,
-		func(node *flow.IdentitySkeleton) error {
-			var err error
+		func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error) {
 			start := time.Now()
 
-			resp, err = b.tryGetAccount(ctx, node, req)
+			resp, err := b.tryGetAccount(ctx, node, req)
 			duration := time.Since(start)
 
 			lg := b.log.With().
@@ -447,12 +446,12 @@ func (b *backendAccounts) getAccountFromAnyExeNode(
 
 			if err != nil {
 				lg.Err(err).Msg("failed to execute GetAccount")
-				return err
+				return nil, err
 			}
 
 			// return if any execution node replied successfully
 			lg.Debug().Msg("Successfully got account info")
-			return nil
+			return resp, nil
 		},
 		nil,
 	)
@@ -461,7 +460,7 @@ func (b *backendAccounts) getAccountFromAnyExeNode(
 		return nil, rpc.ConvertError(errToReturn, "failed to get account from the execution node", codes.Internal)
 	}
 
-	account, err := convert.MessageToAccount(resp.GetAccount())
+	account, err := convert.MessageToAccount(resp.(*execproto.GetAccountAtBlockIDResponse).GetAccount())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert account message: %v", err)
 	}
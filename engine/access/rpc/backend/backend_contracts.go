@@ -0,0 +1,137 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/access/index"
+	"github.com/onflow/flow-go/engine/common/rpc"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/execution"
+	"github.com/onflow/flow-go/storage"
+)
+
+type backendContracts struct {
+	chain                flow.Chain
+	registers            *execution.RegistersAsyncStore
+	contractUpdatesIndex *index.ContractUpdatesIndex
+}
+
+// GetContractHistory returns all versions of the given contract which were deployed, updated or removed
+// within the blocks indexed by the execution state indexer, ordered from oldest to newest. The code of each
+// version is the code of the contract at the end of the block containing the change.
+//
+// Expected errors:
+//   - codes.InvalidArgument if the address is invalid for the chain.
+//   - codes.FailedPrecondition if the contract update index or the register index is not enabled or not yet initialized.
+//   - codes.OutOfRange if the code of a version is no longer available in the register index.
+func (b *backendContracts) GetContractHistory(
+	_ context.Context,
+	address flow.Address,
+	name string,
+) ([]accessmodel.ContractVersion, error) {
+	if b.contractUpdatesIndex == nil || b.registers == nil {
+		return nil, status.Error(codes.FailedPrecondition, "contract update index is not enabled")
+	}
+
+	if !b.chain.IsValid(address) {
+		return nil, status.Errorf(codes.InvalidArgument, "address %s is invalid for chain %s", address, b.chain.ChainID())
+	}
+
+	updates, err := b.contractUpdatesIndex.ByContract(address, name, math.MaxUint64)
+	if err != nil {
+		return nil, rpc.ConvertIndexError(err, math.MaxUint64, "failed to get contract updates")
+	}
+
+	versions := make([]accessmodel.ContractVersion, len(updates))
+	for i := range updates {
+		update := updates[i]
+		versions[i] = accessmodel.ContractVersion{
+			Address: address,
+			Name:    name,
+			Update:  &update,
+		}
+
+		if update.Type == flow.ContractUpdateTypeRemoved {
+			continue
+		}
+
+		code, err := b.contractCode(address, name, update.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
+		versions[i].Code = code
+	}
+
+	return versions, nil
+}
+
+// GetContractAtBlockHeight returns the version of the given contract which was live at the given block height,
+// along with the update which produced it if it happened within the blocks indexed by the execution state indexer.
+//
+// Expected errors:
+//   - codes.InvalidArgument if the address is invalid for the chain.
+//   - codes.FailedPrecondition if the register index is not enabled or not yet initialized.
+//   - codes.OutOfRange if the height is not indexed.
+//   - codes.NotFound if the contract is not deployed at the given height.
+func (b *backendContracts) GetContractAtBlockHeight(
+	_ context.Context,
+	address flow.Address,
+	name string,
+	height uint64,
+) (*accessmodel.ContractVersion, error) {
+	if b.registers == nil {
+		return nil, status.Error(codes.FailedPrecondition, "register index is not enabled")
+	}
+
+	if !b.chain.IsValid(address) {
+		return nil, status.Errorf(codes.InvalidArgument, "address %s is invalid for chain %s", address, b.chain.ChainID())
+	}
+
+	code, err := b.contractCode(address, name, height)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, status.Errorf(codes.NotFound, "contract %s not found on account %s at height %d", name, address, height)
+	}
+
+	version := &accessmodel.ContractVersion{
+		Address: address,
+		Name:    name,
+		Code:    code,
+	}
+
+	if b.contractUpdatesIndex != nil {
+		updates, err := b.contractUpdatesIndex.ByContract(address, name, height)
+		if err != nil {
+			return nil, rpc.ConvertIndexError(err, height, "failed to get contract updates")
+		}
+		if len(updates) > 0 {
+			version.Update = &updates[len(updates)-1]
+		}
+	}
+
+	return version, nil
+}
+
+// contractCode returns the code of the given contract at the given height, or nil if the contract is not deployed.
+//
+// Expected errors:
+//   - codes.FailedPrecondition if the register index is not yet initialized.
+//   - codes.OutOfRange if the height is not indexed.
+func (b *backendContracts) contractCode(address flow.Address, name string, height uint64) ([]byte, error) {
+	values, err := b.registers.RegisterValues(flow.RegisterIDs{flow.ContractRegisterID(address, name)}, height)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, rpc.ConvertIndexError(err, height, "failed to get contract code")
+	}
+	return values[0], nil
+}
@@ -0,0 +1,143 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/access/index"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/execution"
+	syncmock "github.com/onflow/flow-go/module/state_synchronization/mock"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
+	"github.com/onflow/flow-go/storage/store"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestContractHistory tests looking up the versions of a contract from the contract update index and the register index.
+func TestContractHistory(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		chain := flow.Testnet.Chain()
+		address := unittest.RandomAddressFixtureForChain(chain.ChainID())
+		contractID := flow.ContractRegisterID(address, "Foo")
+
+		update := func(updateType flow.ContractUpdateType, height uint64) flow.ContractUpdate {
+			return flow.ContractUpdate{
+				Address:       address,
+				ContractName:  "Foo",
+				Type:          updateType,
+				BlockHeight:   height,
+				TransactionID: unittest.IdentifierFixture(),
+				CodeHash:      unittest.RandomBytes(32),
+			}
+		}
+
+		// the contract is deployed at height 12, updated at height 14 and removed at height 16.
+		// an update above the highest indexed height is ignored.
+		added := update(flow.ContractUpdateTypeAdded, 12)
+		updated := update(flow.ContractUpdateTypeUpdated, 14)
+		removed := update(flow.ContractUpdateTypeRemoved, 16)
+		notIndexed := update(flow.ContractUpdateTypeAdded, 21)
+
+		contractUpdates := store.NewContractUpdates(db)
+		require.NoError(t, db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+			return contractUpdates.BatchStore([]flow.ContractUpdate{added, updated, removed, notIndexed}, rw)
+		}))
+
+		code := func(height uint64) []byte {
+			switch {
+			case height >= 16:
+				return []byte{}
+			case height >= 14:
+				return []byte("v2")
+			case height >= 12:
+				return []byte("v1")
+			default:
+				return nil
+			}
+		}
+
+		registerIndex := storagemock.NewRegisterIndex(t)
+		registerIndex.On("FirstHeight").Return(uint64(10)).Maybe()
+		registerIndex.On("LatestHeight").Return(uint64(20)).Maybe()
+		registerIndex.On("Get", contractID, mock.Anything).Return(
+			func(_ flow.RegisterID, height uint64) ([]byte, error) {
+				value := code(height)
+				if value == nil {
+					return nil, storage.ErrNotFound
+				}
+				return value, nil
+			}).Maybe()
+
+		registers := execution.NewRegistersAsyncStore()
+		require.NoError(t, registers.Initialize(registerIndex))
+
+		reporter := syncmock.NewIndexReporter(t)
+		reporter.On("LowestIndexedHeight").Return(uint64(10), nil).Maybe()
+		reporter.On("HighestIndexedHeight").Return(uint64(20), nil).Maybe()
+
+		indexReporter := index.NewReporter()
+		require.NoError(t, indexReporter.Initialize(reporter))
+
+		backend := backendContracts{
+			chain:                chain,
+			registers:            registers,
+			contractUpdatesIndex: index.NewContractUpdatesIndex(indexReporter, contractUpdates),
+		}
+
+		t.Run("history", func(t *testing.T) {
+			versions, err := backend.GetContractHistory(context.Background(), address, "Foo")
+			require.NoError(t, err)
+			require.Equal(t, []accessmodel.ContractVersion{
+				{Address: address, Name: "Foo", Code: []byte("v1"), Update: &added},
+				{Address: address, Name: "Foo", Code: []byte("v2"), Update: &updated},
+				{Address: address, Name: "Foo", Update: &removed},
+			}, versions)
+		})
+
+		t.Run("contract at height", func(t *testing.T) {
+			version, err := backend.GetContractAtBlockHeight(context.Background(), address, "Foo", 13)
+			require.NoError(t, err)
+			require.Equal(t, &accessmodel.ContractVersion{Address: address, Name: "Foo", Code: []byte("v1"), Update: &added}, version)
+
+			version, err = backend.GetContractAtBlockHeight(context.Background(), address, "Foo", 15)
+			require.NoError(t, err)
+			require.Equal(t, &accessmodel.ContractVersion{Address: address, Name: "Foo", Code: []byte("v2"), Update: &updated}, version)
+		})
+
+		t.Run("contract not deployed at height", func(t *testing.T) {
+			_, err := backend.GetContractAtBlockHeight(context.Background(), address, "Foo", 11)
+			require.Equal(t, codes.NotFound, status.Code(err))
+
+			_, err = backend.GetContractAtBlockHeight(context.Background(), address, "Foo", 17)
+			require.Equal(t, codes.NotFound, status.Code(err))
+		})
+
+		t.Run("height not indexed", func(t *testing.T) {
+			_, err := backend.GetContractAtBlockHeight(context.Background(), address, "Foo", 21)
+			require.Equal(t, codes.OutOfRange, status.Code(err))
+		})
+
+		t.Run("invalid address", func(t *testing.T) {
+			_, err := backend.GetContractHistory(context.Background(), flow.HexToAddress("ffffffffffffffff"), "Foo")
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+
+		t.Run("index not enabled", func(t *testing.T) {
+			backend := backendContracts{chain: chain}
+
+			_, err := backend.GetContractHistory(context.Background(), address, "Foo")
+			require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+			_, err = backend.GetContractAtBlockHeight(context.Background(), address, "Foo", 13)
+			require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+	})
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/onflow/flow-go/engine/access/rpc/connection"
 	"github.com/onflow/flow-go/engine/common/rpc"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/events"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/irrecoverable"
@@ -27,6 +28,12 @@ import (
 	"github.com/onflow/flow-go/storage"
 )
 
+// DefaultEventsPageSize is the number of events returned by filtered event queries when no limit is requested.
+const DefaultEventsPageSize = 50
+
+// MaxEventsPageSize is the maximum number of events returned by a filtered event query in a single page.
+const MaxEventsPageSize = 500
+
 type backendEvents struct {
 	headers                    storage.Headers
 	state                      protocol.State
@@ -57,68 +64,163 @@ func (b *backendEvents) GetEventsForHeightRange(
 	requiredEventEncodingVersion entities.EventEncodingVersion,
 ) ([]flow.BlockEvents, error) {
 
-	if endHeight < startHeight {
-		return nil, status.Error(codes.InvalidArgument, "start height must not be larger than end height")
+	endHeight, err := b.sealedHeightRange(ctx, startHeight, endHeight)
+	if err != nil {
+		return nil, err
 	}
 
-	rangeSize := endHeight - startHeight + 1 // range is inclusive on both ends
-	if rangeSize > uint64(b.maxHeightRange) {
+	// find the block headers for all the blocks between min and max height (inclusive)
+	blockHeaders := make([]blockMetadata, 0, endHeight-startHeight+1)
+
+	for i := startHeight; i <= endHeight; i++ {
+		blockHeader, err := b.blockMetadataByHeight(i)
+		if err != nil {
+			return nil, err
+		}
+		blockHeaders = append(blockHeaders, blockHeader)
+	}
+
+	return b.getBlockEvents(ctx, blockHeaders, eventType, requiredEventEncodingVersion)
+}
+
+// GetEventsForHeightRangeWithFieldFilters retrieves a page of the events with the given type emitted in
+// the sealed blocks between the start block height and the end block height (inclusive), whose decoded
+// payload matches all the given field filters. Events are only served from the local events index.
+//
+// If cursor is nil, the page starts with the first matching event of the range, otherwise it starts with
+// the event referenced by the cursor. A limit of 0 uses DefaultEventsPageSize.
+//
+// Expected errors:
+//   - codes.InvalidArgument if the event type or range is invalid, the limit exceeds the maximum, or the
+//     cursor is outside of the range.
+//   - codes.OutOfRange if the start height is greater than the last sealed block height, or the events of
+//     a block in the range are not indexed yet.
+//   - codes.FailedPrecondition if the events index is not enabled or not yet initialized.
+//   - codes.NotFound if the events of a block in the range are not found.
+func (b *backendEvents) GetEventsForHeightRangeWithFieldFilters(
+	ctx context.Context,
+	eventType string,
+	startHeight, endHeight uint64,
+	fieldFilters events.FieldFilters,
+	limit uint32,
+	cursor *accessmodel.EventCursor,
+	requiredEventEncodingVersion entities.EventEncodingVersion,
+) (*accessmodel.EventsPage, error) {
+	if b.eventsIndex == nil || b.queryMode == IndexQueryModeExecutionNodesOnly {
+		return nil, status.Error(codes.FailedPrecondition, "events index is not enabled")
+	}
+
+	target := flow.EventType(eventType)
+	if _, err := events.ValidateEvent(target, b.chain); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event type: %v", err)
+	}
+
+	if len(fieldFilters) > events.MaxFieldFilters {
 		return nil, status.Errorf(codes.InvalidArgument,
-			"requested block range (%d) exceeded maximum (%d)", rangeSize, b.maxHeightRange)
+			"too many field filters (%d). use %d or fewer", len(fieldFilters), events.MaxFieldFilters)
 	}
 
-	// get the latest sealed block header
-	sealed, err := b.state.Sealed().Head()
+	if limit == 0 {
+		limit = DefaultEventsPageSize
+	}
+	if limit > MaxEventsPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "limit (%d) exceeds maximum (%d)", limit, MaxEventsPageSize)
+	}
+
+	if cursor != nil && (cursor.BlockHeight < startHeight || cursor.BlockHeight > endHeight) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"cursor height %d is outside of the requested range [%d, %d]", cursor.BlockHeight, startHeight, endHeight)
+	}
+
+	endHeight, err := b.sealedHeightRange(ctx, startHeight, endHeight)
 	if err != nil {
-		// sealed block must be in the store, so throw an exception for any error
-		err := irrecoverable.NewExceptionf("failed to lookup sealed header: %w", err)
-		irrecoverable.Throw(ctx, err)
 		return nil, err
 	}
 
-	// start height should not be beyond the last sealed height
-	if startHeight > sealed.Height {
-		return nil, status.Errorf(codes.OutOfRange,
-			"start height %d is greater than the last sealed block height %d", startHeight, sealed.Height)
+	if cursor != nil {
+		startHeight = cursor.BlockHeight
 	}
 
-	// limit max height to last sealed block in the chain
-	//
-	// Note: this causes unintuitive behavior for clients making requests through a proxy that
-	// fronts multiple nodes. With that setup, clients may receive responses for a smaller range
-	// than requested because the node serving the request has a slightly delayed view of the chain.
-	//
-	// An alternative option is to return an error here, but that's likely to cause more pain for
-	// these clients since the requests would intermittently fail. it's recommended instead to
-	// check the block height of the last message in the response. this will be the last block
-	// height searched, and can be used to determine the start height for the next range.
-	if endHeight > sealed.Height {
-		endHeight = sealed.Height
+	page := &accessmodel.EventsPage{
+		BlockEvents: make([]flow.BlockEvents, 0),
 	}
+	count := uint32(0)
 
-	// find the block headers for all the blocks between min and max height (inclusive)
-	blockHeaders := make([]blockMetadata, 0, endHeight-startHeight+1)
+	for height := startHeight; height <= endHeight; height++ {
+		if ctx.Err() != nil {
+			return nil, rpc.ConvertError(ctx.Err(), "failed to get events from storage", codes.Canceled)
+		}
 
-	for i := startHeight; i <= endHeight; i++ {
-		// this looks inefficient, but is actually what's done under the covers by `headers.ByHeight`
-		// and avoids calculating header.ID() for each block.
-		blockID, err := b.headers.BlockIDByHeight(i)
+		blockInfo, err := b.blockMetadataByHeight(height)
 		if err != nil {
-			return nil, rpc.ConvertStorageError(resolveHeightError(b.state.Params(), i, err))
+			return nil, err
 		}
-		header, err := b.headers.ByBlockID(blockID)
+
+		blockEvents, err := b.eventsIndex.ByBlockID(blockInfo.ID, blockInfo.Height)
 		if err != nil {
-			return nil, rpc.ConvertStorageError(fmt.Errorf("failed to get block header for %d: %w", i, err))
+			return nil, rpc.ConvertIndexError(err, height, "failed to get events from storage")
 		}
 
-		blockHeaders = append(blockHeaders, blockMetadata{
-			ID:        blockID,
-			Height:    header.Height,
-			Timestamp: header.Timestamp,
-		})
+		filteredEvents := make([]flow.Event, 0)
+		for _, e := range blockEvents {
+			if e.Type != target {
+				continue
+			}
+
+			// skip the events before the cursor, which were returned in the previous pages
+			if cursor != nil && height == cursor.BlockHeight &&
+				(e.TransactionIndex < cursor.TransactionIndex ||
+					(e.TransactionIndex == cursor.TransactionIndex && e.EventIndex < cursor.EventIndex)) {
+				continue
+			}
+
+			// events are encoded in CCF format in storage, so filters are applied before any conversion
+			matched, err := fieldFilters.Match(e)
+			if err != nil {
+				err = fmt.Errorf("failed to match event payload for block %s: %w", blockInfo.ID, err)
+				return nil, rpc.ConvertError(err, "failed to filter events", codes.Internal)
+			}
+			if !matched {
+				continue
+			}
+
+			if count == limit {
+				page.NextCursor = &accessmodel.EventCursor{
+					BlockHeight:      height,
+					TransactionIndex: e.TransactionIndex,
+					EventIndex:       e.EventIndex,
+				}
+				break
+			}
+
+			if requiredEventEncodingVersion == entities.EventEncodingVersion_JSON_CDC_V0 {
+				payload, err := convert.CcfPayloadToJsonPayload(e.Payload)
+				if err != nil {
+					err = fmt.Errorf("failed to convert event payload for block %s: %w", blockInfo.ID, err)
+					return nil, rpc.ConvertError(err, "failed to convert event payload", codes.Internal)
+				}
+				e.Payload = payload
+			}
+
+			filteredEvents = append(filteredEvents, e)
+			count++
+		}
+
+		if len(filteredEvents) > 0 {
+			page.BlockEvents = append(page.BlockEvents, flow.BlockEvents{
+				BlockID:        blockInfo.ID,
+				BlockHeight:    blockInfo.Height,
+				BlockTimestamp: blockInfo.Timestamp,
+				Events:         filteredEvents,
+			})
+		}
+
+		if page.NextCursor != nil {
+			break
+		}
 	}
 
-	return b.getBlockEvents(ctx, blockHeaders, eventType, requiredEventEncodingVersion)
+	return page, nil
 }
 
 // GetEventsForBlockIDs retrieves events for all the specified block IDs that have the given type
@@ -151,6 +253,75 @@ func (b *backendEvents) GetEventsForBlockIDs(
 	return b.getBlockEvents(ctx, blockHeaders, eventType, requiredEventEncodingVersion)
 }
 
+// sealedHeightRange validates the requested height range, and returns the end height of the range
+// limited to the last sealed block height.
+//
+// Expected errors:
+//   - codes.InvalidArgument if the start height is larger than the end height, or the range exceeds the maximum.
+//   - codes.OutOfRange if the start height is greater than the last sealed block height.
+func (b *backendEvents) sealedHeightRange(ctx context.Context, startHeight, endHeight uint64) (uint64, error) {
+	if endHeight < startHeight {
+		return 0, status.Error(codes.InvalidArgument, "start height must not be larger than end height")
+	}
+
+	rangeSize := endHeight - startHeight + 1 // range is inclusive on both ends
+	if rangeSize > uint64(b.maxHeightRange) {
+		return 0, status.Errorf(codes.InvalidArgument,
+			"requested block range (%d) exceeded maximum (%d)", rangeSize, b.maxHeightRange)
+	}
+
+	// get the latest sealed block header
+	sealed, err := b.state.Sealed().Head()
+	if err != nil {
+		// sealed block must be in the store, so throw an exception for any error
+		err := irrecoverable.NewExceptionf("failed to lookup sealed header: %w", err)
+		irrecoverable.Throw(ctx, err)
+		return 0, err
+	}
+
+	// start height should not be beyond the last sealed height
+	if startHeight > sealed.Height {
+		return 0, status.Errorf(codes.OutOfRange,
+			"start height %d is greater than the last sealed block height %d", startHeight, sealed.Height)
+	}
+
+	// limit max height to last sealed block in the chain
+	//
+	// Note: this causes unintuitive behavior for clients making requests through a proxy that
+	// fronts multiple nodes. With that setup, clients may receive responses for a smaller range
+	// than requested because the node serving the request has a slightly delayed view of the chain.
+	//
+	// An alternative option is to return an error here, but that's likely to cause more pain for
+	// these clients since the requests would intermittently fail. it's recommended instead to
+	// check the block height of the last message in the response. this will be the last block
+	// height searched, and can be used to determine the start height for the next range.
+	if endHeight > sealed.Height {
+		endHeight = sealed.Height
+	}
+
+	return endHeight, nil
+}
+
+// blockMetadataByHeight returns the metadata of the finalized block at the given height.
+func (b *backendEvents) blockMetadataByHeight(height uint64) (blockMetadata, error) {
+	// this looks inefficient, but is actually what's done under the covers by `headers.ByHeight`
+	// and avoids calculating header.ID() for each block.
+	blockID, err := b.headers.BlockIDByHeight(height)
+	if err != nil {
+		return blockMetadata{}, rpc.ConvertStorageError(resolveHeightError(b.state.Params(), height, err))
+	}
+	header, err := b.headers.ByBlockID(blockID)
+	if err != nil {
+		return blockMetadata{}, rpc.ConvertStorageError(fmt.Errorf("failed to get block header for %d: %w", height, err))
+	}
+
+	return blockMetadata{
+		ID:        blockID,
+		Height:    header.Height,
+		Timestamp: header.Timestamp,
+	}, nil
+}
+
 // getBlockEvents retrieves events for all the specified blocks that have the given type
 // It gets all events available in storage, and requests the rest from an execution node.
 func (b *backendEvents) getBlockEvents(
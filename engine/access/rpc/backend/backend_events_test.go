@@ -23,6 +23,8 @@ import (
 	connectionmock "github.com/onflow/flow-go/engine/access/rpc/connection/mock"
 	commonrpc "github.com/onflow/flow-go/engine/common/rpc"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/events"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/irrecoverable"
 	syncmock "github.com/onflow/flow-go/module/state_synchronization/mock"
@@ -509,6 +511,108 @@ func (s *BackendEventsSuite) TestGetEventsForBlockIDs_HandlesErrors() {
 	})
 }
 
+// TestGetEventsForHeightRangeWithFieldFilters tests that events matching the field filters are returned in pages
+// from the events index.
+func (s *BackendEventsSuite) TestGetEventsForHeightRangeWithFieldFilters() {
+	ctx := context.Background()
+
+	startHeight := s.blocks[0].Header.Height
+	endHeight := s.sealedHead.Height
+
+	reporter := syncmock.NewIndexReporter(s.T())
+	reporter.On("LowestIndexedHeight").Return(startHeight, nil)
+	reporter.On("HighestIndexedHeight").Return(endHeight+10, nil)
+	err := s.eventsIndex.Initialize(reporter)
+	s.Require().NoError(err)
+
+	s.state.On("Sealed").Return(s.snapshot)
+	s.snapshot.On("Head").Return(s.sealedHead, nil)
+
+	// the target event of each block has the fields a: 1 and b: "foo"
+	matching, err := events.ParseFieldFilters([]string{"a:gte:1", "a:lt:2", "b:eq:foo"})
+	s.Require().NoError(err)
+
+	for _, encoding := range []entities.EventEncodingVersion{
+		entities.EventEncodingVersion_CCF_V0,
+		entities.EventEncodingVersion_JSON_CDC_V0,
+	} {
+		s.Run(fmt.Sprintf("all matching events are paginated - %s", encoding.String()), func() {
+			backend := s.defaultBackend()
+			backend.queryMode = IndexQueryModeLocalOnly
+
+			var cursor *accessmodel.EventCursor
+			var heights []uint64
+			pages := 0
+			for {
+				page, err := backend.GetEventsForHeightRangeWithFieldFilters(ctx, targetEvent, startHeight, endHeight, matching, 2, cursor, encoding)
+				s.Require().NoError(err)
+				pages++
+
+				for _, blockEvents := range page.BlockEvents {
+					s.Require().Len(blockEvents.Events, 1)
+					s.Assert().Equal(flow.EventType(targetEvent), blockEvents.Events[0].Type)
+					s.assertEncoding(&blockEvents.Events[0], encoding)
+					heights = append(heights, blockEvents.BlockHeight)
+				}
+
+				if page.NextCursor == nil {
+					break
+				}
+				s.Assert().Equal(s.blockEvents[0].TransactionIndex, page.NextCursor.TransactionIndex)
+				s.Assert().Equal(s.blockEvents[0].EventIndex, page.NextCursor.EventIndex)
+				cursor = page.NextCursor
+			}
+
+			s.Assert().Equal(3, pages)
+			s.Require().Len(heights, len(s.blocks))
+			for i, block := range s.blocks {
+				s.Assert().Equal(block.Header.Height, heights[i])
+			}
+		})
+	}
+
+	s.Run("blocks without matching events are omitted", func() {
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeFailover
+
+		filters, err := events.ParseFieldFilters([]string{"b:ne:foo"})
+		s.Require().NoError(err)
+
+		page, err := backend.GetEventsForHeightRangeWithFieldFilters(ctx, targetEvent, startHeight, endHeight, filters, 0, nil, entities.EventEncodingVersion_CCF_V0)
+		s.Require().NoError(err)
+		s.Assert().Empty(page.BlockEvents)
+		s.Assert().Nil(page.NextCursor)
+	})
+
+	s.Run("returns error if the events index is not used", func() {
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeExecutionNodesOnly
+
+		page, err := backend.GetEventsForHeightRangeWithFieldFilters(ctx, targetEvent, startHeight, endHeight, matching, 0, nil, entities.EventEncodingVersion_CCF_V0)
+		s.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+		s.Assert().Nil(page)
+	})
+
+	s.Run("returns error for limit larger than max", func() {
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeLocalOnly
+
+		page, err := backend.GetEventsForHeightRangeWithFieldFilters(ctx, targetEvent, startHeight, endHeight, matching, MaxEventsPageSize+1, nil, entities.EventEncodingVersion_CCF_V0)
+		s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+		s.Assert().Nil(page)
+	})
+
+	s.Run("returns error for cursor outside of the range", func() {
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeLocalOnly
+
+		cursor := &accessmodel.EventCursor{BlockHeight: endHeight + 1}
+		page, err := backend.GetEventsForHeightRangeWithFieldFilters(ctx, targetEvent, startHeight, endHeight, matching, 0, cursor, entities.EventEncodingVersion_CCF_V0)
+		s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+		s.Assert().Nil(page)
+	})
+}
+
 func (s *BackendEventsSuite) assertResponse(response []flow.BlockEvents, encoding entities.EventEncodingVersion) {
 	s.Assert().Len(response, len(s.blocks))
 	for i, block := range s.blocks {
@@ -0,0 +1,202 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/access/rpc/connection"
+	"github.com/onflow/flow-go/engine/common/rpc"
+	commonrpc "github.com/onflow/flow-go/engine/common/rpc"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/common/rpc/registerproofs"
+	"github.com/onflow/flow-go/ledger/common/registerproof"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+)
+
+// backendRegisterProofs serves batch proofs of registers, requested from the execution nodes.
+// The proofs are verified before they are returned, so a node returning an invalid proof is
+// handled like a failing node, and the next execution node is queried.
+type backendRegisterProofs struct {
+	log                        zerolog.Logger
+	state                      protocol.State
+	executionResults           storage.ExecutionResults
+	connFactory                connection.ConnectionFactory
+	nodeCommunicator           Communicator
+	execNodeIdentitiesProvider *commonrpc.ExecutionNodeIdentitiesProvider
+}
+
+// GetRegisterProofsAtBlockID returns a batch proof of the given registers against the final state commitment of
+// the sealed execution result of the given block.
+//
+// Expected errors during normal operations:
+//   - codes.InvalidArgument if no or too many registers are requested
+//   - codes.NotFound if the block isn't sealed
+func (b *backendRegisterProofs) GetRegisterProofsAtBlockID(
+	ctx context.Context,
+	blockID flow.Identifier,
+	registerIDs []flow.RegisterID,
+) (*accessmodel.RegisterProofs, error) {
+	err := validateRegisterIDs(registerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := b.executionResults.ByBlockID(blockID)
+	if err != nil {
+		return nil, rpc.ConvertStorageError(fmt.Errorf("could not find sealed execution result for block %v: %w", blockID, err))
+	}
+	commit, err := result.FinalStateCommitment()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get final state commitment of result %v: %v", result.ID(), err)
+	}
+
+	proof, err := b.getRegisterProofsFromAnyExeNode(ctx, blockID, commit, registerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accessmodel.RegisterProofs{
+		BlockID:         blockID,
+		StateCommitment: commit,
+		Proof:           proof,
+	}, nil
+}
+
+// GetRegisterProofsAtStateCommitment returns a batch proof of the given registers against the given state commitment.
+// The proof is requested from the execution nodes of the latest sealed block, which must still have the state.
+//
+// Expected errors during normal operations:
+//   - codes.InvalidArgument if no or too many registers are requested
+//   - codes.OutOfRange if the execution nodes don't have the state
+func (b *backendRegisterProofs) GetRegisterProofsAtStateCommitment(
+	ctx context.Context,
+	commit flow.StateCommitment,
+	registerIDs []flow.RegisterID,
+) (*accessmodel.RegisterProofs, error) {
+	err := validateRegisterIDs(registerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := b.state.Sealed().Head()
+	if err != nil {
+		err := irrecoverable.NewExceptionf("failed to lookup sealed header: %w", err)
+		irrecoverable.Throw(ctx, err)
+		return nil, err
+	}
+
+	proof, err := b.getRegisterProofsFromAnyExeNode(ctx, sealed.ID(), commit, registerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accessmodel.RegisterProofs{
+		BlockID:         flow.ZeroID,
+		StateCommitment: commit,
+		Proof:           proof,
+	}, nil
+}
+
+// getRegisterProofsFromAnyExeNode requests the proof of the registers at the state commitment from any of the
+// execution nodes of the given block, and returns the first proof which is valid.
+func (b *backendRegisterProofs) getRegisterProofsFromAnyExeNode(
+	ctx context.Context,
+	blockID flow.Identifier,
+	commit flow.StateCommitment,
+	registerIDs []flow.RegisterID,
+) ([]byte, error) {
+	req := &registerproofs.GetRegisterProofsRequest{
+		StateCommitment: convert.StateCommitmentToMessage(commit),
+		Registers:       make([]*entities.RegisterID, len(registerIDs)),
+	}
+	verifierIDs := make([]registerproof.RegisterID, len(registerIDs))
+	for i, id := range registerIDs {
+		req.Registers[i] = convert.RegisterIDToMessage(id)
+		verifierIDs[i] = registerproof.RegisterID{Owner: id.Owner, Key: id.Key}
+	}
+
+	execNodes, err := b.execNodeIdentitiesProvider.ExecutionNodesForBlockID(ctx, blockID)
+	if err != nil {
+		return nil, rpc.ConvertError(err, "failed to find execution node to query", codes.Internal)
+	}
+
+	resp, errToReturn := b.nodeCommunicator.CallAvailableNodeHedged(
+		ctx,
+		execNodes,
+		func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error) {
+			start := time.Now()
+
+			proof, err := b.tryGetRegisterProofs(ctx, node, req, commit, verifierIDs)
+			duration := time.Since(start)
+
+			lg := b.log.With().
+				Str("execution_node", node.String()).
+				Hex("state_commitment", commit[:]).
+				Int("registers", len(registerIDs)).
+				Int64("rtt_ms", duration.Milliseconds()).
+				Logger()
+
+			if err != nil {
+				lg.Err(err).Msg("failed to get register proofs")
+				return nil, err
+			}
+
+			lg.Debug().Msg("successfully got register proofs")
+			return proof, nil
+		},
+		nil,
+	)
+	if errToReturn != nil {
+		return nil, rpc.ConvertError(errToReturn, "failed to get register proofs from the execution node", codes.Internal)
+	}
+
+	return resp.([]byte), nil
+}
+
+// tryGetRegisterProofs requests the proof from the given execution node, and verifies it.
+func (b *backendRegisterProofs) tryGetRegisterProofs(
+	ctx context.Context,
+	execNode *flow.IdentitySkeleton,
+	req *registerproofs.GetRegisterProofsRequest,
+	commit flow.StateCommitment,
+	registerIDs []registerproof.RegisterID,
+) ([]byte, error) {
+	client, closer, err := b.connFactory.GetRegisterProofsAPIClient(execNode.Address)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	resp, err := client.GetRegisterProofs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = registerproof.Verify(commit, registerIDs, resp.Proof)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "execution node %v returned an invalid proof: %v", execNode.NodeID, err)
+	}
+
+	return resp.Proof, nil
+}
+
+// validateRegisterIDs checks the number of requested registers.
+func validateRegisterIDs(registerIDs []flow.RegisterID) error {
+	if len(registerIDs) == 0 {
+		return status.Errorf(codes.InvalidArgument, "no registers requested")
+	}
+	if len(registerIDs) > registerproofs.MaxRegistersPerRequest {
+		return status.Errorf(codes.InvalidArgument, "too many registers requested: %d > %d", len(registerIDs), registerproofs.MaxRegistersPerRequest)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+
+	connectionmock "github.com/onflow/flow-go/engine/access/rpc/connection/mock"
+	commonrpc "github.com/onflow/flow-go/engine/common/rpc"
+	"github.com/onflow/flow-go/engine/common/rpc/registerproofs"
+	registerproofsmock "github.com/onflow/flow-go/engine/common/rpc/registerproofs/mock"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/wal/fixtures"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	protocol "github.com/onflow/flow-go/state/protocol/mock"
+	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+	"github.com/onflow/flow-go/utils/unittest/mocks"
+)
+
+type BackendRegisterProofsSuite struct {
+	suite.Suite
+
+	log        zerolog.Logger
+	state      *protocol.State
+	snapshot   *protocol.Snapshot
+	params     *protocol.Params
+	rootHeader *flow.Header
+
+	receipts          *storagemock.ExecutionReceipts
+	executionResults  *storagemock.ExecutionResults
+	connectionFactory *connectionmock.ConnectionFactory
+	client            *registerproofsmock.RegisterProofsAPIClient
+
+	executionNodes flow.IdentityList
+	block          *flow.Block
+
+	registerIDs []flow.RegisterID
+	commit      flow.StateCommitment
+	proof       []byte
+}
+
+func TestBackendRegisterProofsSuite(t *testing.T) {
+	suite.Run(t, new(BackendRegisterProofsSuite))
+}
+
+func (s *BackendRegisterProofsSuite) SetupTest() {
+	s.log = unittest.Logger()
+	s.state = protocol.NewState(s.T())
+	s.snapshot = protocol.NewSnapshot(s.T())
+	s.params = protocol.NewParams(s.T())
+	s.rootHeader = unittest.BlockHeaderFixture()
+	s.receipts = storagemock.NewExecutionReceipts(s.T())
+	s.executionResults = storagemock.NewExecutionResults(s.T())
+	s.connectionFactory = connectionmock.NewConnectionFactory(s.T())
+	s.client = registerproofsmock.NewRegisterProofsAPIClient(s.T())
+
+	s.executionNodes = unittest.IdentityListFixture(2, unittest.WithRole(flow.RoleExecution))
+	block := unittest.BlockFixture()
+	s.block = &block
+
+	address := unittest.AddressFixture()
+	s.registerIDs = []flow.RegisterID{
+		flow.AccountStatusRegisterID(address),
+		flow.NewRegisterID(address, "missing"),
+	}
+	s.commit, s.proof = s.proveRegisters()
+}
+
+// proveRegisters sets the first register in a new ledger, and returns the state commitment and
+// the encoded batch proof of the registers.
+func (s *BackendRegisterProofsSuite) proveRegisters() (flow.StateCommitment, []byte) {
+	led, err := complete.NewLedger(&fixtures.NoopWAL{}, 100, &metrics.NoopCollector{}, zerolog.Nop(), complete.DefaultPathFinderVersion)
+	s.Require().NoError(err)
+	compactor := fixtures.NewNoopCompactor(led)
+	<-compactor.Ready()
+	defer func() {
+		<-led.Done()
+		<-compactor.Done()
+	}()
+
+	update, err := ledger.NewUpdate(
+		led.InitialState(),
+		[]ledger.Key{convert.RegisterIDToLedgerKey(s.registerIDs[0])},
+		[]ledger.Value{[]byte("status")},
+	)
+	s.Require().NoError(err)
+	state, _, err := led.Set(update)
+	s.Require().NoError(err)
+
+	keys := make([]ledger.Key, len(s.registerIDs))
+	for i, id := range s.registerIDs {
+		keys[i] = convert.RegisterIDToLedgerKey(id)
+	}
+	query, err := ledger.NewQuery(state, keys)
+	s.Require().NoError(err)
+	proof, err := led.Prove(query)
+	s.Require().NoError(err)
+
+	return flow.StateCommitment(state), proof
+}
+
+func (s *BackendRegisterProofsSuite) defaultBackend() *backendRegisterProofs {
+	return &backendRegisterProofs{
+		log:              s.log,
+		state:            s.state,
+		executionResults: s.executionResults,
+		connFactory:      s.connectionFactory,
+		nodeCommunicator: NewNodeCommunicator(false),
+		execNodeIdentitiesProvider: commonrpc.NewExecutionNodeIdentitiesProvider(
+			s.log,
+			s.state,
+			s.receipts,
+			flow.IdentifierList{},
+			flow.IdentifierList{},
+		),
+	}
+}
+
+// setupExecutionNodes sets up the mocks required to query the execution nodes of the block
+func (s *BackendRegisterProofsSuite) setupExecutionNodes() {
+	s.params.On("FinalizedRoot").Return(s.rootHeader, nil)
+	s.state.On("Params").Return(s.params)
+	s.state.On("Final").Return(s.snapshot)
+	s.snapshot.On("Identities", mock.Anything).Return(s.executionNodes, nil)
+
+	var receipts flow.ExecutionReceiptList //nolint:gosimple
+	receipts = unittest.ReceiptsForBlockFixture(s.block, s.executionNodes.NodeIDs())
+	s.receipts.On("ByBlockID", s.block.ID()).Return(receipts, nil)
+
+	s.connectionFactory.On("GetRegisterProofsAPIClient", mock.Anything).
+		Return(s.client, &mocks.MockCloser{}, nil)
+}
+
+func (s *BackendRegisterProofsSuite) expectedRequest() *registerproofs.GetRegisterProofsRequest {
+	req := &registerproofs.GetRegisterProofsRequest{StateCommitment: s.commit[:]}
+	for _, id := range s.registerIDs {
+		req.Registers = append(req.Registers, &entities.RegisterID{Owner: []byte(id.Owner), Key: []byte(id.Key)})
+	}
+	return req
+}
+
+// TestGetRegisterProofsAtBlockID tests that the proof is requested for the final state commitment of
+// the sealed result of the block.
+func (s *BackendRegisterProofsSuite) TestGetRegisterProofsAtBlockID() {
+	s.setupExecutionNodes()
+	s.executionResults.On("ByBlockID", s.block.ID()).
+		Return(unittest.ExecutionResultFixture(unittest.WithFinalState(s.commit)), nil)
+	s.client.On("GetRegisterProofs", mock.Anything, s.expectedRequest()).
+		Return(&registerproofs.GetRegisterProofsResponse{StateCommitment: s.commit[:], Proof: s.proof}, nil).
+		Once()
+
+	proofs, err := s.defaultBackend().GetRegisterProofsAtBlockID(context.Background(), s.block.ID(), s.registerIDs)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.block.ID(), proofs.BlockID)
+	s.Assert().Equal(s.commit, proofs.StateCommitment)
+	s.Assert().Equal(s.proof, proofs.Proof)
+}
+
+// TestGetRegisterProofsAtBlockID_Unsealed tests that NotFound is returned for blocks without sealed result.
+func (s *BackendRegisterProofsSuite) TestGetRegisterProofsAtBlockID_Unsealed() {
+	s.executionResults.On("ByBlockID", s.block.ID()).Return(nil, storage.ErrNotFound)
+
+	_, err := s.defaultBackend().GetRegisterProofsAtBlockID(context.Background(), s.block.ID(), s.registerIDs)
+	s.Require().Error(err)
+	s.Assert().Equal(codes.NotFound, status.Code(err))
+}
+
+// TestGetRegisterProofsAtStateCommitment tests that the proof is requested from the execution nodes of the
+// latest sealed block.
+func (s *BackendRegisterProofsSuite) TestGetRegisterProofsAtStateCommitment() {
+	s.setupExecutionNodes()
+	s.state.On("Sealed").Return(s.snapshot)
+	s.snapshot.On("Head").Return(s.block.Header, nil)
+	s.client.On("GetRegisterProofs", mock.Anything, s.expectedRequest()).
+		Return(&registerproofs.GetRegisterProofsResponse{StateCommitment: s.commit[:], Proof: s.proof}, nil).
+		Once()
+
+	proofs, err := s.defaultBackend().GetRegisterProofsAtStateCommitment(context.Background(), s.commit, s.registerIDs)
+	s.Require().NoError(err)
+	s.Assert().Equal(flow.ZeroID, proofs.BlockID)
+	s.Assert().Equal(s.commit, proofs.StateCommitment)
+	s.Assert().Equal(s.proof, proofs.Proof)
+}
+
+// TestGetRegisterProofs_InvalidProof tests that an invalid proof is rejected, and the next execution node is queried.
+func (s *BackendRegisterProofsSuite) TestGetRegisterProofs_InvalidProof() {
+	s.setupExecutionNodes()
+	s.state.On("Sealed").Return(s.snapshot)
+	s.snapshot.On("Head").Return(s.block.Header, nil)
+
+	s.client.On("GetRegisterProofs", mock.Anything, s.expectedRequest()).
+		Return(&registerproofs.GetRegisterProofsResponse{StateCommitment: s.commit[:], Proof: s.proof[:len(s.proof)-1]}, nil).
+		Once()
+	s.client.On("GetRegisterProofs", mock.Anything, s.expectedRequest()).
+		Return(&registerproofs.GetRegisterProofsResponse{StateCommitment: s.commit[:], Proof: s.proof}, nil).
+		Once()
+
+	proofs, err := s.defaultBackend().GetRegisterProofsAtStateCommitment(context.Background(), s.commit, s.registerIDs)
+	s.Require().NoError(err)
+	s.Assert().Equal(s.proof, proofs.Proof)
+}
+
+// TestGetRegisterProofs_InvalidRequest tests that requests without or with too many registers are rejected.
+func (s *BackendRegisterProofsSuite) TestGetRegisterProofs_InvalidRequest() {
+	backend := s.defaultBackend()
+
+	_, err := backend.GetRegisterProofsAtBlockID(context.Background(), s.block.ID(), nil)
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	tooMany := make([]flow.RegisterID, registerproofs.MaxRegistersPerRequest+1)
+	_, err = backend.GetRegisterProofsAtStateCommitment(context.Background(), s.commit, tooMany)
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -3,6 +3,7 @@ package backend
 import (
 	"context"
 	"crypto/md5" //nolint:gosec
+	"sync/atomic"
 	"time"
 
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -14,7 +15,9 @@ import (
 	"github.com/onflow/flow-go/engine/access/rpc/connection"
 	"github.com/onflow/flow-go/engine/common/rpc"
 	commonrpc "github.com/onflow/flow-go/engine/common/rpc"
+	"github.com/onflow/flow-go/engine/execution/computation/query"
 	fvmerrors "github.com/onflow/flow-go/fvm/errors"
+	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/execution"
@@ -48,14 +51,18 @@ type scriptExecutionRequest struct {
 	script             []byte
 	arguments          [][]byte
 	insecureScriptHash [md5.Size]byte
+	// includeReport is true if the resources used by the script must be reported. Building the full
+	// report has a cost, so it is only done when requested.
+	includeReport bool
 }
 
-func newScriptExecutionRequest(blockID flow.Identifier, height uint64, script []byte, arguments [][]byte) *scriptExecutionRequest {
+func newScriptExecutionRequest(blockID flow.Identifier, height uint64, script []byte, arguments [][]byte, includeReport bool) *scriptExecutionRequest {
 	return &scriptExecutionRequest{
-		blockID:   blockID,
-		height:    height,
-		script:    script,
-		arguments: arguments,
+		blockID:       blockID,
+		height:        height,
+		script:        script,
+		arguments:     arguments,
+		includeReport: includeReport,
 
 		// encode to MD5 as low compute/memory lookup key
 		// CAUTION: cryptographically insecure md5 is used here, but only to de-duplicate logs.
@@ -70,6 +77,79 @@ func (b *backendScripts) ExecuteScriptAtLatestBlock(
 	script []byte,
 	arguments [][]byte,
 ) ([]byte, error) {
+	result, err := b.executeScriptAtLatestBlock(ctx, script, arguments, false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
+
+// ExecuteScriptAtBlockID executes provided script at the provided block ID.
+func (b *backendScripts) ExecuteScriptAtBlockID(
+	ctx context.Context,
+	blockID flow.Identifier,
+	script []byte,
+	arguments [][]byte,
+) ([]byte, error) {
+	result, err := b.executeScriptAtBlockID(ctx, blockID, script, arguments, false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
+
+// ExecuteScriptAtBlockHeight executes provided script at the provided block height.
+func (b *backendScripts) ExecuteScriptAtBlockHeight(
+	ctx context.Context,
+	blockHeight uint64,
+	script []byte,
+	arguments [][]byte,
+) ([]byte, error) {
+	result, err := b.executeScriptAtBlockHeight(ctx, blockHeight, script, arguments, false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Value, nil
+}
+
+// ExecuteScriptAtLatestBlockWithReport executes provided script at the latest sealed block, and returns
+// the resources used by the script along with its result.
+func (b *backendScripts) ExecuteScriptAtLatestBlockWithReport(
+	ctx context.Context,
+	script []byte,
+	arguments [][]byte,
+) (*accessmodel.ScriptExecutionResult, error) {
+	return b.executeScriptAtLatestBlock(ctx, script, arguments, true)
+}
+
+// ExecuteScriptAtBlockIDWithReport executes provided script at the provided block ID, and returns
+// the resources used by the script along with its result.
+func (b *backendScripts) ExecuteScriptAtBlockIDWithReport(
+	ctx context.Context,
+	blockID flow.Identifier,
+	script []byte,
+	arguments [][]byte,
+) (*accessmodel.ScriptExecutionResult, error) {
+	return b.executeScriptAtBlockID(ctx, blockID, script, arguments, true)
+}
+
+// ExecuteScriptAtBlockHeightWithReport executes provided script at the provided block height, and returns
+// the resources used by the script along with its result.
+func (b *backendScripts) ExecuteScriptAtBlockHeightWithReport(
+	ctx context.Context,
+	blockHeight uint64,
+	script []byte,
+	arguments [][]byte,
+) (*accessmodel.ScriptExecutionResult, error) {
+	return b.executeScriptAtBlockHeight(ctx, blockHeight, script, arguments, true)
+}
+
+func (b *backendScripts) executeScriptAtLatestBlock(
+	ctx context.Context,
+	script []byte,
+	arguments [][]byte,
+	includeReport bool,
+) (*accessmodel.ScriptExecutionResult, error) {
 	latestHeader, err := b.state.Sealed().Head()
 	if err != nil {
 		// the latest sealed header MUST be available
@@ -78,37 +158,37 @@ func (b *backendScripts) ExecuteScriptAtLatestBlock(
 		return nil, err
 	}
 
-	return b.executeScript(ctx, newScriptExecutionRequest(latestHeader.ID(), latestHeader.Height, script, arguments))
+	return b.executeScript(ctx, newScriptExecutionRequest(latestHeader.ID(), latestHeader.Height, script, arguments, includeReport))
 }
 
-// ExecuteScriptAtBlockID executes provided script at the provided block ID.
-func (b *backendScripts) ExecuteScriptAtBlockID(
+func (b *backendScripts) executeScriptAtBlockID(
 	ctx context.Context,
 	blockID flow.Identifier,
 	script []byte,
 	arguments [][]byte,
-) ([]byte, error) {
+	includeReport bool,
+) (*accessmodel.ScriptExecutionResult, error) {
 	header, err := b.headers.ByBlockID(blockID)
 	if err != nil {
 		return nil, rpc.ConvertStorageError(err)
 	}
 
-	return b.executeScript(ctx, newScriptExecutionRequest(blockID, header.Height, script, arguments))
+	return b.executeScript(ctx, newScriptExecutionRequest(blockID, header.Height, script, arguments, includeReport))
 }
 
-// ExecuteScriptAtBlockHeight executes provided script at the provided block height.
-func (b *backendScripts) ExecuteScriptAtBlockHeight(
+func (b *backendScripts) executeScriptAtBlockHeight(
 	ctx context.Context,
 	blockHeight uint64,
 	script []byte,
 	arguments [][]byte,
-) ([]byte, error) {
+	includeReport bool,
+) (*accessmodel.ScriptExecutionResult, error) {
 	header, err := b.headers.ByHeight(blockHeight)
 	if err != nil {
 		return nil, rpc.ConvertStorageError(resolveHeightError(b.state.Params(), blockHeight, err))
 	}
 
-	return b.executeScript(ctx, newScriptExecutionRequest(header.ID(), blockHeight, script, arguments))
+	return b.executeScript(ctx, newScriptExecutionRequest(header.ID(), blockHeight, script, arguments, includeReport))
 }
 
 // executeScript executes the provided script using either the local execution state or the execution
@@ -116,7 +196,7 @@ func (b *backendScripts) ExecuteScriptAtBlockHeight(
 func (b *backendScripts) executeScript(
 	ctx context.Context,
 	scriptRequest *scriptExecutionRequest,
-) ([]byte, error) {
+) (*accessmodel.ScriptExecutionResult, error) {
 	switch b.scriptExecMode {
 	case IndexQueryModeExecutionNodesOnly:
 		result, _, err := b.executeScriptOnAvailableExecutionNodes(ctx, scriptRequest)
@@ -137,8 +217,8 @@ func (b *backendScripts) executeScript(
 
 		resultComparer := newScriptResultComparison(b.log, b.metrics, b.shouldLogScript, scriptRequest)
 		_ = resultComparer.compare(
-			newScriptResult(execResult, execDuration, execErr),
-			newScriptResult(localResult, localDuration, localErr),
+			newScriptResult(scriptValue(execResult), execDuration, execErr),
+			newScriptResult(scriptValue(localResult), localDuration, localErr),
 		)
 
 		return execResult, execErr
@@ -155,8 +235,8 @@ func (b *backendScripts) executeScript(
 
 		resultComparer := newScriptResultComparison(b.log, b.metrics, b.shouldLogScript, scriptRequest)
 		_ = resultComparer.compare(
-			newScriptResult(execResult, execDuration, execErr),
-			newScriptResult(localResult, localDuration, localErr),
+			newScriptResult(scriptValue(execResult), execDuration, execErr),
+			newScriptResult(scriptValue(localResult), localDuration, localErr),
 		)
 
 		// always return EN results
@@ -171,10 +251,17 @@ func (b *backendScripts) executeScript(
 func (b *backendScripts) executeScriptLocally(
 	ctx context.Context,
 	r *scriptExecutionRequest,
-) ([]byte, time.Duration, error) {
+) (*accessmodel.ScriptExecutionResult, time.Duration, error) {
 	execStartTime := time.Now()
 
-	result, err := b.scriptExecutor.ExecuteAtBlockHeight(ctx, r.script, r.arguments, r.height)
+	var value []byte
+	var report *query.ScriptExecutionReport
+	var err error
+	if r.includeReport {
+		value, report, err = b.scriptExecutor.ExecuteAtBlockHeightWithReport(ctx, r.script, r.arguments, r.height)
+	} else {
+		value, err = b.scriptExecutor.ExecuteAtBlockHeight(ctx, r.script, r.arguments, r.height)
+	}
 
 	execEndTime := time.Now()
 	execDuration := execEndTime.Sub(execStartTime)
@@ -216,6 +303,23 @@ func (b *backendScripts) executeScriptLocally(
 	// log execution time
 	b.metrics.ScriptExecuted(execDuration, len(r.script))
 
+	result := &accessmodel.ScriptExecutionResult{
+		Value: value,
+	}
+	if report != nil {
+		result.Report = accessmodel.ScriptExecutionReport{
+			ComputationUsed: report.ComputationUsed,
+			MemoryEstimate:  report.MemoryEstimate,
+			RegisterReads:   report.RegisterReads,
+			BytesRead:       report.BytesRead,
+			ContractsLoaded: make([]string, len(report.ContractsLoaded)),
+			ExecutedLocally: true,
+		}
+		for i, location := range report.ContractsLoaded {
+			result.Report.ContractsLoaded[i] = location.ID()
+		}
+	}
+
 	return result, execDuration, nil
 }
 
@@ -223,7 +327,7 @@ func (b *backendScripts) executeScriptLocally(
 func (b *backendScripts) executeScriptOnAvailableExecutionNodes(
 	ctx context.Context,
 	r *scriptExecutionRequest,
-) ([]byte, time.Duration, error) {
+) (*accessmodel.ScriptExecutionResult, time.Duration, error) {
 	// find few execution nodes which have executed the block earlier and provided an execution receipt for it
 	executors, err := b.execNodeIdentitiesProvider.ExecutionNodesForBlockID(ctx, r.blockID)
 	if err != nil {
@@ -235,28 +339,36 @@ func (b *backendScripts) executeScriptOnAvailableExecutionNodes(
 		Hex("script_hash", r.insecureScriptHash[:]).
 		Logger()
 
-	var result []byte
-	var executionTime time.Time
-	var execDuration time.Duration
-	errToReturn := b.nodeCommunicator.CallAvailableNode(
+	// scripts may be executed on several execution nodes concurrently when requests are hedged, so the
+	// result and duration of each execution are returned along with the result of the call.
+	type execution struct {
+		result   *accessmodel.ScriptExecutionResult
+		duration time.Duration
+	}
+
+	// duration of the most recent execution, reported when all executions failed
+	var lastDuration atomic.Int64
+	response, errToReturn := b.nodeCommunicator.CallAvailableNodeHedged(
+		ctx,
 		executors,
-		func(node *flow.IdentitySkeleton) error {
+		func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error) {
 			execStartTime := time.Now()
 
-			result, err = b.tryExecuteScriptOnExecutionNode(ctx, node.Address, r)
+			result, err := b.tryExecuteScriptOnExecutionNode(ctx, node.Address, r)
 
-			executionTime = time.Now()
-			execDuration = executionTime.Sub(execStartTime)
+			executionTime := time.Now()
+			duration := executionTime.Sub(execStartTime)
+			lastDuration.Store(int64(duration))
 
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			if b.shouldLogScript(executionTime, r.insecureScriptHash) {
 				lg.Debug().
 					Str("script_executor_addr", node.Address).
 					Str("script", string(r.script)).
-					Dur("execution_dur_ms", execDuration).
+					Dur("execution_dur_ms", duration).
 					Msg("Successfully executed script")
 				b.loggedScripts.Add(r.insecureScriptHash, executionTime)
 			}
@@ -264,12 +376,12 @@ func (b *backendScripts) executeScriptOnAvailableExecutionNodes(
 			// log execution time
 			b.metrics.ScriptExecuted(time.Since(execStartTime), len(r.script))
 
-			return nil
+			return &execution{result: result, duration: duration}, nil
 		},
 		func(node *flow.IdentitySkeleton, err error) bool {
 			if status.Code(err) == codes.InvalidArgument {
 				logEvent := lg.Debug().Err(err).Str("script_executor_addr", node.Address)
-				if b.shouldLogScript(executionTime, r.insecureScriptHash) {
+				if b.shouldLogScript(time.Now(), r.insecureScriptHash) {
 					logEvent.Str("script", string(r.script))
 				}
 				logEvent.Msg("script failed to execute on the execution node")
@@ -284,18 +396,20 @@ func (b *backendScripts) executeScriptOnAvailableExecutionNodes(
 			b.metrics.ScriptExecutionErrorOnExecutionNode()
 			b.log.Error().Err(errToReturn).Msg("script execution failed for execution node internal reasons")
 		}
-		return nil, execDuration, rpc.ConvertError(errToReturn, "failed to execute script on execution nodes", codes.Internal)
+		return nil, time.Duration(lastDuration.Load()), rpc.ConvertError(errToReturn, "failed to execute script on execution nodes", codes.Internal)
 	}
 
-	return result, execDuration, nil
+	exec := response.(*execution)
+	return exec.result, exec.duration, nil
 }
 
 // tryExecuteScriptOnExecutionNode attempts to execute the script on the given execution node.
+// Execution nodes only report the computation used by the script.
 func (b *backendScripts) tryExecuteScriptOnExecutionNode(
 	ctx context.Context,
 	executorAddress string,
 	r *scriptExecutionRequest,
-) ([]byte, error) {
+) (*accessmodel.ScriptExecutionResult, error) {
 	execRPCClient, closer, err := b.connFactory.GetExecutionAPIClient(executorAddress)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create client for execution node %s: %v",
@@ -311,7 +425,20 @@ func (b *backendScripts) tryExecuteScriptOnExecutionNode(
 	if err != nil {
 		return nil, status.Errorf(status.Code(err), "failed to execute the script on the execution node %s: %v", executorAddress, err)
 	}
-	return execResp.GetValue(), nil
+	return &accessmodel.ScriptExecutionResult{
+		Value: execResp.GetValue(),
+		Report: accessmodel.ScriptExecutionReport{
+			ComputationUsed: execResp.GetComputationUsage(),
+		},
+	}, nil
+}
+
+// scriptValue returns the encoded value of the script result, or nil if there is no result.
+func scriptValue(result *accessmodel.ScriptExecutionResult) []byte {
+	if result == nil {
+		return nil
+	}
+	return result.Value
 }
 
 // isInvalidArgumentError checks if the error is from an invalid argument
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/onflow/cadence/common"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -19,7 +20,9 @@ import (
 	access "github.com/onflow/flow-go/engine/access/mock"
 	connectionmock "github.com/onflow/flow-go/engine/access/rpc/connection/mock"
 	commonrpc "github.com/onflow/flow-go/engine/common/rpc"
+	"github.com/onflow/flow-go/engine/execution/computation/query"
 	fvmerrors "github.com/onflow/flow-go/fvm/errors"
+	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 	execmock "github.com/onflow/flow-go/module/execution/mock"
 	"github.com/onflow/flow-go/module/irrecoverable"
@@ -32,7 +35,8 @@ import (
 )
 
 var (
-	expectedResponse = []byte("response_data")
+	expectedResponse        = []byte("response_data")
+	expectedComputationUsed = uint64(42)
 
 	cadenceErr    = fvmerrors.NewCodedError(fvmerrors.ErrCodeCadenceRunTimeError, "cadence error")
 	fvmFailureErr = fvmerrors.NewCodedFailure(fvmerrors.FailureCodeBlockFinderFailure, "fvm error")
@@ -142,7 +146,8 @@ func (s *BackendScriptsSuite) setupENSuccessResponse(blockID flow.Identifier) {
 
 	s.execClient.On("ExecuteScriptAtBlockID", mock.Anything, expectedExecRequest).
 		Return(&execproto.ExecuteScriptAtBlockIDResponse{
-			Value: expectedResponse,
+			Value:            expectedResponse,
+			ComputationUsage: expectedComputationUsed,
 		}, nil)
 }
 
@@ -413,6 +418,66 @@ func (s *BackendScriptsSuite) TestExecuteScriptWithFailover_ReturnsENErrors() {
 	})
 }
 
+// TestExecuteScriptWithReport_OnExecutionNode tests that only the computation used is reported for
+// scripts executed on execution nodes
+func (s *BackendScriptsSuite) TestExecuteScriptWithReport_OnExecutionNode() {
+	ctx := context.Background()
+
+	s.setupExecutionNodes(s.block)
+	s.setupENSuccessResponse(s.block.ID())
+
+	backend := s.defaultBackend()
+	backend.scriptExecMode = IndexQueryModeExecutionNodesOnly
+
+	s.headers.On("ByBlockID", s.block.ID()).Return(s.block.Header, nil).Once()
+
+	result, err := backend.ExecuteScriptAtBlockIDWithReport(ctx, s.block.ID(), s.script, s.arguments)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedResponse, result.Value)
+	s.Require().Equal(accessmodel.ScriptExecutionReport{
+		ComputationUsed: expectedComputationUsed,
+	}, result.Report)
+}
+
+// TestExecuteScriptWithReport_FromStorage tests that the full resource report is returned for scripts
+// executed using the local storage
+func (s *BackendScriptsSuite) TestExecuteScriptWithReport_FromStorage() {
+	ctx := context.Background()
+
+	location := common.AddressLocation{
+		Address: common.Address(unittest.AddressFixture()),
+		Name:    "Contract",
+	}
+
+	scriptExecutor := execmock.NewScriptExecutor(s.T())
+	scriptExecutor.On("ExecuteAtBlockHeightWithReport", mock.Anything, s.script, s.arguments, s.block.Header.Height).
+		Return(expectedResponse, &query.ScriptExecutionReport{
+			ComputationUsed: expectedComputationUsed,
+			MemoryEstimate:  1024,
+			RegisterReads:   3,
+			BytesRead:       512,
+			ContractsLoaded: []common.AddressLocation{location},
+		}, nil)
+
+	backend := s.defaultBackend()
+	backend.scriptExecMode = IndexQueryModeLocalOnly
+	backend.scriptExecutor = scriptExecutor
+
+	s.headers.On("ByHeight", s.block.Header.Height).Return(s.block.Header, nil).Once()
+
+	result, err := backend.ExecuteScriptAtBlockHeightWithReport(ctx, s.block.Header.Height, s.script, s.arguments)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedResponse, result.Value)
+	s.Require().Equal(accessmodel.ScriptExecutionReport{
+		ComputationUsed: expectedComputationUsed,
+		MemoryEstimate:  1024,
+		RegisterReads:   3,
+		BytesRead:       512,
+		ContractsLoaded: []string{location.ID()},
+		ExecutedLocally: true,
+	}, result.Report)
+}
+
 // TestExecuteScriptAtLatestBlockFromStorage_InconsistentState tests that signaler context received error when node state is
 // inconsistent
 func (s *BackendScriptsSuite) TestExecuteScriptAtLatestBlockFromStorage_InconsistentState() {
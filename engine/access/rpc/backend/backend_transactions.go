@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -19,6 +20,7 @@ import (
 	"github.com/onflow/flow-go/engine/common/rpc"
 	commonrpc "github.com/onflow/flow-go/engine/common/rpc"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/common/rpc/pendingtx"
 	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
@@ -29,10 +31,31 @@ import (
 
 const DefaultFailedErrorMessage = "failed"
 
+const (
+	// pendingDetailsCacheSize is the number of transactions whose pending details are cached.
+	pendingDetailsCacheSize = 1_000
+
+	// pendingDetailsCacheTTL is the duration for which the pending details of a transaction are reused,
+	// so that clients polling the status of a transaction do not query the collection nodes on every call.
+	pendingDetailsCacheTTL = 5 * time.Second
+
+	// maxConcurrentPendingDetailsLookups is the maximum number of transactions whose pending details are
+	// queried from the collection nodes at the same time.
+	maxConcurrentPendingDetailsLookups = 16
+)
+
+// pendingDetailsEntry is a cached result of querying the collection nodes for a pending transaction.
+type pendingDetailsEntry struct {
+	details *accessmodel.PendingTransactionDetails
+	fetched time.Time
+}
+
 type backendTransactions struct {
 	*TransactionsLocalDataProvider
 	staticCollectionRPC accessproto.AccessAPIClient // rpc client tied to a fixed collection node
-	transactions        storage.Transactions
+	// staticCollectionPendingTxRPC is the pending transactions client tied to the fixed collection node
+	staticCollectionPendingTxRPC pendingtx.PendingTransactionsAPIClient
+	transactions                 storage.Transactions
 	// NOTE: The transaction error message is currently only used by the access node and not by the observer node.
 	//       To avoid introducing unnecessary command line arguments in the observer, one case could be that the error
 	//       message cache is nil for the observer node.
@@ -48,6 +71,12 @@ type backendTransactions struct {
 	nodeCommunicator    Communicator
 	txResultCache       *lru.Cache[flow.Identifier, *accessmodel.TransactionResult]
 	txResultQueryMode   IndexQueryMode
+	// queryPendingTxStatus enables querying the collection nodes for the status of pending transactions
+	queryPendingTxStatus bool
+	// pendingDetailsCache caches the pending details of recently queried transactions
+	pendingDetailsCache *lru.Cache[flow.Identifier, pendingDetailsEntry]
+	// pendingDetailsLookups bounds the number of concurrent pending details lookups
+	pendingDetailsLookups chan struct{}
 
 	systemTxID                 flow.Identifier
 	systemTx                   *flow.TransactionBody
@@ -181,6 +210,129 @@ func (b *backendTransactions) grpcTxSend(ctx context.Context, client accessproto
 	return err
 }
 
+// GetPendingTransactionDetails queries the collection nodes of the cluster responsible for the transaction
+// for its status, and returns their aggregated answers. Collection nodes which could not be queried are
+// reported as unreachable. The answers are cached for a few seconds.
+//
+// Expected errors during normal operations:
+//   - codes.FailedPrecondition if querying the status of pending transactions is not enabled.
+//   - codes.ResourceExhausted if too many transactions are queried at the same time.
+//   - codes.Unavailable if the collection nodes responsible for the transaction could not be determined.
+func (b *backendTransactions) GetPendingTransactionDetails(
+	ctx context.Context,
+	txID flow.Identifier,
+) (*accessmodel.PendingTransactionDetails, error) {
+	if !b.queryPendingTxStatus {
+		return nil, status.Error(codes.FailedPrecondition, "querying the status of pending transactions is not enabled")
+	}
+	return b.getPendingTransactionDetails(ctx, txID)
+}
+
+// getPendingTransactionDetails returns the cached pending details of the transaction, or queries the
+// collection nodes for them.
+//
+// Expected errors during normal operations:
+//   - codes.ResourceExhausted if too many transactions are queried at the same time.
+//   - codes.Unavailable if the collection nodes responsible for the transaction could not be determined.
+func (b *backendTransactions) getPendingTransactionDetails(ctx context.Context, txID flow.Identifier) (*accessmodel.PendingTransactionDetails, error) {
+	if entry, ok := b.pendingDetailsCache.Get(txID); ok && time.Since(entry.fetched) < pendingDetailsCacheTTL {
+		return entry.details, nil
+	}
+
+	select {
+	case b.pendingDetailsLookups <- struct{}{}:
+		defer func() { <-b.pendingDetailsLookups }()
+	default:
+		return nil, status.Error(codes.ResourceExhausted, "too many concurrent pending transaction lookups")
+	}
+
+	details, err := b.queryPendingTransactionDetails(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	b.pendingDetailsCache.Add(txID, pendingDetailsEntry{details: details, fetched: time.Now()})
+	return details, nil
+}
+
+// queryPendingTransactionDetails queries the collection nodes of the cluster responsible for the
+// transaction for the status of the transaction, and aggregates their answers.
+//
+// Expected errors during normal operations:
+//   - codes.Unavailable if the collection nodes responsible for the transaction could not be determined.
+func (b *backendTransactions) queryPendingTransactionDetails(ctx context.Context, txID flow.Identifier) (*accessmodel.PendingTransactionDetails, error) {
+	req := &pendingtx.GetPendingTransactionStatusRequest{Id: txID[:]}
+
+	// if a collection node rpc client was provided at startup, just use that
+	if b.staticCollectionPendingTxRPC != nil {
+		nodeStatus := b.grpcTxStatus(ctx, b.staticCollectionPendingTxRPC, flow.ZeroID, req)
+		return accessmodel.NewPendingTransactionDetails([]accessmodel.CollectionNodeTransactionStatus{nodeStatus}), nil
+	}
+
+	collNodes, err := b.chooseCollectionNodes(txID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to determine collection nodes for transaction: %v", err)
+	}
+
+	nodeStatuses := make([]accessmodel.CollectionNodeTransactionStatus, len(collNodes))
+	var wg sync.WaitGroup
+	for i, node := range collNodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nodeStatuses[i] = b.getCollectorTransactionStatus(ctx, node, req)
+		}()
+	}
+	wg.Wait()
+
+	return accessmodel.NewPendingTransactionDetails(nodeStatuses), nil
+}
+
+// getCollectorTransactionStatus queries the given collection node for the status of a pending transaction.
+func (b *backendTransactions) getCollectorTransactionStatus(
+	ctx context.Context,
+	node *flow.IdentitySkeleton,
+	req *pendingtx.GetPendingTransactionStatusRequest,
+) accessmodel.CollectionNodeTransactionStatus {
+	client, closer, err := b.connFactory.GetPendingTransactionsAPIClient(node.Address)
+	if err != nil {
+		return accessmodel.CollectionNodeTransactionStatus{
+			NodeID:  node.NodeID,
+			Reason:  accessmodel.PendingReasonUnreachable,
+			Message: fmt.Sprintf("failed to connect to collection node at %s: %v", node.Address, err),
+		}
+	}
+	defer closer.Close()
+
+	return b.grpcTxStatus(ctx, client, node.NodeID, req)
+}
+
+func (b *backendTransactions) grpcTxStatus(
+	ctx context.Context,
+	client pendingtx.PendingTransactionsAPIClient,
+	nodeID flow.Identifier,
+	req *pendingtx.GetPendingTransactionStatusRequest,
+) accessmodel.CollectionNodeTransactionStatus {
+	clientDeadline := time.Now().Add(time.Duration(2) * time.Second)
+	ctx, cancel := context.WithDeadline(ctx, clientDeadline)
+	defer cancel()
+
+	resp, err := client.GetPendingTransactionStatus(ctx, req)
+	if err != nil {
+		return accessmodel.CollectionNodeTransactionStatus{
+			NodeID:  nodeID,
+			Reason:  accessmodel.PendingReasonUnreachable,
+			Message: fmt.Sprintf("failed to get transaction status from collection node: %v", err),
+		}
+	}
+
+	return accessmodel.CollectionNodeTransactionStatus{
+		NodeID:  nodeID,
+		Reason:  convert.MessageToPendingTransactionReason(resp.GetReason()),
+		Message: resp.GetMessage(),
+	}
+}
+
 // SendRawTransaction sends a raw transaction to the collection node
 func (b *backendTransactions) SendRawTransaction(
 	ctx context.Context,
@@ -341,6 +493,16 @@ func (b *backendTransactions) GetTransactionResult(
 			Status:        txStatus,
 			CollectionID:  collectionID,
 		}
+
+		// the transaction was not included in a block yet, ask the collection nodes why
+		if b.queryPendingTxStatus && txStatus == flow.TransactionStatusPending && block == nil {
+			// the details are best effort, the result is returned without them if they are not available
+			details, err := b.getPendingTransactionDetails(ctx, txID)
+			if err != nil {
+				b.log.Debug().Err(err).Hex("tx_id", txID[:]).Msg("failed to get pending transaction details")
+			}
+			txResult.PendingDetails = details
+		}
 	} else {
 		txResult.CollectionID = collectionID
 	}
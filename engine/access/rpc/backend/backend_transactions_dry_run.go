@@ -0,0 +1,176 @@
+package backend
+
+import (
+	"context"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/common/rpc"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/execution/computation/query"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/execution"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/logging"
+)
+
+type backendTransactionDryRun struct {
+	log            zerolog.Logger
+	chain          flow.Chain
+	state          protocol.State
+	headers        storage.Headers
+	scriptExecutor execution.ScriptExecutor
+	scriptExecMode IndexQueryMode
+}
+
+// DryRunTransactionAtLatestBlock executes the provided transaction against the latest sealed block
+// without submitting it to the network.
+func (b *backendTransactionDryRun) DryRunTransactionAtLatestBlock(
+	ctx context.Context,
+	tx *flow.TransactionBody,
+	options accessmodel.TransactionDryRunOptions,
+	requiredEventEncodingVersion entities.EventEncodingVersion,
+) (*accessmodel.TransactionDryRunResult, error) {
+	latestHeader, err := b.state.Sealed().Head()
+	if err != nil {
+		// the latest sealed header MUST be available
+		err := irrecoverable.NewExceptionf("failed to lookup sealed header: %w", err)
+		irrecoverable.Throw(ctx, err)
+		return nil, err
+	}
+
+	return b.dryRunTransaction(ctx, latestHeader, tx, options, requiredEventEncodingVersion)
+}
+
+// DryRunTransactionAtBlockID executes the provided transaction against the provided block ID
+// without submitting it to the network.
+func (b *backendTransactionDryRun) DryRunTransactionAtBlockID(
+	ctx context.Context,
+	blockID flow.Identifier,
+	tx *flow.TransactionBody,
+	options accessmodel.TransactionDryRunOptions,
+	requiredEventEncodingVersion entities.EventEncodingVersion,
+) (*accessmodel.TransactionDryRunResult, error) {
+	header, err := b.headers.ByBlockID(blockID)
+	if err != nil {
+		return nil, rpc.ConvertStorageError(err)
+	}
+
+	return b.dryRunTransaction(ctx, header, tx, options, requiredEventEncodingVersion)
+}
+
+// DryRunTransactionAtBlockHeight executes the provided transaction against the provided block height
+// without submitting it to the network.
+func (b *backendTransactionDryRun) DryRunTransactionAtBlockHeight(
+	ctx context.Context,
+	blockHeight uint64,
+	tx *flow.TransactionBody,
+	options accessmodel.TransactionDryRunOptions,
+	requiredEventEncodingVersion entities.EventEncodingVersion,
+) (*accessmodel.TransactionDryRunResult, error) {
+	header, err := b.headers.ByHeight(blockHeight)
+	if err != nil {
+		return nil, rpc.ConvertStorageError(resolveHeightError(b.state.Params(), blockHeight, err))
+	}
+
+	return b.dryRunTransaction(ctx, header, tx, options, requiredEventEncodingVersion)
+}
+
+// dryRunTransaction executes the transaction against the local execution state at the provided block.
+// Transactions can only be dry-run locally, since execution nodes do not provide an API for it.
+//
+// A failed transaction is reported using the status code and error message of the returned result.
+//
+// Expected errors:
+//   - codes.Unimplemented if local script execution is not enabled.
+//   - codes.InvalidArgument if the transaction is malformed.
+//   - codes.OutOfRange if the data for the block is not available.
+func (b *backendTransactionDryRun) dryRunTransaction(
+	ctx context.Context,
+	header *flow.Header,
+	tx *flow.TransactionBody,
+	options accessmodel.TransactionDryRunOptions,
+	requiredEventEncodingVersion entities.EventEncodingVersion,
+) (*accessmodel.TransactionDryRunResult, error) {
+	if b.scriptExecMode == IndexQueryModeExecutionNodesOnly {
+		return nil, status.Error(codes.Unimplemented, "transaction dry run requires local script execution")
+	}
+
+	err := b.validateTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	queryOptions := query.TransactionDryRunOptions{
+		AuthorizationChecksEnabled: options.VerifySignatures,
+		SequenceNumberCheckEnabled: options.CheckSequenceNumber,
+	}
+
+	result, err := b.scriptExecutor.DryRunTransaction(ctx, tx, queryOptions, header.Height)
+	if err != nil {
+		b.log.Debug().Err(err).
+			Hex("block_id", logging.ID(header.ID())).
+			Uint64("height", header.Height).
+			Msg("failed to dry run transaction")
+		return nil, rpc.ConvertIndexError(err, header.Height, "failed to dry run transaction")
+	}
+
+	// events are encoded in CCF format by the FVM. convert to JSON-CDC if requested
+	events := []flow.Event(result.Events)
+	if requiredEventEncodingVersion == entities.EventEncodingVersion_JSON_CDC_V0 {
+		events, err = convert.CcfEventsToJsonEvents(events)
+		if err != nil {
+			return nil, rpc.ConvertError(err, "failed to convert event payload", codes.Internal)
+		}
+	}
+
+	dryRun := &accessmodel.TransactionDryRunResult{
+		BlockID:         header.ID(),
+		BlockHeight:     header.Height,
+		ComputationUsed: result.ComputationUsed,
+		MemoryEstimate:  result.MemoryEstimate,
+		Fees:            result.Fees,
+		Events:          events,
+	}
+	if result.Err != nil {
+		dryRun.StatusCode = 1
+		dryRun.ErrorMessage = result.Err.Error()
+	}
+
+	return dryRun, nil
+}
+
+// validateTransaction performs the static checks on the transaction which do not depend on the
+// execution state. Signatures and sequence numbers are checked during execution if requested.
+//
+// Expected errors:
+//   - codes.InvalidArgument if the transaction is malformed.
+func (b *backendTransactionDryRun) validateTransaction(tx *flow.TransactionBody) error {
+	if tx == nil {
+		return status.Error(codes.InvalidArgument, "transaction is required")
+	}
+
+	if len(tx.Script) == 0 {
+		return status.Error(codes.InvalidArgument, "transaction script is required")
+	}
+
+	if tx.GasLimit > flow.DefaultMaxTransactionGasLimit {
+		return status.Errorf(codes.InvalidArgument, "transaction gas limit (%d) exceeds the maximum gas limit (%d)",
+			tx.GasLimit, flow.DefaultMaxTransactionGasLimit)
+	}
+
+	addresses := append([]flow.Address{tx.Payer, tx.ProposalKey.Address}, tx.Authorizers...)
+	for _, address := range addresses {
+		if !b.chain.IsValid(address) {
+			return status.Errorf(codes.InvalidArgument, "address %s is invalid for chain %s", address, b.chain.ChainID())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/execution/computation/query"
+	"github.com/onflow/flow-go/fvm/errors"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	execmock "github.com/onflow/flow-go/module/execution/mock"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestDryRunTransaction tests dry running transactions against the local execution state.
+func TestDryRunTransaction(t *testing.T) {
+	chain := flow.Testnet.Chain()
+	header := unittest.BlockHeaderFixture()
+
+	newTx := func() *flow.TransactionBody {
+		address := chain.ServiceAddress()
+		return flow.NewTransactionBody().
+			SetScript([]byte(`transaction { execute {} }`)).
+			SetComputeLimit(flow.DefaultMaxTransactionGasLimit).
+			SetProposalKey(address, 0, 0).
+			SetPayer(address).
+			AddAuthorizer(address)
+	}
+
+	newBackend := func(mode IndexQueryMode) (*backendTransactionDryRun, *execmock.ScriptExecutor) {
+		headers := storagemock.NewHeaders(t)
+		headers.On("ByBlockID", header.ID()).Return(header, nil).Maybe()
+
+		scriptExecutor := execmock.NewScriptExecutor(t)
+		return &backendTransactionDryRun{
+			log:            zerolog.Nop(),
+			chain:          chain,
+			headers:        headers,
+			scriptExecutor: scriptExecutor,
+			scriptExecMode: mode,
+		}, scriptExecutor
+	}
+
+	t.Run("successful transaction", func(t *testing.T) {
+		backend, scriptExecutor := newBackend(IndexQueryModeLocalOnly)
+		tx := newTx()
+
+		options := accessmodel.TransactionDryRunOptions{VerifySignatures: true}
+		expectedOptions := query.TransactionDryRunOptions{AuthorizationChecksEnabled: true}
+
+		scriptExecutor.
+			On("DryRunTransaction", mock.Anything, tx, expectedOptions, header.Height).
+			Return(&query.TransactionDryRunResult{
+				ComputationUsed: 10,
+				MemoryEstimate:  20,
+				Fees:            30,
+			}, nil).
+			Once()
+
+		result, err := backend.DryRunTransactionAtBlockID(context.Background(), header.ID(), tx, options, entities.EventEncodingVersion_CCF_V0)
+		require.NoError(t, err)
+
+		require.Equal(t, header.ID(), result.BlockID)
+		require.Equal(t, header.Height, result.BlockHeight)
+		require.Equal(t, uint(0), result.StatusCode)
+		require.Empty(t, result.ErrorMessage)
+		require.Equal(t, uint64(10), result.ComputationUsed)
+		require.Equal(t, uint64(20), result.MemoryEstimate)
+		require.Equal(t, uint64(30), result.Fees)
+	})
+
+	t.Run("failed transaction", func(t *testing.T) {
+		backend, scriptExecutor := newBackend(IndexQueryModeFailover)
+		tx := newTx()
+
+		txErr := errors.NewComputationLimitExceededError(flow.DefaultMaxTransactionGasLimit)
+		scriptExecutor.
+			On("DryRunTransaction", mock.Anything, tx, query.TransactionDryRunOptions{}, header.Height).
+			Return(&query.TransactionDryRunResult{Err: txErr}, nil).
+			Once()
+
+		result, err := backend.DryRunTransactionAtBlockID(context.Background(), header.ID(), tx, accessmodel.TransactionDryRunOptions{}, entities.EventEncodingVersion_CCF_V0)
+		require.NoError(t, err)
+
+		require.Equal(t, uint(1), result.StatusCode)
+		require.Equal(t, txErr.Error(), result.ErrorMessage)
+	})
+
+	t.Run("execution nodes only mode", func(t *testing.T) {
+		backend, _ := newBackend(IndexQueryModeExecutionNodesOnly)
+
+		_, err := backend.DryRunTransactionAtBlockID(context.Background(), header.ID(), newTx(), accessmodel.TransactionDryRunOptions{}, entities.EventEncodingVersion_CCF_V0)
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("invalid transaction", func(t *testing.T) {
+		backend, _ := newBackend(IndexQueryModeLocalOnly)
+
+		tx := newTx()
+		tx.Payer = flow.Emulator.Chain().ServiceAddress()
+
+		_, err := backend.DryRunTransactionAtBlockID(context.Background(), header.ID(), tx, accessmodel.TransactionDryRunOptions{}, entities.EventEncodingVersion_CCF_V0)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		tx = newTx()
+		tx.GasLimit = flow.DefaultMaxTransactionGasLimit + 1
+
+		_, err = backend.DryRunTransactionAtBlockID(context.Background(), header.ID(), tx, accessmodel.TransactionDryRunOptions{}, entities.EventEncodingVersion_CCF_V0)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/onflow/flow-go/engine/access/index"
 	connectionmock "github.com/onflow/flow-go/engine/access/rpc/connection/mock"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/common/rpc/pendingtx"
+	pendingtxmock "github.com/onflow/flow-go/engine/common/rpc/pendingtx/mock"
 	"github.com/onflow/flow-go/fvm/blueprints"
 	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
@@ -98,6 +100,174 @@ func (suite *Suite) TestGetTransactionResultReturnsUnknown() {
 	})
 }
 
+// TestGetTransactionResultPendingDetails tests that the status reported by the collection nodes is
+// returned for pending transactions
+func (suite *Suite) TestGetTransactionResultPendingDetails() {
+	suite.withPreConfiguredState(func(snap protocol.Snapshot) {
+		head, err := snap.Head()
+		suite.Require().NoError(err)
+
+		tx := unittest.TransactionBodyFixture()
+		tx.ReferenceBlockID = head.ID()
+		txID := tx.ID()
+		req := &pendingtx.GetPendingTransactionStatusRequest{Id: txID[:]}
+
+		suite.state.On("AtBlockID", head.ID()).Return(snap, nil)
+		suite.transactions.On("ByID", txID).Return(&tx, nil)
+		suite.collections.On("LightByTransactionID", txID).Return(nil, storage.ErrNotFound)
+
+		getResult := func(params Params) *accessmodel.TransactionResult {
+			backend, err := New(params)
+			suite.Require().NoError(err)
+
+			res, err := backend.GetTransactionResult(
+				context.Background(),
+				txID,
+				flow.ZeroID,
+				flow.ZeroID,
+				entities.EventEncodingVersion_JSON_CDC_V0,
+			)
+			suite.Require().NoError(err)
+			suite.Require().Equal(flow.TransactionStatusPending, res.Status)
+			return res
+		}
+
+		suite.Run("disabled", func() {
+			res := getResult(suite.defaultBackendParams())
+			suite.Assert().Nil(res.PendingDetails)
+		})
+
+		suite.Run("static collection node", func() {
+			pendingTxClient := pendingtxmock.NewPendingTransactionsAPIClient(suite.T())
+			pendingTxClient.
+				On("GetPendingTransactionStatus", mock.Anything, req).
+				Return(&pendingtx.PendingTransactionStatusResponse{
+					TransactionId: txID[:],
+					Reason:        pendingtx.PendingTransactionReason_PENDING_TRANSACTION_REASON_IN_MEMPOOL,
+					Message:       "in mempool",
+				}, nil).
+				Once()
+
+			params := suite.defaultBackendParams()
+			params.CollectionPendingTxRPC = pendingTxClient
+			params.QueryPendingTxStatus = true
+
+			res := getResult(params)
+			suite.Require().NotNil(res.PendingDetails)
+			suite.Assert().Equal(accessmodel.PendingReasonInMempool, res.PendingDetails.Reason)
+			suite.Assert().Equal("in mempool", res.PendingDetails.Message)
+			suite.Assert().Len(res.PendingDetails.Nodes, 1)
+			suite.Assert().Empty(res.ErrorMessage)
+		})
+
+		suite.Run("collection node cluster", func() {
+			epoch, err := snap.Epochs().Current()
+			suite.Require().NoError(err)
+			clusters, err := epoch.Clustering()
+			suite.Require().NoError(err)
+			cluster, ok := clusters.ByTxID(txID)
+			suite.Require().True(ok)
+
+			// the first collection node rejected the transaction, the others can not be reached
+			pendingTxClient := pendingtxmock.NewPendingTransactionsAPIClient(suite.T())
+			pendingTxClient.
+				On("GetPendingTransactionStatus", mock.Anything, req).
+				Return(&pendingtx.PendingTransactionStatusResponse{
+					TransactionId: txID[:],
+					Reason:        pendingtx.PendingTransactionReason_PENDING_TRANSACTION_REASON_REJECTED,
+					Message:       "rejected",
+				}, nil).
+				Once()
+			connFactory := connectionmock.NewConnectionFactory(suite.T())
+			connFactory.
+				On("GetPendingTransactionsAPIClient", cluster[0].Address).
+				Return(pendingTxClient, &mocks.MockCloser{}, nil)
+			connFactory.
+				On("GetPendingTransactionsAPIClient", mock.Anything).
+				Return(nil, nil, fmt.Errorf("unreachable")).
+				Maybe()
+
+			params := suite.defaultBackendParams()
+			params.CollectionRPC = nil
+			params.ConnFactory = connFactory
+			params.QueryPendingTxStatus = true
+
+			res := getResult(params)
+			suite.Require().NotNil(res.PendingDetails)
+			suite.Assert().Equal(accessmodel.PendingReasonRejected, res.PendingDetails.Reason)
+			suite.Assert().Equal("rejected", res.PendingDetails.Message)
+			suite.Require().Len(res.PendingDetails.Nodes, len(cluster))
+			for i, node := range res.PendingDetails.Nodes {
+				suite.Assert().Equal(cluster[i].NodeID, node.NodeID)
+				if i > 0 {
+					suite.Assert().Equal(accessmodel.PendingReasonUnreachable, node.Reason)
+				}
+			}
+		})
+	})
+}
+
+// TestGetPendingTransactionDetails tests that the pending details of a transaction are cached, and that
+// the number of concurrent lookups is bounded.
+func (suite *Suite) TestGetPendingTransactionDetails() {
+	txID := unittest.IdentifierFixture()
+	req := &pendingtx.GetPendingTransactionStatusRequest{Id: txID[:]}
+
+	suite.Run("disabled", func() {
+		backend, err := New(suite.defaultBackendParams())
+		suite.Require().NoError(err)
+
+		_, err = backend.GetPendingTransactionDetails(context.Background(), txID)
+		suite.Require().Error(err)
+		suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+	})
+
+	suite.Run("cached", func() {
+		// the collection node is queried only once, the second call is served from the cache
+		pendingTxClient := pendingtxmock.NewPendingTransactionsAPIClient(suite.T())
+		pendingTxClient.
+			On("GetPendingTransactionStatus", mock.Anything, req).
+			Return(&pendingtx.PendingTransactionStatusResponse{
+				TransactionId: txID[:],
+				Reason:        pendingtx.PendingTransactionReason_PENDING_TRANSACTION_REASON_RATE_LIMITED,
+				Message:       "rate limited",
+			}, nil).
+			Once()
+
+		params := suite.defaultBackendParams()
+		params.CollectionPendingTxRPC = pendingTxClient
+		params.QueryPendingTxStatus = true
+
+		backend, err := New(params)
+		suite.Require().NoError(err)
+
+		for i := 0; i < 2; i++ {
+			details, err := backend.GetPendingTransactionDetails(context.Background(), txID)
+			suite.Require().NoError(err)
+			suite.Assert().Equal(accessmodel.PendingReasonRateLimited, details.Reason)
+			suite.Assert().Equal("rate limited", details.Message)
+		}
+	})
+
+	suite.Run("too many concurrent lookups", func() {
+		params := suite.defaultBackendParams()
+		params.CollectionPendingTxRPC = pendingtxmock.NewPendingTransactionsAPIClient(suite.T())
+		params.QueryPendingTxStatus = true
+
+		backend, err := New(params)
+		suite.Require().NoError(err)
+
+		// occupy all lookup slots
+		for i := 0; i < maxConcurrentPendingDetailsLookups; i++ {
+			backend.backendTransactions.pendingDetailsLookups <- struct{}{}
+		}
+
+		_, err = backend.GetPendingTransactionDetails(context.Background(), txID)
+		suite.Require().Error(err)
+		suite.Assert().Equal(codes.ResourceExhausted, status.Code(err))
+	})
+}
+
 // TestGetTransactionResultReturnsTransactionError returns error from transaction storage
 func (suite *Suite) TestGetTransactionResultReturnsTransactionError() {
 	suite.withPreConfiguredState(func(snap protocol.Snapshot) {
@@ -19,6 +19,8 @@ type Config struct {
 	ScriptExecutionMode       string                          // the mode in which scripts are executed
 	EventQueryMode            string                          // the mode in which events are queried
 	TxResultQueryMode         string                          // the mode in which tx results are queried
+	NodeScoringEnabled        bool                            // whether execution nodes are selected by their latency, error rate and executed height
+	HedgeDelay                time.Duration                   // delay after which script and account requests are also sent to the next execution node, 0 to disable
 }
 
 type IndexQueryMode int
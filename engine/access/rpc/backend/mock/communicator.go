@@ -3,6 +3,8 @@
 package mock
 
 import (
+	context "context"
+
 	flow "github.com/onflow/flow-go/model/flow"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -30,6 +32,36 @@ func (_m *Communicator) CallAvailableNode(nodes flow.GenericIdentityList[flow.Id
 	return r0
 }
 
+// CallAvailableNodeHedged provides a mock function with given fields: ctx, nodes, call, shouldTerminateOnError
+func (_m *Communicator) CallAvailableNodeHedged(ctx context.Context, nodes flow.GenericIdentityList[flow.IdentitySkeleton], call func(context.Context, *flow.IdentitySkeleton) (interface{}, error), shouldTerminateOnError func(*flow.IdentitySkeleton, error) bool) (interface{}, error) {
+	ret := _m.Called(ctx, nodes, call, shouldTerminateOnError)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallAvailableNodeHedged")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.GenericIdentityList[flow.IdentitySkeleton], func(context.Context, *flow.IdentitySkeleton) (interface{}, error), func(*flow.IdentitySkeleton, error) bool) (interface{}, error)); ok {
+		return rf(ctx, nodes, call, shouldTerminateOnError)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.GenericIdentityList[flow.IdentitySkeleton], func(context.Context, *flow.IdentitySkeleton) (interface{}, error), func(*flow.IdentitySkeleton, error) bool) interface{}); ok {
+		r0 = rf(ctx, nodes, call, shouldTerminateOnError)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.GenericIdentityList[flow.IdentitySkeleton], func(context.Context, *flow.IdentitySkeleton) (interface{}, error), func(*flow.IdentitySkeleton, error) bool) error); ok {
+		r1 = rf(ctx, nodes, call, shouldTerminateOnError)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewCommunicator creates a new instance of Communicator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCommunicator(t interface {
@@ -1,6 +1,9 @@
 package backend
 
 import (
+	"context"
+	"time"
+
 	"github.com/hashicorp/go-multierror"
 	"github.com/sony/gobreaker"
 	"google.golang.org/grpc/codes"
@@ -23,6 +26,19 @@ type Communicator interface {
 		// It takes an error as input and returns a boolean value indicating whether the error should be considered terminal.
 		shouldTerminateOnError func(node *flow.IdentitySkeleton, err error) bool,
 	) error
+
+	CallAvailableNodeHedged(
+		ctx context.Context,
+		//List of node identifiers to execute callback on
+		nodes flow.IdentitySkeletonList,
+		//Callback function that represents an action to be performed on a node.
+		//It takes a node as input and returns the result of the action, or an error.
+		//The provided context is cancelled once the result of another node is used.
+		call func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error),
+		// Callback function that determines whether an error should terminate further execution.
+		// It takes an error as input and returns a boolean value indicating whether the error should be considered terminal.
+		shouldTerminateOnError func(node *flow.IdentitySkeleton, err error) bool,
+	) (interface{}, error)
 }
 
 var _ Communicator = (*NodeCommunicator)(nil)
@@ -30,6 +46,8 @@ var _ Communicator = (*NodeCommunicator)(nil)
 // NodeCommunicator is responsible for calling available nodes in the backend.
 type NodeCommunicator struct {
 	nodeSelectorFactory NodeSelectorFactory
+	scorer              *NodeScorer
+	hedgeDelay          time.Duration
 }
 
 // NewNodeCommunicator creates a new instance of NodeCommunicator.
@@ -39,6 +57,21 @@ func NewNodeCommunicator(circuitBreakerEnabled bool) *NodeCommunicator {
 	}
 }
 
+// NewScoringNodeCommunicator creates a new instance of NodeCommunicator, which sends requests to the nodes
+// with the best score first, and records the latency and outcome of all requests with the provided scorer.
+// If hedgeDelay is not zero, hedged calls send the request to the next node if the previous node did not
+// respond within hedgeDelay, and use the first successful response.
+func NewScoringNodeCommunicator(circuitBreakerEnabled bool, scorer *NodeScorer, hedgeDelay time.Duration) *NodeCommunicator {
+	return &NodeCommunicator{
+		nodeSelectorFactory: NodeSelectorFactory{
+			circuitBreakerEnabled: circuitBreakerEnabled,
+			scorer:                scorer,
+		},
+		scorer:     scorer,
+		hedgeDelay: hedgeDelay,
+	}
+}
+
 // CallAvailableNode calls the provided function on the available nodes.
 // It iterates through the nodes and executes the function.
 // If an error occurs, it applies the custom error terminator (if provided) and keeps track of the errors.
@@ -61,14 +94,20 @@ func (b *NodeCommunicator) CallAvailableNode(
 	}
 
 	for node := nodeSelector.Next(); node != nil; node = nodeSelector.Next() {
+		start := time.Now()
 		err := call(node)
 		if err == nil {
+			b.recordRequest(node, time.Since(start), nil)
 			return nil
 		}
 
-		if shouldTerminateOnError != nil && shouldTerminateOnError(node, err) {
+		terminal := shouldTerminateOnError != nil && shouldTerminateOnError(node, err)
+		if terminal {
+			// terminal errors are caused by the request, not by the node
+			b.recordRequest(node, time.Since(start), nil)
 			return err
 		}
+		b.recordRequest(node, time.Since(start), err)
 
 		if err == gobreaker.ErrOpenState {
 			if !nodeSelector.HasNext() && errs == nil {
@@ -85,3 +124,157 @@ func (b *NodeCommunicator) CallAvailableNode(
 
 	return errs.ErrorOrNil()
 }
+
+// hedgedResponse is the response of a single node to a hedged call.
+type hedgedResponse struct {
+	node     *flow.IdentitySkeleton
+	result   interface{}
+	err      error
+	duration time.Duration
+}
+
+// CallAvailableNodeHedged calls the provided function on the available nodes, and returns the result of the
+// first successful call.
+// If the communicator was created with a hedge delay, the call is sent to the next node whenever no node
+// responded within the hedge delay, or a node responded with a non-terminal error, so that a single slow
+// node does not delay the response. Outstanding calls are cancelled once the result is returned.
+// Without a hedge delay, the nodes are called one after another, the same as in CallAvailableNode.
+// If the error occurs in circuit breaker, it continues to the next node.
+// If the maximum failed request count is reached, it returns the accumulated errors.
+func (b *NodeCommunicator) CallAvailableNodeHedged(
+	ctx context.Context,
+	nodes flow.IdentitySkeletonList,
+	call func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error),
+	shouldTerminateOnError func(node *flow.IdentitySkeleton, err error) bool,
+) (interface{}, error) {
+	if b.hedgeDelay <= 0 {
+		var result interface{}
+		err := b.CallAvailableNode(
+			nodes,
+			func(node *flow.IdentitySkeleton) error {
+				var err error
+				result, err = call(ctx, node)
+				return err
+			},
+			shouldTerminateOnError,
+		)
+		return result, err
+	}
+
+	if len(nodes) == 0 {
+		return nil, status.Error(codes.Unavailable, "there are no available nodes")
+	}
+
+	nodeSelector, err := b.nodeSelectorFactory.SelectNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// the channel is buffered for all nodes, so that calls which finish after the result was returned
+	// do not block.
+	responses := make(chan hedgedResponse, len(nodes))
+
+	// outstanding tracks the start time of the calls without a response. Calls which are still outstanding
+	// when the result is returned are abandoned, and recorded as at least as slow as the hedge delay, so that
+	// a node which never wins a hedged call is not scored as if it was never used.
+	outstanding := make(map[flow.Identifier]time.Time, len(nodes))
+	defer func() {
+		for nodeID, callStart := range outstanding {
+			b.recordAbandonedRequest(nodeID, time.Since(callStart))
+		}
+	}()
+
+	start := func(node *flow.IdentitySkeleton) {
+		callStart := time.Now()
+		outstanding[node.NodeID] = callStart
+		go func() {
+			result, err := call(ctx, node)
+			responses <- hedgedResponse{node: node, result: result, err: err, duration: time.Since(callStart)}
+		}()
+	}
+
+	hedgeTimer := time.NewTimer(b.hedgeDelay)
+	defer hedgeTimer.Stop()
+
+	var errs *multierror.Error
+	inFlight := 0
+	startNext := func() {
+		if node := nodeSelector.Next(); node != nil {
+			start(node)
+			inFlight++
+		}
+		if !hedgeTimer.Stop() {
+			select {
+			case <-hedgeTimer.C:
+			default:
+			}
+		}
+		hedgeTimer.Reset(b.hedgeDelay)
+	}
+
+	startNext()
+	for inFlight > 0 {
+		select {
+		case <-hedgeTimer.C:
+			hedgeTimer.Reset(b.hedgeDelay)
+			if node := nodeSelector.Next(); node != nil {
+				start(node)
+				inFlight++
+			}
+
+		case response := <-responses:
+			inFlight--
+			delete(outstanding, response.node.NodeID)
+
+			if response.err == nil {
+				b.recordRequest(response.node, response.duration, nil)
+				return response.result, nil
+			}
+
+			if shouldTerminateOnError != nil && shouldTerminateOnError(response.node, response.err) {
+				b.recordRequest(response.node, response.duration, nil)
+				return nil, response.err
+			}
+			b.recordRequest(response.node, response.duration, response.err)
+
+			if response.err == gobreaker.ErrOpenState {
+				if !nodeSelector.HasNext() && inFlight == 0 && errs == nil {
+					errs = multierror.Append(errs, status.Error(codes.Unavailable, "there are no available nodes"))
+				}
+			} else {
+				errs = multierror.Append(errs, response.err)
+				if len(errs.Errors) >= maxFailedRequestCount {
+					return nil, errs.ErrorOrNil()
+				}
+			}
+
+			// replace the failed call with a call to the next node
+			startNext()
+		}
+	}
+
+	return nil, errs.ErrorOrNil()
+}
+
+// recordRequest records the latency and outcome of a request with the scorer, if scoring is enabled.
+// Requests rejected by the circuit breaker and requests cancelled by the caller are not recorded,
+// since they do not reflect the performance of the node.
+func (b *NodeCommunicator) recordRequest(node *flow.IdentitySkeleton, duration time.Duration, err error) {
+	if b.scorer == nil || err == gobreaker.ErrOpenState || status.Code(err) == codes.Canceled {
+		return
+	}
+	b.scorer.RecordRequest(node.NodeID, duration, err != nil)
+}
+
+// recordAbandonedRequest records a hedged call which was abandoned before the node responded. The call is
+// recorded as a successful request with a latency of at least the hedge delay, since the node was slower
+// than the node which provided the result.
+func (b *NodeCommunicator) recordAbandonedRequest(nodeID flow.Identifier, elapsed time.Duration) {
+	if b.scorer == nil {
+		return
+	}
+	b.scorer.RecordRequest(nodeID, max(elapsed, b.hedgeDelay), false)
+}
@@ -0,0 +1,156 @@
+package backend
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestNodeCommunicator_Hedged tests that hedged calls are sent to the next node if a node is slow or fails.
+func TestNodeCommunicator_Hedged(t *testing.T) {
+	nodes := unittest.IdentityListFixture(3, unittest.WithRole(flow.RoleExecution)).ToSkeleton()
+
+	newCommunicator := func(t *testing.T) (*NodeCommunicator, *NodeScorer) {
+		scorer := NewNodeScorer(unittest.Logger(), nil, metrics.NewNoopCollector())
+		// make sure the nodes are called in order
+		for i, node := range nodes {
+			scorer.RecordRequest(node.NodeID, time.Duration(i+1)*time.Millisecond, false)
+		}
+		return NewScoringNodeCommunicator(false, scorer, 10*time.Millisecond), scorer
+	}
+
+	t.Run("slow node is hedged", func(t *testing.T) {
+		communicator, scorer := newCommunicator(t)
+
+		var mu sync.Mutex
+		var called []flow.Identifier
+		result, err := communicator.CallAvailableNodeHedged(
+			context.Background(),
+			nodes,
+			func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error) {
+				mu.Lock()
+				called = append(called, node.NodeID)
+				mu.Unlock()
+
+				if node.NodeID == nodes[0].NodeID {
+					// the first node does not respond until the call is cancelled
+					<-ctx.Done()
+					return nil, status.Error(codes.Canceled, ctx.Err().Error())
+				}
+				return node.NodeID, nil
+			},
+			nil,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, nodes[1].NodeID, result)
+
+		mu.Lock()
+		assert.Equal(t, []flow.Identifier{nodes[0].NodeID, nodes[1].NodeID}, called)
+		mu.Unlock()
+
+		score, _ := scorer.Score(nodes[1].NodeID)
+		assert.Equal(t, uint64(2), score.Requests)
+	})
+
+	t.Run("slow node is ranked behind the node which answers", func(t *testing.T) {
+		scorer := NewNodeScorer(unittest.Logger(), nil, metrics.NewNoopCollector())
+		communicator := NewScoringNodeCommunicator(false, scorer, 10*time.Millisecond)
+
+		slow, fast := nodes[0], nodes[1]
+		// the slow node starts with the better score
+		scorer.RecordRequest(slow.NodeID, time.Millisecond, false)
+		scorer.RecordRequest(fast.NodeID, 2*time.Millisecond, false)
+
+		for i := 0; i < 5; i++ {
+			result, err := communicator.CallAvailableNodeHedged(
+				context.Background(),
+				flow.IdentitySkeletonList{slow, fast},
+				func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error) {
+					if node.NodeID == slow.NodeID {
+						<-ctx.Done()
+						return nil, status.Error(codes.Canceled, ctx.Err().Error())
+					}
+					return node.NodeID, nil
+				},
+				nil,
+			)
+			require.NoError(t, err)
+			assert.Equal(t, fast.NodeID, result)
+		}
+
+		// the abandoned calls are recorded with at least the hedge delay
+		score, _ := scorer.Score(slow.NodeID)
+		assert.GreaterOrEqual(t, score.LatencyP95, 10*time.Millisecond)
+
+		sorted := flow.IdentitySkeletonList{slow, fast}
+		scorer.Sort(sorted)
+		assert.Equal(t, flow.IdentitySkeletonList{fast, slow}, sorted)
+	})
+
+	t.Run("failed node is replaced", func(t *testing.T) {
+		communicator, scorer := newCommunicator(t)
+
+		result, err := communicator.CallAvailableNodeHedged(
+			context.Background(),
+			nodes,
+			func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error) {
+				if node.NodeID == nodes[2].NodeID {
+					return node.NodeID, nil
+				}
+				return nil, status.Error(codes.Unavailable, "unavailable")
+			},
+			nil,
+		)
+		require.NoError(t, err)
+		assert.Equal(t, nodes[2].NodeID, result)
+
+		score, _ := scorer.Score(nodes[0].NodeID)
+		assert.Equal(t, uint64(1), score.Failures)
+	})
+
+	t.Run("terminal error is returned", func(t *testing.T) {
+		communicator, _ := newCommunicator(t)
+
+		calls := 0
+		_, err := communicator.CallAvailableNodeHedged(
+			context.Background(),
+			nodes,
+			func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error) {
+				calls++
+				return nil, status.Error(codes.InvalidArgument, "invalid script")
+			},
+			func(node *flow.IdentitySkeleton, err error) bool {
+				return status.Code(err) == codes.InvalidArgument
+			},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("all nodes fail", func(t *testing.T) {
+		communicator, _ := newCommunicator(t)
+
+		_, err := communicator.CallAvailableNodeHedged(
+			context.Background(),
+			nodes,
+			func(ctx context.Context, node *flow.IdentitySkeleton) (interface{}, error) {
+				return nil, status.Error(codes.Unavailable, "unavailable")
+			},
+			nil,
+		)
+		assert.Error(t, err)
+
+		_, err = communicator.CallAvailableNodeHedged(context.Background(), nil, nil, nil)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
@@ -0,0 +1,336 @@
+package backend
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/storage"
+)
+
+const (
+	// latencyWindowSize is the number of most recent request latencies tracked for each node.
+	latencyWindowSize = 100
+
+	// latencySampleTTL is the duration after which latency samples are no longer used to score a node,
+	// so that nodes which were slow in the past are eventually tried again.
+	latencySampleTTL = 5 * time.Minute
+
+	// errorRateWeight is the weight of the latest request in the exponentially weighted error rate.
+	errorRateWeight = 0.2
+
+	// errorRateHalfLife is the duration after which the error rate of a node without new requests is halved.
+	errorRateHalfLife = time.Minute
+
+	// errorRatePenalty scales the latency score of a node by its error rate. A node which failed all its
+	// recent requests is scored as if it was (1 + errorRatePenalty) times slower.
+	errorRatePenalty = 10
+
+	// heightLagPenalty is the latency added to the score of a node for each block its executed height lags
+	// behind the highest executed height of all nodes.
+	heightLagPenalty = 50 * time.Millisecond
+)
+
+// NodeScore is a snapshot of the statistics and score of an upstream node.
+type NodeScore struct {
+	NodeID flow.Identifier
+	// Requests is the total number of requests sent to the node.
+	Requests uint64
+	// Failures is the total number of failed requests sent to the node.
+	Failures uint64
+	// LatencyP50, LatencyP95 and LatencyP99 are the latency percentiles of the recent requests.
+	LatencyP50 time.Duration
+	LatencyP95 time.Duration
+	LatencyP99 time.Duration
+	// ErrorRate is the exponentially weighted rate of failed requests, between 0 and 1.
+	ErrorRate float64
+	// ExecutedHeight is the highest block height the node produced an execution receipt for.
+	ExecutedHeight uint64
+	// HeightLag is the number of blocks the executed height of the node lags behind the highest
+	// executed height of all nodes.
+	HeightLag uint64
+	// Samples is the number of recent latency samples the score is based on. Nodes without samples are
+	// ranked by Sort with the median score of the other nodes.
+	Samples int
+	// Score of the node, lower is better.
+	Score float64
+}
+
+// latencySample is the latency of a single request.
+type latencySample struct {
+	latency  time.Duration
+	observed time.Time
+}
+
+// nodeStats are the statistics tracked for a single node.
+type nodeStats struct {
+	requests        uint64
+	failures        uint64
+	latencies       []latencySample // ring buffer of the most recent latencies
+	next            int             // index of the next latency sample in the ring buffer
+	errorRate       float64
+	errorRateUpdate time.Time
+	executedHeight  uint64
+}
+
+// NodeScorer tracks the latency percentiles and error rates of the requests sent to upstream nodes, along
+// with the executed height of execution nodes, and scores the nodes so that requests are sent to the best
+// nodes first.
+//
+// The score of a node is its p95 latency, scaled by its error rate, plus a penalty for each block its
+// executed height lags behind the other execution nodes. Nodes without recent latency samples are ranked
+// with the median score of the nodes which have samples, so that new nodes and nodes which have not been
+// used for a while are tried again, without being preferred over nodes which are known to be fast.
+//
+// All methods are safe for concurrent use.
+type NodeScorer struct {
+	log     zerolog.Logger
+	headers storage.Headers
+	metrics module.NodeScoreMetrics
+
+	mu                    sync.Mutex
+	nodes                 map[flow.Identifier]*nodeStats
+	highestExecutedHeight uint64
+	now                   func() time.Time
+}
+
+// NewNodeScorer creates a new NodeScorer. Headers are used to resolve the executed height of the
+// execution receipts reported to the scorer.
+func NewNodeScorer(log zerolog.Logger, headers storage.Headers, metrics module.NodeScoreMetrics) *NodeScorer {
+	return &NodeScorer{
+		log:     log.With().Str("component", "node_scorer").Logger(),
+		headers: headers,
+		metrics: metrics,
+		nodes:   make(map[flow.Identifier]*nodeStats),
+		now:     time.Now,
+	}
+}
+
+// RecordRequest records the latency and outcome of a request sent to the given node.
+func (s *NodeScorer) RecordRequest(nodeID flow.Identifier, latency time.Duration, failed bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	stats := s.stats(nodeID)
+
+	stats.requests++
+	if failed {
+		stats.failures++
+	}
+
+	if len(stats.latencies) < latencyWindowSize {
+		stats.latencies = append(stats.latencies, latencySample{latency: latency, observed: now})
+	} else {
+		stats.latencies[stats.next] = latencySample{latency: latency, observed: now}
+	}
+	stats.next = (stats.next + 1) % latencyWindowSize
+
+	outcome := 0.0
+	if failed {
+		outcome = 1.0
+	}
+	stats.errorRate = (1-errorRateWeight)*s.decayedErrorRate(stats, now) + errorRateWeight*outcome
+	stats.errorRateUpdate = now
+
+	s.reportMetrics(nodeID, stats, now)
+}
+
+// OnExecutionReceipt records the height of the block of the given execution receipt as executed by its executor.
+func (s *NodeScorer) OnExecutionReceipt(receipt *flow.ExecutionReceipt) {
+	header, err := s.headers.ByBlockID(receipt.ExecutionResult.BlockID)
+	if err != nil {
+		// receipts may be received before the block is known locally, in which case a later receipt
+		// of the same executor will update its executed height.
+		s.log.Debug().Err(err).
+			Hex("block_id", receipt.ExecutionResult.BlockID[:]).
+			Msg("could not find block of execution receipt")
+		return
+	}
+
+	s.ReportExecutedHeight(receipt.ExecutorID, header.Height)
+}
+
+// ReportExecutedHeight records that the given execution node executed the block at the given height.
+func (s *NodeScorer) ReportExecutedHeight(nodeID flow.Identifier, height uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.stats(nodeID)
+	if height <= stats.executedHeight {
+		return
+	}
+	stats.executedHeight = height
+	if height > s.highestExecutedHeight {
+		s.highestExecutedHeight = height
+	}
+
+	s.reportMetrics(nodeID, stats, s.now())
+}
+
+// Sort sorts the given nodes by ascending score, so that the best nodes come first.
+// Nodes without recent latency samples are scored with the median score of the given nodes which have
+// samples, plus their height lag penalty. Nodes with equal scores keep their relative order.
+func (s *NodeScorer) Sort(nodes flow.IdentitySkeletonList) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	scores := make(map[flow.Identifier]float64, len(nodes))
+	sampled := make([]float64, 0, len(nodes))
+	for _, node := range nodes {
+		stats, ok := s.nodes[node.NodeID]
+		if !ok {
+			continue
+		}
+		score := s.score(stats, now)
+		if score.Samples > 0 {
+			scores[node.NodeID] = score.Score
+			sampled = append(sampled, score.Score)
+		}
+	}
+
+	neutral := median(sampled)
+	for _, node := range nodes {
+		if _, ok := scores[node.NodeID]; ok {
+			continue
+		}
+		scores[node.NodeID] = neutral
+		if stats, ok := s.nodes[node.NodeID]; ok {
+			scores[node.NodeID] += s.score(stats, now).Score
+		}
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return scores[nodes[i].NodeID] < scores[nodes[j].NodeID]
+	})
+}
+
+// Score returns the current score of the given node, and false if no statistics are tracked for the node.
+func (s *NodeScorer) Score(nodeID flow.Identifier) (NodeScore, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats, ok := s.nodes[nodeID]
+	if !ok {
+		return NodeScore{}, false
+	}
+
+	score := s.score(stats, s.now())
+	score.NodeID = nodeID
+	return score, true
+}
+
+// Scores returns the current scores of all tracked nodes, ordered by ascending score.
+func (s *NodeScorer) Scores() []NodeScore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	scores := make([]NodeScore, 0, len(s.nodes))
+	for nodeID, stats := range s.nodes {
+		score := s.score(stats, now)
+		score.NodeID = nodeID
+		scores = append(scores, score)
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score < scores[j].Score
+		}
+		return scores[i].NodeID.String() < scores[j].NodeID.String()
+	})
+	return scores
+}
+
+// stats returns the statistics of the given node, creating them if the node is not tracked yet.
+// The caller must hold the lock.
+func (s *NodeScorer) stats(nodeID flow.Identifier) *nodeStats {
+	stats, ok := s.nodes[nodeID]
+	if !ok {
+		stats = &nodeStats{
+			latencies: make([]latencySample, 0, latencyWindowSize),
+		}
+		s.nodes[nodeID] = stats
+	}
+	return stats
+}
+
+// score computes the score of the given node statistics. The caller must hold the lock.
+func (s *NodeScorer) score(stats *nodeStats, now time.Time) NodeScore {
+	latencies := make([]time.Duration, 0, len(stats.latencies))
+	for _, sample := range stats.latencies {
+		if now.Sub(sample.observed) <= latencySampleTTL {
+			latencies = append(latencies, sample.latency)
+		}
+	}
+	slices.Sort(latencies)
+
+	score := NodeScore{
+		Requests:       stats.requests,
+		Failures:       stats.failures,
+		LatencyP50:     percentile(latencies, 0.50),
+		LatencyP95:     percentile(latencies, 0.95),
+		LatencyP99:     percentile(latencies, 0.99),
+		ErrorRate:      s.decayedErrorRate(stats, now),
+		ExecutedHeight: stats.executedHeight,
+		Samples:        len(latencies),
+	}
+
+	// nodes with unknown executed height are not penalized
+	if stats.executedHeight > 0 {
+		score.HeightLag = s.highestExecutedHeight - stats.executedHeight
+	}
+
+	score.Score = float64(score.LatencyP95)*(1+errorRatePenalty*score.ErrorRate) +
+		float64(score.HeightLag)*float64(heightLagPenalty)
+
+	return score
+}
+
+// decayedErrorRate returns the error rate of the node, decayed by the time passed since its last update.
+func (s *NodeScorer) decayedErrorRate(stats *nodeStats, now time.Time) float64 {
+	if stats.errorRateUpdate.IsZero() {
+		return 0
+	}
+	elapsed := now.Sub(stats.errorRateUpdate)
+	return stats.errorRate * math.Pow(0.5, float64(elapsed)/float64(errorRateHalfLife))
+}
+
+// reportMetrics reports the current score of the given node. The caller must hold the lock.
+func (s *NodeScorer) reportMetrics(nodeID flow.Identifier, stats *nodeStats, now time.Time) {
+	score := s.score(stats, now)
+	s.metrics.NodeScoreUpdated(nodeID, score.LatencyP50, score.LatencyP99, score.ErrorRate, score.HeightLag, score.Score)
+}
+
+// median returns the median of the given scores, or 0 if there are no scores.
+func median(scores []float64) float64 {
+	if len(scores) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(scores)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// percentile returns the given percentile of the sorted latencies, or 0 if there are no latencies.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	index := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if index < 0 {
+		index = 0
+	}
+	return sorted[index]
+}
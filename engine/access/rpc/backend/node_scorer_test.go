@@ -0,0 +1,127 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// newTestNodeScorer returns a node scorer with a controllable clock.
+func newTestNodeScorer(t *testing.T, headers *storagemock.Headers) (*NodeScorer, *time.Time) {
+	now := time.Now()
+	scorer := NewNodeScorer(unittest.Logger(), headers, metrics.NewNoopCollector())
+	scorer.now = func() time.Time { return now }
+	return scorer, &now
+}
+
+// TestNodeScorer_Sort tests that nodes are ordered by their latency, error rate and executed height lag.
+func TestNodeScorer_Sort(t *testing.T) {
+	scorer, _ := newTestNodeScorer(t, nil)
+	nodes := unittest.IdentityListFixture(4, unittest.WithRole(flow.RoleExecution)).ToSkeleton()
+
+	fast, slow, failing, unknown := nodes[0], nodes[1], nodes[2], nodes[3]
+	for i := 0; i < 10; i++ {
+		scorer.RecordRequest(fast.NodeID, 10*time.Millisecond, false)
+		scorer.RecordRequest(slow.NodeID, 100*time.Millisecond, false)
+		scorer.RecordRequest(failing.NodeID, 20*time.Millisecond, true)
+	}
+
+	// the unknown node is ranked with the median score, which is the score of the slow node
+	sorted := flow.IdentitySkeletonList{failing, slow, fast, unknown}
+	scorer.Sort(sorted)
+	assert.Equal(t, flow.IdentitySkeletonList{fast, slow, unknown, failing}, sorted)
+
+	sorted = flow.IdentitySkeletonList{unknown, failing, fast}
+	scorer.Sort(sorted)
+	assert.Equal(t, flow.IdentitySkeletonList{fast, unknown, failing}, sorted)
+
+	// a lagging executed height is penalized
+	scorer.ReportExecutedHeight(slow.NodeID, 100)
+	scorer.ReportExecutedHeight(fast.NodeID, 90)
+
+	sorted = flow.IdentitySkeletonList{fast, slow}
+	scorer.Sort(sorted)
+	assert.Equal(t, flow.IdentitySkeletonList{slow, fast}, sorted)
+
+	score, ok := scorer.Score(fast.NodeID)
+	require.True(t, ok)
+	assert.Equal(t, uint64(10), score.HeightLag)
+	assert.Equal(t, uint64(90), score.ExecutedHeight)
+
+	_, ok = scorer.Score(unknown.NodeID)
+	assert.False(t, ok)
+}
+
+// TestNodeScorer_Percentiles tests the latency percentiles of a node, and that only recent samples are used.
+func TestNodeScorer_Percentiles(t *testing.T) {
+	scorer, now := newTestNodeScorer(t, nil)
+	nodeID := unittest.IdentifierFixture()
+
+	for i := 1; i <= latencyWindowSize+10; i++ {
+		scorer.RecordRequest(nodeID, time.Duration(i)*time.Millisecond, false)
+	}
+
+	// only the most recent samples are tracked
+	score, ok := scorer.Score(nodeID)
+	require.True(t, ok)
+	assert.Equal(t, uint64(latencyWindowSize+10), score.Requests)
+	assert.Equal(t, 60*time.Millisecond, score.LatencyP50)
+	assert.Equal(t, 105*time.Millisecond, score.LatencyP95)
+	assert.Equal(t, 109*time.Millisecond, score.LatencyP99)
+
+	// expired samples are not used
+	*now = now.Add(latencySampleTTL + time.Second)
+	score, _ = scorer.Score(nodeID)
+	assert.Zero(t, score.LatencyP99)
+	assert.Zero(t, score.Score)
+}
+
+// TestNodeScorer_ErrorRateDecay tests that the error rate of a node decays over time.
+func TestNodeScorer_ErrorRateDecay(t *testing.T) {
+	scorer, now := newTestNodeScorer(t, nil)
+	nodeID := unittest.IdentifierFixture()
+
+	for i := 0; i < 5; i++ {
+		scorer.RecordRequest(nodeID, time.Millisecond, true)
+	}
+
+	score, _ := scorer.Score(nodeID)
+	assert.Equal(t, uint64(5), score.Failures)
+	assert.InDelta(t, 1-0.8*0.8*0.8*0.8*0.8, score.ErrorRate, 1e-9)
+
+	*now = now.Add(errorRateHalfLife)
+	decayed, _ := scorer.Score(nodeID)
+	assert.InDelta(t, score.ErrorRate/2, decayed.ErrorRate, 1e-9)
+	assert.Less(t, decayed.Score, score.Score)
+}
+
+// TestNodeScorer_OnExecutionReceipt tests that the executed height of a node is resolved from its receipts.
+func TestNodeScorer_OnExecutionReceipt(t *testing.T) {
+	headers := storagemock.NewHeaders(t)
+	scorer, _ := newTestNodeScorer(t, headers)
+
+	header := unittest.BlockHeaderFixture()
+	receipt := unittest.ExecutionReceiptFixture(unittest.WithResult(
+		unittest.ExecutionResultFixture(unittest.WithBlock(&flow.Block{Header: header})),
+	))
+	headers.On("ByBlockID", header.ID()).Return(header, nil).Once()
+
+	scorer.OnExecutionReceipt(receipt)
+
+	score, ok := scorer.Score(receipt.ExecutorID)
+	require.True(t, ok)
+	assert.Equal(t, header.Height, score.ExecutedHeight)
+
+	// receipts for unknown blocks are ignored
+	headers.On("ByBlockID", mock.Anything).Return(nil, assert.AnError).Once()
+	scorer.OnExecutionReceipt(unittest.ExecutionReceiptFixture())
+	assert.Len(t, scorer.Scores(), 1)
+}
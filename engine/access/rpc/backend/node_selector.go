@@ -20,8 +20,11 @@ type NodeSelector interface {
 // Supported configurations:
 // circuitBreakerEnabled = true - nodes will be pseudo-randomly sampled and picked in-order.
 // circuitBreakerEnabled = false - nodes will be picked from proposed list in-order without any changes.
+// If a scorer is configured, the nodes are shuffled and then ordered by their score, so that the nodes with
+// the lowest latency, error rate and executed height lag are picked first.
 type NodeSelectorFactory struct {
 	circuitBreakerEnabled bool
+	scorer                *NodeScorer
 }
 
 // NewNodeSelectorFactory creates a new instance of NodeSelectorFactory with the provided circuit breaker configuration.
@@ -40,6 +43,10 @@ func NewNodeSelectorFactory(circuitBreakerEnabled bool) *NodeSelectorFactory {
 // SelectNodes selects the configured number of node identities from the provided list of nodes
 // and returns the node selector to iterate through them.
 func (n *NodeSelectorFactory) SelectNodes(nodes flow.IdentitySkeletonList) (NodeSelector, error) {
+	if n.scorer != nil {
+		return n.selectScoredNodes(nodes)
+	}
+
 	var err error
 	// If the circuit breaker is disabled, the legacy logic should be used, which selects only a specified number of nodes.
 	if !n.circuitBreakerEnabled {
@@ -52,6 +59,26 @@ func (n *NodeSelectorFactory) SelectNodes(nodes flow.IdentitySkeletonList) (Node
 	return NewMainNodeSelector(nodes), nil
 }
 
+// selectScoredNodes orders the provided nodes by ascending score, and returns the node selector to iterate
+// through them. Nodes are shuffled before they are ordered, so that nodes with equal scores, such as nodes
+// without recent requests, are picked in random order.
+func (n *NodeSelectorFactory) selectScoredNodes(nodes flow.IdentitySkeletonList) (NodeSelector, error) {
+	// sampling all nodes returns a shuffled copy, leaving the provided list unchanged
+	shuffled, err := nodes.Sample(uint(len(nodes)))
+	if err != nil {
+		return nil, fmt.Errorf("sampling failed: %w", err)
+	}
+
+	n.scorer.Sort(shuffled)
+
+	// If the circuit breaker is disabled, only the specified number of best nodes are selected.
+	if !n.circuitBreakerEnabled && len(shuffled) > commonrpc.MaxNodesCnt {
+		shuffled = shuffled[:commonrpc.MaxNodesCnt]
+	}
+
+	return NewMainNodeSelector(shuffled), nil
+}
+
 // MainNodeSelector is a specific implementation of the node selector.
 // Which performs in-order node selection using fixed list of pre-defined nodes.
 type MainNodeSelector struct {
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/onflow/flow-go/engine/common/version"
+	"github.com/onflow/flow-go/engine/execution/computation/query"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/execution"
 	"github.com/onflow/flow-go/module/state_synchronization"
@@ -102,6 +103,22 @@ func (s *ScriptExecutor) ExecuteAtBlockHeight(ctx context.Context, script []byte
 	return s.scriptExecutor.ExecuteAtBlockHeight(ctx, script, arguments, height)
 }
 
+// ExecuteAtBlockHeightWithReport executes provided script at the provided block height against a local execution state,
+// and returns a report of the resources used along with the result.
+//
+// Expected errors:
+//   - storage.ErrNotFound if the register or block height is not found
+//   - storage.ErrHeightNotIndexed if the ScriptExecutor is not initialized, or if the height is not indexed yet,
+//     or if the height is before the lowest indexed height.
+//   - ErrIncompatibleNodeVersion if the block height is not compatible with the node version.
+func (s *ScriptExecutor) ExecuteAtBlockHeightWithReport(ctx context.Context, script []byte, arguments [][]byte, height uint64) ([]byte, *query.ScriptExecutionReport, error) {
+	if err := s.checkHeight(height); err != nil {
+		return nil, nil, err
+	}
+
+	return s.scriptExecutor.ExecuteAtBlockHeightWithReport(ctx, script, arguments, height)
+}
+
 // GetAccountAtBlockHeight returns the account at the provided block height from a local execution state.
 //
 // Expected errors:
@@ -173,6 +190,27 @@ func (s *ScriptExecutor) GetAccountKey(ctx context.Context, address flow.Address
 	return s.scriptExecutor.GetAccountKey(ctx, address, keyIndex, height)
 }
 
+// DryRunTransaction executes the provided transaction at the provided block height against a local execution
+// state, without committing any of its changes.
+//
+// Expected errors:
+//   - storage.ErrNotFound if the register or block height is not found
+//   - storage.ErrHeightNotIndexed if the ScriptExecutor is not initialized, or if the height is not indexed yet,
+//     or if the height is before the lowest indexed height.
+//   - ErrIncompatibleNodeVersion if the block height is not compatible with the node version.
+func (s *ScriptExecutor) DryRunTransaction(
+	ctx context.Context,
+	tx *flow.TransactionBody,
+	options query.TransactionDryRunOptions,
+	height uint64,
+) (*query.TransactionDryRunResult, error) {
+	if err := s.checkHeight(height); err != nil {
+		return nil, err
+	}
+
+	return s.scriptExecutor.DryRunTransaction(ctx, tx, options, height)
+}
+
 // checkHeight checks if the provided block height is within the range of indexed heights
 // and compatible with the node's version.
 //
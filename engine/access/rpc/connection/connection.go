@@ -11,6 +11,8 @@ import (
 	"github.com/onflow/flow/protobuf/go/flow/execution"
 	"github.com/rs/zerolog"
 
+	"github.com/onflow/flow-go/engine/common/rpc/pendingtx"
+	"github.com/onflow/flow-go/engine/common/rpc/registerproofs"
 	"github.com/onflow/flow-go/module"
 )
 
@@ -27,6 +29,12 @@ type ConnectionFactory interface {
 	// GetExecutionAPIClient gets an execution API client for the specified address using the default ExecutionGRPCPort.
 	// The returned io.Closer should close the connection after the call if no error occurred during client creation.
 	GetExecutionAPIClient(address string) (execution.ExecutionAPIClient, io.Closer, error)
+	// GetRegisterProofsAPIClient gets a register proofs API client for the specified address using the default ExecutionGRPCPort.
+	// The returned io.Closer should close the connection after the call if no error occurred during client creation.
+	GetRegisterProofsAPIClient(address string) (registerproofs.RegisterProofsAPIClient, io.Closer, error)
+	// GetPendingTransactionsAPIClient gets a pending transactions API client for the specified address using the default CollectionGRPCPort.
+	// The returned io.Closer should close the connection after the call if no error occurred during client creation.
+	GetPendingTransactionsAPIClient(address string) (pendingtx.PendingTransactionsAPIClient, io.Closer, error)
 }
 
 // ProxyConnectionFactory wraps an existing ConnectionFactory and allows getting API clients for a target address.
@@ -48,6 +56,12 @@ func (p *ProxyConnectionFactory) GetExecutionAPIClient(address string) (executio
 	return p.ConnectionFactory.GetExecutionAPIClient(p.targetAddress)
 }
 
+// GetRegisterProofsAPIClient gets a register proofs API client for a target address using the default ExecutionGRPCPort.
+// The returned io.Closer should close the connection after the call if no error occurred during client creation.
+func (p *ProxyConnectionFactory) GetRegisterProofsAPIClient(address string) (registerproofs.RegisterProofsAPIClient, io.Closer, error) {
+	return p.ConnectionFactory.GetRegisterProofsAPIClient(p.targetAddress)
+}
+
 var _ ConnectionFactory = (*ConnectionFactoryImpl)(nil)
 
 type ConnectionFactoryImpl struct {
@@ -99,6 +113,38 @@ func (cf *ConnectionFactoryImpl) GetExecutionAPIClient(address string) (executio
 	return execution.NewExecutionAPIClient(conn), closer, nil
 }
 
+// GetRegisterProofsAPIClient gets a register proofs API client for the specified address using the default ExecutionGRPCPort.
+// The returned io.Closer should close the connection after the call if no error occurred during client creation.
+func (cf *ConnectionFactoryImpl) GetRegisterProofsAPIClient(address string) (registerproofs.RegisterProofsAPIClient, io.Closer, error) {
+	grpcAddress, err := getGRPCAddress(address, cf.ExecutionGRPCPort)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, closer, err := cf.Manager.GetConnection(grpcAddress, cf.ExecutionNodeGRPCTimeout, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return registerproofs.NewRegisterProofsAPIClient(conn), closer, nil
+}
+
+// GetPendingTransactionsAPIClient gets a pending transactions API client for the specified address using the default CollectionGRPCPort.
+// The returned io.Closer should close the connection after the call if no error occurred during client creation.
+func (cf *ConnectionFactoryImpl) GetPendingTransactionsAPIClient(address string) (pendingtx.PendingTransactionsAPIClient, io.Closer, error) {
+	grpcAddress, err := getGRPCAddress(address, cf.CollectionGRPCPort)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, closer, err := cf.Manager.GetConnection(grpcAddress, cf.CollectionNodeGRPCTimeout, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pendingtx.NewPendingTransactionsAPIClient(conn), closer, nil
+}
+
 // getGRPCAddress translates the flow.Identity address to the GRPC address of the node by switching the port to the
 // GRPC port from the libp2p port.
 func getGRPCAddress(address string, grpcPort uint) (string, error) {
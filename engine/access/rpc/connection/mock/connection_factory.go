@@ -12,6 +12,10 @@ import (
 	io "io"
 
 	mock "github.com/stretchr/testify/mock"
+
+	pendingtx "github.com/onflow/flow-go/engine/common/rpc/pendingtx"
+
+	registerproofs "github.com/onflow/flow-go/engine/common/rpc/registerproofs"
 )
 
 // ConnectionFactory is an autogenerated mock type for the ConnectionFactory type
@@ -136,6 +140,84 @@ func (_m *ConnectionFactory) GetExecutionAPIClient(address string) (execution.Ex
 	return r0, r1, r2
 }
 
+// GetPendingTransactionsAPIClient provides a mock function with given fields: address
+func (_m *ConnectionFactory) GetPendingTransactionsAPIClient(address string) (pendingtx.PendingTransactionsAPIClient, io.Closer, error) {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingTransactionsAPIClient")
+	}
+
+	var r0 pendingtx.PendingTransactionsAPIClient
+	var r1 io.Closer
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) (pendingtx.PendingTransactionsAPIClient, io.Closer, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) pendingtx.PendingTransactionsAPIClient); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pendingtx.PendingTransactionsAPIClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) io.Closer); ok {
+		r1 = rf(address)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.Closer)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(address)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetRegisterProofsAPIClient provides a mock function with given fields: address
+func (_m *ConnectionFactory) GetRegisterProofsAPIClient(address string) (registerproofs.RegisterProofsAPIClient, io.Closer, error) {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegisterProofsAPIClient")
+	}
+
+	var r0 registerproofs.RegisterProofsAPIClient
+	var r1 io.Closer
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) (registerproofs.RegisterProofsAPIClient, io.Closer, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) registerproofs.RegisterProofsAPIClient); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(registerproofs.RegisterProofsAPIClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) io.Closer); ok {
+		r1 = rf(address)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.Closer)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(address)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewConnectionFactory creates a new instance of ConnectionFactory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewConnectionFactory(t interface {
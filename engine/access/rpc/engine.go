@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/access/ratelimit"
 	"github.com/onflow/flow-go/consensus/hotstuff/model"
 	"github.com/onflow/flow-go/engine/access/rest"
 	"github.com/onflow/flow-go/engine/access/rest/websockets"
@@ -74,6 +75,8 @@ type Engine struct {
 
 	stateStreamBackend state_stream.API
 	stateStreamConfig  statestreambackend.Config
+
+	restRateLimiter *ratelimit.ClientLimiter // optional per client limiter for the REST server
 }
 type Option func(*RPCEngineBuilder)
 
@@ -253,6 +256,7 @@ func (e *Engine) serveREST(ctx irrecoverable.SignalerContext, ready component.Re
 		e.stateStreamConfig,
 		e.config.EnableWebSocketsStreamAPI,
 		e.config.WebSocketConfig,
+		e.restRateLimiter,
 	)
 	if err != nil {
 		e.log.Err(err).Msg("failed to initialize the REST server")
@@ -9,7 +9,9 @@ import (
 	"google.golang.org/grpc"
 
 	legacyaccess "github.com/onflow/flow-go/access/legacy"
+	"github.com/onflow/flow-go/access/ratelimit"
 	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/state_synchronization"
 )
@@ -87,6 +89,14 @@ func (builder *RPCEngineBuilder) DefaultHandler(signerIndicesDecoder hotstuff.Bl
 	}
 }
 
+// WithRestRateLimiter specifies that the given limiter should be applied to the requests of each
+// client of the REST server, including websocket connection requests.
+// Returns self-reference for chaining.
+func (builder *RPCEngineBuilder) WithRestRateLimiter(limiter *ratelimit.ClientLimiter) *RPCEngineBuilder {
+	builder.restRateLimiter = limiter
+	return builder
+}
+
 // WithMetrics specifies the metrics should be collected.
 // Returns self-reference for chaining.
 func (builder *RPCEngineBuilder) WithMetrics() *RPCEngineBuilder {
@@ -115,5 +125,18 @@ func (builder *RPCEngineBuilder) Build() (*Engine, error) {
 	builder.secureGrpcServer.RegisterService(func(s *grpc.Server) {
 		accessproto.RegisterAccessAPIServer(s, rpcHandler)
 	})
+
+	// the extended API is served from the local backend, like the REST API, if the handler does not implement it.
+	// this is the case for the observer's upstream router.
+	extendedHandler, ok := rpcHandler.(extended.ExtendedAccessAPIServer)
+	if !ok {
+		extendedHandler = builder.DefaultHandler(nil)
+	}
+	builder.unsecureGrpcServer.RegisterService(func(s *grpc.Server) {
+		extended.RegisterExtendedAccessAPIServer(s, extendedHandler)
+	})
+	builder.secureGrpcServer.RegisterService(func(s *grpc.Server) {
+		extended.RegisterExtendedAccessAPIServer(s, extendedHandler)
+	})
 	return builder.Engine, nil
 }
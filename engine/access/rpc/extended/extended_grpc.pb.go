@@ -0,0 +1,549 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: engine/access/rpc/extended/extended.proto
+
+package extended
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ExtendedAccessAPIClient is the client API for ExtendedAccessAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExtendedAccessAPIClient interface {
+	// GetTransactionsByAddress returns a page of the transactions which touched the given account,
+	// ordered by descending block height and transaction index.
+	GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*AccountTransactionsResponse, error)
+	// GetContractHistory returns all versions of the given contract which were deployed, updated or removed
+	// within the indexed blocks, ordered from oldest to newest.
+	GetContractHistory(ctx context.Context, in *GetContractHistoryRequest, opts ...grpc.CallOption) (*ContractHistoryResponse, error)
+	// GetContractAtBlockHeight returns the version of the given contract which was live at the given block height.
+	GetContractAtBlockHeight(ctx context.Context, in *GetContractAtBlockHeightRequest, opts ...grpc.CallOption) (*ContractVersionResponse, error)
+	// ExecuteScriptAtLatestBlockWithReport executes the script at the latest sealed block, and returns the
+	// encoded value along with the resources used by the script.
+	ExecuteScriptAtLatestBlockWithReport(ctx context.Context, in *ExecuteScriptAtLatestBlockWithReportRequest, opts ...grpc.CallOption) (*ExecuteScriptWithReportResponse, error)
+	// ExecuteScriptAtBlockHeightWithReport executes the script at the given block height, and returns the
+	// encoded value along with the resources used by the script.
+	ExecuteScriptAtBlockHeightWithReport(ctx context.Context, in *ExecuteScriptAtBlockHeightWithReportRequest, opts ...grpc.CallOption) (*ExecuteScriptWithReportResponse, error)
+	// ExecuteScriptAtBlockIDWithReport executes the script at the given block ID, and returns the encoded
+	// value along with the resources used by the script.
+	ExecuteScriptAtBlockIDWithReport(ctx context.Context, in *ExecuteScriptAtBlockIDWithReportRequest, opts ...grpc.CallOption) (*ExecuteScriptWithReportResponse, error)
+	// GetPendingTransactionDetails queries the collection nodes of the cluster responsible for the transaction
+	// for its status, and returns their aggregated answers.
+	GetPendingTransactionDetails(ctx context.Context, in *GetPendingTransactionDetailsRequest, opts ...grpc.CallOption) (*PendingTransactionDetailsResponse, error)
+	// DryRunTransactionAtLatestBlock executes the transaction against the latest sealed block without
+	// submitting it, and returns the computation used, the fees that would be charged and the emitted events.
+	DryRunTransactionAtLatestBlock(ctx context.Context, in *DryRunTransactionAtLatestBlockRequest, opts ...grpc.CallOption) (*DryRunTransactionResponse, error)
+	// DryRunTransactionAtBlockHeight executes the transaction against the block at the given height
+	// without submitting it.
+	DryRunTransactionAtBlockHeight(ctx context.Context, in *DryRunTransactionAtBlockHeightRequest, opts ...grpc.CallOption) (*DryRunTransactionResponse, error)
+	// DryRunTransactionAtBlockID executes the transaction against the block with the given ID without
+	// submitting it.
+	DryRunTransactionAtBlockID(ctx context.Context, in *DryRunTransactionAtBlockIDRequest, opts ...grpc.CallOption) (*DryRunTransactionResponse, error)
+	// GetEventsForHeightRangeWithFieldFilters returns a page of the events with the given type emitted in the
+	// sealed blocks of the height range, whose decoded payload matches all the field filters.
+	GetEventsForHeightRangeWithFieldFilters(ctx context.Context, in *GetEventsForHeightRangeWithFieldFiltersRequest, opts ...grpc.CallOption) (*EventsPageResponse, error)
+	// GetRegisterProofs returns a batch proof of the given registers, requested from the execution nodes and
+	// verified by the access node. The registers are proven against the final state of the given sealed block,
+	// or against the given state commitment. If neither is set, the latest sealed block is used.
+	GetRegisterProofs(ctx context.Context, in *GetRegisterProofsRequest, opts ...grpc.CallOption) (*RegisterProofsResponse, error)
+}
+
+type extendedAccessAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExtendedAccessAPIClient(cc grpc.ClientConnInterface) ExtendedAccessAPIClient {
+	return &extendedAccessAPIClient{cc}
+}
+
+func (c *extendedAccessAPIClient) GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*AccountTransactionsResponse, error) {
+	out := new(AccountTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/GetTransactionsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) GetContractHistory(ctx context.Context, in *GetContractHistoryRequest, opts ...grpc.CallOption) (*ContractHistoryResponse, error) {
+	out := new(ContractHistoryResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/GetContractHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) GetContractAtBlockHeight(ctx context.Context, in *GetContractAtBlockHeightRequest, opts ...grpc.CallOption) (*ContractVersionResponse, error) {
+	out := new(ContractVersionResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/GetContractAtBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) ExecuteScriptAtLatestBlockWithReport(ctx context.Context, in *ExecuteScriptAtLatestBlockWithReportRequest, opts ...grpc.CallOption) (*ExecuteScriptWithReportResponse, error) {
+	out := new(ExecuteScriptWithReportResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/ExecuteScriptAtLatestBlockWithReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) ExecuteScriptAtBlockHeightWithReport(ctx context.Context, in *ExecuteScriptAtBlockHeightWithReportRequest, opts ...grpc.CallOption) (*ExecuteScriptWithReportResponse, error) {
+	out := new(ExecuteScriptWithReportResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/ExecuteScriptAtBlockHeightWithReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) ExecuteScriptAtBlockIDWithReport(ctx context.Context, in *ExecuteScriptAtBlockIDWithReportRequest, opts ...grpc.CallOption) (*ExecuteScriptWithReportResponse, error) {
+	out := new(ExecuteScriptWithReportResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/ExecuteScriptAtBlockIDWithReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) GetPendingTransactionDetails(ctx context.Context, in *GetPendingTransactionDetailsRequest, opts ...grpc.CallOption) (*PendingTransactionDetailsResponse, error) {
+	out := new(PendingTransactionDetailsResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/GetPendingTransactionDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) DryRunTransactionAtLatestBlock(ctx context.Context, in *DryRunTransactionAtLatestBlockRequest, opts ...grpc.CallOption) (*DryRunTransactionResponse, error) {
+	out := new(DryRunTransactionResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/DryRunTransactionAtLatestBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) DryRunTransactionAtBlockHeight(ctx context.Context, in *DryRunTransactionAtBlockHeightRequest, opts ...grpc.CallOption) (*DryRunTransactionResponse, error) {
+	out := new(DryRunTransactionResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/DryRunTransactionAtBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) DryRunTransactionAtBlockID(ctx context.Context, in *DryRunTransactionAtBlockIDRequest, opts ...grpc.CallOption) (*DryRunTransactionResponse, error) {
+	out := new(DryRunTransactionResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/DryRunTransactionAtBlockID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) GetEventsForHeightRangeWithFieldFilters(ctx context.Context, in *GetEventsForHeightRangeWithFieldFiltersRequest, opts ...grpc.CallOption) (*EventsPageResponse, error) {
+	out := new(EventsPageResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/GetEventsForHeightRangeWithFieldFilters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extendedAccessAPIClient) GetRegisterProofs(ctx context.Context, in *GetRegisterProofsRequest, opts ...grpc.CallOption) (*RegisterProofsResponse, error) {
+	out := new(RegisterProofsResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.extended.ExtendedAccessAPI/GetRegisterProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExtendedAccessAPIServer is the server API for ExtendedAccessAPI service.
+// All implementations must embed UnimplementedExtendedAccessAPIServer
+// for forward compatibility
+type ExtendedAccessAPIServer interface {
+	// GetTransactionsByAddress returns a page of the transactions which touched the given account,
+	// ordered by descending block height and transaction index.
+	GetTransactionsByAddress(context.Context, *GetTransactionsByAddressRequest) (*AccountTransactionsResponse, error)
+	// GetContractHistory returns all versions of the given contract which were deployed, updated or removed
+	// within the indexed blocks, ordered from oldest to newest.
+	GetContractHistory(context.Context, *GetContractHistoryRequest) (*ContractHistoryResponse, error)
+	// GetContractAtBlockHeight returns the version of the given contract which was live at the given block height.
+	GetContractAtBlockHeight(context.Context, *GetContractAtBlockHeightRequest) (*ContractVersionResponse, error)
+	// ExecuteScriptAtLatestBlockWithReport executes the script at the latest sealed block, and returns the
+	// encoded value along with the resources used by the script.
+	ExecuteScriptAtLatestBlockWithReport(context.Context, *ExecuteScriptAtLatestBlockWithReportRequest) (*ExecuteScriptWithReportResponse, error)
+	// ExecuteScriptAtBlockHeightWithReport executes the script at the given block height, and returns the
+	// encoded value along with the resources used by the script.
+	ExecuteScriptAtBlockHeightWithReport(context.Context, *ExecuteScriptAtBlockHeightWithReportRequest) (*ExecuteScriptWithReportResponse, error)
+	// ExecuteScriptAtBlockIDWithReport executes the script at the given block ID, and returns the encoded
+	// value along with the resources used by the script.
+	ExecuteScriptAtBlockIDWithReport(context.Context, *ExecuteScriptAtBlockIDWithReportRequest) (*ExecuteScriptWithReportResponse, error)
+	// GetPendingTransactionDetails queries the collection nodes of the cluster responsible for the transaction
+	// for its status, and returns their aggregated answers.
+	GetPendingTransactionDetails(context.Context, *GetPendingTransactionDetailsRequest) (*PendingTransactionDetailsResponse, error)
+	// DryRunTransactionAtLatestBlock executes the transaction against the latest sealed block without
+	// submitting it, and returns the computation used, the fees that would be charged and the emitted events.
+	DryRunTransactionAtLatestBlock(context.Context, *DryRunTransactionAtLatestBlockRequest) (*DryRunTransactionResponse, error)
+	// DryRunTransactionAtBlockHeight executes the transaction against the block at the given height
+	// without submitting it.
+	DryRunTransactionAtBlockHeight(context.Context, *DryRunTransactionAtBlockHeightRequest) (*DryRunTransactionResponse, error)
+	// DryRunTransactionAtBlockID executes the transaction against the block with the given ID without
+	// submitting it.
+	DryRunTransactionAtBlockID(context.Context, *DryRunTransactionAtBlockIDRequest) (*DryRunTransactionResponse, error)
+	// GetEventsForHeightRangeWithFieldFilters returns a page of the events with the given type emitted in the
+	// sealed blocks of the height range, whose decoded payload matches all the field filters.
+	GetEventsForHeightRangeWithFieldFilters(context.Context, *GetEventsForHeightRangeWithFieldFiltersRequest) (*EventsPageResponse, error)
+	// GetRegisterProofs returns a batch proof of the given registers, requested from the execution nodes and
+	// verified by the access node. The registers are proven against the final state of the given sealed block,
+	// or against the given state commitment. If neither is set, the latest sealed block is used.
+	GetRegisterProofs(context.Context, *GetRegisterProofsRequest) (*RegisterProofsResponse, error)
+	mustEmbedUnimplementedExtendedAccessAPIServer()
+}
+
+// UnimplementedExtendedAccessAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedExtendedAccessAPIServer struct {
+}
+
+func (UnimplementedExtendedAccessAPIServer) GetTransactionsByAddress(context.Context, *GetTransactionsByAddressRequest) (*AccountTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionsByAddress not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) GetContractHistory(context.Context, *GetContractHistoryRequest) (*ContractHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContractHistory not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) GetContractAtBlockHeight(context.Context, *GetContractAtBlockHeightRequest) (*ContractVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContractAtBlockHeight not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) ExecuteScriptAtLatestBlockWithReport(context.Context, *ExecuteScriptAtLatestBlockWithReportRequest) (*ExecuteScriptWithReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteScriptAtLatestBlockWithReport not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) ExecuteScriptAtBlockHeightWithReport(context.Context, *ExecuteScriptAtBlockHeightWithReportRequest) (*ExecuteScriptWithReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteScriptAtBlockHeightWithReport not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) ExecuteScriptAtBlockIDWithReport(context.Context, *ExecuteScriptAtBlockIDWithReportRequest) (*ExecuteScriptWithReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteScriptAtBlockIDWithReport not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) GetPendingTransactionDetails(context.Context, *GetPendingTransactionDetailsRequest) (*PendingTransactionDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTransactionDetails not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) DryRunTransactionAtLatestBlock(context.Context, *DryRunTransactionAtLatestBlockRequest) (*DryRunTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunTransactionAtLatestBlock not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) DryRunTransactionAtBlockHeight(context.Context, *DryRunTransactionAtBlockHeightRequest) (*DryRunTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunTransactionAtBlockHeight not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) DryRunTransactionAtBlockID(context.Context, *DryRunTransactionAtBlockIDRequest) (*DryRunTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunTransactionAtBlockID not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) GetEventsForHeightRangeWithFieldFilters(context.Context, *GetEventsForHeightRangeWithFieldFiltersRequest) (*EventsPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventsForHeightRangeWithFieldFilters not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) GetRegisterProofs(context.Context, *GetRegisterProofsRequest) (*RegisterProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegisterProofs not implemented")
+}
+func (UnimplementedExtendedAccessAPIServer) mustEmbedUnimplementedExtendedAccessAPIServer() {}
+
+// UnsafeExtendedAccessAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExtendedAccessAPIServer will
+// result in compilation errors.
+type UnsafeExtendedAccessAPIServer interface {
+	mustEmbedUnimplementedExtendedAccessAPIServer()
+}
+
+func RegisterExtendedAccessAPIServer(s grpc.ServiceRegistrar, srv ExtendedAccessAPIServer) {
+	s.RegisterService(&ExtendedAccessAPI_ServiceDesc, srv)
+}
+
+func _ExtendedAccessAPI_GetTransactionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).GetTransactionsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/GetTransactionsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).GetTransactionsByAddress(ctx, req.(*GetTransactionsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_GetContractHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).GetContractHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/GetContractHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).GetContractHistory(ctx, req.(*GetContractHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_GetContractAtBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractAtBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).GetContractAtBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/GetContractAtBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).GetContractAtBlockHeight(ctx, req.(*GetContractAtBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_ExecuteScriptAtLatestBlockWithReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteScriptAtLatestBlockWithReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).ExecuteScriptAtLatestBlockWithReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/ExecuteScriptAtLatestBlockWithReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).ExecuteScriptAtLatestBlockWithReport(ctx, req.(*ExecuteScriptAtLatestBlockWithReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_ExecuteScriptAtBlockHeightWithReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteScriptAtBlockHeightWithReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).ExecuteScriptAtBlockHeightWithReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/ExecuteScriptAtBlockHeightWithReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).ExecuteScriptAtBlockHeightWithReport(ctx, req.(*ExecuteScriptAtBlockHeightWithReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_ExecuteScriptAtBlockIDWithReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteScriptAtBlockIDWithReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).ExecuteScriptAtBlockIDWithReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/ExecuteScriptAtBlockIDWithReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).ExecuteScriptAtBlockIDWithReport(ctx, req.(*ExecuteScriptAtBlockIDWithReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_GetPendingTransactionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTransactionDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).GetPendingTransactionDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/GetPendingTransactionDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).GetPendingTransactionDetails(ctx, req.(*GetPendingTransactionDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_DryRunTransactionAtLatestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunTransactionAtLatestBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).DryRunTransactionAtLatestBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/DryRunTransactionAtLatestBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).DryRunTransactionAtLatestBlock(ctx, req.(*DryRunTransactionAtLatestBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_DryRunTransactionAtBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunTransactionAtBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).DryRunTransactionAtBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/DryRunTransactionAtBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).DryRunTransactionAtBlockHeight(ctx, req.(*DryRunTransactionAtBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_DryRunTransactionAtBlockID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunTransactionAtBlockIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).DryRunTransactionAtBlockID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/DryRunTransactionAtBlockID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).DryRunTransactionAtBlockID(ctx, req.(*DryRunTransactionAtBlockIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_GetEventsForHeightRangeWithFieldFilters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsForHeightRangeWithFieldFiltersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).GetEventsForHeightRangeWithFieldFilters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/GetEventsForHeightRangeWithFieldFilters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).GetEventsForHeightRangeWithFieldFilters(ctx, req.(*GetEventsForHeightRangeWithFieldFiltersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtendedAccessAPI_GetRegisterProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegisterProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtendedAccessAPIServer).GetRegisterProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.extended.ExtendedAccessAPI/GetRegisterProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtendedAccessAPIServer).GetRegisterProofs(ctx, req.(*GetRegisterProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExtendedAccessAPI_ServiceDesc is the grpc.ServiceDesc for ExtendedAccessAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExtendedAccessAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flow.access.extended.ExtendedAccessAPI",
+	HandlerType: (*ExtendedAccessAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTransactionsByAddress",
+			Handler:    _ExtendedAccessAPI_GetTransactionsByAddress_Handler,
+		},
+		{
+			MethodName: "GetContractHistory",
+			Handler:    _ExtendedAccessAPI_GetContractHistory_Handler,
+		},
+		{
+			MethodName: "GetContractAtBlockHeight",
+			Handler:    _ExtendedAccessAPI_GetContractAtBlockHeight_Handler,
+		},
+		{
+			MethodName: "ExecuteScriptAtLatestBlockWithReport",
+			Handler:    _ExtendedAccessAPI_ExecuteScriptAtLatestBlockWithReport_Handler,
+		},
+		{
+			MethodName: "ExecuteScriptAtBlockHeightWithReport",
+			Handler:    _ExtendedAccessAPI_ExecuteScriptAtBlockHeightWithReport_Handler,
+		},
+		{
+			MethodName: "ExecuteScriptAtBlockIDWithReport",
+			Handler:    _ExtendedAccessAPI_ExecuteScriptAtBlockIDWithReport_Handler,
+		},
+		{
+			MethodName: "GetPendingTransactionDetails",
+			Handler:    _ExtendedAccessAPI_GetPendingTransactionDetails_Handler,
+		},
+		{
+			MethodName: "DryRunTransactionAtLatestBlock",
+			Handler:    _ExtendedAccessAPI_DryRunTransactionAtLatestBlock_Handler,
+		},
+		{
+			MethodName: "DryRunTransactionAtBlockHeight",
+			Handler:    _ExtendedAccessAPI_DryRunTransactionAtBlockHeight_Handler,
+		},
+		{
+			MethodName: "DryRunTransactionAtBlockID",
+			Handler:    _ExtendedAccessAPI_DryRunTransactionAtBlockID_Handler,
+		},
+		{
+			MethodName: "GetEventsForHeightRangeWithFieldFilters",
+			Handler:    _ExtendedAccessAPI_GetEventsForHeightRangeWithFieldFilters_Handler,
+		},
+		{
+			MethodName: "GetRegisterProofs",
+			Handler:    _ExtendedAccessAPI_GetRegisterProofs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "engine/access/rpc/extended/extended.proto",
+}
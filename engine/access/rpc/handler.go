@@ -14,6 +14,7 @@ import (
 	"github.com/onflow/flow-go/access"
 	"github.com/onflow/flow-go/consensus/hotstuff"
 	"github.com/onflow/flow-go/consensus/hotstuff/signature"
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
 	"github.com/onflow/flow-go/engine/access/subscription"
 	"github.com/onflow/flow-go/engine/common/rpc"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
@@ -27,6 +28,7 @@ import (
 
 type Handler struct {
 	subscription.StreamingData
+	extended.UnimplementedExtendedAccessAPIServer
 	api                  access.API
 	chain                flow.Chain
 	signerIndicesDecoder hotstuff.BlockSignerDecoder
@@ -39,6 +41,7 @@ type Handler struct {
 type HandlerOption func(*Handler)
 
 var _ accessproto.AccessAPIServer = (*Handler)(nil)
+var _ extended.ExtendedAccessAPIServer = (*Handler)(nil)
 
 // sendSubscribeBlocksResponseFunc is a callback function used to send
 // SubscribeBlocksResponse to the client stream.
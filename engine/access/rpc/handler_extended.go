@@ -0,0 +1,396 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// GetTransactionsByAddress returns a page of the transactions which touched the given account, ordered by
+// descending block height and transaction index.
+func (h *Handler) GetTransactionsByAddress(
+	ctx context.Context,
+	req *extended.GetTransactionsByAddressRequest,
+) (*extended.AccountTransactionsResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := convert.MessageToAccountTransactionCursor(req.GetCursor())
+
+	page, err := h.api.GetTransactionsByAddress(ctx, address, req.GetLimit(), cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	response := convert.AccountTransactionsPageToMessage(page)
+	response.Metadata = metadata
+
+	return response, nil
+}
+
+// GetContractHistory returns all versions of the given contract which were deployed, updated or removed
+// within the indexed blocks, ordered from oldest to newest.
+func (h *Handler) GetContractHistory(
+	ctx context.Context,
+	req *extended.GetContractHistoryRequest,
+) (*extended.ContractHistoryResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "contract name must be provided")
+	}
+
+	versions, err := h.api.GetContractHistory(ctx, address, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extended.ContractHistoryResponse{
+		Versions: convert.ContractVersionsToMessages(versions),
+		Metadata: metadata,
+	}, nil
+}
+
+// GetContractAtBlockHeight returns the version of the given contract which was live at the given block height.
+func (h *Handler) GetContractAtBlockHeight(
+	ctx context.Context,
+	req *extended.GetContractAtBlockHeightRequest,
+) (*extended.ContractVersionResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "contract name must be provided")
+	}
+
+	version, err := h.api.GetContractAtBlockHeight(ctx, address, req.GetName(), req.GetBlockHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extended.ContractVersionResponse{
+		Version:  convert.ContractVersionToMessage(version),
+		Metadata: metadata,
+	}, nil
+}
+
+// GetPendingTransactionDetails returns the status of a pending transaction reported by the collection nodes
+// of its cluster.
+func (h *Handler) GetPendingTransactionDetails(
+	ctx context.Context,
+	req *extended.GetPendingTransactionDetailsRequest,
+) (*extended.PendingTransactionDetailsResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := convert.TransactionID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := h.api.GetPendingTransactionDetails(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := convert.PendingTransactionDetailsToMessage(details)
+	response.Metadata = metadata
+
+	return response, nil
+}
+
+// ExecuteScriptAtLatestBlockWithReport executes a script at the latest sealed block, and reports the resources
+// used by the script.
+func (h *Handler) ExecuteScriptAtLatestBlockWithReport(
+	ctx context.Context,
+	req *extended.ExecuteScriptAtLatestBlockWithReportRequest,
+) (*extended.ExecuteScriptWithReportResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.api.ExecuteScriptAtLatestBlockWithReport(ctx, req.GetScript(), req.GetArguments())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extended.ExecuteScriptWithReportResponse{
+		Value:    result.Value,
+		Report:   convert.ScriptExecutionReportToMessage(result.Report),
+		Metadata: metadata,
+	}, nil
+}
+
+// ExecuteScriptAtBlockHeightWithReport executes a script at a specific block height, and reports the resources
+// used by the script.
+func (h *Handler) ExecuteScriptAtBlockHeightWithReport(
+	ctx context.Context,
+	req *extended.ExecuteScriptAtBlockHeightWithReportRequest,
+) (*extended.ExecuteScriptWithReportResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.api.ExecuteScriptAtBlockHeightWithReport(ctx, req.GetBlockHeight(), req.GetScript(), req.GetArguments())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extended.ExecuteScriptWithReportResponse{
+		Value:    result.Value,
+		Report:   convert.ScriptExecutionReportToMessage(result.Report),
+		Metadata: metadata,
+	}, nil
+}
+
+// ExecuteScriptAtBlockIDWithReport executes a script at a specific block ID, and reports the resources used
+// by the script.
+func (h *Handler) ExecuteScriptAtBlockIDWithReport(
+	ctx context.Context,
+	req *extended.ExecuteScriptAtBlockIDWithReportRequest,
+) (*extended.ExecuteScriptWithReportResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	blockID, err := convert.BlockID(req.GetBlockId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.api.ExecuteScriptAtBlockIDWithReport(ctx, blockID, req.GetScript(), req.GetArguments())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extended.ExecuteScriptWithReportResponse{
+		Value:    result.Value,
+		Report:   convert.ScriptExecutionReportToMessage(result.Report),
+		Metadata: metadata,
+	}, nil
+}
+
+// DryRunTransactionAtLatestBlock executes the transaction against the latest sealed block without submitting it.
+func (h *Handler) DryRunTransactionAtLatestBlock(
+	ctx context.Context,
+	req *extended.DryRunTransactionAtLatestBlockRequest,
+) (*extended.DryRunTransactionResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := convert.MessageToTransaction(req.GetTransaction(), h.chain)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	options := convert.MessageToTransactionDryRunOptions(req.GetOptions())
+
+	result, err := h.api.DryRunTransactionAtLatestBlock(ctx, &tx, options, req.GetEventEncodingVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	response := convert.TransactionDryRunResultToMessage(result)
+	response.Metadata = metadata
+
+	return response, nil
+}
+
+// DryRunTransactionAtBlockHeight executes the transaction against the block at the given height without submitting it.
+func (h *Handler) DryRunTransactionAtBlockHeight(
+	ctx context.Context,
+	req *extended.DryRunTransactionAtBlockHeightRequest,
+) (*extended.DryRunTransactionResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := convert.MessageToTransaction(req.GetTransaction(), h.chain)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	options := convert.MessageToTransactionDryRunOptions(req.GetOptions())
+
+	result, err := h.api.DryRunTransactionAtBlockHeight(ctx, req.GetBlockHeight(), &tx, options, req.GetEventEncodingVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	response := convert.TransactionDryRunResultToMessage(result)
+	response.Metadata = metadata
+
+	return response, nil
+}
+
+// DryRunTransactionAtBlockID executes the transaction against the block with the given ID without submitting it.
+func (h *Handler) DryRunTransactionAtBlockID(
+	ctx context.Context,
+	req *extended.DryRunTransactionAtBlockIDRequest,
+) (*extended.DryRunTransactionResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	blockID, err := convert.BlockID(req.GetBlockId())
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := convert.MessageToTransaction(req.GetTransaction(), h.chain)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	options := convert.MessageToTransactionDryRunOptions(req.GetOptions())
+
+	result, err := h.api.DryRunTransactionAtBlockID(ctx, blockID, &tx, options, req.GetEventEncodingVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	response := convert.TransactionDryRunResultToMessage(result)
+	response.Metadata = metadata
+
+	return response, nil
+}
+
+// GetEventsForHeightRangeWithFieldFilters returns a page of the events with the given type emitted in the sealed
+// blocks of the height range, whose decoded payload matches all the field filters.
+func (h *Handler) GetEventsForHeightRangeWithFieldFilters(
+	ctx context.Context,
+	req *extended.GetEventsForHeightRangeWithFieldFiltersRequest,
+) (*extended.EventsPageResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	eventType, err := convert.EventType(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	fieldFilters, err := convert.MessagesToFieldFilters(req.GetFieldFilters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cursor := convert.MessageToEventCursor(req.GetCursor())
+
+	page, err := h.api.GetEventsForHeightRangeWithFieldFilters(
+		ctx,
+		eventType,
+		req.GetStartHeight(),
+		req.GetEndHeight(),
+		fieldFilters,
+		req.GetLimit(),
+		cursor,
+		req.GetEventEncodingVersion(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := convert.EventsPageToMessage(page)
+	if err != nil {
+		return nil, err
+	}
+	response.Metadata = metadata
+
+	return response, nil
+}
+
+// GetRegisterProofs returns a batch proof of the given registers, against the final state of the given sealed
+// block, or against the given state commitment. It defaults to the latest sealed block.
+func (h *Handler) GetRegisterProofs(
+	ctx context.Context,
+	req *extended.GetRegisterProofsRequest,
+) (*extended.RegisterProofsResponse, error) {
+	metadata, err := h.buildMetadataResponse()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetBlockId()) > 0 && len(req.GetStateCommitment()) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "only one of block ID and state commitment can be set")
+	}
+
+	registerIDs, err := convert.MessagesToRegisterIDs(req.GetRegisters(), h.chain)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid registers: %v", err)
+	}
+
+	var proofs *accessmodel.RegisterProofs
+	if len(req.GetStateCommitment()) > 0 {
+		commit, err := convert.MessageToStateCommitment(req.GetStateCommitment())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid state commitment: %v", err)
+		}
+		proofs, err = h.api.GetRegisterProofsAtStateCommitment(ctx, commit, registerIDs)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var blockID flow.Identifier
+		if len(req.GetBlockId()) > 0 {
+			blockID, err = convert.BlockID(req.GetBlockId())
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// default to the latest sealed block
+			sealed, _, err := h.api.GetLatestBlockHeader(ctx, true)
+			if err != nil {
+				return nil, err
+			}
+			blockID = sealed.ID()
+		}
+		proofs, err = h.api.GetRegisterProofsAtBlockID(ctx, blockID, registerIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := convert.RegisterProofsToMessage(proofs)
+	response.Metadata = metadata
+
+	return response, nil
+}
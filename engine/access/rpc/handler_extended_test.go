@@ -0,0 +1,396 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accessmock "github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
+	"github.com/onflow/flow-go/engine/access/subscription"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/events"
+	"github.com/onflow/flow-go/model/flow"
+	modulemock "github.com/onflow/flow-go/module/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+type ExtendedHandlerSuite struct {
+	suite.Suite
+
+	api     *accessmock.API
+	chain   flow.Chain
+	header  *flow.Header
+	handler *Handler
+}
+
+func TestExtendedHandler(t *testing.T) {
+	suite.Run(t, new(ExtendedHandlerSuite))
+}
+
+func (s *ExtendedHandlerSuite) SetupTest() {
+	s.api = accessmock.NewAPI(s.T())
+	s.chain = flow.Testnet.Chain()
+	s.header = unittest.BlockHeaderFixture()
+
+	finalizedHeaderCache := modulemock.NewFinalizedHeaderCache(s.T())
+	finalizedHeaderCache.On("Get").Return(s.header).Maybe()
+	me := modulemock.NewLocal(s.T())
+	me.On("NodeID").Return(unittest.IdentifierFixture()).Maybe()
+
+	s.handler = NewHandler(s.api, s.chain, finalizedHeaderCache, me, subscription.DefaultMaxGlobalStreams)
+}
+
+// TestGetTransactionsByAddress tests that the request is converted to a backend call, and the returned page
+// is converted to the response.
+func (s *ExtendedHandlerSuite) TestGetTransactionsByAddress() {
+	address := unittest.RandomAddressFixtureForChain(s.chain.ChainID())
+	cursor := &accessmodel.AccountTransactionCursor{BlockHeight: 100, TransactionIndex: 2}
+	page := &accessmodel.AccountTransactionsPage{
+		Transactions: []flow.AccountTransaction{
+			{
+				Address:          address,
+				BlockHeight:      100,
+				TransactionID:    unittest.IdentifierFixture(),
+				TransactionIndex: 2,
+				Roles:            []flow.TransactionRole{flow.TransactionRolePayer, flow.TransactionRoleAuthorizer},
+			},
+		},
+		NextCursor: &accessmodel.AccountTransactionCursor{BlockHeight: 99, TransactionIndex: 0},
+	}
+
+	s.api.
+		On("GetTransactionsByAddress", mock.Anything, address, uint32(1), cursor).
+		Return(page, nil).
+		Once()
+
+	response, err := s.handler.GetTransactionsByAddress(context.Background(), &extended.GetTransactionsByAddressRequest{
+		Address: address.Bytes(),
+		Limit:   1,
+		Cursor:  convert.AccountTransactionCursorToMessage(cursor),
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.GetTransactions(), 1)
+	s.Assert().Equal(page.Transactions[0], convert.MessageToAccountTransaction(response.GetTransactions()[0]))
+	s.Assert().Equal(page.NextCursor, convert.MessageToAccountTransactionCursor(response.GetNextCursor()))
+	s.Assert().Equal(s.header.Height, response.GetMetadata().GetLatestFinalizedHeight())
+}
+
+// TestGetTransactionsByAddress_InvalidAddress tests that an address which is invalid for the chain is rejected
+// without calling the backend.
+func (s *ExtendedHandlerSuite) TestGetTransactionsByAddress_InvalidAddress() {
+	invalid := unittest.InvalidAddressFixture()
+
+	_, err := s.handler.GetTransactionsByAddress(context.Background(), &extended.GetTransactionsByAddressRequest{
+		Address: invalid.Bytes(),
+	})
+	require.Error(s.T(), err)
+}
+
+// TestGetContractHistory tests that the versions returned by the backend are converted to the response,
+// including versions without a known update.
+func (s *ExtendedHandlerSuite) TestGetContractHistory() {
+	address := unittest.RandomAddressFixtureForChain(s.chain.ChainID())
+	versions := []accessmodel.ContractVersion{
+		{
+			Address: address,
+			Name:    "Foo",
+			Code:    []byte("access(all) contract Foo {}"),
+		},
+		{
+			Address: address,
+			Name:    "Foo",
+			Update: &flow.ContractUpdate{
+				Address:          address,
+				ContractName:     "Foo",
+				Type:             flow.ContractUpdateTypeRemoved,
+				BlockHeight:      42,
+				TransactionID:    unittest.IdentifierFixture(),
+				TransactionIndex: 1,
+				EventIndex:       2,
+				CodeHash:         unittest.RandomBytes(32),
+			},
+		},
+	}
+
+	s.api.
+		On("GetContractHistory", mock.Anything, address, "Foo").
+		Return(versions, nil).
+		Once()
+
+	response, err := s.handler.GetContractHistory(context.Background(), &extended.GetContractHistoryRequest{
+		Address: address.Bytes(),
+		Name:    "Foo",
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(response.GetVersions(), 2)
+	for i, version := range response.GetVersions() {
+		s.Assert().Equal(&versions[i], convert.MessageToContractVersion(version))
+	}
+	s.Assert().Equal(s.header.Height, response.GetMetadata().GetLatestFinalizedHeight())
+}
+
+// TestGetContractAtBlockHeight_MissingName tests that a request without a contract name is rejected without
+// calling the backend.
+func (s *ExtendedHandlerSuite) TestGetContractAtBlockHeight_MissingName() {
+	address := unittest.RandomAddressFixtureForChain(s.chain.ChainID())
+
+	_, err := s.handler.GetContractAtBlockHeight(context.Background(), &extended.GetContractAtBlockHeightRequest{
+		Address:     address.Bytes(),
+		BlockHeight: 42,
+	})
+	s.Require().Error(err)
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+// TestDryRunTransactionAtBlockHeight tests that the transaction and options are converted to a backend call,
+// and the returned result is converted to the response.
+func (s *ExtendedHandlerSuite) TestDryRunTransactionAtBlockHeight() {
+	tx := unittest.TransactionBodyFixture(func(tb *flow.TransactionBody) {
+		tb.Payer = unittest.RandomAddressFixtureForChain(s.chain.ChainID())
+		tb.ProposalKey.Address = tb.Payer
+		tb.Authorizers = []flow.Address{tb.Payer}
+		tb.PayloadSignatures = nil
+		tb.EnvelopeSignatures = nil
+	})
+	options := accessmodel.TransactionDryRunOptions{CheckSequenceNumber: true}
+	result := &accessmodel.TransactionDryRunResult{
+		BlockID:         unittest.IdentifierFixture(),
+		BlockHeight:     42,
+		StatusCode:      1,
+		ErrorMessage:    "failed",
+		ComputationUsed: 10,
+		MemoryEstimate:  20,
+		Fees:            30,
+		Events:          unittest.EventsFixture(2),
+	}
+
+	s.api.
+		On("DryRunTransactionAtBlockHeight", mock.Anything, uint64(42), mock.MatchedBy(func(actual *flow.TransactionBody) bool {
+			return actual.ID() == tx.ID()
+		}), options, entities.EventEncodingVersion_CCF_V0).
+		Return(result, nil).
+		Once()
+
+	response, err := s.handler.DryRunTransactionAtBlockHeight(context.Background(), &extended.DryRunTransactionAtBlockHeightRequest{
+		BlockHeight:          42,
+		Transaction:          convert.TransactionToMessage(tx),
+		Options:              &extended.DryRunTransactionOptions{CheckSequenceNumber: true},
+		EventEncodingVersion: entities.EventEncodingVersion_CCF_V0,
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(result, convert.MessageToTransactionDryRunResult(response))
+	s.Assert().Equal(s.header.Height, response.GetMetadata().GetLatestFinalizedHeight())
+}
+
+// TestExecuteScriptAtBlockIDWithReport tests that the resources reported by the backend are returned along
+// with the script value.
+func (s *ExtendedHandlerSuite) TestExecuteScriptAtBlockIDWithReport() {
+	blockID := unittest.IdentifierFixture()
+	script := []byte("access(all) fun main(): Int { return 1 }")
+	arguments := [][]byte{[]byte("arg")}
+	result := &accessmodel.ScriptExecutionResult{
+		Value: []byte("1"),
+		Report: accessmodel.ScriptExecutionReport{
+			ComputationUsed: 10,
+			MemoryEstimate:  20,
+			RegisterReads:   3,
+			BytesRead:       100,
+			ContractsLoaded: []string{"A.0000000000000001.Contract"},
+			ExecutedLocally: true,
+		},
+	}
+
+	s.api.
+		On("ExecuteScriptAtBlockIDWithReport", mock.Anything, blockID, script, arguments).
+		Return(result, nil).
+		Once()
+
+	response, err := s.handler.ExecuteScriptAtBlockIDWithReport(context.Background(), &extended.ExecuteScriptAtBlockIDWithReportRequest{
+		BlockId:   blockID[:],
+		Script:    script,
+		Arguments: arguments,
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(result.Value, response.GetValue())
+	s.Assert().Equal(result.Report, convert.MessageToScriptExecutionReport(response.GetReport()))
+}
+
+// TestGetEventsForHeightRangeWithFieldFilters tests that the field filters and cursor are converted to a backend
+// call, and the returned page is converted to the response.
+func (s *ExtendedHandlerSuite) TestGetEventsForHeightRangeWithFieldFilters() {
+	eventType := unittest.EventTypeFixture(s.chain.ChainID())
+	fieldFilters, err := events.ParseFieldFilters([]string{"amount:gte:10.5", "memo:eq:a:b"})
+	s.Require().NoError(err)
+	cursor := &accessmodel.EventCursor{BlockHeight: 12, TransactionIndex: 1, EventIndex: 2}
+	blockEvents := unittest.BlockEventsFixture(unittest.BlockHeaderFixture(), 2, eventType)
+	page := &accessmodel.EventsPage{
+		BlockEvents: []flow.BlockEvents{blockEvents},
+		NextCursor:  &accessmodel.EventCursor{BlockHeight: 15, TransactionIndex: 0, EventIndex: 1},
+	}
+
+	s.api.
+		On("GetEventsForHeightRangeWithFieldFilters", mock.Anything, string(eventType), uint64(10), uint64(20),
+			fieldFilters, uint32(5), cursor, entities.EventEncodingVersion_CCF_V0).
+		Return(page, nil).
+		Once()
+
+	response, err := s.handler.GetEventsForHeightRangeWithFieldFilters(context.Background(), &extended.GetEventsForHeightRangeWithFieldFiltersRequest{
+		Type:                 string(eventType),
+		StartHeight:          10,
+		EndHeight:            20,
+		FieldFilters:         convert.FieldFiltersToMessages(fieldFilters),
+		Limit:                5,
+		Cursor:               convert.EventCursorToMessage(cursor),
+		EventEncodingVersion: entities.EventEncodingVersion_CCF_V0,
+	})
+	s.Require().NoError(err)
+
+	results := convert.MessagesToBlockEvents(response.GetResults())
+	s.Require().Len(results, 1)
+	s.Assert().Equal(blockEvents.BlockID, results[0].BlockID)
+	s.Assert().Equal(blockEvents.BlockHeight, results[0].BlockHeight)
+	s.Assert().Equal(blockEvents.Events, results[0].Events)
+	s.Assert().Equal(page.NextCursor, convert.MessageToEventCursor(response.GetNextCursor()))
+	s.Assert().Equal(s.header.Height, response.GetMetadata().GetLatestFinalizedHeight())
+}
+
+// TestGetEventsForHeightRangeWithFieldFilters_InvalidFilter tests that an invalid field filter is rejected
+// without calling the backend.
+func (s *ExtendedHandlerSuite) TestGetEventsForHeightRangeWithFieldFilters_InvalidFilter() {
+	_, err := s.handler.GetEventsForHeightRangeWithFieldFilters(context.Background(), &extended.GetEventsForHeightRangeWithFieldFiltersRequest{
+		Type:        string(unittest.EventTypeFixture(s.chain.ChainID())),
+		StartHeight: 10,
+		EndHeight:   20,
+		FieldFilters: []*extended.EventFieldFilter{
+			{Field: "amount", Operator: "gte", Value: "not a number"},
+		},
+	})
+	s.Require().Error(err)
+	s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+// TestGetPendingTransactionDetails tests that the pending details returned by the backend are converted to the
+// response.
+func (s *ExtendedHandlerSuite) TestGetPendingTransactionDetails() {
+	txID := unittest.IdentifierFixture()
+	details := accessmodel.NewPendingTransactionDetails([]accessmodel.CollectionNodeTransactionStatus{
+		{
+			NodeID:  unittest.IdentifierFixture(),
+			Reason:  accessmodel.PendingReasonRateLimited,
+			Message: "payer is rate limited",
+		},
+		{
+			NodeID:  unittest.IdentifierFixture(),
+			Reason:  accessmodel.PendingReasonUnreachable,
+			Message: "connection refused",
+		},
+	})
+
+	s.api.
+		On("GetPendingTransactionDetails", mock.Anything, txID).
+		Return(details, nil).
+		Once()
+
+	response, err := s.handler.GetPendingTransactionDetails(context.Background(), &extended.GetPendingTransactionDetailsRequest{
+		Id: txID[:],
+	})
+	s.Require().NoError(err)
+
+	s.Assert().Equal(details, convert.MessageToPendingTransactionDetails(response))
+	s.Assert().Equal(s.header.Height, response.GetMetadata().GetLatestFinalizedHeight())
+}
+
+// TestGetRegisterProofs tests that the request is converted to a backend call for the requested block or state
+// commitment, defaulting to the latest sealed block, and the returned proofs are converted to the response.
+func (s *ExtendedHandlerSuite) TestGetRegisterProofs() {
+	address := unittest.RandomAddressFixtureForChain(s.chain.ChainID())
+	registerIDs := []flow.RegisterID{flow.AccountStatusRegisterID(address), flow.UUIDRegisterID(0)}
+	registers := make([]*entities.RegisterID, len(registerIDs))
+	for i, id := range registerIDs {
+		registers[i] = convert.RegisterIDToMessage(id)
+	}
+	commit := unittest.StateCommitmentFixture()
+	proof := unittest.RandomBytes(32)
+
+	s.Run("at block ID", func() {
+		blockID := unittest.IdentifierFixture()
+		proofs := &accessmodel.RegisterProofs{BlockID: blockID, StateCommitment: commit, Proof: proof}
+		s.api.
+			On("GetRegisterProofsAtBlockID", mock.Anything, blockID, registerIDs).
+			Return(proofs, nil).
+			Once()
+
+		response, err := s.handler.GetRegisterProofs(context.Background(), &extended.GetRegisterProofsRequest{
+			BlockId:   blockID[:],
+			Registers: registers,
+		})
+		s.Require().NoError(err)
+
+		actual, err := convert.MessageToRegisterProofs(response)
+		s.Require().NoError(err)
+		s.Assert().Equal(proofs, actual)
+		s.Assert().Equal(s.header.Height, response.GetMetadata().GetLatestFinalizedHeight())
+	})
+
+	s.Run("at latest sealed block", func() {
+		sealed := unittest.BlockHeaderFixture()
+		proofs := &accessmodel.RegisterProofs{BlockID: sealed.ID(), StateCommitment: commit, Proof: proof}
+		s.api.
+			On("GetLatestBlockHeader", mock.Anything, true).
+			Return(sealed, flow.BlockStatusSealed, nil).
+			Once()
+		s.api.
+			On("GetRegisterProofsAtBlockID", mock.Anything, sealed.ID(), registerIDs).
+			Return(proofs, nil).
+			Once()
+
+		response, err := s.handler.GetRegisterProofs(context.Background(), &extended.GetRegisterProofsRequest{
+			Registers: registers,
+		})
+		s.Require().NoError(err)
+		s.Assert().Equal(convert.IdentifierToMessage(sealed.ID()), response.GetBlockId())
+	})
+
+	s.Run("at state commitment", func() {
+		proofs := &accessmodel.RegisterProofs{BlockID: flow.ZeroID, StateCommitment: commit, Proof: proof}
+		s.api.
+			On("GetRegisterProofsAtStateCommitment", mock.Anything, commit, registerIDs).
+			Return(proofs, nil).
+			Once()
+
+		response, err := s.handler.GetRegisterProofs(context.Background(), &extended.GetRegisterProofsRequest{
+			StateCommitment: commit[:],
+			Registers:       registers,
+		})
+		s.Require().NoError(err)
+		s.Assert().Empty(response.GetBlockId())
+
+		actual, err := convert.MessageToRegisterProofs(response)
+		s.Require().NoError(err)
+		s.Assert().Equal(proofs, actual)
+	})
+
+	s.Run("invalid requests", func() {
+		for _, req := range []*extended.GetRegisterProofsRequest{
+			{BlockId: commit[:], StateCommitment: commit[:], Registers: registers},
+			{StateCommitment: commit[:4], Registers: registers},
+			{BlockId: []byte{1}, Registers: registers},
+		} {
+			_, err := s.handler.GetRegisterProofs(context.Background(), req)
+			s.Require().Error(err)
+			s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
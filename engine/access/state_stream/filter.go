@@ -50,6 +50,10 @@ type EventFilter struct {
 	Addresses         map[string]struct{}
 	Contracts         map[string]struct{}
 	EventFieldFilters map[flow.EventType]FieldFilter
+
+	// FieldFilters are applied to the decoded payload of all events matching the other filters.
+	// Events only match if they match all field filters.
+	FieldFilters events.FieldFilters
 }
 
 func NewEventFilter(
@@ -123,6 +127,18 @@ func (f *EventFilter) Filter(events flow.EventsList) flow.EventsList {
 
 // Match applies all filters to a specific event, and returns true if the event matches
 func (f *EventFilter) Match(event flow.Event) bool {
+	if !f.matchEvent(event) {
+		return false
+	}
+
+	// events with payloads that cannot be decoded never match field filters
+	matched, err := f.FieldFilters.Match(event)
+	return err == nil && matched
+}
+
+// matchEvent applies the event type, address, contract and event field filters to a specific event,
+// and returns true if the event matches
+func (f *EventFilter) matchEvent(event flow.Event) bool {
 	// No filters means all events match
 	if !f.hasFilters {
 		return true
@@ -6,8 +6,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/onflow/flow-go/engine/access/state_stream"
+	"github.com/onflow/flow-go/model/events"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/utils/unittest"
+	"github.com/onflow/flow-go/utils/unittest/generator"
 )
 
 var eventTypes = map[flow.EventType]bool{
@@ -183,3 +185,27 @@ func TestMatch(t *testing.T) {
 		})
 	}
 }
+
+// TestMatchFieldFilters tests that field filters are applied to the events matching the other filters.
+func TestMatchFieldFilters(t *testing.T) {
+	t.Parallel()
+
+	address := unittest.AddressFixture()
+	event := generator.GenerateAccountCreateEvent(t, address)
+	other := generator.GenerateAccountCreateEvent(t, unittest.RandomAddressFixture())
+
+	filter, err := state_stream.NewEventFilter(state_stream.DefaultEventFilterConfig, flow.Emulator.Chain(), []string{string(event.Type)}, nil, nil)
+	assert.NoError(t, err)
+
+	filter.FieldFilters, err = events.ParseFieldFilters([]string{"address:eq:" + address.HexWithPrefix()})
+	assert.NoError(t, err)
+
+	assert.True(t, filter.Match(event))
+	assert.False(t, filter.Match(other))
+	assert.Equal(t, flow.EventsList{event}, filter.Filter(flow.EventsList{event, other}))
+
+	// events with payloads which are not CCF encoded never match field filters
+	invalid := event
+	invalid.Payload = []byte("invalid")
+	assert.False(t, filter.Match(invalid))
+}
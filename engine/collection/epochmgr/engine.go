@@ -11,11 +11,13 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/onflow/flow-go/engine/collection"
+	"github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/component"
 	"github.com/onflow/flow-go/module/epochs"
 	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/module/mempool"
 	epochpool "github.com/onflow/flow-go/module/mempool/epochs"
 	"github.com/onflow/flow-go/module/util"
 	"github.com/onflow/flow-go/state/protocol"
@@ -49,11 +51,12 @@ type Engine struct {
 	log            zerolog.Logger
 	me             module.Local
 	state          protocol.State
-	pools          *epochpool.TransactionPools // epoch-scoped transaction pools
-	factory        EpochComponentsFactory      // consolidates creating epoch for an epoch
-	voter          module.ClusterRootQCVoter   // manages process of voting for next epoch's QC
-	heightEvents   events.Heights              // allows subscribing to particular heights
-	startupTimeout time.Duration               // how long we wait for epoch components to start up
+	pools          *epochpool.TransactionPools  // epoch-scoped transaction pools
+	rejected       mempool.RejectedTransactions // records transactions dropped from the pools because they expired
+	factory        EpochComponentsFactory       // consolidates creating epoch for an epoch
+	voter          module.ClusterRootQCVoter    // manages process of voting for next epoch's QC
+	heightEvents   events.Heights               // allows subscribing to particular heights
+	startupTimeout time.Duration                // how long we wait for epoch components to start up
 
 	mu     sync.RWMutex                       // protects epochs map
 	epochs map[uint64]*RunningEpochComponents // epoch-scoped components per epoch
@@ -77,6 +80,7 @@ func New(
 	me module.Local,
 	state protocol.State,
 	pools *epochpool.TransactionPools,
+	rejected mempool.RejectedTransactions,
 	voter module.ClusterRootQCVoter,
 	factory EpochComponentsFactory,
 	heightEvents events.Heights,
@@ -87,6 +91,7 @@ func New(
 		me:                           me,
 		state:                        state,
 		pools:                        pools,
+		rejected:                     rejected,
 		voter:                        voter,
 		factory:                      factory,
 		heightEvents:                 heightEvents,
@@ -514,7 +519,13 @@ func (e *Engine) stopEpochComponents(counter uint64) error {
 	select {
 	case <-components.Done():
 		e.removeEpoch(counter)
-		e.pools.ForEpoch(counter).Clear()
+		// the epoch is stopped once all transactions referencing its blocks have expired
+		pool := e.pools.ForEpoch(counter)
+		for _, tx := range pool.All() {
+			e.rejected.Add(tx.ID(), access.PendingReasonExpired, fmt.Sprintf(
+				"transaction expired: dropped from the mempool of epoch %d when the epoch stopped", counter))
+		}
+		pool.Clear()
 		activeClusterIDS, err := e.activeClusterIDs()
 		if err != nil {
 			return fmt.Errorf("failed to get active cluster IDs: %w", err)
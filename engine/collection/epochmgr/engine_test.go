@@ -15,6 +15,7 @@ import (
 	mockhotstuff "github.com/onflow/flow-go/consensus/hotstuff/mocks"
 	epochmgr "github.com/onflow/flow-go/engine/collection/epochmgr/mock"
 	mockcollection "github.com/onflow/flow-go/engine/collection/mock"
+	"github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 	realmodule "github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/component"
@@ -23,6 +24,7 @@ import (
 	"github.com/onflow/flow-go/module/mempool"
 	"github.com/onflow/flow-go/module/mempool/epochs"
 	"github.com/onflow/flow-go/module/mempool/herocache"
+	"github.com/onflow/flow-go/module/mempool/stdmap"
 	"github.com/onflow/flow-go/module/metrics"
 	mockmodule "github.com/onflow/flow-go/module/mock"
 	realcluster "github.com/onflow/flow-go/state/cluster"
@@ -77,11 +79,12 @@ type Suite struct {
 	suite.Suite
 
 	// engine dependencies
-	log   zerolog.Logger
-	me    *mockmodule.Local
-	state *protocol.State
-	snap  *protocol.Snapshot
-	pools *epochs.TransactionPools
+	log      zerolog.Logger
+	me       *mockmodule.Local
+	state    *protocol.State
+	snap     *protocol.Snapshot
+	pools    *epochs.TransactionPools
+	rejected *stdmap.RejectedTransactions
 
 	// qc voter dependencies
 	signer  *mockhotstuff.Signer
@@ -180,11 +183,13 @@ func (suite *Suite) SetupTest() {
 	suite.pools = epochs.NewTransactionPools(func(_ uint64) mempool.Transactions {
 		return herocache.NewTransactions(1000, suite.log, metrics.NewNoopCollector())
 	})
+	var err error
+	suite.rejected, err = stdmap.NewRejectedTransactions(stdmap.DefaultRejectedTransactionsLimit)
+	suite.Require().NoError(err)
 
 	suite.engineEventsDistributor = mockcollection.NewEngineEvents(suite.T())
 
-	var err error
-	suite.engine, err = New(suite.log, suite.me, suite.state, suite.pools, suite.voter, suite.factory, suite.heights, suite.engineEventsDistributor)
+	suite.engine, err = New(suite.log, suite.me, suite.state, suite.pools, suite.rejected, suite.voter, suite.factory, suite.heights, suite.engineEventsDistributor)
 	suite.Require().Nil(err)
 
 }
@@ -279,7 +284,7 @@ func (suite *Suite) MockAsUnauthorizedNode(forEpoch uint64) {
 	suite.MockFactoryCreate(mock.MatchedBy(authorizedMatcher))
 
 	var err error
-	suite.engine, err = New(suite.log, suite.me, suite.state, suite.pools, suite.voter, suite.factory, suite.heights, suite.engineEventsDistributor)
+	suite.engine, err = New(suite.log, suite.me, suite.state, suite.pools, suite.rejected, suite.voter, suite.factory, suite.heights, suite.engineEventsDistributor)
 	suite.Require().Nil(err)
 }
 
@@ -545,6 +550,10 @@ func (suite *Suite) TestRespondToEpochTransition() {
 	// the newly started (current) epoch should have been started
 	suite.AssertEpochStarted(suite.counter)
 
+	// a transaction left in the pool of the previous epoch has expired
+	tx := unittest.TransactionBodyFixture()
+	suite.pools.ForEpoch(suite.counter - 1).Add(&tx)
+
 	// when we invoke the callback registered to handle the previous epoch's
 	// expiry, the previous epoch components should be cleaned up
 	expiryCallback()
@@ -563,6 +572,12 @@ func (suite *Suite) TestRespondToEpochTransition() {
 
 	// the expired epoch should have been stopped
 	suite.AssertEpochStopped(suite.counter - 1)
+
+	// the transactions left in the pool of the expired epoch are reported as expired
+	suite.Assert().Eventually(func() bool {
+		reason, _, ok := suite.rejected.ByID(tx.ID())
+		return ok && reason == access.PendingReasonExpired
+	}, time.Second, 10*time.Millisecond)
 }
 
 // TestStopQcVoting tests that, if we encounter an EpochEmergencyFallbackTriggered event
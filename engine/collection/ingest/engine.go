@@ -12,10 +12,12 @@ import (
 	"github.com/onflow/flow-go/access/validator"
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/engine/common/fifoqueue"
+	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/component"
 	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/module/mempool"
 	"github.com/onflow/flow-go/module/mempool/epochs"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/network"
@@ -39,6 +41,10 @@ type Engine struct {
 	messageHandler       *engine.MessageHandler
 	pools                *epochs.TransactionPools
 	transactionValidator *validator.TransactionValidator
+	// rejected holds the reason the most recently rejected or expired transactions will not be
+	// included in a collection. It is shared with the collection builder and the epoch manager,
+	// which drop expired transactions from the transaction pools.
+	rejected mempool.RejectedTransactions
 
 	config Config
 }
@@ -54,6 +60,7 @@ func New(
 	me module.Local,
 	chain flow.Chain,
 	pools *epochs.TransactionPools,
+	rejected mempool.RejectedTransactions,
 	config Config,
 	limiter *AddressRateLimiter,
 ) (*Engine, error) {
@@ -114,6 +121,7 @@ func New(
 		pools:                pools,
 		config:               config,
 		transactionValidator: transactionValidator,
+		rejected:             rejected,
 	}
 
 	e.ComponentManager = component.NewComponentManagerBuilder().
@@ -165,6 +173,28 @@ func (e *Engine) ProcessTransaction(tx *flow.TransactionBody) error {
 	return e.onTransaction(e.me.NodeID(), tx)
 }
 
+// TransactionStatus returns the status of a transaction which was not yet included in a
+// collection by this node, along with a human-readable message describing it:
+//   - PendingReasonInMempool if the transaction is in the transaction pool of any epoch.
+//   - PendingReasonRateLimited, PendingReasonExpired or PendingReasonRejected if the transaction
+//     recently failed validation.
+//   - PendingReasonExpired if the transaction was recently dropped from a transaction pool, because it expired.
+//   - PendingReasonUnknown otherwise.
+func (e *Engine) TransactionStatus(txID flow.Identifier) (accessmodel.PendingTransactionReason, string) {
+	epoch, pool, ok := e.pools.ByTransactionID(txID)
+	if ok {
+		return accessmodel.PendingReasonInMempool, fmt.Sprintf(
+			"transaction is in the mempool of epoch %d (%d transactions in total)", epoch, pool.Size())
+	}
+
+	reason, message, ok := e.rejected.ByID(txID)
+	if ok {
+		return reason, message
+	}
+
+	return accessmodel.PendingReasonUnknown, "transaction is not known to this node"
+}
+
 // processQueuedTransactions is the main message processing loop for transaction messages.
 func (e *Engine) processQueuedTransactions(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
 	ready()
@@ -350,6 +380,14 @@ func (e *Engine) ingestTransaction(
 	// we don't pass actual ctx as we don't execute any scripts inside for now
 	err := e.transactionValidator.Validate(context.Background(), tx)
 	if err != nil {
+		reason := accessmodel.PendingReasonRejected
+		if errors.As(err, &validator.InvalidTxRateLimitedError{}) {
+			reason = accessmodel.PendingReasonRateLimited
+		} else if errors.As(err, &validator.ExpiredTransactionError{}) {
+			reason = accessmodel.PendingReasonExpired
+		}
+		e.rejected.Add(txID, reason, err.Error())
+
 		return engine.NewInvalidInputErrorf("invalid transaction (%x): %w", txID, err)
 	}
 
@@ -14,6 +14,7 @@ import (
 
 	"github.com/onflow/flow-go/access/validator"
 	"github.com/onflow/flow-go/engine"
+	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/model/flow/factory"
 	"github.com/onflow/flow-go/model/flow/filter"
@@ -22,6 +23,7 @@ import (
 	"github.com/onflow/flow-go/module/mempool"
 	"github.com/onflow/flow-go/module/mempool/epochs"
 	"github.com/onflow/flow-go/module/mempool/herocache"
+	"github.com/onflow/flow-go/module/mempool/stdmap"
 	"github.com/onflow/flow-go/module/metrics"
 	module "github.com/onflow/flow-go/module/mock"
 	"github.com/onflow/flow-go/network"
@@ -43,7 +45,8 @@ type Suite struct {
 	me      *module.Local
 	conf    Config
 
-	pools *epochs.TransactionPools
+	pools    *epochs.TransactionPools
+	rejected *stdmap.RejectedTransactions
 
 	identities flow.IdentityList
 	clusters   flow.ClusterList
@@ -88,6 +91,8 @@ func (suite *Suite) SetupTest() {
 	suite.pools = epochs.NewTransactionPools(func(_ uint64) mempool.Transactions {
 		return herocache.NewTransactions(1000, log, metrics)
 	})
+	suite.rejected, err = stdmap.NewRejectedTransactions(stdmap.DefaultRejectedTransactionsLimit)
+	suite.Require().NoError(err)
 
 	assignments := unittest.ClusterAssignment(suite.N_CLUSTERS, collectors.ToSkeleton())
 	suite.clusters, err = factory.NewClusterList(assignments, collectors.ToSkeleton())
@@ -126,7 +131,7 @@ func (suite *Suite) SetupTest() {
 
 	suite.conf = DefaultConfig()
 	chain := flow.Testnet.Chain()
-	suite.engine, err = New(log, net, suite.state, metrics, metrics, metrics, suite.me, chain, suite.pools, suite.conf, NewAddressRateLimiter(rate.Limit(1), 1))
+	suite.engine, err = New(log, net, suite.state, metrics, metrics, metrics, suite.me, chain, suite.pools, suite.rejected, suite.conf, NewAddressRateLimiter(rate.Limit(1), 1))
 	suite.Require().NoError(err)
 }
 
@@ -333,6 +338,72 @@ func (suite *Suite) TestRoutingLocalCluster() {
 	suite.conduit.AssertExpectations(suite.T())
 }
 
+// should report the status of transactions in the mempool and of rejected transactions
+func (suite *Suite) TestTransactionStatus() {
+
+	local, _, ok := suite.clusters.ByNodeID(suite.me.NodeID())
+	suite.Require().True(ok)
+
+	suite.Run("in mempool", func() {
+		tx := unittest.TransactionBodyFixture()
+		tx.ReferenceBlockID = suite.root.ID()
+		tx = unittest.AlterTransactionForCluster(tx, suite.clusters, local, func(transaction *flow.TransactionBody) {})
+
+		suite.conduit.
+			On("Multicast", &tx, suite.conf.PropagationRedundancy+1, local.NodeIDs()[0], local.NodeIDs()[1]).
+			Return(nil)
+		err := suite.engine.ProcessTransaction(&tx)
+		suite.Require().NoError(err)
+
+		reason, msg := suite.engine.TransactionStatus(tx.ID())
+		suite.Assert().Equal(accessmodel.PendingReasonInMempool, reason)
+		suite.Assert().Contains(msg, "mempool of epoch 1")
+	})
+
+	suite.Run("rejected", func() {
+		tx := unittest.TransactionBodyFixture()
+		tx.ReferenceBlockID = suite.root.ID()
+		tx.Script = nil
+
+		err := suite.engine.ProcessTransaction(&tx)
+		suite.Require().Error(err)
+
+		reason, msg := suite.engine.TransactionStatus(tx.ID())
+		suite.Assert().Equal(accessmodel.PendingReasonRejected, reason)
+		suite.Assert().Contains(msg, "missing required fields")
+	})
+
+	suite.Run("expired", func() {
+		// "finalize" a sufficiently high block that root block is expired
+		final := unittest.BlockFixture()
+		final.Header.Height = suite.root.Header.Height + flow.DefaultTransactionExpiry + 1
+		suite.final = &final
+
+		tx := unittest.TransactionBodyFixture()
+		tx.ReferenceBlockID = suite.root.ID()
+
+		err := suite.engine.ProcessTransaction(&tx)
+		suite.Require().Error(err)
+
+		reason, msg := suite.engine.TransactionStatus(tx.ID())
+		suite.Assert().Equal(accessmodel.PendingReasonExpired, reason)
+		suite.Assert().Contains(msg, "expired")
+	})
+
+	suite.Run("dropped for expiry", func() {
+		txID := unittest.IdentifierFixture()
+		suite.rejected.Add(txID, accessmodel.PendingReasonExpired, "transaction expired")
+
+		reason, _ := suite.engine.TransactionStatus(txID)
+		suite.Assert().Equal(accessmodel.PendingReasonExpired, reason)
+	})
+
+	suite.Run("unknown", func() {
+		reason, _ := suite.engine.TransactionStatus(unittest.IdentifierFixture())
+		suite.Assert().Equal(accessmodel.PendingReasonUnknown, reason)
+	})
+}
+
 // should not store transactions for a different cluster and should propagate
 // to the responsible cluster
 func (suite *Suite) TestRoutingRemoteCluster() {
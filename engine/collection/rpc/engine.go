@@ -20,6 +20,8 @@ import (
 
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/common/rpc/pendingtx"
+	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/grpcserver"
 )
@@ -29,6 +31,10 @@ type Backend interface {
 	// ProcessTransaction handles validating and ingesting a new transaction,
 	// ultimately for inclusion in a future collection.
 	ProcessTransaction(*flow.TransactionBody) error
+
+	// TransactionStatus returns the status of a transaction which was not yet included in a
+	// collection by this node, along with a human-readable message describing it.
+	TransactionStatus(txID flow.Identifier) (accessmodel.PendingTransactionReason, string)
 }
 
 // Config defines the configurable options for the ingress server.
@@ -86,9 +92,10 @@ func New(
 		unit: engine.NewUnit(),
 		log:  log.With().Str("engine", "collection_rpc").Logger(),
 		handler: &handler{
-			UnimplementedAccessAPIServer: access.UnimplementedAccessAPIServer{},
-			backend:                      backend,
-			chainID:                      chainID,
+			UnimplementedAccessAPIServer:              access.UnimplementedAccessAPIServer{},
+			UnimplementedPendingTransactionsAPIServer: pendingtx.UnimplementedPendingTransactionsAPIServer{},
+			backend: backend,
+			chainID: chainID,
 		},
 		server: server,
 		config: config,
@@ -100,6 +107,7 @@ func New(
 	}
 
 	access.RegisterAccessAPIServer(e.server, e.handler)
+	pendingtx.RegisterPendingTransactionsAPIServer(e.server, e.handler)
 
 	return e
 }
@@ -137,9 +145,10 @@ func (e *Engine) serve() {
 	}
 }
 
-// handler implements a subset of the Observation API.
+// handler implements a subset of the Observation API, and the PendingTransactionsAPI.
 type handler struct {
 	access.UnimplementedAccessAPIServer
+	pendingtx.UnimplementedPendingTransactionsAPIServer
 	backend Backend
 	chainID flow.ChainID
 }
@@ -169,3 +178,23 @@ func (h *handler) SendTransaction(_ context.Context, req *access.SendTransaction
 
 	return &access.SendTransactionResponse{Id: txID[:]}, nil
 }
+
+// GetPendingTransactionStatus reports the status of a transaction which was not yet included in a
+// collection by this node. Only the status of the transaction within this node is reported.
+func (h *handler) GetPendingTransactionStatus(
+	_ context.Context,
+	req *pendingtx.GetPendingTransactionStatusRequest,
+) (*pendingtx.PendingTransactionStatusResponse, error) {
+	txID, err := convert.TransactionID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	reason, msg := h.backend.TransactionStatus(txID)
+
+	return &pendingtx.PendingTransactionStatusResponse{
+		TransactionId: txID[:],
+		Reason:        convert.PendingTransactionReasonToMessage(reason),
+		Message:       msg,
+	}, nil
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow/protobuf/go/flow/access"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	rpcmock "github.com/onflow/flow-go/engine/collection/rpc/mock"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/common/rpc/pendingtx"
+	accessmodel "github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -59,3 +63,40 @@ func TestSubmitTransaction(t *testing.T) {
 		assert.Nil(t, res)
 	})
 }
+
+func TestGetPendingTransactionStatus(t *testing.T) {
+	backend := new(rpcmock.Backend)
+
+	h := handler{
+		chainID: flow.Testnet,
+		backend: backend,
+	}
+
+	txID := unittest.IdentifierFixture()
+
+	t.Run("should report pending transaction in mempool", func(t *testing.T) {
+		backend.On("TransactionStatus", txID).Return(accessmodel.PendingReasonInMempool, "in mempool").Once()
+
+		res, err := h.GetPendingTransactionStatus(context.Background(), &pendingtx.GetPendingTransactionStatusRequest{Id: txID[:]})
+		require.NoError(t, err)
+
+		assert.Equal(t, pendingtx.PendingTransactionReason_PENDING_TRANSACTION_REASON_IN_MEMPOOL, res.Reason)
+		assert.Equal(t, "in mempool", res.Message)
+		assert.Equal(t, txID[:], res.TransactionId)
+	})
+
+	t.Run("should report rejected transaction", func(t *testing.T) {
+		backend.On("TransactionStatus", txID).Return(accessmodel.PendingReasonRejected, "rejected").Once()
+
+		res, err := h.GetPendingTransactionStatus(context.Background(), &pendingtx.GetPendingTransactionStatusRequest{Id: txID[:]})
+		require.NoError(t, err)
+
+		assert.Equal(t, pendingtx.PendingTransactionReason_PENDING_TRANSACTION_REASON_REJECTED, res.Reason)
+		assert.Equal(t, "rejected", res.Message)
+	})
+
+	t.Run("should reject invalid transaction ID", func(t *testing.T) {
+		_, err := h.GetPendingTransactionStatus(context.Background(), &pendingtx.GetPendingTransactionStatusRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
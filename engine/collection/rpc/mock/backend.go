@@ -3,7 +3,9 @@
 package mock
 
 import (
+	access "github.com/onflow/flow-go/model/access"
 	flow "github.com/onflow/flow-go/model/flow"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -30,6 +32,34 @@ func (_m *Backend) ProcessTransaction(_a0 *flow.TransactionBody) error {
 	return r0
 }
 
+// TransactionStatus provides a mock function with given fields: txID
+func (_m *Backend) TransactionStatus(txID flow.Identifier) (access.PendingTransactionReason, string) {
+	ret := _m.Called(txID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransactionStatus")
+	}
+
+	var r0 access.PendingTransactionReason
+	var r1 string
+	if rf, ok := ret.Get(0).(func(flow.Identifier) (access.PendingTransactionReason, string)); ok {
+		return rf(txID)
+	}
+	if rf, ok := ret.Get(0).(func(flow.Identifier) access.PendingTransactionReason); ok {
+		r0 = rf(txID)
+	} else {
+		r0 = ret.Get(0).(access.PendingTransactionReason)
+	}
+
+	if rf, ok := ret.Get(1).(func(flow.Identifier) string); ok {
+		r1 = rf(txID)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	return r0, r1
+}
+
 // NewBackend creates a new instance of Backend. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBackend(t interface {
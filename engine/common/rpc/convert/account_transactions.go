@@ -0,0 +1,76 @@
+package convert
+
+import (
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// AccountTransactionToMessage converts a flow.AccountTransaction to a protobuf message
+func AccountTransactionToMessage(tx flow.AccountTransaction) *extended.AccountTransaction {
+	roles := make([]extended.TransactionRole, len(tx.Roles))
+	for i, role := range tx.Roles {
+		roles[i] = extended.TransactionRole(role)
+	}
+
+	return &extended.AccountTransaction{
+		Address:          tx.Address.Bytes(),
+		BlockHeight:      tx.BlockHeight,
+		TransactionId:    IdentifierToMessage(tx.TransactionID),
+		TransactionIndex: tx.TransactionIndex,
+		Roles:            roles,
+	}
+}
+
+// MessageToAccountTransaction converts a protobuf message to a flow.AccountTransaction
+func MessageToAccountTransaction(m *extended.AccountTransaction) flow.AccountTransaction {
+	roles := make([]flow.TransactionRole, len(m.GetRoles()))
+	for i, role := range m.GetRoles() {
+		roles[i] = flow.TransactionRole(role)
+	}
+
+	return flow.AccountTransaction{
+		Address:          flow.BytesToAddress(m.GetAddress()),
+		BlockHeight:      m.GetBlockHeight(),
+		TransactionID:    MessageToIdentifier(m.GetTransactionId()),
+		TransactionIndex: m.GetTransactionIndex(),
+		Roles:            roles,
+	}
+}
+
+// AccountTransactionCursorToMessage converts an account transaction cursor to a protobuf message.
+// A nil cursor is converted to a nil message.
+func AccountTransactionCursorToMessage(cursor *accessmodel.AccountTransactionCursor) *extended.AccountTransactionCursor {
+	if cursor == nil {
+		return nil
+	}
+	return &extended.AccountTransactionCursor{
+		BlockHeight:      cursor.BlockHeight,
+		TransactionIndex: cursor.TransactionIndex,
+	}
+}
+
+// MessageToAccountTransactionCursor converts a protobuf message to an account transaction cursor.
+// A nil message is converted to a nil cursor.
+func MessageToAccountTransactionCursor(m *extended.AccountTransactionCursor) *accessmodel.AccountTransactionCursor {
+	if m == nil {
+		return nil
+	}
+	return &accessmodel.AccountTransactionCursor{
+		BlockHeight:      m.GetBlockHeight(),
+		TransactionIndex: m.GetTransactionIndex(),
+	}
+}
+
+// AccountTransactionsPageToMessage converts a page of account transactions to a protobuf message
+func AccountTransactionsPageToMessage(page *accessmodel.AccountTransactionsPage) *extended.AccountTransactionsResponse {
+	txs := make([]*extended.AccountTransaction, len(page.Transactions))
+	for i, tx := range page.Transactions {
+		txs[i] = AccountTransactionToMessage(tx)
+	}
+
+	return &extended.AccountTransactionsResponse{
+		Transactions: txs,
+		NextCursor:   AccountTransactionCursorToMessage(page.NextCursor),
+	}
+}
@@ -0,0 +1,72 @@
+package convert
+
+import (
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ContractUpdateToMessage converts a flow.ContractUpdate to a protobuf message.
+// A nil update is converted to a nil message.
+func ContractUpdateToMessage(update *flow.ContractUpdate) *extended.ContractUpdate {
+	if update == nil {
+		return nil
+	}
+	return &extended.ContractUpdate{
+		Address:          update.Address.Bytes(),
+		ContractName:     update.ContractName,
+		Type:             extended.ContractUpdateType(update.Type),
+		BlockHeight:      update.BlockHeight,
+		TransactionId:    IdentifierToMessage(update.TransactionID),
+		TransactionIndex: update.TransactionIndex,
+		EventIndex:       update.EventIndex,
+		CodeHash:         update.CodeHash,
+	}
+}
+
+// MessageToContractUpdate converts a protobuf message to a flow.ContractUpdate.
+// A nil message is converted to a nil update.
+func MessageToContractUpdate(m *extended.ContractUpdate) *flow.ContractUpdate {
+	if m == nil {
+		return nil
+	}
+	return &flow.ContractUpdate{
+		Address:          flow.BytesToAddress(m.GetAddress()),
+		ContractName:     m.GetContractName(),
+		Type:             flow.ContractUpdateType(m.GetType()),
+		BlockHeight:      m.GetBlockHeight(),
+		TransactionID:    MessageToIdentifier(m.GetTransactionId()),
+		TransactionIndex: m.GetTransactionIndex(),
+		EventIndex:       m.GetEventIndex(),
+		CodeHash:         m.GetCodeHash(),
+	}
+}
+
+// ContractVersionToMessage converts a contract version to a protobuf message
+func ContractVersionToMessage(version *accessmodel.ContractVersion) *extended.ContractVersion {
+	return &extended.ContractVersion{
+		Address: version.Address.Bytes(),
+		Name:    version.Name,
+		Code:    version.Code,
+		Update:  ContractUpdateToMessage(version.Update),
+	}
+}
+
+// MessageToContractVersion converts a protobuf message to a contract version
+func MessageToContractVersion(m *extended.ContractVersion) *accessmodel.ContractVersion {
+	return &accessmodel.ContractVersion{
+		Address: flow.BytesToAddress(m.GetAddress()),
+		Name:    m.GetName(),
+		Code:    m.GetCode(),
+		Update:  MessageToContractUpdate(m.GetUpdate()),
+	}
+}
+
+// ContractVersionsToMessages converts a list of contract versions to protobuf messages
+func ContractVersionsToMessages(versions []accessmodel.ContractVersion) []*extended.ContractVersion {
+	messages := make([]*extended.ContractVersion, len(versions))
+	for i := range versions {
+		messages[i] = ContractVersionToMessage(&versions[i])
+	}
+	return messages
+}
@@ -0,0 +1,80 @@
+package convert
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/events"
+)
+
+// FieldFiltersToMessages converts a list of event field filters to protobuf messages
+func FieldFiltersToMessages(filters events.FieldFilters) []*extended.EventFieldFilter {
+	messages := make([]*extended.EventFieldFilter, len(filters))
+	for i, filter := range filters {
+		messages[i] = &extended.EventFieldFilter{
+			Field:    filter.Field,
+			Operator: string(filter.Operator),
+			Value:    filter.Value,
+		}
+	}
+	return messages
+}
+
+// MessagesToFieldFilters converts protobuf messages to a list of validated event field filters.
+// Expected errors during normal operations:
+//   - error if there are more than events.MaxFieldFilters filters, or any of the filters is invalid.
+func MessagesToFieldFilters(m []*extended.EventFieldFilter) (events.FieldFilters, error) {
+	if len(m) > events.MaxFieldFilters {
+		return nil, fmt.Errorf("too many field filters (%d). use %d or fewer", len(m), events.MaxFieldFilters)
+	}
+
+	filters := make(events.FieldFilters, len(m))
+	for i, message := range m {
+		filter, err := events.NewFieldFilter(message.GetField(), events.FieldOperator(message.GetOperator()), message.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("invalid field filter %d: %w", i, err)
+		}
+		filters[i] = filter
+	}
+	return filters, nil
+}
+
+// EventCursorToMessage converts an event cursor to a protobuf message.
+// A nil cursor is converted to a nil message.
+func EventCursorToMessage(cursor *accessmodel.EventCursor) *extended.EventCursor {
+	if cursor == nil {
+		return nil
+	}
+	return &extended.EventCursor{
+		BlockHeight:      cursor.BlockHeight,
+		TransactionIndex: cursor.TransactionIndex,
+		EventIndex:       cursor.EventIndex,
+	}
+}
+
+// MessageToEventCursor converts a protobuf message to an event cursor.
+// A nil message is converted to a nil cursor.
+func MessageToEventCursor(m *extended.EventCursor) *accessmodel.EventCursor {
+	if m == nil {
+		return nil
+	}
+	return &accessmodel.EventCursor{
+		BlockHeight:      m.GetBlockHeight(),
+		TransactionIndex: m.GetTransactionIndex(),
+		EventIndex:       m.GetEventIndex(),
+	}
+}
+
+// EventsPageToMessage converts a page of events to a protobuf message
+func EventsPageToMessage(page *accessmodel.EventsPage) (*extended.EventsPageResponse, error) {
+	results, err := BlockEventsToMessages(page.BlockEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extended.EventsPageResponse{
+		Results:    results,
+		NextCursor: EventCursorToMessage(page.NextCursor),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package convert
+
+import (
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
+	"github.com/onflow/flow-go/engine/common/rpc/pendingtx"
+	accessmodel "github.com/onflow/flow-go/model/access"
+)
+
+// PendingTransactionReasonToMessage converts a pending transaction reason to a protobuf message
+func PendingTransactionReasonToMessage(reason accessmodel.PendingTransactionReason) pendingtx.PendingTransactionReason {
+	return pendingtx.PendingTransactionReason(reason)
+}
+
+// MessageToPendingTransactionReason converts a protobuf message to a pending transaction reason
+func MessageToPendingTransactionReason(m pendingtx.PendingTransactionReason) accessmodel.PendingTransactionReason {
+	return accessmodel.PendingTransactionReason(m)
+}
+
+// PendingTransactionDetailsToMessage converts the aggregated status of a pending transaction to a protobuf message
+func PendingTransactionDetailsToMessage(details *accessmodel.PendingTransactionDetails) *extended.PendingTransactionDetailsResponse {
+	nodes := make([]*extended.CollectionNodeTransactionStatus, len(details.Nodes))
+	for i, node := range details.Nodes {
+		nodes[i] = &extended.CollectionNodeTransactionStatus{
+			NodeId:  IdentifierToMessage(node.NodeID),
+			Reason:  PendingTransactionReasonToMessage(node.Reason),
+			Message: node.Message,
+		}
+	}
+
+	return &extended.PendingTransactionDetailsResponse{
+		Reason:  PendingTransactionReasonToMessage(details.Reason),
+		Message: details.Message,
+		Nodes:   nodes,
+	}
+}
+
+// MessageToPendingTransactionDetails converts a protobuf message to the aggregated status of a pending transaction
+func MessageToPendingTransactionDetails(m *extended.PendingTransactionDetailsResponse) *accessmodel.PendingTransactionDetails {
+	nodes := make([]accessmodel.CollectionNodeTransactionStatus, len(m.GetNodes()))
+	for i, node := range m.GetNodes() {
+		nodes[i] = accessmodel.CollectionNodeTransactionStatus{
+			NodeID:  MessageToIdentifier(node.GetNodeId()),
+			Reason:  MessageToPendingTransactionReason(node.GetReason()),
+			Message: node.GetMessage(),
+		}
+	}
+
+	return &accessmodel.PendingTransactionDetails{
+		Reason:  MessageToPendingTransactionReason(m.GetReason()),
+		Message: m.GetMessage(),
+		Nodes:   nodes,
+	}
+}
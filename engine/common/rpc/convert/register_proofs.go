@@ -0,0 +1,40 @@
+package convert
+
+import (
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
+	accessmodel "github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// RegisterProofsToMessage converts a batch proof of registers to a protobuf message.
+// The block ID is omitted if the proofs were requested for a state commitment.
+func RegisterProofsToMessage(proofs *accessmodel.RegisterProofs) *extended.RegisterProofsResponse {
+	var blockID []byte
+	if proofs.BlockID != flow.ZeroID {
+		blockID = IdentifierToMessage(proofs.BlockID)
+	}
+	return &extended.RegisterProofsResponse{
+		BlockId:         blockID,
+		StateCommitment: StateCommitmentToMessage(proofs.StateCommitment),
+		Proof:           proofs.Proof,
+	}
+}
+
+// MessageToRegisterProofs converts a protobuf message to a batch proof of registers.
+// Expected errors during normal operations:
+//   - error if the state commitment is invalid.
+func MessageToRegisterProofs(m *extended.RegisterProofsResponse) (*accessmodel.RegisterProofs, error) {
+	commit, err := MessageToStateCommitment(m.GetStateCommitment())
+	if err != nil {
+		return nil, err
+	}
+	blockID := flow.ZeroID
+	if len(m.GetBlockId()) > 0 {
+		blockID = MessageToIdentifier(m.GetBlockId())
+	}
+	return &accessmodel.RegisterProofs{
+		BlockID:         blockID,
+		StateCommitment: commit,
+		Proof:           m.GetProof(),
+	}, nil
+}
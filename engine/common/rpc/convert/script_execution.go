@@ -0,0 +1,30 @@
+package convert
+
+import (
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
+	accessmodel "github.com/onflow/flow-go/model/access"
+)
+
+// ScriptExecutionReportToMessage converts a script execution report to a protobuf message
+func ScriptExecutionReportToMessage(report accessmodel.ScriptExecutionReport) *extended.ScriptExecutionReport {
+	return &extended.ScriptExecutionReport{
+		ComputationUsed: report.ComputationUsed,
+		MemoryEstimate:  report.MemoryEstimate,
+		RegisterReads:   report.RegisterReads,
+		BytesRead:       report.BytesRead,
+		ContractsLoaded: report.ContractsLoaded,
+		ExecutedLocally: report.ExecutedLocally,
+	}
+}
+
+// MessageToScriptExecutionReport converts a protobuf message to a script execution report
+func MessageToScriptExecutionReport(m *extended.ScriptExecutionReport) accessmodel.ScriptExecutionReport {
+	return accessmodel.ScriptExecutionReport{
+		ComputationUsed: m.GetComputationUsed(),
+		MemoryEstimate:  m.GetMemoryEstimate(),
+		RegisterReads:   m.GetRegisterReads(),
+		BytesRead:       m.GetBytesRead(),
+		ContractsLoaded: m.GetContractsLoaded(),
+		ExecutedLocally: m.GetExecutedLocally(),
+	}
+}
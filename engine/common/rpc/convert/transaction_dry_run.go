@@ -0,0 +1,43 @@
+package convert
+
+import (
+	"github.com/onflow/flow-go/engine/access/rpc/extended"
+	accessmodel "github.com/onflow/flow-go/model/access"
+)
+
+// MessageToTransactionDryRunOptions converts a protobuf message to transaction dry-run options.
+// A nil message is converted to the default options.
+func MessageToTransactionDryRunOptions(m *extended.DryRunTransactionOptions) accessmodel.TransactionDryRunOptions {
+	return accessmodel.TransactionDryRunOptions{
+		VerifySignatures:    m.GetVerifySignatures(),
+		CheckSequenceNumber: m.GetCheckSequenceNumber(),
+	}
+}
+
+// TransactionDryRunResultToMessage converts a transaction dry-run result to a protobuf message
+func TransactionDryRunResultToMessage(result *accessmodel.TransactionDryRunResult) *extended.DryRunTransactionResponse {
+	return &extended.DryRunTransactionResponse{
+		BlockId:         IdentifierToMessage(result.BlockID),
+		BlockHeight:     result.BlockHeight,
+		StatusCode:      uint32(result.StatusCode),
+		ErrorMessage:    result.ErrorMessage,
+		ComputationUsed: result.ComputationUsed,
+		MemoryEstimate:  result.MemoryEstimate,
+		Fees:            result.Fees,
+		Events:          EventsToMessages(result.Events),
+	}
+}
+
+// MessageToTransactionDryRunResult converts a protobuf message to a transaction dry-run result
+func MessageToTransactionDryRunResult(m *extended.DryRunTransactionResponse) *accessmodel.TransactionDryRunResult {
+	return &accessmodel.TransactionDryRunResult{
+		BlockID:         MessageToIdentifier(m.GetBlockId()),
+		BlockHeight:     m.GetBlockHeight(),
+		StatusCode:      uint(m.GetStatusCode()),
+		ErrorMessage:    m.GetErrorMessage(),
+		ComputationUsed: m.GetComputationUsed(),
+		MemoryEstimate:  m.GetMemoryEstimate(),
+		Fees:            m.GetFees(),
+		Events:          MessagesToEvents(m.GetEvents()),
+	}
+}
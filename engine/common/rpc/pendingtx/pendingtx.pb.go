@@ -0,0 +1,307 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.0
+// 	protoc        v3.21.12
+// source: engine/common/rpc/pendingtx/pendingtx.proto
+
+package pendingtx
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PendingTransactionReason describes why a transaction has not been included in a collection yet.
+type PendingTransactionReason int32
+
+const (
+	// The collection node does not know the transaction. It was either never received, or was already
+	// included in a collection or removed from the mempool.
+	PendingTransactionReason_PENDING_TRANSACTION_REASON_UNKNOWN PendingTransactionReason = 0
+	// The transaction is waiting in the mempool of the collection node.
+	PendingTransactionReason_PENDING_TRANSACTION_REASON_IN_MEMPOOL PendingTransactionReason = 1
+	// The transaction was rejected, because its payer is rate limited by the collection node.
+	PendingTransactionReason_PENDING_TRANSACTION_REASON_RATE_LIMITED PendingTransactionReason = 2
+	// The transaction was rejected by the collection node, because it failed validation.
+	PendingTransactionReason_PENDING_TRANSACTION_REASON_REJECTED PendingTransactionReason = 3
+	// The collection node could not be queried. Only reported by access nodes.
+	PendingTransactionReason_PENDING_TRANSACTION_REASON_UNREACHABLE PendingTransactionReason = 4
+	// The transaction expired before it was included in a collection. It was either rejected by the
+	// collection node, or dropped from its mempool.
+	PendingTransactionReason_PENDING_TRANSACTION_REASON_EXPIRED PendingTransactionReason = 5
+)
+
+// Enum value maps for PendingTransactionReason.
+var (
+	PendingTransactionReason_name = map[int32]string{
+		0: "PENDING_TRANSACTION_REASON_UNKNOWN",
+		1: "PENDING_TRANSACTION_REASON_IN_MEMPOOL",
+		2: "PENDING_TRANSACTION_REASON_RATE_LIMITED",
+		3: "PENDING_TRANSACTION_REASON_REJECTED",
+		4: "PENDING_TRANSACTION_REASON_UNREACHABLE",
+		5: "PENDING_TRANSACTION_REASON_EXPIRED",
+	}
+	PendingTransactionReason_value = map[string]int32{
+		"PENDING_TRANSACTION_REASON_UNKNOWN":      0,
+		"PENDING_TRANSACTION_REASON_IN_MEMPOOL":   1,
+		"PENDING_TRANSACTION_REASON_RATE_LIMITED": 2,
+		"PENDING_TRANSACTION_REASON_REJECTED":     3,
+		"PENDING_TRANSACTION_REASON_UNREACHABLE":  4,
+		"PENDING_TRANSACTION_REASON_EXPIRED":      5,
+	}
+)
+
+func (x PendingTransactionReason) Enum() *PendingTransactionReason {
+	p := new(PendingTransactionReason)
+	*p = x
+	return p
+}
+
+func (x PendingTransactionReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PendingTransactionReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_engine_common_rpc_pendingtx_pendingtx_proto_enumTypes[0].Descriptor()
+}
+
+func (PendingTransactionReason) Type() protoreflect.EnumType {
+	return &file_engine_common_rpc_pendingtx_pendingtx_proto_enumTypes[0]
+}
+
+func (x PendingTransactionReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PendingTransactionReason.Descriptor instead.
+func (PendingTransactionReason) EnumDescriptor() ([]byte, []int) {
+	return file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescGZIP(), []int{0}
+}
+
+type GetPendingTransactionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            []byte                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPendingTransactionStatusRequest) Reset() {
+	*x = GetPendingTransactionStatusRequest{}
+	mi := &file_engine_common_rpc_pendingtx_pendingtx_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPendingTransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingTransactionStatusRequest) ProtoMessage() {}
+
+func (x *GetPendingTransactionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_common_rpc_pendingtx_pendingtx_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingTransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingTransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPendingTransactionStatusRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type PendingTransactionStatusResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	TransactionId []byte                   `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Reason        PendingTransactionReason `protobuf:"varint,2,opt,name=reason,proto3,enum=flow.collection.pendingtx.PendingTransactionReason" json:"reason,omitempty"`
+	// message is a human-readable description of the reason.
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingTransactionStatusResponse) Reset() {
+	*x = PendingTransactionStatusResponse{}
+	mi := &file_engine_common_rpc_pendingtx_pendingtx_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingTransactionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingTransactionStatusResponse) ProtoMessage() {}
+
+func (x *PendingTransactionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_common_rpc_pendingtx_pendingtx_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingTransactionStatusResponse.ProtoReflect.Descriptor instead.
+func (*PendingTransactionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PendingTransactionStatusResponse) GetTransactionId() []byte {
+	if x != nil {
+		return x.TransactionId
+	}
+	return nil
+}
+
+func (x *PendingTransactionStatusResponse) GetReason() PendingTransactionReason {
+	if x != nil {
+		return x.Reason
+	}
+	return PendingTransactionReason_PENDING_TRANSACTION_REASON_UNKNOWN
+}
+
+func (x *PendingTransactionStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_engine_common_rpc_pendingtx_pendingtx_proto protoreflect.FileDescriptor
+
+var file_engine_common_rpc_pendingtx_pendingtx_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x74, 0x78, 0x2f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x66,
+	0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x74, 0x78, 0x22, 0x34, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0xb0,
+	0x01, 0x0a, 0x20, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x66, 0x6c, 0x6f,
+	0x77, 0x2e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x74, 0x78, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2a, 0x97, 0x02, 0x0a, 0x18, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x22, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x5f, 0x4d, 0x45, 0x4d, 0x50, 0x4f, 0x4f, 0x4c, 0x10,
+	0x01, 0x12, 0x2b, 0x0a, 0x27, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x27,
+	0x0a, 0x23, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x2a, 0x0a, 0x26, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x32, 0xb4, 0x01, 0x0a, 0x16,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x41, 0x50, 0x49, 0x12, 0x99, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x74, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x66, 0x6c, 0x6f, 0x77, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x74,
+	0x78, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6f, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x66, 0x6c, 0x6f, 0x77, 0x2d, 0x67, 0x6f, 0x2f,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x72, 0x70,
+	0x63, 0x2f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x74, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescOnce sync.Once
+	file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescData = file_engine_common_rpc_pendingtx_pendingtx_proto_rawDesc
+)
+
+func file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescGZIP() []byte {
+	file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescOnce.Do(func() {
+		file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescData = protoimpl.X.CompressGZIP(file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescData)
+	})
+	return file_engine_common_rpc_pendingtx_pendingtx_proto_rawDescData
+}
+
+var file_engine_common_rpc_pendingtx_pendingtx_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_engine_common_rpc_pendingtx_pendingtx_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_engine_common_rpc_pendingtx_pendingtx_proto_goTypes = []any{
+	(PendingTransactionReason)(0),              // 0: flow.collection.pendingtx.PendingTransactionReason
+	(*GetPendingTransactionStatusRequest)(nil), // 1: flow.collection.pendingtx.GetPendingTransactionStatusRequest
+	(*PendingTransactionStatusResponse)(nil),   // 2: flow.collection.pendingtx.PendingTransactionStatusResponse
+}
+var file_engine_common_rpc_pendingtx_pendingtx_proto_depIdxs = []int32{
+	0, // 0: flow.collection.pendingtx.PendingTransactionStatusResponse.reason:type_name -> flow.collection.pendingtx.PendingTransactionReason
+	1, // 1: flow.collection.pendingtx.PendingTransactionsAPI.GetPendingTransactionStatus:input_type -> flow.collection.pendingtx.GetPendingTransactionStatusRequest
+	2, // 2: flow.collection.pendingtx.PendingTransactionsAPI.GetPendingTransactionStatus:output_type -> flow.collection.pendingtx.PendingTransactionStatusResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_engine_common_rpc_pendingtx_pendingtx_proto_init() }
+func file_engine_common_rpc_pendingtx_pendingtx_proto_init() {
+	if File_engine_common_rpc_pendingtx_pendingtx_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_engine_common_rpc_pendingtx_pendingtx_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_engine_common_rpc_pendingtx_pendingtx_proto_goTypes,
+		DependencyIndexes: file_engine_common_rpc_pendingtx_pendingtx_proto_depIdxs,
+		EnumInfos:         file_engine_common_rpc_pendingtx_pendingtx_proto_enumTypes,
+		MessageInfos:      file_engine_common_rpc_pendingtx_pendingtx_proto_msgTypes,
+	}.Build()
+	File_engine_common_rpc_pendingtx_pendingtx_proto = out.File
+	file_engine_common_rpc_pendingtx_pendingtx_proto_rawDesc = nil
+	file_engine_common_rpc_pendingtx_pendingtx_proto_goTypes = nil
+	file_engine_common_rpc_pendingtx_pendingtx_proto_depIdxs = nil
+}
@@ -0,0 +1,108 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: engine/common/rpc/pendingtx/pendingtx.proto
+
+package pendingtx
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PendingTransactionsAPIClient is the client API for PendingTransactionsAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PendingTransactionsAPIClient interface {
+	// GetPendingTransactionStatus returns the status of the transaction within the collection node.
+	GetPendingTransactionStatus(ctx context.Context, in *GetPendingTransactionStatusRequest, opts ...grpc.CallOption) (*PendingTransactionStatusResponse, error)
+}
+
+type pendingTransactionsAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPendingTransactionsAPIClient(cc grpc.ClientConnInterface) PendingTransactionsAPIClient {
+	return &pendingTransactionsAPIClient{cc}
+}
+
+func (c *pendingTransactionsAPIClient) GetPendingTransactionStatus(ctx context.Context, in *GetPendingTransactionStatusRequest, opts ...grpc.CallOption) (*PendingTransactionStatusResponse, error) {
+	out := new(PendingTransactionStatusResponse)
+	err := c.cc.Invoke(ctx, "/flow.collection.pendingtx.PendingTransactionsAPI/GetPendingTransactionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PendingTransactionsAPIServer is the server API for PendingTransactionsAPI service.
+// All implementations must embed UnimplementedPendingTransactionsAPIServer
+// for forward compatibility
+type PendingTransactionsAPIServer interface {
+	// GetPendingTransactionStatus returns the status of the transaction within the collection node.
+	GetPendingTransactionStatus(context.Context, *GetPendingTransactionStatusRequest) (*PendingTransactionStatusResponse, error)
+	mustEmbedUnimplementedPendingTransactionsAPIServer()
+}
+
+// UnimplementedPendingTransactionsAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedPendingTransactionsAPIServer struct {
+}
+
+func (UnimplementedPendingTransactionsAPIServer) GetPendingTransactionStatus(context.Context, *GetPendingTransactionStatusRequest) (*PendingTransactionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingTransactionStatus not implemented")
+}
+func (UnimplementedPendingTransactionsAPIServer) mustEmbedUnimplementedPendingTransactionsAPIServer() {
+}
+
+// UnsafePendingTransactionsAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PendingTransactionsAPIServer will
+// result in compilation errors.
+type UnsafePendingTransactionsAPIServer interface {
+	mustEmbedUnimplementedPendingTransactionsAPIServer()
+}
+
+func RegisterPendingTransactionsAPIServer(s grpc.ServiceRegistrar, srv PendingTransactionsAPIServer) {
+	s.RegisterService(&PendingTransactionsAPI_ServiceDesc, srv)
+}
+
+func _PendingTransactionsAPI_GetPendingTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PendingTransactionsAPIServer).GetPendingTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.collection.pendingtx.PendingTransactionsAPI/GetPendingTransactionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PendingTransactionsAPIServer).GetPendingTransactionStatus(ctx, req.(*GetPendingTransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PendingTransactionsAPI_ServiceDesc is the grpc.ServiceDesc for PendingTransactionsAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PendingTransactionsAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flow.collection.pendingtx.PendingTransactionsAPI",
+	HandlerType: (*PendingTransactionsAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPendingTransactionStatus",
+			Handler:    _PendingTransactionsAPI_GetPendingTransactionStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "engine/common/rpc/pendingtx/pendingtx.proto",
+}
@@ -0,0 +1,6 @@
+// Package registerproofs defines the RegisterProofsAPI gRPC service, served by execution nodes to
+// provide batch proofs of registers against a state commitment.
+package registerproofs
+
+// MaxRegistersPerRequest is the maximum number of registers which can be proven by a single request.
+const MaxRegistersPerRequest = 1024
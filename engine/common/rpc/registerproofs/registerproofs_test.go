@@ -0,0 +1,62 @@
+package registerproofs_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+
+	"github.com/onflow/flow-go/engine/common/rpc/registerproofs"
+)
+
+type server struct {
+	registerproofs.UnimplementedRegisterProofsAPIServer
+	received *registerproofs.GetRegisterProofsRequest
+}
+
+func (s *server) GetRegisterProofs(_ context.Context, req *registerproofs.GetRegisterProofsRequest) (*registerproofs.GetRegisterProofsResponse, error) {
+	s.received = req
+	return &registerproofs.GetRegisterProofsResponse{
+		StateCommitment: []byte("commit"),
+		Proof:           []byte("proof"),
+	}, nil
+}
+
+// TestGetRegisterProofs tests that the requests and responses are transmitted over gRPC.
+func TestGetRegisterProofs(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	srv := &server{}
+	registerproofs.RegisterRegisterProofsAPIServer(grpcServer, srv)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	req := &registerproofs.GetRegisterProofsRequest{
+		BlockId:   []byte("block"),
+		Registers: []*entities.RegisterID{{Owner: []byte("owner"), Key: []byte("key")}},
+	}
+	resp, err := registerproofs.NewRegisterProofsAPIClient(conn).GetRegisterProofs(context.Background(), req)
+	require.NoError(t, err)
+
+	require.True(t, proto.Equal(req, srv.received))
+	require.Equal(t, []byte("commit"), resp.GetStateCommitment())
+	require.Equal(t, []byte("proof"), resp.GetProof())
+}
@@ -0,0 +1,216 @@
+package checker
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/vmihailenco/msgpack"
+
+	"github.com/onflow/flow-go/fvm/meter"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ForensicBundle holds everything needed to investigate a block whose locally computed execution
+// result diverges from the sealed result. The bundle is self-contained: the transactions of the
+// diverging chunk can be replayed using only the registers included in the bundle, for example
+// with the `debug-tx` util command.
+type ForensicBundle struct {
+	ChainID flow.ChainID `json:"chain_id"`
+	// Block is the block whose execution result diverges.
+	Block *flow.Block `json:"-"`
+	// Collections are the collections of the block, in the order of the block's guarantees.
+	Collections []*flow.Collection `json:"-"`
+	// LocalResult is the execution result computed by this node.
+	LocalResult *flow.ExecutionResult `json:"-"`
+	// SealedResult is the execution result which was sealed.
+	SealedResult *flow.ExecutionResult `json:"-"`
+
+	// ChunkIndex is the index of the first chunk whose end state diverges.
+	ChunkIndex uint64 `json:"chunk_index"`
+	// TransactionOffset is the index of the first transaction of the chunk within the block.
+	TransactionOffset uint32 `json:"transaction_offset"`
+	// IsSystemChunk indicates whether the diverging chunk is the system chunk.
+	IsSystemChunk bool `json:"is_system_chunk"`
+	// ChunkDataPack is the chunk data pack of the diverging chunk, as computed by this node.
+	ChunkDataPack *flow.ChunkDataPack `json:"-"`
+
+	// Registers holds the value of all registers read while re-executing the chunk, at the start
+	// state of the chunk.
+	Registers []BundleRegister `json:"registers"`
+	// MissingRegisters holds the registers read while re-executing the chunk, which are not
+	// included in the chunk data pack.
+	MissingRegisters []BundleRegister `json:"missing_registers,omitempty"`
+	// Transactions holds the trace of each transaction of the chunk, in execution order.
+	Transactions []TransactionTrace `json:"transactions"`
+	// ReexecutedEndState is the end state of the chunk when re-executing it, which is expected
+	// to equal the end state of the chunk in the local result.
+	ReexecutedEndState flow.StateCommitment `json:"reexecuted_end_state"`
+}
+
+// bundleEntities holds the protocol entities of a bundle. They are msgpack encoded, the same way
+// they are stored in the protocol database, because decoding a header from JSON is not supported.
+type bundleEntities struct {
+	Block         *flow.Block
+	Collections   []*flow.Collection
+	LocalResult   *flow.ExecutionResult
+	SealedResult  *flow.ExecutionResult
+	ChunkDataPack *flow.ChunkDataPack
+}
+
+// encodableBundle is the file format of a bundle: the register diffs and traces are JSON encoded,
+// so they can be read with any JSON tool, and the protocol entities are embedded msgpack encoded.
+type encodableBundle struct {
+	ForensicBundle
+	Entities []byte `json:"entities"`
+}
+
+// TransactionTrace is the outcome of re-executing a single transaction of the diverging chunk.
+type TransactionTrace struct {
+	TransactionID          flow.Identifier                     `json:"transaction_id"`
+	Index                  uint32                              `json:"index"`
+	Transaction            *flow.TransactionBody               `json:"transaction"`
+	ComputationUsed        uint64                              `json:"computation_used"`
+	ComputationIntensities meter.MeteredComputationIntensities `json:"computation_intensities,omitempty"`
+	MemoryEstimate         uint64                              `json:"memory_estimate"`
+	Error                  string                              `json:"error,omitempty"`
+	Logs                   []string                            `json:"logs,omitempty"`
+	Events                 []flow.Event                        `json:"events"`
+	RegisterWrites         []RegisterDiff                      `json:"register_writes"`
+}
+
+// BundleRegister is a register and its value. The owner and key are hex encoded, because they
+// are arbitrary bytes.
+type BundleRegister struct {
+	Owner string `json:"owner"`
+	Key   string `json:"key"`
+	Value []byte `json:"value,omitempty"`
+}
+
+// RegisterDiff is a register written by a transaction, with its value before and after the write.
+type RegisterDiff struct {
+	Owner string `json:"owner"`
+	Key   string `json:"key"`
+	Old   []byte `json:"old,omitempty"`
+	New   []byte `json:"new,omitempty"`
+}
+
+// NewBundleRegister returns the bundle representation of a register.
+func NewBundleRegister(id flow.RegisterID, value flow.RegisterValue) BundleRegister {
+	return BundleRegister{
+		Owner: hex.EncodeToString([]byte(id.Owner)),
+		Key:   hex.EncodeToString([]byte(id.Key)),
+		Value: value,
+	}
+}
+
+// NewRegisterDiff returns the bundle representation of a register write.
+func NewRegisterDiff(id flow.RegisterID, old flow.RegisterValue, new flow.RegisterValue) RegisterDiff {
+	return RegisterDiff{
+		Owner: hex.EncodeToString([]byte(id.Owner)),
+		Key:   hex.EncodeToString([]byte(id.Key)),
+		Old:   old,
+		New:   new,
+	}
+}
+
+// RegisterID returns the ID of the register.
+// Expected errors during normal operations:
+//   - error if the owner or key are not hex encoded.
+func (r BundleRegister) RegisterID() (flow.RegisterID, error) {
+	return decodeRegisterID(r.Owner, r.Key)
+}
+
+// RegisterID returns the ID of the written register.
+// Expected errors during normal operations:
+//   - error if the owner or key are not hex encoded.
+func (d RegisterDiff) RegisterID() (flow.RegisterID, error) {
+	return decodeRegisterID(d.Owner, d.Key)
+}
+
+func decodeRegisterID(owner string, key string) (flow.RegisterID, error) {
+	ownerBytes, err := hex.DecodeString(owner)
+	if err != nil {
+		return flow.RegisterID{}, fmt.Errorf("invalid register owner %q: %w", owner, err)
+	}
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return flow.RegisterID{}, fmt.Errorf("invalid register key %q: %w", key, err)
+	}
+	return flow.RegisterID{Owner: string(ownerBytes), Key: string(keyBytes)}, nil
+}
+
+// BundleFileName returns the name of the bundle file for the given block.
+func BundleFileName(header *flow.Header) string {
+	return fmt.Sprintf("forensics_%d_%s.json", header.Height, header.ID())
+}
+
+// WriteForensicBundle writes the bundle to the given file. The file is written atomically, so a
+// partially written bundle is never observed under the final name.
+func WriteForensicBundle(path string, bundle *ForensicBundle) error {
+	entities, err := msgpack.Marshal(bundleEntities{
+		Block:         bundle.Block,
+		Collections:   bundle.Collections,
+		LocalResult:   bundle.LocalResult,
+		SealedResult:  bundle.SealedResult,
+		ChunkDataPack: bundle.ChunkDataPack,
+	})
+	if err != nil {
+		return fmt.Errorf("could not encode forensic bundle entities: %w", err)
+	}
+
+	data, err := json.MarshalIndent(encodableBundle{
+		ForensicBundle: *bundle,
+		Entities:       entities,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode forensic bundle: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("could not create forensic bundle directory: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	err = os.WriteFile(tmp, data, 0644)
+	if err != nil {
+		return fmt.Errorf("could not write forensic bundle: %w", err)
+	}
+
+	err = os.Rename(tmp, path)
+	if err != nil {
+		return fmt.Errorf("could not rename forensic bundle: %w", err)
+	}
+	return nil
+}
+
+// ReadForensicBundle reads a bundle written by WriteForensicBundle.
+func ReadForensicBundle(path string) (*ForensicBundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read forensic bundle: %w", err)
+	}
+
+	var encodable encodableBundle
+	err = json.Unmarshal(data, &encodable)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode forensic bundle: %w", err)
+	}
+
+	var entities bundleEntities
+	err = msgpack.Unmarshal(encodable.Entities, &entities)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode forensic bundle entities: %w", err)
+	}
+
+	bundle := encodable.ForensicBundle
+	bundle.Block = entities.Block
+	bundle.Collections = entities.Collections
+	bundle.LocalResult = entities.LocalResult
+	bundle.SealedResult = entities.SealedResult
+	bundle.ChunkDataPack = entities.ChunkDataPack
+	return &bundle, nil
+}
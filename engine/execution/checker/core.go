@@ -11,6 +11,7 @@ import (
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/state/protocol"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/logging"
 )
 
 // Core is the core logic of the checker engine that checks if the execution result matches the sealed result.
@@ -18,17 +19,22 @@ type Core struct {
 	log       zerolog.Logger
 	state     protocol.State
 	execState state.ExecutionState
+	forensics *Forensics // optional, collects a forensic bundle when a mismatch is detected
 }
 
+// NewCore creates a new checker core. The forensics is optional and may be nil, when provided a
+// forensic bundle is collected before a mismatch is reported.
 func NewCore(
 	logger zerolog.Logger,
 	state protocol.State,
 	execState state.ExecutionState,
+	forensics *Forensics,
 ) *Core {
 	e := &Core{
 		log:       logger.With().Str("engine", "checker").Logger(),
 		state:     state,
 		execState: execState,
+		forensics: forensics,
 	}
 
 	return e
@@ -71,7 +77,7 @@ func (c *Core) RunCheck() error {
 	mycommitAtLastSealed, err := c.execState.StateCommitmentByBlockID(lastSealedBlock.ID())
 	if err == nil {
 		// if last sealed block has been executed, then check if they match
-		return checkMyCommitWithSealedCommit(c.log, lastSealedBlock, mycommitAtLastSealed, seal.FinalState)
+		return c.check(lastSealedBlock, mycommitAtLastSealed, seal)
 	}
 
 	// if last sealed block has not been executed, then check if recent executed block has
@@ -94,8 +100,6 @@ func (c *Core) RunCheck() error {
 		return fmt.Errorf("could not get the last sealed block at height: %v, err: %w", lastExecutedHeight, err)
 	}
 
-	sealedCommit := seal.FinalState
-
 	mycommit, err := c.execState.StateCommitmentByBlockID(seal.BlockID)
 	if errors.Is(err, storage.ErrNotFound) {
 		// have not executed the sealed block yet
@@ -108,7 +112,31 @@ func (c *Core) RunCheck() error {
 		return fmt.Errorf("could not get my state commitment OnFinalizedBlock, blockID: %v", seal.BlockID)
 	}
 
-	return checkMyCommitWithSealedCommit(c.log, sealedExecuted, mycommit, sealedCommit)
+	return c.check(sealedExecuted, mycommit, seal)
+}
+
+// check checks if the local commitment of the executed block matches the sealed commitment, and
+// collects a forensic bundle if they don't.
+func (c *Core) check(executedBlock *flow.Header, myCommit flow.StateCommitment, seal *flow.Seal) error {
+	mismatch := checkMyCommitWithSealedCommit(c.log, executedBlock, myCommit, seal.FinalState)
+	if mismatch == nil || c.forensics == nil {
+		return mismatch
+	}
+
+	// failing to collect the bundle must not hide the mismatch
+	path, err := c.forensics.Collect(executedBlock, seal.ResultID)
+	if err != nil {
+		c.log.Error().Err(err).
+			Hex("block_id", logging.Entity(executedBlock)).
+			Msg("could not collect forensic bundle for execution result mismatch")
+		return mismatch
+	}
+
+	c.log.Warn().
+		Hex("block_id", logging.Entity(executedBlock)).
+		Str("bundle", path).
+		Msg("collected forensic bundle for execution result mismatch")
+	return mismatch
 }
 
 // findLastSealedBlock finds the last sealed block
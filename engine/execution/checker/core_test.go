@@ -18,7 +18,7 @@ func makeCore(t *testing.T) (*checker.Core, *protocol.State, *stateMock.Executio
 	logger := unittest.Logger()
 	state := protocol.NewState(t)
 	execState := stateMock.NewExecutionState(t)
-	core := checker.NewCore(logger, state, execState)
+	core := checker.NewCore(logger, state, execState, nil)
 	return core, state, execState
 }
 
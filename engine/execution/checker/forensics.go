@@ -0,0 +1,325 @@
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/engine/execution/computation/computer"
+	executionState "github.com/onflow/flow-go/engine/execution/state"
+	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/blueprints"
+	"github.com/onflow/flow-go/fvm/storage/derived"
+	"github.com/onflow/flow-go/fvm/storage/logical"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	fvmState "github.com/onflow/flow-go/fvm/storage/state"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/partial"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+)
+
+// Forensics collects a forensic bundle for a block whose locally computed execution result
+// diverges from the sealed result. It finds the first chunk whose end state diverges, re-executes
+// it from the local chunk data pack while capturing the outcome of each transaction, and writes
+// the bundle to disk.
+type Forensics struct {
+	log            zerolog.Logger
+	dir            string
+	vm             fvm.VM
+	vmCtx          fvm.Context
+	state          protocol.State
+	blocks         storage.Blocks
+	collections    storage.Collections
+	results        storage.ExecutionResultsReader
+	chunkDataPacks storage.ChunkDataPacks
+}
+
+// NewForensics creates a new Forensics, which writes bundles to the given directory.
+func NewForensics(
+	logger zerolog.Logger,
+	dir string,
+	vm fvm.VM,
+	vmCtx fvm.Context,
+	state protocol.State,
+	blocks storage.Blocks,
+	collections storage.Collections,
+	results storage.ExecutionResultsReader,
+	chunkDataPacks storage.ChunkDataPacks,
+) *Forensics {
+	return &Forensics{
+		log:            logger.With().Str("component", "execution_forensics").Logger(),
+		dir:            dir,
+		vm:             vm,
+		vmCtx:          vmCtx,
+		state:          state,
+		blocks:         blocks,
+		collections:    collections,
+		results:        results,
+		chunkDataPacks: chunkDataPacks,
+	}
+}
+
+// Collect collects the forensic bundle for the given block, whose locally computed result diverges
+// from the sealed result with the given ID, and returns the path of the written bundle.
+// If a bundle for the block was already written, it is not collected again.
+//
+// No errors are expected during normal operations.
+func (f *Forensics) Collect(executedBlock *flow.Header, sealedResultID flow.Identifier) (string, error) {
+	path := filepath.Join(f.dir, BundleFileName(executedBlock))
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	bundle, err := f.buildBundle(executedBlock.ID(), sealedResultID)
+	if err != nil {
+		return "", err
+	}
+
+	err = WriteForensicBundle(path, bundle)
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// buildBundle builds the forensic bundle for the given block.
+func (f *Forensics) buildBundle(blockID flow.Identifier, sealedResultID flow.Identifier) (*ForensicBundle, error) {
+	block, err := f.blocks.ByID(blockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block %v: %w", blockID, err)
+	}
+
+	collections := make([]*flow.Collection, 0, len(block.Payload.Guarantees))
+	for _, guarantee := range block.Payload.Guarantees {
+		collection, err := f.collections.ByID(guarantee.CollectionID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get collection %v: %w", guarantee.CollectionID, err)
+		}
+		collections = append(collections, collection)
+	}
+
+	localResult, err := f.results.ByBlockID(blockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get local execution result for block %v: %w", blockID, err)
+	}
+	sealedResult, err := f.results.ByID(sealedResultID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get sealed execution result %v: %w", sealedResultID, err)
+	}
+
+	chunkIndex, err := FirstDivergingChunk(localResult, sealedResult)
+	if err != nil {
+		return nil, err
+	}
+	chunk := localResult.Chunks[chunkIndex]
+
+	chunkDataPack, err := f.chunkDataPacks.ByChunkID(chunk.ID())
+	if err != nil {
+		return nil, fmt.Errorf("could not get chunk data pack for chunk %d: %w", chunkIndex, err)
+	}
+
+	var transactionOffset uint32
+	for _, c := range localResult.Chunks[:chunkIndex] {
+		transactionOffset += uint32(c.NumberOfTransactions)
+	}
+
+	bundle := &ForensicBundle{
+		ChainID:           f.vmCtx.Chain.ChainID(),
+		Block:             block,
+		Collections:       collections,
+		LocalResult:       localResult,
+		SealedResult:      sealedResult,
+		ChunkIndex:        chunkIndex,
+		TransactionOffset: transactionOffset,
+		IsSystemChunk:     int(chunkIndex) == len(localResult.Chunks)-1,
+		ChunkDataPack:     chunkDataPack,
+	}
+
+	err = f.reexecuteChunk(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("could not re-execute chunk %d: %w", chunkIndex, err)
+	}
+
+	f.log.Info().
+		Hex("block_id", blockID[:]).
+		Uint64("chunk_index", chunkIndex).
+		Bool("reexecution_matches_local", bundle.ReexecutedEndState == chunk.EndState).
+		Msg("re-executed diverging chunk")
+
+	return bundle, nil
+}
+
+// FirstDivergingChunk returns the index of the first chunk of the local result whose end state or
+// events differ from the sealed result.
+//
+// No errors are expected during normal operations, unless the results do not diverge or have a
+// different number of chunks.
+func FirstDivergingChunk(localResult *flow.ExecutionResult, sealedResult *flow.ExecutionResult) (uint64, error) {
+	if len(localResult.Chunks) != len(sealedResult.Chunks) {
+		return 0, fmt.Errorf("local result has %d chunks, sealed result has %d chunks",
+			len(localResult.Chunks), len(sealedResult.Chunks))
+	}
+
+	for i, local := range localResult.Chunks {
+		sealed := sealedResult.Chunks[i]
+		if local.EndState != sealed.EndState || local.EventCollection != sealed.EventCollection {
+			return uint64(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("local result %v does not diverge from sealed result %v", localResult.ID(), sealedResult.ID())
+}
+
+// recordingSnapshot records the value of all registers read from the chunk data pack.
+type recordingSnapshot struct {
+	backing snapshot.StorageSnapshot
+	read    map[flow.RegisterID]flow.RegisterValue
+	missing map[flow.RegisterID]struct{}
+}
+
+var _ snapshot.StorageSnapshot = (*recordingSnapshot)(nil)
+
+func (s *recordingSnapshot) Get(id flow.RegisterID) (flow.RegisterValue, error) {
+	value, err := s.backing.Get(id)
+	if err != nil && errors.Is(err, ledger.ErrMissingKeys{}) {
+		// the register is not part of the chunk data pack, execute with an empty value like
+		// the verification nodes do
+		s.missing[id] = struct{}{}
+		return flow.RegisterValue{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.read[id] = value
+	return value, nil
+}
+
+// reexecuteChunk executes the transactions of the bundle's chunk on top of the start state of the
+// chunk data pack, and records the trace of each transaction in the bundle.
+func (f *Forensics) reexecuteChunk(bundle *ForensicBundle) error {
+	chunkDataPack := bundle.ChunkDataPack
+	header := bundle.Block.Header
+
+	var ctx fvm.Context
+	var transactions []*flow.TransactionBody
+	if bundle.IsSystemChunk {
+		ctx = computer.SystemChunkContext(f.vmCtx, metrics.NewNoopCollector())
+
+		txBody, err := blueprints.SystemChunkTransaction(f.vmCtx.Chain)
+		if err != nil {
+			return fmt.Errorf("could not get system chunk transaction: %w", err)
+		}
+		transactions = []*flow.TransactionBody{txBody}
+	} else {
+		ctx = f.vmCtx
+		if chunkDataPack.Collection == nil {
+			return fmt.Errorf("chunk data pack of chunk %d has no collection", bundle.ChunkIndex)
+		}
+		transactions = chunkDataPack.Collection.Transactions
+	}
+
+	ctx = fvm.NewContextFromParent(
+		ctx,
+		fvm.WithBlockHeader(header),
+		fvm.WithProtocolStateSnapshot(f.state.AtBlockID(header.ID())),
+		fvm.WithDerivedBlockData(
+			derived.NewEmptyDerivedBlockData(logical.Time(bundle.TransactionOffset))))
+
+	psmt, err := partial.NewLedger(chunkDataPack.Proof, ledger.State(chunkDataPack.StartState), partial.DefaultPathFinderVersion)
+	if err != nil {
+		return fmt.Errorf("could not construct partial trie from chunk data pack: %w", err)
+	}
+
+	recorder := &recordingSnapshot{
+		backing: executionState.NewLedgerStorageSnapshot(psmt, chunkDataPack.StartState),
+		read:    make(map[flow.RegisterID]flow.RegisterValue),
+		missing: make(map[flow.RegisterID]struct{}),
+	}
+	snapshotTree := snapshot.NewSnapshotTree(recorder)
+	chunkState := fvmState.NewExecutionState(nil, fvmState.DefaultParameters())
+
+	bundle.Transactions = make([]TransactionTrace, 0, len(transactions))
+	for i, txBody := range transactions {
+		index := bundle.TransactionOffset + uint32(i)
+		executionSnapshot, output, err := f.vm.Run(ctx, fvm.Transaction(txBody, index), snapshotTree)
+		if err != nil {
+			return fmt.Errorf("failed to execute transaction %d: %w", i, err)
+		}
+
+		trace := TransactionTrace{
+			TransactionID:          txBody.ID(),
+			Index:                  index,
+			Transaction:            txBody,
+			ComputationUsed:        output.ComputationUsed,
+			ComputationIntensities: output.ComputationIntensities,
+			MemoryEstimate:         output.MemoryEstimate,
+			Logs:                   output.Logs,
+			Events:                 output.Events,
+		}
+		if output.Err != nil {
+			trace.Error = output.Err.Error()
+		}
+
+		for _, entry := range executionSnapshot.UpdatedRegisters() {
+			old, err := snapshotTree.Get(entry.Key)
+			if err != nil {
+				return fmt.Errorf("could not read register %v: %w", entry.Key, err)
+			}
+			trace.RegisterWrites = append(trace.RegisterWrites, NewRegisterDiff(entry.Key, old, entry.Value))
+		}
+		bundle.Transactions = append(bundle.Transactions, trace)
+
+		snapshotTree = snapshotTree.Append(executionSnapshot)
+		err = chunkState.Merge(executionSnapshot)
+		if err != nil {
+			return fmt.Errorf("failed to merge transaction %d: %w", i, err)
+		}
+	}
+
+	bundle.Registers = sortedRegisters(recorder.read)
+	missing := make(map[flow.RegisterID]flow.RegisterValue, len(recorder.missing))
+	for id := range recorder.missing {
+		missing[id] = nil
+	}
+	bundle.MissingRegisters = sortedRegisters(missing)
+
+	// compute the end state of the chunk, unless registers are missing from the chunk data pack
+	if len(recorder.missing) > 0 {
+		return nil
+	}
+	keys, values := executionState.RegisterEntriesToKeysValues(chunkState.Finalize().UpdatedRegisters())
+	update, err := ledger.NewUpdate(ledger.State(chunkDataPack.StartState), keys, values)
+	if err != nil {
+		return fmt.Errorf("could not create ledger update: %w", err)
+	}
+	endState, _, err := psmt.Set(update)
+	if err != nil {
+		return fmt.Errorf("could not apply chunk updates to partial trie: %w", err)
+	}
+	bundle.ReexecutedEndState = flow.StateCommitment(endState)
+
+	return nil
+}
+
+// sortedRegisters returns the given registers sorted by owner and key, so bundles are deterministic.
+func sortedRegisters(registers map[flow.RegisterID]flow.RegisterValue) []BundleRegister {
+	result := make([]BundleRegister, 0, len(registers))
+	for id, value := range registers {
+		result = append(result, NewBundleRegister(id, value))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Owner != result[j].Owner {
+			return result[i].Owner < result[j].Owner
+		}
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
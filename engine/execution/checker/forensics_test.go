@@ -0,0 +1,206 @@
+package checker_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/engine/execution/checker"
+	executionState "github.com/onflow/flow-go/engine/execution/state"
+	"github.com/onflow/flow-go/fvm"
+	fvmmock "github.com/onflow/flow-go/fvm/mock"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/ledger"
+	completeLedger "github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/wal/fixtures"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	protocol "github.com/onflow/flow-go/state/protocol/mock"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestForensicsCollect(t *testing.T) {
+	readID := flow.NewRegisterID(unittest.RandomAddressFixture(), "read")
+	writeID := flow.NewRegisterID(unittest.RandomAddressFixture(), "write")
+
+	// set up the start state of the chunk and the proof of the registers touched by the chunk
+	l, err := completeLedger.NewLedger(&fixtures.NoopWAL{}, 100, metrics.NewNoopCollector(), zerolog.Nop(), completeLedger.DefaultPathFinderVersion)
+	require.NoError(t, err)
+	compactor := fixtures.NewNoopCompactor(l)
+	<-compactor.Ready()
+	defer func() {
+		<-l.Done()
+		<-compactor.Done()
+	}()
+
+	keys, values := executionState.RegisterEntriesToKeysValues(flow.RegisterEntries{
+		{Key: readID, Value: []byte{'a'}},
+		{Key: writeID, Value: []byte{'b'}},
+	})
+	update, err := ledger.NewUpdate(l.InitialState(), keys, values)
+	require.NoError(t, err)
+	startState, _, err := l.Set(update)
+	require.NoError(t, err)
+	query, err := ledger.NewQuery(startState, keys)
+	require.NoError(t, err)
+	proof, err := l.Prove(query)
+	require.NoError(t, err)
+
+	// the expected end state of the chunk after the transaction wrote the register
+	keys, values = executionState.RegisterEntriesToKeysValues(flow.RegisterEntries{
+		{Key: writeID, Value: []byte{'B'}},
+	})
+	update, err = ledger.NewUpdate(startState, keys, values)
+	require.NoError(t, err)
+	endState, _, err := l.Set(update)
+	require.NoError(t, err)
+
+	collection := unittest.CollectionFixture(1)
+	guarantee := collection.Guarantee()
+	block := unittest.BlockWithGuaranteesFixture([]*flow.CollectionGuarantee{&guarantee})
+	header := block.Header
+
+	localResult := unittest.ExecutionResultFixture(unittest.WithBlock(block))
+	require.Len(t, localResult.Chunks, 2)
+	localResult.Chunks[0].StartState = flow.StateCommitment(startState)
+	localResult.Chunks[0].EndState = flow.StateCommitment(endState)
+	localResult.Chunks[0].NumberOfTransactions = 1
+
+	// the sealed result diverges at the first chunk
+	sealedResult := unittest.ExecutionResultFixture(unittest.WithBlock(block))
+	sealedResult.Chunks[0].StartState = flow.StateCommitment(startState)
+	sealedResult.Chunks[0].EndState = unittest.StateCommitmentFixture()
+
+	chunkDataPack := &flow.ChunkDataPack{
+		ChunkID:    localResult.Chunks[0].ID(),
+		StartState: flow.StateCommitment(startState),
+		Proof:      proof,
+		Collection: &collection,
+	}
+
+	events := unittest.EventsFixture(2)
+
+	vm := fvmmock.NewVM(t)
+	vm.On("Run", mock.Anything, mock.AnythingOfType("*fvm.TransactionProcedure"), mock.AnythingOfType("snapshot.SnapshotTree")).
+		Return(
+			func(_ fvm.Context, _ fvm.Procedure, storage snapshot.StorageSnapshot) *snapshot.ExecutionSnapshot {
+				value, err := storage.Get(readID)
+				require.NoError(t, err)
+				require.Equal(t, flow.RegisterValue{'a'}, value)
+
+				return &snapshot.ExecutionSnapshot{
+					ReadSet:  map[flow.RegisterID]struct{}{readID: {}},
+					WriteSet: map[flow.RegisterID]flow.RegisterValue{writeID: {'B'}},
+				}
+			},
+			fvm.ProcedureOutput{
+				ComputationUsed: 42,
+				Logs:            []string{"log"},
+				Events:          events,
+			},
+			nil,
+		).
+		Once()
+
+	state := protocol.NewState(t)
+	state.On("AtBlockID", header.ID()).Return(protocol.NewSnapshot(t))
+
+	blocks := storagemock.NewBlocks(t)
+	blocks.On("ByID", header.ID()).Return(block, nil)
+	collections := storagemock.NewCollections(t)
+	collections.On("ByID", collection.ID()).Return(&collection, nil)
+	results := storagemock.NewExecutionResults(t)
+	results.On("ByBlockID", header.ID()).Return(localResult, nil)
+	results.On("ByID", sealedResult.ID()).Return(sealedResult, nil)
+	chunkDataPacks := storagemock.NewChunkDataPacks(t)
+	chunkDataPacks.On("ByChunkID", localResult.Chunks[0].ID()).Return(chunkDataPack, nil)
+
+	dir := t.TempDir()
+	forensics := checker.NewForensics(
+		unittest.Logger(),
+		dir,
+		vm,
+		fvm.NewContext(fvm.WithChain(flow.Emulator.Chain())),
+		state,
+		blocks,
+		collections,
+		results,
+		chunkDataPacks,
+	)
+
+	path, err := forensics.Collect(header, sealedResult.ID())
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, checker.BundleFileName(header)), path)
+
+	bundle, err := checker.ReadForensicBundle(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, flow.Emulator, bundle.ChainID)
+	assert.Equal(t, header.ID(), bundle.Block.ID())
+	assert.Equal(t, []*flow.Collection{&collection}, bundle.Collections)
+	assert.Equal(t, localResult.ID(), bundle.LocalResult.ID())
+	assert.Equal(t, sealedResult.ID(), bundle.SealedResult.ID())
+	assert.Equal(t, uint64(0), bundle.ChunkIndex)
+	assert.False(t, bundle.IsSystemChunk)
+	assert.Equal(t, chunkDataPack.ID(), bundle.ChunkDataPack.ID())
+
+	// re-executing the chunk reproduces the local end state
+	assert.Equal(t, flow.StateCommitment(endState), bundle.ReexecutedEndState)
+	assert.Empty(t, bundle.MissingRegisters)
+
+	// the bundle includes the start value of all registers touched by the chunk
+	registers := make(map[flow.RegisterID]flow.RegisterValue)
+	for _, register := range bundle.Registers {
+		id, err := register.RegisterID()
+		require.NoError(t, err)
+		registers[id] = register.Value
+	}
+	assert.Equal(t, map[flow.RegisterID]flow.RegisterValue{
+		readID:  {'a'},
+		writeID: {'b'},
+	}, registers)
+
+	require.Len(t, bundle.Transactions, 1)
+	trace := bundle.Transactions[0]
+	assert.Equal(t, collection.Transactions[0].ID(), trace.TransactionID)
+	assert.Equal(t, uint64(42), trace.ComputationUsed)
+	assert.Equal(t, []string{"log"}, trace.Logs)
+	assert.Equal(t, []flow.Event(events), trace.Events)
+	assert.Equal(t, []checker.RegisterDiff{checker.NewRegisterDiff(writeID, []byte{'b'}, []byte{'B'})}, trace.RegisterWrites)
+
+	// the bundle is only collected once
+	_, err = forensics.Collect(header, sealedResult.ID())
+	require.NoError(t, err)
+}
+
+func TestFirstDivergingChunk(t *testing.T) {
+	local := unittest.ExecutionResultFixture(unittest.WithChunks(3))
+	sealed := unittest.ExecutionResultFixture()
+	sealed.Chunks = make(flow.ChunkList, len(local.Chunks))
+	for i, chunk := range local.Chunks {
+		c := *chunk
+		sealed.Chunks[i] = &c
+	}
+
+	_, err := checker.FirstDivergingChunk(local, sealed)
+	assert.Error(t, err)
+
+	sealed.Chunks[1].EventCollection = unittest.IdentifierFixture()
+	index, err := checker.FirstDivergingChunk(local, sealed)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), index)
+
+	sealed.Chunks[0].EndState = unittest.StateCommitmentFixture()
+	index, err = checker.FirstDivergingChunk(local, sealed)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), index)
+
+	sealed.Chunks = sealed.Chunks[:1]
+	_, err = checker.FirstDivergingChunk(local, sealed)
+	assert.Error(t, err)
+}
@@ -4,22 +4,28 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/onflow/flow-go/fvm/errors"
 
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/encoding/ccf"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/fvm"
 	"github.com/onflow/flow-go/fvm/storage/derived"
 	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/fvm/systemcontracts"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/state/protocol"
 	"github.com/onflow/flow-go/utils/debug"
+	"github.com/onflow/flow-go/utils/logging"
 	"github.com/onflow/flow-go/utils/rand"
 )
 
@@ -42,6 +48,18 @@ type Executor interface {
 		error,
 	)
 
+	ExecuteScriptWithReport(
+		ctx context.Context,
+		script []byte,
+		arguments [][]byte,
+		blockHeader *flow.Header,
+		snapshot snapshot.StorageSnapshot,
+	) (
+		[]byte,
+		*ScriptExecutionReport,
+		error,
+	)
+
 	GetAccount(
 		ctx context.Context,
 		addr flow.Address,
@@ -92,6 +110,54 @@ type Executor interface {
 		*flow.AccountPublicKey,
 		error,
 	)
+
+	DryRunTransaction(
+		ctx context.Context,
+		tx *flow.TransactionBody,
+		options TransactionDryRunOptions,
+		header *flow.Header,
+		snapshot snapshot.StorageSnapshot,
+	) (
+		*TransactionDryRunResult,
+		error,
+	)
+}
+
+// ScriptExecutionReport contains the resources used while executing a script.
+type ScriptExecutionReport struct {
+	ComputationUsed uint64
+	MemoryEstimate  uint64
+	// RegisterReads is the number of distinct registers read from the storage snapshot.
+	RegisterReads uint64
+	// BytesRead is the total size of the registers read from the storage snapshot.
+	BytesRead uint64
+	// ContractsLoaded are the locations of the contracts loaded by the script, sorted by location ID.
+	ContractsLoaded []common.AddressLocation
+}
+
+// TransactionDryRunOptions configures the checks performed when dry-running a transaction.
+type TransactionDryRunOptions struct {
+	// AuthorizationChecksEnabled enables verification of the transaction signatures and
+	// of the key weights of the proposer, payer and authorizers.
+	AuthorizationChecksEnabled bool
+	// SequenceNumberCheckEnabled enables the check of the proposal key sequence number.
+	SequenceNumberCheckEnabled bool
+	// SkipBodyExecution skips the execution of the transaction body, so only the enabled
+	// authorization and sequence number checks are performed.
+	SkipBodyExecution bool
+}
+
+// TransactionDryRunResult is the output of a transaction executed against a block snapshot
+// without committing its changes.
+type TransactionDryRunResult struct {
+	ComputationUsed uint64
+	MemoryEstimate  uint64
+	// Fees is the amount deducted from the payer, in UFix64 units (1e-8 FLOW).
+	// It is 0 if transaction fees are not enabled on the chain.
+	Fees   uint64
+	Events flow.EventsList
+	// Err is the error the transaction failed with, or nil if it succeeded.
+	Err errors.CodedError
 }
 
 type QueryConfig struct {
@@ -153,9 +219,29 @@ func (e *QueryExecutor) ExecuteScript(
 	arguments [][]byte,
 	blockHeader *flow.Header,
 	snapshot snapshot.StorageSnapshot,
+) (
+	[]byte,
+	uint64,
+	error,
+) {
+	encodedValue, report, err := e.ExecuteScriptWithReport(ctx, script, arguments, blockHeader, snapshot)
+	if err != nil {
+		return nil, 0, err
+	}
+	return encodedValue, report.ComputationUsed, nil
+}
+
+// ExecuteScriptWithReport executes the script and returns the encoded value along with a report of the
+// resources used during execution. The report is only returned if the script executed successfully.
+func (e *QueryExecutor) ExecuteScriptWithReport(
+	ctx context.Context,
+	script []byte,
+	arguments [][]byte,
+	blockHeader *flow.Header,
+	snapshot snapshot.StorageSnapshot,
 ) (
 	encodedValue []byte,
-	computationUsed uint64,
+	report *ScriptExecutionReport,
 	err error,
 ) {
 
@@ -170,7 +256,7 @@ func (e *QueryExecutor) ExecuteScript(
 		defer e.rngLock.Unlock()
 		trackerID, err := rand.Uint32()
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to generate trackerID: %w", err)
+			return nil, nil, fmt.Errorf("failed to generate trackerID: %w", err)
 		}
 
 		trackedLogger := e.logger.With().Hex("script_hex", script).Uint32("trackerID", trackerID).Logger()
@@ -211,8 +297,7 @@ func (e *QueryExecutor) ExecuteScript(
 		}
 	}()
 
-	var output fvm.ProcedureOutput
-	_, output, err = e.vm.Run(
+	executionSnapshot, output, err := e.vm.Run(
 		fvm.NewContextFromParent(
 			e.vmCtx,
 			fvm.WithBlockHeader(blockHeader),
@@ -222,11 +307,11 @@ func (e *QueryExecutor) ExecuteScript(
 		fvm.NewScriptWithContextAndArgs(script, requestCtx, arguments...),
 		snapshot)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to execute script (internal error): %w", err)
+		return nil, nil, fmt.Errorf("failed to execute script (internal error): %w", err)
 	}
 
 	if output.Err != nil {
-		return nil, 0, errors.NewCodedError(
+		return nil, nil, errors.NewCodedError(
 			output.Err.Code(),
 			"failed to execute script at block (%s): %s", blockHeader.ID(),
 			summarizeLog(output.Err.Error(), e.config.MaxErrorMessageSize),
@@ -235,7 +320,7 @@ func (e *QueryExecutor) ExecuteScript(
 
 	encodedValue, err = jsoncdc.Encode(output.Value)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to encode runtime value: %w", err)
+		return nil, nil, fmt.Errorf("failed to encode runtime value: %w", err)
 	}
 
 	memAllocAfter := debug.GetHeapAllocsBytes()
@@ -245,7 +330,45 @@ func (e *QueryExecutor) ExecuteScript(
 		memAllocAfter-memAllocBefore,
 		output.MemoryEstimate)
 
-	return encodedValue, output.ComputationUsed, nil
+	return encodedValue, newScriptExecutionReport(output, executionSnapshot), nil
+}
+
+// newScriptExecutionReport builds the report of the resources used by a script from its output and
+// the execution snapshot. Registers read from the snapshot include the registers read while loading
+// the contracts from the derived data cache, so the contract code registers identify all contracts
+// loaded by the script.
+func newScriptExecutionReport(
+	output fvm.ProcedureOutput,
+	executionSnapshot *snapshot.ExecutionSnapshot,
+) *ScriptExecutionReport {
+	report := &ScriptExecutionReport{
+		ComputationUsed: output.ComputationUsed,
+		MemoryEstimate:  output.MemoryEstimate,
+		ContractsLoaded: []common.AddressLocation{},
+	}
+	if executionSnapshot == nil {
+		return report
+	}
+
+	report.RegisterReads = uint64(len(executionSnapshot.ReadSet))
+	if executionSnapshot.Meter != nil {
+		report.BytesRead = executionSnapshot.TotalBytesReadFromStorage()
+	}
+
+	for registerID := range executionSnapshot.ReadSet {
+		if registerID.Owner == "" || !flow.IsContractKey(registerID.Key) {
+			continue
+		}
+		report.ContractsLoaded = append(report.ContractsLoaded, common.AddressLocation{
+			Address: common.Address(flow.BytesToAddress([]byte(registerID.Owner))),
+			Name:    flow.KeyContractName(registerID.Key),
+		})
+	}
+	slices.SortFunc(report.ContractsLoaded, func(a, b common.AddressLocation) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+
+	return report
 }
 
 func summarizeLog(log string, limit int) string {
@@ -406,3 +529,108 @@ func (e *QueryExecutor) GetAccountKey(
 
 	return accountKey, nil
 }
+
+// DryRunTransaction executes the transaction against the given block without persisting its changes.
+// The execution is cancelled when ctx is done or ExecutionTimeLimit is exceeded, like for scripts.
+//
+// Expected errors during normal operations:
+//   - context.Canceled or context.DeadlineExceeded if the execution was interrupted.
+func (e *QueryExecutor) DryRunTransaction(
+	ctx context.Context,
+	tx *flow.TransactionBody,
+	options TransactionDryRunOptions,
+	blockHeader *flow.Header,
+	snapshot snapshot.StorageSnapshot,
+) (*TransactionDryRunResult, error) {
+	startedAt := time.Now()
+
+	requestCtx, cancel := context.WithTimeout(ctx, e.config.ExecutionTimeLimit)
+	defer cancel()
+
+	blockCtx := fvm.NewContextFromParent(
+		e.vmCtx,
+		fvm.WithBlockHeader(blockHeader),
+		fvm.WithProtocolStateSnapshot(e.protocolStateSnapshot.AtBlockID(blockHeader.ID())),
+		fvm.WithAuthorizationChecksEnabled(options.AuthorizationChecksEnabled),
+		fvm.WithSequenceNumberCheckAndIncrementEnabled(options.SequenceNumberCheckEnabled),
+		fvm.WithTransactionBodyExecutionEnabled(!options.SkipBodyExecution),
+		fvm.WithDerivedBlockData(
+			e.derivedChainData.NewDerivedBlockDataForScript(blockHeader.ID())))
+
+	// the execution snapshot is discarded, so none of the changes made by the transaction are persisted
+	_, output, err := e.vm.Run(blockCtx, fvm.Transaction(tx, 0).WithRequestContext(requestCtx), snapshot)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to dry run transaction (%s) at block (%s) (internal error): %w",
+			tx.ID(),
+			blockHeader.ID(),
+			err)
+	}
+
+	// an interrupted execution fails the transaction, but its result is not the result of the transaction
+	if ctxErr := requestCtx.Err(); ctxErr != nil {
+		return nil, fmt.Errorf(
+			"failed to dry run transaction (%s) at block (%s): %w",
+			tx.ID(),
+			blockHeader.ID(),
+			ctxErr)
+	}
+
+	fees, err := deductedFees(e.vmCtx.Chain.ChainID(), output.Events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deducted fees: %w", err)
+	}
+
+	elapsed := time.Since(startedAt)
+	if elapsed >= e.config.LogTimeThreshold {
+		e.logger.Warn().
+			Hex("tx_id", logging.Entity(tx)).
+			Dur("duration", elapsed).
+			Msg("transaction dry run exceeded threshold")
+	}
+
+	return &TransactionDryRunResult{
+		ComputationUsed: output.ComputationUsed,
+		MemoryEstimate:  output.MemoryEstimate,
+		Fees:            fees,
+		Events:          output.Events,
+		Err:             output.Err,
+	}, nil
+}
+
+// deductedFees returns the fees charged to the transaction payer, as reported by the FeesDeducted
+// event emitted by the FlowFees contract. If no such event was emitted, 0 is returned.
+func deductedFees(chainID flow.ChainID, events flow.EventsList) (uint64, error) {
+	sc := systemcontracts.SystemContractsForChain(chainID)
+	feesDeductedType := flow.EventType(fmt.Sprintf(
+		"A.%s.%s.%s",
+		sc.FlowFees.Address.Hex(),
+		systemcontracts.ContractNameFlowFees,
+		systemcontracts.EventNameFeesDeducted,
+	))
+
+	for _, event := range events {
+		if event.Type != feesDeductedType {
+			continue
+		}
+
+		value, err := ccf.Decode(nil, event.Payload)
+		if err != nil {
+			return 0, fmt.Errorf("could not decode event payload: %w", err)
+		}
+
+		cdcEvent, ok := value.(cadence.Event)
+		if !ok {
+			return 0, fmt.Errorf("unexpected event payload type: %T", value)
+		}
+
+		amount, ok := cadence.SearchFieldByName(cdcEvent, "amount").(cadence.UFix64)
+		if !ok {
+			return 0, fmt.Errorf("missing or invalid amount field in %s event", feesDeductedType)
+		}
+
+		return uint64(amount), nil
+	}
+
+	return 0, nil
+}
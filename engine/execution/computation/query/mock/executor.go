@@ -8,6 +8,8 @@ import (
 	flow "github.com/onflow/flow-go/model/flow"
 	mock "github.com/stretchr/testify/mock"
 
+	query "github.com/onflow/flow-go/engine/execution/computation/query"
+
 	snapshot "github.com/onflow/flow-go/fvm/storage/snapshot"
 )
 
@@ -16,6 +18,36 @@ type Executor struct {
 	mock.Mock
 }
 
+// DryRunTransaction provides a mock function with given fields: ctx, tx, options, header, _a4
+func (_m *Executor) DryRunTransaction(ctx context.Context, tx *flow.TransactionBody, options query.TransactionDryRunOptions, header *flow.Header, _a4 snapshot.StorageSnapshot) (*query.TransactionDryRunResult, error) {
+	ret := _m.Called(ctx, tx, options, header, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunTransaction")
+	}
+
+	var r0 *query.TransactionDryRunResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, query.TransactionDryRunOptions, *flow.Header, snapshot.StorageSnapshot) (*query.TransactionDryRunResult, error)); ok {
+		return rf(ctx, tx, options, header, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, query.TransactionDryRunOptions, *flow.Header, snapshot.StorageSnapshot) *query.TransactionDryRunResult); ok {
+		r0 = rf(ctx, tx, options, header, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*query.TransactionDryRunResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *flow.TransactionBody, query.TransactionDryRunOptions, *flow.Header, snapshot.StorageSnapshot) error); ok {
+		r1 = rf(ctx, tx, options, header, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExecuteScript provides a mock function with given fields: ctx, script, arguments, blockHeader, _a4
 func (_m *Executor) ExecuteScript(ctx context.Context, script []byte, arguments [][]byte, blockHeader *flow.Header, _a4 snapshot.StorageSnapshot) ([]byte, uint64, error) {
 	ret := _m.Called(ctx, script, arguments, blockHeader, _a4)
@@ -53,6 +85,45 @@ func (_m *Executor) ExecuteScript(ctx context.Context, script []byte, arguments
 	return r0, r1, r2
 }
 
+// ExecuteScriptWithReport provides a mock function with given fields: ctx, script, arguments, blockHeader, _a4
+func (_m *Executor) ExecuteScriptWithReport(ctx context.Context, script []byte, arguments [][]byte, blockHeader *flow.Header, _a4 snapshot.StorageSnapshot) ([]byte, *query.ScriptExecutionReport, error) {
+	ret := _m.Called(ctx, script, arguments, blockHeader, _a4)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteScriptWithReport")
+	}
+
+	var r0 []byte
+	var r1 *query.ScriptExecutionReport
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, *flow.Header, snapshot.StorageSnapshot) ([]byte, *query.ScriptExecutionReport, error)); ok {
+		return rf(ctx, script, arguments, blockHeader, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, *flow.Header, snapshot.StorageSnapshot) []byte); ok {
+		r0 = rf(ctx, script, arguments, blockHeader, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, [][]byte, *flow.Header, snapshot.StorageSnapshot) *query.ScriptExecutionReport); ok {
+		r1 = rf(ctx, script, arguments, blockHeader, _a4)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*query.ScriptExecutionReport)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, [][]byte, *flow.Header, snapshot.StorageSnapshot) error); ok {
+		r2 = rf(ctx, script, arguments, blockHeader, _a4)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetAccount provides a mock function with given fields: ctx, addr, header, _a3
 func (_m *Executor) GetAccount(ctx context.Context, addr flow.Address, header *flow.Header, _a3 snapshot.StorageSnapshot) (*flow.Account, error) {
 	ret := _m.Called(ctx, addr, header, _a3)
@@ -18,4 +18,7 @@ type ScriptExecutor interface {
 
 	// GetRegisterAtBlockID returns the value of a register at the given Block id (if available)
 	GetRegisterAtBlockID(ctx context.Context, owner, key []byte, blockID flow.Identifier) ([]byte, error)
+
+	// GetRegisterProofs returns the encoded batch proof of the registers at the given state commitment
+	GetRegisterProofs(ctx context.Context, commit flow.StateCommitment, registerIDs []flow.RegisterID) ([]byte, error)
 }
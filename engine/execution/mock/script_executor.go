@@ -112,6 +112,36 @@ func (_m *ScriptExecutor) GetRegisterAtBlockID(ctx context.Context, owner []byte
 	return r0, r1
 }
 
+// GetRegisterProofs provides a mock function with given fields: ctx, commit, registerIDs
+func (_m *ScriptExecutor) GetRegisterProofs(ctx context.Context, commit flow.StateCommitment, registerIDs []flow.RegisterID) ([]byte, error) {
+	ret := _m.Called(ctx, commit, registerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegisterProofs")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.StateCommitment, []flow.RegisterID) ([]byte, error)); ok {
+		return rf(ctx, commit, registerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.StateCommitment, []flow.RegisterID) []byte); ok {
+		r0 = rf(ctx, commit, registerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.StateCommitment, []flow.RegisterID) error); ok {
+		r1 = rf(ctx, commit, registerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewScriptExecutor creates a new instance of ScriptExecutor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewScriptExecutor(t interface {
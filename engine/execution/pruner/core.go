@@ -32,22 +32,61 @@ func LoopPruneExecutionDataFromRootToLatestSealed(
 	chunkDataPacksDB *pebble.DB,
 	config PruningConfig,
 ) error {
+	data := PrunableData{
+		Name:        ChunkDataPacksName,
+		ProgressKey: NextHeightForUnprunedExecutionDataPackKey,
+		DB:          pebbleimpl.ToDB(chunkDataPacksDB),
+		Pruner:      NewChunkDataPackPruner(chunkDataPacks, results),
+		Config:      config,
+	}
+
+	// chunk data packs keep reporting their dedicated pruned height metrics
+	return loopPruneFromRootToLatestSealed(ctx, log, metrics, state, protocolDB, headers, data)
+}
+
+// LoopPruneFromRootToLatestSealed prunes the given data from the root block to the latest sealed and executed
+// block below the threshold of the data, until the context is done.
+// The pruned heights are reported by the metrics labelled with the name of the data.
+// No errors are expected during normal operations.
+func LoopPruneFromRootToLatestSealed(
+	ctx context.Context,
+	log zerolog.Logger,
+	metrics module.ExecutionMetrics,
+	state protocol.State,
+	protocolDB storage.DB,
+	headers storage.Headers,
+	data PrunableData,
+) error {
+	return loopPruneFromRootToLatestSealed(ctx, log, &dataPrunerMetrics{ExecutionMetrics: metrics, data: data.Name},
+		state, protocolDB, headers, data)
+}
+
+func loopPruneFromRootToLatestSealed(
+	ctx context.Context,
+	log zerolog.Logger,
+	metrics module.ExecutionMetrics,
+	state protocol.State,
+	protocolDB storage.DB,
+	headers storage.Headers,
+	data PrunableData,
+) error {
+	config := data.Config
+	log = log.With().Str("data", data.Name).Logger()
 
-	chunksDB := pebbleimpl.ToDB(chunkDataPacksDB)
 	// the creator can be reused to create new block iterator that can iterate from the last
 	// checkpoint to the new latest (sealed) block.
-	creator, getNextAndLatest, err := makeBlockIteratorCreator(state, protocolDB, headers, chunksDB, config)
+	creator, getNextAndLatest, err := makeBlockIteratorCreator(state, protocolDB, headers, data.DB, data.ProgressKey, config)
 	if err != nil {
 		return err
 	}
 
-	pruner := NewChunkDataPackPruner(chunkDataPacks, results)
+	pruner := &blockPrunerExecutor{BlockPruner: data.Pruner}
 
 	// iterateAndPruneAll takes a block iterator and iterates through all the blocks
-	// and decides how to prune the chunk data packs.
+	// and decides how to prune the data.
 	iterateAndPruneAll := func(iter module.BlockIterator) error {
 		err := executor.IterateExecuteAndCommitInBatch(
-			ctx, log, metrics, iter, pruner, chunksDB, config.BatchSize, config.SleepAfterEachBatchCommit)
+			ctx, log, metrics, iter, pruner, data.DB, config.BatchSize, config.SleepAfterEachBatchCommit)
 		if err != nil {
 			return fmt.Errorf("failed to iterate, execute, and commit in batch: %w", err)
 		}
@@ -88,7 +127,8 @@ func LoopPruneExecutionDataFromRootToLatestSealed(
 			Dur("sleepAfterEachIteration", config.SleepAfterEachIteration).
 			Uint64("batchCount", batchCount).
 			Str("totalDuration", totalDuration.String()).
-			Msgf("execution data pruning will start in %s at %s, complete at %s",
+			Msgf("%s pruning will start in %s at %s, complete at %s",
+				data.Name,
 				config.SleepAfterEachIteration,
 				time.Now().Add(config.SleepAfterEachIteration).UTC(),
 				time.Now().Add(config.SleepAfterEachIteration).Add(totalDuration).UTC(),
@@ -126,7 +166,8 @@ func makeBlockIteratorCreator(
 	state protocol.State,
 	protocolDB storage.DB,
 	headers storage.Headers,
-	chunkDataPacksDB storage.DB,
+	db storage.DB,
+	progressKey string,
 	config PruningConfig,
 ) (
 	module.IteratorCreator,
@@ -149,7 +190,7 @@ func makeBlockIteratorCreator(
 		threshold:               config.Threshold,
 	}
 
-	initializer := store.NewConsumerProgress(chunkDataPacksDB, NextHeightForUnprunedExecutionDataPackKey)
+	initializer := store.NewConsumerProgress(db, progressKey)
 
 	creator, err := block_iterator.NewHeightBasedCreator(
 		headers.BlockIDByHeight,
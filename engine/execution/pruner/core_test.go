@@ -123,6 +123,78 @@ func TestLoopPruneExecutionDataFromRootToLatestSealed(t *testing.T) {
 	})
 }
 
+func TestLoopPruneFromRootToLatestSealed(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(bdb *badger.DB) {
+		// create dependencies
+		ps := unittestMocks.NewProtocolState()
+		blocks, rootResult, rootSeal := unittest.ChainFixture(0)
+		genesis := blocks[0]
+		require.NoError(t, ps.Bootstrap(genesis, rootResult, rootSeal))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		metrics := metrics.NewNoopCollector()
+		db := badgerimpl.ToDB(bdb)
+		headers := badgerstorage.NewHeaders(metrics, bdb)
+		events := store.NewEvents(metrics, db)
+
+		lastSealedHeight := 30
+		lastFinalizedHeight := lastSealedHeight + 2 // 2 finalized but unsealed
+		// indexed by height
+		blockIDs := make([]flow.Identifier, lastFinalizedHeight+1)
+		parent := genesis.Header
+		require.NoError(t, headers.Store(genesis.Header))
+		for i := 1; i <= lastFinalizedHeight; i++ {
+			block := unittest.BlockWithParentFixture(parent)
+			blockIDs[i] = block.ID()
+			require.NoError(t, headers.Store(block.Header))
+			require.NoError(t, bdb.Update(operation.IndexBlockHeight(block.Header.Height, block.ID())))
+			require.NoError(t, events.Store(block.ID(), []flow.EventsList{unittest.EventsFixture(2)}))
+
+			require.NoError(t, ps.Extend(block))
+			require.NoError(t, ps.Finalize(block.ID()))
+			parent = block.Header
+		}
+
+		// last sealed and executed is the last sealed
+		require.NoError(t, bdb.Update(operation.InsertExecutedBlock(blockIDs[lastFinalizedHeight])))
+		require.NoError(t, ps.MakeSeal(blockIDs[lastSealedHeight]))
+
+		cfg := PruningConfig{
+			Threshold:                 10,
+			BatchSize:                 3,
+			SleepAfterEachBatchCommit: 1 * time.Millisecond,
+			SleepAfterEachIteration:   100 * time.Millisecond,
+		}
+
+		// wait long enough for the events to be pruned
+		go (func(cancel func()) {
+			time.Sleep(1 * time.Second)
+			// cancel the context to stop the loop
+			cancel()
+		})(cancel)
+
+		require.NoError(t, LoopPruneFromRootToLatestSealed(
+			ctx, unittest.Logger(), metrics, ps, db, headers, NewEventsData(db, events, cfg),
+		))
+
+		// verify the events beyond the threshold are pruned, using a new store to bypass the cache
+		events = store.NewEvents(metrics, db)
+		lastPrunedHeight := lastSealedHeight - int(cfg.Threshold)
+		for i := 1; i <= lastPrunedHeight; i++ {
+			pruned, err := events.ByBlockID(blockIDs[i])
+			require.NoError(t, err)
+			require.Empty(t, pruned, "events at height %v should be pruned", i)
+		}
+
+		// verify the events within the threshold are not pruned
+		for i := lastPrunedHeight + 1; i <= lastFinalizedHeight; i++ {
+			kept, err := events.ByBlockID(blockIDs[i])
+			require.NoError(t, err)
+			require.Len(t, kept, 2, "events at height %v should not be pruned", i)
+		}
+	})
+}
+
 func TestEstimateBatchProcessing(t *testing.T) {
 	tests := []struct {
 		name                      string
@@ -0,0 +1,116 @@
+package pruner
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/module/pruner/pruners"
+	"github.com/onflow/flow-go/storage"
+)
+
+// Names of the data pruned by the execution node, used in the logs and as metric labels.
+const (
+	ChunkDataPacksName     = "chunk_data_packs"
+	EventsName             = "events"
+	ServiceEventsName      = "service_events"
+	TransactionResultsName = "transaction_results"
+	ComputationResultsName = "computation_results"
+	ChunkLocatorsName      = "chunk_locators"
+)
+
+// Keys of the consumer progress recording the next height to prune for each type of data.
+const (
+	NextHeightForUnprunedEventsKey             = "NextHeightForUnprunedEventsKey"
+	NextHeightForUnprunedServiceEventsKey      = "NextHeightForUnprunedServiceEventsKey"
+	NextHeightForUnprunedTransactionResultsKey = "NextHeightForUnprunedTransactionResultsKey"
+	NextHeightForUnprunedComputationResultsKey = "NextHeightForUnprunedComputationResultsKey"
+	NextHeightForUnprunedChunkLocatorsKey      = "NextHeightForUnprunedChunkLocatorsKey"
+)
+
+// BlockPruner removes the data indexed by a block.
+type BlockPruner interface {
+	// PruneByBlockID adds the removal of the data indexed by the block to the batch.
+	// No errors are expected during normal operations, pruning a block without data is a no-op.
+	PruneByBlockID(blockID flow.Identifier, batchWriter storage.ReaderBatchWriter) error
+}
+
+// PrunableData is a type of data indexed by block, pruned from the root block to the latest sealed and
+// executed block below the threshold of its config.
+type PrunableData struct {
+	Name        string        // The name of the data, used in the logs and as metric label.
+	ProgressKey string        // The key of the consumer progress recording the next height to prune.
+	DB          storage.DB    // The database storing the data and the pruning progress.
+	Pruner      BlockPruner   // The pruner removing the data indexed by a block.
+	Config      PruningConfig // The pruning config, including the number of blocks for which the data is kept.
+}
+
+// NewEventsData returns the events stored in the protocol database, pruned with the given config.
+func NewEventsData(protocolDB storage.DB, events storage.Events, config PruningConfig) PrunableData {
+	return PrunableData{
+		Name:        EventsName,
+		ProgressKey: NextHeightForUnprunedEventsKey,
+		DB:          protocolDB,
+		Pruner:      pruners.NewEventsPruner(events),
+		Config:      config,
+	}
+}
+
+// NewServiceEventsData returns the service events stored in the protocol database, pruned with the given config.
+func NewServiceEventsData(protocolDB storage.DB, serviceEvents storage.ServiceEvents, config PruningConfig) PrunableData {
+	return PrunableData{
+		Name:        ServiceEventsName,
+		ProgressKey: NextHeightForUnprunedServiceEventsKey,
+		DB:          protocolDB,
+		Pruner:      pruners.NewServiceEventsPruner(serviceEvents),
+		Config:      config,
+	}
+}
+
+// NewTransactionResultsData returns the transaction results stored in the protocol database, pruned with the given config.
+func NewTransactionResultsData(protocolDB storage.DB, transactionResults storage.TransactionResults, config PruningConfig) PrunableData {
+	return PrunableData{
+		Name:        TransactionResultsName,
+		ProgressKey: NextHeightForUnprunedTransactionResultsKey,
+		DB:          protocolDB,
+		Pruner:      pruners.NewTransactionResultsPruner(transactionResults),
+		Config:      config,
+	}
+}
+
+// NewComputationResultsData returns the computation result upload statuses stored in the protocol database,
+// pruned with the given config.
+func NewComputationResultsData(protocolDB storage.DB, config PruningConfig) PrunableData {
+	return PrunableData{
+		Name:        ComputationResultsName,
+		ProgressKey: NextHeightForUnprunedComputationResultsKey,
+		DB:          protocolDB,
+		Pruner:      pruners.NewComputationResultsPruner(),
+		Config:      config,
+	}
+}
+
+// NewChunkLocatorsData returns the chunk locators stored in the protocol database, pruned with the given config.
+func NewChunkLocatorsData(protocolDB storage.DB, results storage.ExecutionResults, config PruningConfig) PrunableData {
+	return PrunableData{
+		Name:        ChunkLocatorsName,
+		ProgressKey: NextHeightForUnprunedChunkLocatorsKey,
+		DB:          protocolDB,
+		Pruner:      pruners.NewChunkLocatorsPruner(results),
+		Config:      config,
+	}
+}
+
+// dataPrunerMetrics reports the pruned heights of a type of data other than chunk data packs with the
+// metrics labelled by the name of the data, since the block iterator executor reports the pruned heights
+// as chunk data pack heights.
+type dataPrunerMetrics struct {
+	module.ExecutionMetrics
+	data string
+}
+
+func (m *dataPrunerMetrics) ExecutionLastChunkDataPackPrunedHeight(height uint64) {
+	m.ExecutionLastPrunedHeight(m.data, height)
+}
+
+func (m *dataPrunerMetrics) ExecutionTargetChunkDataPackPrunedHeight(height uint64) {
+	m.ExecutionTargetPrunedHeight(m.data, height)
+}
@@ -37,3 +37,29 @@ func NewChunkDataPackPruningEngine(
 		}).
 		Build()
 }
+
+// NewExecutionDataPruningEngine creates a component that prunes each of the given data
+// from root to the latest sealed block below the threshold of the data.
+func NewExecutionDataPruningEngine(
+	log zerolog.Logger,
+	metrics module.ExecutionMetrics,
+	state protocol.State,
+	protocolDB storage.DB,
+	headers storage.Headers,
+	data ...PrunableData,
+) *component.ComponentManager {
+	builder := component.NewComponentManagerBuilder()
+	for _, d := range data {
+		builder.AddWorker(func(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+			ready()
+
+			err := LoopPruneFromRootToLatestSealed(
+				ctx, log.With().Str("component", "execution-data-pruner").Logger(), metrics,
+				state, protocolDB, headers, d)
+			if err != nil {
+				ctx.Throw(err)
+			}
+		})
+	}
+	return builder.Build()
+}
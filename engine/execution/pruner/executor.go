@@ -22,3 +22,14 @@ func NewChunkDataPackPruner(chunkDataPacks storage.ChunkDataPacks, results stora
 func (c *ChunkDataPackPruner) ExecuteByBlockID(blockID flow.Identifier, batch storage.ReaderBatchWriter) (exception error) {
 	return c.PruneByBlockID(blockID, batch)
 }
+
+// blockPrunerExecutor executes the pruner of a type of data for each iterated block.
+type blockPrunerExecutor struct {
+	BlockPruner
+}
+
+var _ executor.IterationExecutor = (*blockPrunerExecutor)(nil)
+
+func (e *blockPrunerExecutor) ExecuteByBlockID(blockID flow.Identifier, batch storage.ReaderBatchWriter) (exception error) {
+	return e.PruneByBlockID(blockID, batch)
+}
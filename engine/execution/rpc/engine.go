@@ -26,6 +26,7 @@ import (
 	"github.com/onflow/flow-go/consensus/hotstuff"
 	"github.com/onflow/flow-go/engine"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/common/rpc/registerproofs"
 	exeEng "github.com/onflow/flow-go/engine/execution"
 	"github.com/onflow/flow-go/engine/execution/computation/metrics"
 	"github.com/onflow/flow-go/engine/execution/state"
@@ -123,6 +124,7 @@ func New(
 	}
 
 	execution.RegisterExecutionAPIServer(eng.server, eng.handler)
+	registerproofs.RegisterRegisterProofsAPIServer(eng.server, eng.handler)
 
 	return eng
 }
@@ -161,6 +163,8 @@ func (e *Engine) serve() {
 
 // handler implements a subset of the Observation API.
 type handler struct {
+	registerproofs.UnimplementedRegisterProofsAPIServer
+
 	engine               exeEng.ScriptExecutor
 	chain                flow.ChainID
 	headers              storage.Headers
@@ -176,6 +180,7 @@ type handler struct {
 }
 
 var _ execution.ExecutionAPIServer = (*handler)(nil)
+var _ registerproofs.RegisterProofsAPIServer = (*handler)(nil)
 
 // Ping responds to requests when the server is up.
 func (h *handler) Ping(
@@ -692,6 +697,67 @@ func (h *handler) eventResult(
 	}, nil
 }
 
+// GetRegisterProofs returns the batch proof of the requested registers, either at the state of the
+// requested executed block, or at the requested state commitment.
+func (h *handler) GetRegisterProofs(
+	ctx context.Context,
+	req *registerproofs.GetRegisterProofsRequest,
+) (*registerproofs.GetRegisterProofsResponse, error) {
+	if len(req.GetRegisters()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no registers requested")
+	}
+	if len(req.GetRegisters()) > registerproofs.MaxRegistersPerRequest {
+		return nil, status.Errorf(codes.InvalidArgument, "too many registers requested: %d > %d", len(req.GetRegisters()), registerproofs.MaxRegistersPerRequest)
+	}
+
+	var commit flow.StateCommitment
+	switch {
+	case len(req.GetBlockId()) > 0 && len(req.GetStateCommitment()) > 0:
+		return nil, status.Errorf(codes.InvalidArgument, "only one of block ID and state commitment can be set")
+
+	case len(req.GetBlockId()) > 0:
+		blockID, err := convert.BlockID(req.GetBlockId())
+		if err != nil {
+			return nil, err
+		}
+		commit, err = h.commits.ByBlockID(blockID)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "block %s has not been executed by node or was pruned", blockID)
+			}
+			return nil, status.Errorf(codes.Internal, "state commitment for block ID %s could not be retrieved", blockID)
+		}
+
+	case len(req.GetStateCommitment()) > 0:
+		var err error
+		commit, err = flow.ToStateCommitment(req.GetStateCommitment())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid state commitment: %v", err)
+		}
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "either block ID or state commitment must be set")
+	}
+
+	registerIDs, err := convert.MessagesToRegisterIDs(req.GetRegisters(), h.chain.Chain())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid registers: %v", err)
+	}
+
+	proof, err := h.engine.GetRegisterProofs(ctx, commit, registerIDs)
+	if err != nil {
+		if errors.Is(err, state.ErrExecutionStatePruned) {
+			return nil, status.Errorf(codes.OutOfRange, "state for commitment %x not available", commit)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get register proofs: %v", err)
+	}
+
+	return &registerproofs.GetRegisterProofsResponse{
+		StateCommitment: commit[:],
+		Proof:           proof,
+	}, nil
+}
+
 func (h *handler) GetAccountAtBlockID(
 	ctx context.Context,
 	req *execution.GetAccountAtBlockIDRequest,
@@ -19,6 +19,7 @@ import (
 	"github.com/onflow/flow/protobuf/go/flow/execution"
 
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/common/rpc/registerproofs"
 	mockEng "github.com/onflow/flow-go/engine/execution/mock"
 	"github.com/onflow/flow-go/engine/execution/state"
 	"github.com/onflow/flow-go/model/flow"
@@ -399,6 +400,88 @@ func (suite *Suite) TestGetRegisterAtBlockID() {
 	})
 }
 
+// TestGetRegisterProofs tests the GetRegisterProofs API call
+func (suite *Suite) TestGetRegisterProofs() {
+
+	id := unittest.IdentifierFixture()
+	commit := unittest.StateCommitmentFixture()
+	serviceAddress := flow.Mainnet.Chain().ServiceAddress()
+	registerID := flow.NewRegisterID(serviceAddress, "key")
+	proof := []byte("proof")
+
+	mockEngine := mockEng.NewScriptExecutor(suite.T())
+
+	// create the handler
+	handler := &handler{
+		engine:  mockEngine,
+		chain:   flow.Mainnet,
+		commits: suite.commits,
+	}
+
+	registers := []*entities.RegisterID{{Owner: serviceAddress.Bytes(), Key: []byte("key")}}
+
+	suite.Run("happy path with block ID", func() {
+		suite.commits.On("ByBlockID", id).Return(commit, nil).Once()
+		mockEngine.On("GetRegisterProofs", mock.Anything, commit, []flow.RegisterID{registerID}).Return(proof, nil).Once()
+
+		resp, err := handler.GetRegisterProofs(context.Background(), &registerproofs.GetRegisterProofsRequest{
+			BlockId:   id[:],
+			Registers: registers,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(commit[:], resp.StateCommitment)
+		suite.Require().Equal(proof, resp.Proof)
+	})
+
+	suite.Run("happy path with state commitment", func() {
+		mockEngine.On("GetRegisterProofs", mock.Anything, commit, []flow.RegisterID{registerID}).Return(proof, nil).Once()
+
+		resp, err := handler.GetRegisterProofs(context.Background(), &registerproofs.GetRegisterProofsRequest{
+			StateCommitment: commit[:],
+			Registers:       registers,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(commit[:], resp.StateCommitment)
+		suite.Require().Equal(proof, resp.Proof)
+	})
+
+	suite.Run("unexecuted block", func() {
+		suite.commits.On("ByBlockID", id).Return(flow.DummyStateCommitment, realstorage.ErrNotFound).Once()
+
+		_, err := handler.GetRegisterProofs(context.Background(), &registerproofs.GetRegisterProofsRequest{
+			BlockId:   id[:],
+			Registers: registers,
+		})
+		suite.Require().Equal(codes.NotFound, status.Code(err))
+	})
+
+	suite.Run("pruned state", func() {
+		mockEngine.On("GetRegisterProofs", mock.Anything, commit, []flow.RegisterID{registerID}).
+			Return(nil, fmt.Errorf("pruned: %w", state.ErrExecutionStatePruned)).Once()
+
+		_, err := handler.GetRegisterProofs(context.Background(), &registerproofs.GetRegisterProofsRequest{
+			StateCommitment: commit[:],
+			Registers:       registers,
+		})
+		suite.Require().Equal(codes.OutOfRange, status.Code(err))
+	})
+
+	suite.Run("invalid requests", func() {
+		tooMany := make([]*entities.RegisterID, registerproofs.MaxRegistersPerRequest+1)
+
+		for _, req := range []*registerproofs.GetRegisterProofsRequest{
+			{BlockId: id[:]},
+			{BlockId: id[:], Registers: tooMany},
+			{Registers: registers},
+			{BlockId: id[:], StateCommitment: commit[:], Registers: registers},
+			{StateCommitment: commit[:4], Registers: registers},
+		} {
+			_, err := handler.GetRegisterProofs(context.Background(), req)
+			suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
+
 // TestGetTransactionResult tests the GetTransactionResult and GetTransactionResultByIndex API calls
 func (suite *Suite) TestGetTransactionResult() {
 
@@ -83,6 +83,19 @@ func (e *Engine) GetRegisterAtBlockID(
 	return data, nil
 }
 
+func (e *Engine) GetRegisterProofs(
+	ctx context.Context,
+	commit flow.StateCommitment,
+	registerIDs []flow.RegisterID,
+) ([]byte, error) {
+	proof, err := e.execState.GetRegisterProofs(commit, registerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get register proofs: %w", err)
+	}
+
+	return proof, nil
+}
+
 func (e *Engine) GetAccount(
 	ctx context.Context,
 	addr flow.Address,
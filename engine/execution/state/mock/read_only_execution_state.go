@@ -152,6 +152,36 @@ func (_m *ReadOnlyExecutionState) GetLastExecutedBlockID(_a0 context.Context) (u
 	return r0, r1, r2
 }
 
+// GetRegisterProofs provides a mock function with given fields: commit, registerIDs
+func (_m *ReadOnlyExecutionState) GetRegisterProofs(commit flow.StateCommitment, registerIDs []flow.RegisterID) ([]byte, error) {
+	ret := _m.Called(commit, registerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRegisterProofs")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(flow.StateCommitment, []flow.RegisterID) ([]byte, error)); ok {
+		return rf(commit, registerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(flow.StateCommitment, []flow.RegisterID) []byte); ok {
+		r0 = rf(commit, registerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(flow.StateCommitment, []flow.RegisterID) error); ok {
+		r1 = rf(commit, registerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // IsBlockExecuted provides a mock function with given fields: height, blockID
 func (_m *ReadOnlyExecutionState) IsBlockExecuted(height uint64, blockID flow.Identifier) (bool, error) {
 	ret := _m.Called(height, blockID)
@@ -52,6 +52,11 @@ type ScriptExecutionState interface {
 
 	// Any error returned is exception
 	IsBlockExecuted(height uint64, blockID flow.Identifier) (bool, error)
+
+	// GetRegisterProofs returns the encoded batch proof of the given registers at the state commitment.
+	// It returns:
+	// - (nil, state.ErrExecutionStatePruned) if the state isn't available in the ledger
+	GetRegisterProofs(commit flow.StateCommitment, registerIDs []flow.RegisterID) ([]byte, error)
 }
 
 func IsParentExecuted(state ReadOnlyExecutionState, header *flow.Header) (bool, error) {
@@ -334,6 +339,28 @@ func (s *state) StateCommitmentByBlockID(blockID flow.Identifier) (flow.StateCom
 	return s.commits.ByBlockID(blockID)
 }
 
+func (s *state) GetRegisterProofs(commit flow.StateCommitment, registerIDs []flow.RegisterID) ([]byte, error) {
+	if !s.ls.HasState(ledger.State(commit)) {
+		return nil, fmt.Errorf("state not found in ledger for commit %x: %w", commit, ErrExecutionStatePruned)
+	}
+
+	keys := make([]ledger.Key, len(registerIDs))
+	for i, id := range registerIDs {
+		keys[i] = convert.RegisterIDToLedgerKey(id)
+	}
+
+	query, err := ledger.NewQuery(ledger.State(commit), keys)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create ledger query: %w", err)
+	}
+
+	proof, err := s.ls.Prove(query)
+	if err != nil {
+		return nil, fmt.Errorf("cannot prove registers at commit %x: %w", commit, err)
+	}
+	return proof, nil
+}
+
 func (s *state) ChunkDataPackByChunkID(chunkID flow.Identifier) (*flow.ChunkDataPack, error) {
 	chunkDataPack, err := s.chunkDataPacks.ByChunkID(chunkID)
 	if err != nil {
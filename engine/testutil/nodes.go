@@ -71,6 +71,7 @@ import (
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/model/flow/filter"
 	"github.com/onflow/flow-go/module"
+	builder "github.com/onflow/flow-go/module/builder/collection"
 	"github.com/onflow/flow-go/module/chainsync"
 	"github.com/onflow/flow-go/module/chunks"
 	"github.com/onflow/flow-go/module/compliance"
@@ -147,6 +148,11 @@ func GenericNodeFromParticipants(t testing.TB, hub *stub.Hub, identity bootstrap
 // GenericNode returns a generic test node, containing components shared across
 // all node roles. The generic node is used as the core data structure to create
 // other types of flow nodes.
+// The nodes created with the same hub exchange messages over memory; a hub created by
+// stub.NewSimulatedNetworkHub subjects the messages to the latency, loss, bandwidth and
+// partitions of its simulator, so that whole clusters can run under realistic network conditions.
+// Nodes on a hub created by stub.NewNetworkHub behave as before. Timers used by the engines follow
+// the wall clock, not the virtual clock of the simulator.
 func GenericNode(
 	t testing.TB,
 	hub *stub.Hub,
@@ -289,11 +295,13 @@ func CollectionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ro
 		func(_ uint64) mempool.Transactions {
 			return herocache.NewTransactions(1000, node.Log, metrics.NewNoopCollector())
 		})
+	rejectedTxs, err := stdmap.NewRejectedTransactions(stdmap.DefaultRejectedTransactionsLimit)
+	require.NoError(t, err)
 	transactions := storage.NewTransactions(node.Metrics, node.PublicDB)
 	collections := storage.NewCollections(node.PublicDB, transactions)
 	clusterPayloads := storage.NewClusterPayloads(node.Metrics, node.PublicDB)
 
-	ingestionEngine, err := collectioningest.New(node.Log, node.Net, node.State, node.Metrics, node.Metrics, node.Metrics, node.Me, node.ChainID.Chain(), pools, collectioningest.DefaultConfig(),
+	ingestionEngine, err := collectioningest.New(node.Log, node.Net, node.State, node.Metrics, node.Metrics, node.Metrics, node.Me, node.ChainID.Chain(), pools, rejectedTxs, collectioningest.DefaultConfig(),
 		ingest.NewAddressRateLimiter(rate.Limit(1), 10)) // 10 tps
 	require.NoError(t, err)
 
@@ -333,6 +341,7 @@ func CollectionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ro
 		node.Metrics,
 		pusherEngine,
 		node.Log,
+		builder.WithRejectedTransactions(rejectedTxs),
 	)
 	require.NoError(t, err)
 
@@ -404,6 +413,7 @@ func CollectionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ro
 		node.Me,
 		node.State,
 		pools,
+		rejectedTxs,
 		rootQCVoter,
 		factory,
 		heights,
@@ -303,7 +303,8 @@ func WithAccountKeyWeightThreshold(threshold int) Option {
 // WithTransactionBodyExecutionEnabled enables or disables the transaction body
 // execution.
 //
-// Note: This is disabled only by tests
+// Note: This is disabled by tests, and when only the pre-execution checks of a
+// transaction are run (e.g. transaction pre-validation on access nodes)
 func WithTransactionBodyExecutionEnabled(enabled bool) Option {
 	return func(ctx Context) Context {
 		ctx.TransactionBodyExecutionEnabled = enabled
@@ -196,12 +196,33 @@ func NewTransactionEnvironment(
 	tracer tracing.TracerSpan,
 	params EnvironmentParams,
 	txnState storage.TransactionPreparer,
+) *facadeEnvironment {
+	return newTransactionEnvironment(tracer, params, txnState, NewMeter(txnState))
+}
+
+// NewCancellableTransactionEnvironment returns a transaction environment whose computation
+// metering fails once the given context is done. It must only be used for transactions which
+// are not executed as part of a block, since cancelling the execution is not deterministic.
+func NewCancellableTransactionEnvironment(
+	ctx context.Context,
+	tracer tracing.TracerSpan,
+	params EnvironmentParams,
+	txnState storage.TransactionPreparer,
+) *facadeEnvironment {
+	return newTransactionEnvironment(tracer, params, txnState, NewCancellableMeter(ctx, txnState))
+}
+
+func newTransactionEnvironment(
+	tracer tracing.TracerSpan,
+	params EnvironmentParams,
+	txnState storage.TransactionPreparer,
+	meter Meter,
 ) *facadeEnvironment {
 	env := newFacadeEnvironment(
 		tracer,
 		params,
 		txnState,
-		NewMeter(txnState),
+		meter,
 	)
 
 	env.TransactionInfo = NewTransactionInfo(
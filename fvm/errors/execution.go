@@ -263,8 +263,8 @@ func IsBlockHeightOutOfRangeError(err error) bool {
 // that Cadence Script execution has been cancelled (e.g. request connection
 // has been droped)
 //
-// note: this error is used by scripts only and won't be emitted for
-// transactions since transaction execution has to be deterministic.
+// note: this error is used by scripts and transaction dry runs only and won't be
+// emitted for transactions of a block since their execution has to be deterministic.
 func NewScriptExecutionCancelledError(err error) CodedError {
 	return WrapCodedError(
 		ErrCodeScriptExecutionCancelledError,
@@ -275,8 +275,8 @@ func NewScriptExecutionCancelledError(err error) CodedError {
 // NewScriptExecutionTimedOutError construct a new CodedError which indicates
 // that Cadence Script execution has been taking more time than what is allowed.
 //
-// note: this error is used by scripts only and won't be emitted for
-// transactions since transaction execution has to be deterministic.
+// note: this error is used by scripts and transaction dry runs only and won't be
+// emitted for transactions of a block since their execution has to be deterministic.
 func NewScriptExecutionTimedOutError() CodedError {
 	return NewCodedError(
 		ErrCodeScriptExecutionTimedOutError,
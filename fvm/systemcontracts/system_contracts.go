@@ -62,6 +62,10 @@ const (
 	EventNameVersionBeacon               = "VersionBeacon"               // VersionBeacon only controls version of ENs, describing software compatability via semantic versioning
 	EventNameProtocolStateVersionUpgrade = "ProtocolStateVersionUpgrade" // Protocol State version applies to all nodes and uses an _integer version_ of the _protocol state_
 
+	// Unqualified names of other system contract events (not including address prefix or contract name)
+
+	EventNameFeesDeducted = "FeesDeducted"
+
 	//  Unqualified names of service event contract functions (not including address prefix or contract name)
 
 	ContractServiceAccountFunction_setupNewAccount                            = "setupNewAccount"
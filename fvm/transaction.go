@@ -1,6 +1,8 @@
 package fvm
 
 import (
+	"context"
+
 	"github.com/onflow/flow-go/fvm/storage"
 	"github.com/onflow/flow-go/fvm/storage/logical"
 	"github.com/onflow/flow-go/model/flow"
@@ -29,6 +31,23 @@ type TransactionProcedure struct {
 	ID          flow.Identifier
 	Transaction *flow.TransactionBody
 	TxIndex     uint32
+	// RequestContext is used to cancel the execution of the transaction, if set.
+	// It must only be set for transactions which are not executed as part of a block, such
+	// as dry runs, since cancelling the execution makes it non-deterministic.
+	RequestContext context.Context
+}
+
+// WithRequestContext returns a copy of the procedure whose execution is cancelled when the
+// given context is done. See RequestContext.
+func (proc *TransactionProcedure) WithRequestContext(
+	reqContext context.Context,
+) *TransactionProcedure {
+	return &TransactionProcedure{
+		ID:             proc.ID,
+		Transaction:    proc.Transaction,
+		TxIndex:        proc.TxIndex,
+		RequestContext: reqContext,
+	}
 }
 
 func (proc *TransactionProcedure) NewExecutor(
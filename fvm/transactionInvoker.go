@@ -33,7 +33,8 @@ type TransactionExecutorParams struct {
 	// Note: This is set only by tests
 	AccountKeyWeightThreshold int
 
-	// Note: This is disabled only by tests
+	// Note: This is disabled by tests, and when only the pre-execution checks
+	// are run
 	TransactionBodyExecutionEnabled bool
 }
 
@@ -89,10 +90,19 @@ func newTransactionExecutor(
 	ctx.TxId = proc.ID
 	ctx.TxBody = proc.Transaction
 
-	env := environment.NewTransactionEnvironment(
-		span,
-		ctx.EnvironmentParams,
-		txnState)
+	var env environment.Environment
+	if proc.RequestContext != nil {
+		env = environment.NewCancellableTransactionEnvironment(
+			proc.RequestContext,
+			span,
+			ctx.EnvironmentParams,
+			txnState)
+	} else {
+		env = environment.NewTransactionEnvironment(
+			span,
+			ctx.EnvironmentParams,
+			txnState)
+	}
 
 	return &transactionExecutor{
 		TransactionExecutorParams: ctx.TransactionExecutorParams,
@@ -107,6 +107,11 @@ func (c *CorruptGossipSubAdapter) GetLocalMeshPeers(topic channels.Topic) []peer
 	return []peer.ID{}
 }
 
+func (c *CorruptGossipSubAdapter) GetLocalMesh() map[string][]peer.ID {
+	// this method is a no-op in the corrupt gossipsub; as the corrupt gossipsub is solely used for testing, it does not come with a mesh tracer.
+	return map[string][]peer.ID{}
+}
+
 func (c *CorruptGossipSubAdapter) ActiveClustersChanged(lst flow.ChainIDList) {
 	c.clusterChangeConsumer.ActiveClustersChanged(lst)
 }
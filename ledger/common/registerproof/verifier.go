@@ -0,0 +1,379 @@
+// Package registerproof verifies batch proofs of registers against a state commitment.
+//
+// The package is intended for light clients and bridges reading account state from an
+// untrusted Access or Execution node: it only depends on the ledger hashing primitives,
+// and decodes the batch proofs encoded by the ledger (see ledger.EncodeTrieBatchProof).
+//
+// The state commitment the proofs are verified against must be trusted by the caller, for
+// instance the final state of a seal included in a block whose header was verified.
+package registerproof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	cryptoHash "github.com/onflow/crypto/hash"
+
+	"github.com/onflow/flow-go/ledger/common/bitutils"
+	"github.com/onflow/flow-go/ledger/common/hash"
+	"github.com/onflow/flow-go/ledger/common/utils"
+)
+
+const (
+	// trieBatchProofVersion is the encoding version of batch proofs, see ledger.TrieBatchProofVersion.
+	trieBatchProofVersion = uint16(0)
+	// typeBatchProof is the encoded type of batch proofs, see ledger.TypeBatchProof.
+	typeBatchProof = uint8(8)
+
+	// key part types, see ledger.KeyPartOwner and ledger.KeyPartKey
+	keyPartOwner = uint16(0)
+	keyPartKey   = uint16(2)
+
+	// treeHeight is the height of the ledger trie, which is the number of bits of a path.
+	treeHeight = hash.HashLen * 8
+)
+
+// ErrInvalidProof is returned when a proof doesn't match the state commitment or the registers.
+var ErrInvalidProof = errors.New("invalid register proof")
+
+// RegisterID identifies a register, in the same way as flow.RegisterID.
+type RegisterID struct {
+	// Owner is the address of the account owning the register as raw bytes, or empty for global registers.
+	Owner string
+	// Key is the key of the register.
+	Key string
+}
+
+var defaultHashes [treeHeight + 1]hash.Hash
+
+func init() {
+	cryptoHash.ComputeSHA3_256((*[hash.HashLen]byte)(&defaultHashes[0]), []byte("default:"))
+	for i := 1; i <= treeHeight; i++ {
+		defaultHashes[i] = hash.HashInterNode(defaultHashes[i-1], defaultHashes[i-1])
+	}
+}
+
+// trieProof is a decoded proof of a single register, see ledger.TrieProof.
+type trieProof struct {
+	inclusion bool
+	steps     uint8
+	flags     []byte
+	path      hash.Hash
+	key       []byte // encoded key of the payload, empty for unallocated registers
+	value     []byte
+	interims  []hash.Hash
+}
+
+// Verify verifies the encoded batch proof of the given registers against the state commitment, and
+// returns the proven values of the registers. The value of a register which isn't allocated is empty.
+//
+// Expected errors during normal operations:
+//   - ErrInvalidProof if the proof can't be decoded, doesn't prove all the registers, or doesn't
+//     match the state commitment.
+func Verify(stateCommitment [hash.HashLen]byte, registerIDs []RegisterID, encodedProof []byte) (map[RegisterID][]byte, error) {
+	proofs, err := decodeBatchProof(encodedProof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+
+	byPath := make(map[hash.Hash]*trieProof, len(proofs))
+	for _, p := range proofs {
+		if !p.inclusion {
+			return nil, fmt.Errorf("%w: unexpected non-inclusion proof for path %v", ErrInvalidProof, p.path)
+		}
+		if !verifyTrieProof(p, hash.Hash(stateCommitment)) {
+			return nil, fmt.Errorf("%w: proof for path %v doesn't match state commitment %x", ErrInvalidProof, p.path, stateCommitment)
+		}
+		byPath[p.path] = p
+	}
+
+	values := make(map[RegisterID][]byte, len(registerIDs))
+	for _, id := range registerIDs {
+		p, ok := byPath[registerPath(id)]
+		if !ok {
+			return nil, fmt.Errorf("%w: missing proof for register %x/%x", ErrInvalidProof, id.Owner, id.Key)
+		}
+
+		// the path commits to the register, but the key of the payload isn't hashed into the trie,
+		// so it is only checked for consistency
+		if len(p.key) > 0 {
+			err = checkKey(p.key, id)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidProof, err)
+			}
+		}
+
+		values[id] = p.value
+	}
+
+	return values, nil
+}
+
+// registerPath returns the path of the register in the trie, see pathfinder.KeyToPath (version 1).
+func registerPath(id RegisterID) hash.Hash {
+	var canonical []byte
+	canonical = append(canonical, '/')
+	canonical = strconv.AppendUint(canonical, uint64(keyPartOwner), 10)
+	canonical = append(canonical, '/')
+	canonical = append(canonical, id.Owner...)
+	canonical = append(canonical, '/')
+	canonical = strconv.AppendUint(canonical, uint64(keyPartKey), 10)
+	canonical = append(canonical, '/')
+	canonical = append(canonical, id.Key...)
+
+	var path hash.Hash
+	cryptoHash.ComputeSHA3_256((*[hash.HashLen]byte)(&path), canonical)
+	return path
+}
+
+// verifyTrieProof computes the root hash from the leaf up to the root, see proof.VerifyTrieProof.
+func verifyTrieProof(p *trieProof, expectedRoot hash.Hash) bool {
+	leafHeight := treeHeight - int(p.steps)
+	if leafHeight < 0 {
+		return false
+	}
+
+	computed := compactValue(p.path, p.value, leafHeight)
+	proofIndex := len(p.interims) - 1
+	for h := leafHeight + 1; h <= treeHeight; h++ {
+		if treeHeight-h >= len(p.flags)*8 {
+			return false
+		}
+
+		var siblingHash hash.Hash
+		if bitutils.ReadBit(p.flags, treeHeight-h) == 1 {
+			if proofIndex < 0 {
+				return false
+			}
+			siblingHash = p.interims[proofIndex]
+			proofIndex--
+		} else {
+			siblingHash = defaultHashes[h-1]
+		}
+
+		if bitutils.ReadBit(p.path[:], treeHeight-h) == 1 {
+			computed = hash.HashInterNode(siblingHash, computed)
+		} else {
+			computed = hash.HashInterNode(computed, siblingHash)
+		}
+	}
+
+	// all the interims must be used
+	return proofIndex == -1 && computed == expectedRoot
+}
+
+// compactValue computes the hash of a leaf at the given height, see ledger.ComputeCompactValue.
+func compactValue(path hash.Hash, value []byte, height int) hash.Hash {
+	if len(value) == 0 {
+		return defaultHashes[height]
+	}
+
+	computed := hash.HashLeaf(path, value)
+	for h := 1; h <= height; h++ {
+		if bitutils.ReadBit(path[:], treeHeight-h) == 1 {
+			computed = hash.HashInterNode(defaultHashes[h-1], computed)
+		} else {
+			computed = hash.HashInterNode(computed, defaultHashes[h-1])
+		}
+	}
+	return computed
+}
+
+// checkKey checks that the encoded payload key is the key of the register.
+func checkKey(encodedKey []byte, id RegisterID) error {
+	numParts, rest, err := utils.ReadUint16(encodedKey)
+	if err != nil {
+		return fmt.Errorf("could not decode payload key: %w", err)
+	}
+
+	var owner, key []byte
+	for i := 0; i < int(numParts); i++ {
+		var size uint32
+		size, rest, err = utils.ReadUint32(rest)
+		if err != nil {
+			return fmt.Errorf("could not decode payload key: %w", err)
+		}
+		var part []byte
+		part, rest, err = utils.ReadSlice(rest, int(size))
+		if err != nil {
+			return fmt.Errorf("could not decode payload key: %w", err)
+		}
+		partType, value, err := utils.ReadUint16(part)
+		if err != nil {
+			return fmt.Errorf("could not decode payload key: %w", err)
+		}
+
+		switch partType {
+		case keyPartOwner:
+			owner = value
+		case keyPartKey:
+			key = value
+		}
+	}
+
+	if !bytes.Equal(owner, []byte(id.Owner)) || !bytes.Equal(key, []byte(id.Key)) {
+		return fmt.Errorf("payload key doesn't match register %x/%x", id.Owner, id.Key)
+	}
+	return nil
+}
+
+// decodeBatchProof decodes a batch proof, see ledger.DecodeTrieBatchProof.
+func decodeBatchProof(encoded []byte) ([]*trieProof, error) {
+	version, rest, err := utils.ReadUint16(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode batch proof version: %w", err)
+	}
+	if version != trieBatchProofVersion {
+		return nil, fmt.Errorf("unsupported batch proof version %d", version)
+	}
+
+	typ, rest, err := utils.ReadUint8(rest)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode batch proof type: %w", err)
+	}
+	if typ != typeBatchProof {
+		return nil, fmt.Errorf("unexpected encoded type %d, expected batch proof", typ)
+	}
+
+	count, rest, err := utils.ReadUint32(rest)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode number of proofs: %w", err)
+	}
+
+	// each proof is at least 8 bytes, which bounds the allocation for malformed proofs
+	proofs := make([]*trieProof, 0, min(int(count), len(rest)/8))
+	for i := 0; i < int(count); i++ {
+		var size uint64
+		size, rest, err = utils.ReadUint64(rest)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode proof size: %w", err)
+		}
+		if size > uint64(len(rest)) {
+			return nil, fmt.Errorf("proof size %d exceeds remaining %d bytes", size, len(rest))
+		}
+
+		var encodedProof []byte
+		encodedProof, rest, err = utils.ReadSlice(rest, int(size))
+		if err != nil {
+			return nil, fmt.Errorf("could not decode proof: %w", err)
+		}
+
+		p, err := decodeTrieProof(encodedProof)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode proof %d: %w", i, err)
+		}
+		proofs = append(proofs, p)
+	}
+
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("unexpected %d trailing bytes after batch proof", len(rest))
+	}
+
+	return proofs, nil
+}
+
+// decodeTrieProof decodes a proof encoded with version 0, see ledger.encodeTrieProof.
+func decodeTrieProof(encoded []byte) (*trieProof, error) {
+	p := &trieProof{}
+
+	inclusion, rest, err := utils.ReadUint8(encoded)
+	if err != nil {
+		return nil, err
+	}
+	p.inclusion = inclusion&(1<<7) != 0
+
+	p.steps, rest, err = utils.ReadUint8(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	flagsSize, rest, err := utils.ReadUint8(rest)
+	if err != nil {
+		return nil, err
+	}
+	p.flags, rest, err = utils.ReadSlice(rest, int(flagsSize))
+	if err != nil {
+		return nil, err
+	}
+
+	pathSize, rest, err := utils.ReadUint16(rest)
+	if err != nil {
+		return nil, err
+	}
+	path, rest, err := utils.ReadSlice(rest, int(pathSize))
+	if err != nil {
+		return nil, err
+	}
+	p.path, err = hash.ToHash(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path: %w", err)
+	}
+
+	payloadSize, rest, err := utils.ReadUint64(rest)
+	if err != nil {
+		return nil, err
+	}
+	if payloadSize > uint64(len(rest)) {
+		return nil, fmt.Errorf("payload size %d exceeds remaining %d bytes", payloadSize, len(rest))
+	}
+	payload, rest, err := utils.ReadSlice(rest, int(payloadSize))
+	if err != nil {
+		return nil, err
+	}
+	p.key, p.value, err = decodePayload(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	interimsCount, rest, err := utils.ReadUint8(rest)
+	if err != nil {
+		return nil, err
+	}
+	p.interims = make([]hash.Hash, interimsCount)
+	for i := range p.interims {
+		var size uint16
+		size, rest, err = utils.ReadUint16(rest)
+		if err != nil {
+			return nil, err
+		}
+		var interim []byte
+		interim, rest, err = utils.ReadSlice(rest, int(size))
+		if err != nil {
+			return nil, err
+		}
+		p.interims[i], err = hash.ToHash(interim)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interim hash: %w", err)
+		}
+	}
+
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("unexpected %d trailing bytes after proof", len(rest))
+	}
+
+	return p, nil
+}
+
+// decodePayload decodes a payload encoded with version 0, see ledger.encodePayload.
+func decodePayload(encoded []byte) ([]byte, []byte, error) {
+	keySize, rest, err := utils.ReadUint32(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, rest, err := utils.ReadSlice(rest, int(keySize))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valueSize, rest, err := utils.ReadUint64(rest)
+	if err != nil {
+		return nil, nil, err
+	}
+	if valueSize != uint64(len(rest)) {
+		return nil, nil, fmt.Errorf("value size %d doesn't match remaining %d bytes", valueSize, len(rest))
+	}
+
+	return key, rest, nil
+}
@@ -0,0 +1,136 @@
+package registerproof_test
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/ledger/common/registerproof"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/wal/fixtures"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// proveRegisters sets the given registers in a new ledger, and returns the state commitment and the
+// encoded batch proof of the queried registers.
+func proveRegisters(t *testing.T, registers map[flow.RegisterID][]byte, queried []flow.RegisterID) (ledger.State, ledger.Proof) {
+	led, err := complete.NewLedger(&fixtures.NoopWAL{}, 100, &metrics.NoopCollector{}, zerolog.Nop(), complete.DefaultPathFinderVersion)
+	require.NoError(t, err)
+
+	compactor := fixtures.NewNoopCompactor(led)
+	<-compactor.Ready()
+	t.Cleanup(func() {
+		<-led.Done()
+		<-compactor.Done()
+	})
+
+	keys := make([]ledger.Key, 0, len(registers))
+	values := make([]ledger.Value, 0, len(registers))
+	for id, value := range registers {
+		keys = append(keys, convert.RegisterIDToLedgerKey(id))
+		values = append(values, value)
+	}
+
+	update, err := ledger.NewUpdate(led.InitialState(), keys, values)
+	require.NoError(t, err)
+	state, _, err := led.Set(update)
+	require.NoError(t, err)
+
+	queriedKeys := make([]ledger.Key, len(queried))
+	for i, id := range queried {
+		queriedKeys[i] = convert.RegisterIDToLedgerKey(id)
+	}
+	query, err := ledger.NewQuery(state, queriedKeys)
+	require.NoError(t, err)
+
+	proof, err := led.Prove(query)
+	require.NoError(t, err)
+
+	return state, proof
+}
+
+func toRegisterIDs(ids []flow.RegisterID) []registerproof.RegisterID {
+	result := make([]registerproof.RegisterID, len(ids))
+	for i, id := range ids {
+		result[i] = registerproof.RegisterID{Owner: id.Owner, Key: id.Key}
+	}
+	return result
+}
+
+func TestVerify(t *testing.T) {
+	owner := unittest.RandomAddressFixture()
+	existing := []flow.RegisterID{
+		flow.AccountStatusRegisterID(owner),
+		flow.ContractRegisterID(owner, "Token"),
+		flow.NewRegisterID(unittest.RandomAddressFixture(), "public_key_0"),
+	}
+	registers := map[flow.RegisterID][]byte{
+		existing[0]: []byte("status"),
+		existing[1]: []byte("contract code"),
+		existing[2]: []byte("key"),
+	}
+	missing := flow.NewRegisterID(owner, "missing")
+	queried := append(existing, missing)
+
+	state, proof := proveRegisters(t, registers, queried)
+
+	t.Run("valid proof", func(t *testing.T) {
+		values, err := registerproof.Verify(state, toRegisterIDs(queried), proof)
+		require.NoError(t, err)
+
+		require.Len(t, values, len(queried))
+		for id, value := range registers {
+			require.Equal(t, value, values[registerproof.RegisterID{Owner: id.Owner, Key: id.Key}])
+		}
+		require.Empty(t, values[registerproof.RegisterID{Owner: missing.Owner, Key: missing.Key}])
+	})
+
+	t.Run("subset of the proven registers", func(t *testing.T) {
+		values, err := registerproof.Verify(state, toRegisterIDs(existing[:1]), proof)
+		require.NoError(t, err)
+		require.Len(t, values, 1)
+	})
+
+	t.Run("wrong state commitment", func(t *testing.T) {
+		otherState, _ := proveRegisters(t, map[flow.RegisterID][]byte{existing[0]: []byte("other")}, existing[:1])
+
+		_, err := registerproof.Verify(otherState, toRegisterIDs(queried), proof)
+		require.ErrorIs(t, err, registerproof.ErrInvalidProof)
+	})
+
+	t.Run("register without proof", func(t *testing.T) {
+		other := registerproof.RegisterID{Owner: owner.String(), Key: "other"}
+
+		_, err := registerproof.Verify(state, append(toRegisterIDs(queried), other), proof)
+		require.ErrorIs(t, err, registerproof.ErrInvalidProof)
+	})
+
+	t.Run("tampered value", func(t *testing.T) {
+		decoded, err := ledger.DecodeTrieBatchProof(proof)
+		require.NoError(t, err)
+
+		for _, p := range decoded.Proofs {
+			if len(p.Payload.Value()) > 0 {
+				key, err := p.Payload.Key()
+				require.NoError(t, err)
+				p.Payload = ledger.NewPayload(key, []byte("tampered"))
+			}
+		}
+		tampered := ledger.EncodeTrieBatchProof(decoded)
+
+		_, err = registerproof.Verify(state, toRegisterIDs(queried), tampered)
+		require.ErrorIs(t, err, registerproof.ErrInvalidProof)
+	})
+
+	t.Run("malformed proof", func(t *testing.T) {
+		for _, malformed := range [][]byte{nil, proof[:len(proof)/2], append(proof, 0)} {
+			_, err := registerproof.Verify(state, toRegisterIDs(queried), malformed)
+			require.ErrorIs(t, err, registerproof.ErrInvalidProof)
+		}
+	})
+}
@@ -25,6 +25,80 @@ type WALTrieUpdate struct {
 	TrieCh   <-chan *trie.MTrie // TrieCh channel is used to send new trie from Ledger to Compactor.
 }
 
+// CheckpointKind is the kind of checkpoint created by the Compactor.
+type CheckpointKind uint32
+
+const (
+	// CheckpointKindDefault lets the Compactor decide the kind of checkpoint, based on its configuration.
+	CheckpointKindDefault CheckpointKind = iota
+	// CheckpointKindFull is a checkpoint holding all the trie nodes of the checkpointed tries.
+	CheckpointKindFull
+	// CheckpointKindDelta is a checkpoint holding only the trie nodes created since the previous checkpoint.
+	CheckpointKindDelta
+)
+
+func (k CheckpointKind) String() string {
+	switch k {
+	case CheckpointKindDefault:
+		return "default"
+	case CheckpointKindFull:
+		return "full"
+	case CheckpointKindDelta:
+		return "delta"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint32(k))
+	}
+}
+
+// ParseCheckpointKind parses the name of a checkpoint kind.
+func ParseCheckpointKind(s string) (CheckpointKind, error) {
+	for _, kind := range []CheckpointKind{CheckpointKindDefault, CheckpointKindFull, CheckpointKindDelta} {
+		if kind.String() == s {
+			return kind, nil
+		}
+	}
+	return CheckpointKindDefault, fmt.Errorf("unknown checkpoint kind: %s", s)
+}
+
+// CompactorOption is an optional configuration of the Compactor.
+type CompactorOption func(*Compactor)
+
+// WithDeltaCheckpoints enables delta checkpoints: after a full checkpoint, the next maxDeltas
+// checkpoints are delta checkpoints of the previous checkpoint. Once the chain of delta checkpoints
+// reaches maxDeltas, the next checkpoint is a full checkpoint again, which merges the chain.
+// The tries of the previous checkpoint are kept in memory to create the next delta checkpoint.
+func WithDeltaCheckpoints(maxDeltas uint) CompactorOption {
+	return func(c *Compactor) {
+		c.maxDeltaCheckpoints = maxDeltas
+	}
+}
+
+// WithTriggeredCheckpointKind sets the kind of checkpoint created when checkpointing is triggered
+// by triggerCheckpointOnNextSegmentFinish. The kind is reset to CheckpointKindDefault once read.
+func WithTriggeredCheckpointKind(kind *atomic.Uint32) CompactorOption {
+	return func(c *Compactor) {
+		c.triggeredCheckpointKind = kind
+	}
+}
+
+// WALShipper is notified by the Compactor of the WAL segments and checkpoints that are finished,
+// so that they can be copied to a remote storage.
+// Implementations must not block, since they are called by the Compactor goroutines.
+type WALShipper interface {
+	// OnSegmentFinished is called once the segment is finished, no more records are appended to it.
+	OnSegmentFinished(segmentNum int)
+
+	// OnCheckpointCreated is called once all the files of the checkpoint are written.
+	OnCheckpointCreated(checkpointNum int)
+}
+
+// WithWALShipper sets the WALShipper notified of finished WAL segments and checkpoints.
+func WithWALShipper(shipper WALShipper) CompactorOption {
+	return func(c *Compactor) {
+		c.walShipper = shipper
+	}
+}
+
 // checkpointResult is a message to communicate checkpointing number and error if any.
 type checkpointResult struct {
 	num int
@@ -57,8 +131,16 @@ type Compactor struct {
 	checkpointsToKeep                    uint
 	stopCh                               chan chan struct{}
 	trieUpdateCh                         <-chan *WALTrieUpdate
-	triggerCheckpointOnNextSegmentFinish *atomic.Bool // to trigger checkpoint manually
+	triggerCheckpointOnNextSegmentFinish *atomic.Bool   // to trigger checkpoint manually
+	triggeredCheckpointKind              *atomic.Uint32 // kind of the manually triggered checkpoint
+	maxDeltaCheckpoints                  uint
+	walShipper                           WALShipper // optional
 	metrics                              module.WALMetrics
+
+	// base of the next delta checkpoint, only accessed by the checkpointing goroutine.
+	lastCheckpointNum   int
+	lastCheckpointTries []*trie.MTrie
+	deltaCheckpoints    uint // number of delta checkpoints since the last full checkpoint
 }
 
 // NewCompactor creates new Compactor which writes WAL record and triggers
@@ -79,6 +161,7 @@ func NewCompactor(
 	checkpointsToKeep uint,
 	triggerCheckpointOnNextSegmentFinish *atomic.Bool,
 	metrics module.WALMetrics,
+	opts ...CompactorOption,
 ) (*Compactor, error) {
 	if checkpointDistance < 1 {
 		checkpointDistance = 1
@@ -105,7 +188,7 @@ func NewCompactor(
 	// Create trieQueue with initial values from ledger state.
 	trieQueue := realWAL.NewTrieQueueWithValues(checkpointCapacity, tries)
 
-	return &Compactor{
+	c := &Compactor{
 		checkpointer:                         checkpointer,
 		wal:                                  w,
 		trieQueue:                            trieQueue,
@@ -118,7 +201,14 @@ func NewCompactor(
 		checkpointsToKeep:                    checkpointsToKeep,
 		triggerCheckpointOnNextSegmentFinish: triggerCheckpointOnNextSegmentFinish,
 		metrics:                              metrics,
-	}, nil
+		lastCheckpointNum:                    -1,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
 }
 
 // Subscribe subscribes observer to Compactor.
@@ -198,6 +288,9 @@ func (c *Compactor) run() {
 		nextCheckpointNum = activeSegmentNum
 	}
 
+	// requestedKind is the kind of the next checkpoint requested by the admin tool.
+	requestedKind := CheckpointKindDefault
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 Loop:
@@ -230,6 +323,9 @@ Loop:
 
 			// listen to signals from admin tool in order to trigger a checkpoint when the current segment file is finished
 			if c.triggerCheckpointOnNextSegmentFinish.CompareAndSwap(true, false) {
+				if c.triggeredCheckpointKind != nil {
+					requestedKind = CheckpointKind(c.triggeredCheckpointKind.Swap(uint32(CheckpointKindDefault)))
+				}
 				// sanity checking, usually the nextCheckpointNum is a segment number in the future that when the activeSegmentNum
 				// finishes and reaches the nextCheckpointNum, then checkpoint will be triggered.
 				if nextCheckpointNum >= activeSegmentNum {
@@ -257,9 +353,12 @@ Loop:
 				// Compute next checkpoint number
 				nextCheckpointNum = checkpointNum + int(c.checkpointDistance)
 
+				kind := requestedKind
+				requestedKind = CheckpointKindDefault
+
 				go func() {
 					defer checkpointSem.Release(1)
-					err := c.checkpoint(ctx, checkpointTries, checkpointNum)
+					err := c.checkpoint(ctx, checkpointTries, checkpointNum, kind)
 					checkpointResultCh <- checkpointResult{checkpointNum, err}
 				}()
 			} else {
@@ -290,13 +389,34 @@ Loop:
 // Errors indicate that checkpoint file can't be created or prior checkpoints can't be removed.
 // Caller should handle returned errors by retrying checkpointing when appropriate.
 // Since this function is only for checkpointing, Compactor isn't affected by returned error.
-func (c *Compactor) checkpoint(ctx context.Context, tries []*trie.MTrie, checkpointNum int) error {
+func (c *Compactor) checkpoint(ctx context.Context, tries []*trie.MTrie, checkpointNum int, requestedKind CheckpointKind) error {
 
-	err := createCheckpoint(c.checkpointer, c.logger, tries, checkpointNum, c.metrics)
+	kind := c.checkpointKind(requestedKind)
+
+	var err error
+	if kind == CheckpointKindDelta {
+		err = createDeltaCheckpoint(c.checkpointer, c.logger, c.lastCheckpointTries, c.lastCheckpointNum, tries, checkpointNum, c.metrics)
+	} else {
+		err = createCheckpoint(c.checkpointer, c.logger, tries, checkpointNum, c.metrics)
+	}
 	if err != nil {
 		return &createCheckpointError{num: checkpointNum, err: err}
 	}
 
+	if c.walShipper != nil {
+		c.walShipper.OnCheckpointCreated(checkpointNum)
+	}
+
+	if c.maxDeltaCheckpoints > 0 {
+		c.lastCheckpointNum = checkpointNum
+		c.lastCheckpointTries = tries
+		if kind == CheckpointKindDelta {
+			c.deltaCheckpoints++
+		} else {
+			c.deltaCheckpoints = 0
+		}
+	}
+
 	// Return if context is canceled.
 	select {
 	case <-ctx.Done():
@@ -326,6 +446,38 @@ func (c *Compactor) checkpoint(ctx context.Context, tries []*trie.MTrie, checkpo
 	return nil
 }
 
+// checkpointKind returns the kind of the next checkpoint. A delta checkpoint is only created if
+// delta checkpoints are enabled, the tries of the previous checkpoint are known, which is not the
+// case for the first checkpoint created after startup, and the chain of delta checkpoints is shorter
+// than maxDeltaCheckpoints. This also applies to explicitly requested delta checkpoints.
+func (c *Compactor) checkpointKind(requested CheckpointKind) CheckpointKind {
+	if c.lastCheckpointTries == nil {
+		if requested == CheckpointKindDelta {
+			c.logger.Warn().Msg("cannot create requested delta checkpoint without a base checkpoint, creating full checkpoint")
+		}
+		return CheckpointKindFull
+	}
+
+	switch requested {
+	case CheckpointKindFull:
+		return CheckpointKindFull
+	case CheckpointKindDelta:
+		if c.deltaCheckpoints >= c.maxDeltaCheckpoints {
+			c.logger.Warn().
+				Uint("delta_checkpoints", c.deltaCheckpoints).
+				Uint("max_delta_checkpoints", c.maxDeltaCheckpoints).
+				Msg("cannot create requested delta checkpoint, chain of delta checkpoints is too long, creating full checkpoint")
+			return CheckpointKindFull
+		}
+		return CheckpointKindDelta
+	default:
+		if c.deltaCheckpoints < c.maxDeltaCheckpoints {
+			return CheckpointKindDelta
+		}
+		return CheckpointKindFull
+	}
+}
+
 // createCheckpoint creates checkpoint with given checkpointNum and tries.
 // Errors indicate that checkpoint file can't be created.
 // Caller should handle returned errors by retrying checkpointing when appropriate.
@@ -354,7 +506,45 @@ func createCheckpoint(checkpointer *realWAL.Checkpointer, logger zerolog.Logger,
 	return nil
 }
 
+// createDeltaCheckpoint creates a delta checkpoint with given checkpointNum and tries, on top of
+// the checkpoint baseNum holding the given base tries.
+// Errors indicate that checkpoint file can't be created.
+// Caller should handle returned errors by retrying checkpointing when appropriate.
+func createDeltaCheckpoint(
+	checkpointer *realWAL.Checkpointer,
+	logger zerolog.Logger,
+	baseTries []*trie.MTrie,
+	baseNum int,
+	tries []*trie.MTrie,
+	checkpointNum int,
+	metrics module.WALMetrics,
+) error {
+
+	logger.Info().Msgf("serializing delta checkpoint %d of checkpoint %d with %v tries", checkpointNum, baseNum, len(tries))
+
+	startTime := time.Now()
+
+	fileName := realWAL.NumberToFilename(checkpointNum)
+	err := realWAL.StoreDeltaCheckpointV6(baseTries, realWAL.NumberToFilename(baseNum), tries, checkpointer.Dir(), fileName, logger)
+	if err != nil {
+		return fmt.Errorf("error serializing delta checkpoint (%d): %w", checkpointNum, err)
+	}
+
+	size, err := realWAL.ReadCheckpointFileSize(checkpointer.Dir(), fileName)
+	if err != nil {
+		return fmt.Errorf("error reading checkpoint file size (%d): %w", checkpointNum, err)
+	}
+
+	metrics.ExecutionCheckpointSize(size)
+
+	duration := time.Since(startTime)
+	logger.Info().Float64("total_time_s", duration.Seconds()).Msgf("created delta checkpoint %d", checkpointNum)
+
+	return nil
+}
+
 // cleanupCheckpoints deletes prior checkpoint files if needed.
+// The base checkpoints of the kept delta checkpoints are kept as well.
 // Since the function is side-effect free, all failures are simply a no-op.
 func cleanupCheckpoints(checkpointer *realWAL.Checkpointer, checkpointsToKeep int) error {
 	// Don't list checkpoints if we keep them all
@@ -369,7 +559,21 @@ func cleanupCheckpoints(checkpointer *realWAL.Checkpointer, checkpointsToKeep in
 		// if condition guarantees this never fails
 		checkpointsToRemove := checkpoints[:len(checkpoints)-int(checkpointsToKeep)]
 
+		bases := make(map[string]struct{})
+		for _, checkpoint := range checkpoints[len(checkpoints)-int(checkpointsToKeep):] {
+			chain, err := realWAL.CheckpointChain(checkpointer.Dir(), realWAL.NumberToFilename(checkpoint))
+			if err != nil {
+				return fmt.Errorf("cannot read base checkpoints of checkpoint %d: %w", checkpoint, err)
+			}
+			for _, fileName := range chain[1:] {
+				bases[fileName] = struct{}{}
+			}
+		}
+
 		for _, checkpoint := range checkpointsToRemove {
+			if _, ok := bases[realWAL.NumberToFilename(checkpoint)]; ok {
+				continue
+			}
 			err := checkpointer.RemoveCheckpoint(checkpoint)
 			if err != nil {
 				return fmt.Errorf("cannot remove checkpoint %d: %w", checkpoint, err)
@@ -440,6 +644,10 @@ func (c *Compactor) processTrieUpdate(
 	c.logger.Info().Msgf("finish writing segment file %v, trie update is writing to segment file %v, checkpoint will trigger when segment %v is finished",
 		prevSegmentNum, activeSegmentNum, nextCheckpointNum)
 
+	if c.walShipper != nil {
+		c.walShipper.OnSegmentFinished(prevSegmentNum)
+	}
+
 	if nextCheckpointNum > prevSegmentNum {
 		// Not enough segments for checkpointing
 		return activeSegmentNum, -1, nil
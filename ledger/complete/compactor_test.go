@@ -618,6 +618,141 @@ func TestCompactorTriggeredByAdminTool(t *testing.T) {
 	})
 }
 
+// TestCompactorDeltaCheckpoints tests that delta checkpoints are created between full checkpoints,
+// and that the base checkpoints of the kept delta checkpoints are not removed.
+func TestCompactorDeltaCheckpoints(t *testing.T) {
+
+	const (
+		numInsPerStep       = 2 // the number of payloads in each trie update
+		pathByteSize        = 32
+		minPayloadByteSize  = 2<<11 - 256 // 3840 bytes
+		maxPayloadByteSize  = 2 << 11     // 4096 bytes
+		checkpointDistance  = 2           // create checkpoint on every 2 segment files
+		checkpointsToKeep   = 2
+		maxDeltaCheckpoints = 2
+		forestCapacity      = 500 // the number of tries to be included in a checkpoint file
+	)
+
+	metricsCollector := &metrics.NoopCollector{}
+
+	unittest.RunWithTempDir(t, func(dir string) {
+
+		rootHash := trie.EmptyTrieRootHash()
+
+		wal, err := realWAL.NewDiskWAL(unittest.LoggerWithName("wal"), nil, metrics.NewNoopCollector(), dir, forestCapacity, pathByteSize, 32*1024)
+		require.NoError(t, err)
+
+		l, err := NewLedger(wal, forestCapacity, metricsCollector, unittest.LoggerWithName("ledger"), DefaultPathFinderVersion)
+		require.NoError(t, err)
+
+		compactor, err := NewCompactor(l, wal, unittest.LoggerWithName("compactor"), forestCapacity, checkpointDistance, checkpointsToKeep, atomic.NewBool(true), metrics.NewNoopCollector(),
+			WithDeltaCheckpoints(maxDeltaCheckpoints))
+		require.NoError(t, err)
+
+		// checkpoint 0 is forced to trigger and is a full checkpoint, checkpoint 2 and 4 are delta
+		// checkpoints, and checkpoint 6 is a full checkpoint again
+		co := CompactorObserver{fromBound: 6, done: make(chan struct{})}
+		compactor.Subscribe(&co)
+
+		// Run Compactor in background.
+		<-compactor.Ready()
+
+		// 2 trie updates will fill a segment file, 15 trie updates will finish segment 6
+		for i := 0; i < 15; i++ {
+			// slow down updating the ledger, because running too fast would cause the previous checkpoint
+			// to not finish and get delayed
+			time.Sleep(LedgerUpdateDelay)
+
+			payloads := testutils.RandomPayloads(numInsPerStep, minPayloadByteSize, maxPayloadByteSize)
+
+			keys := make([]ledger.Key, len(payloads))
+			values := make([]ledger.Value, len(payloads))
+			for i, p := range payloads {
+				k, err := p.Key()
+				require.NoError(t, err)
+				keys[i] = k
+				values[i] = p.Value()
+			}
+
+			update, err := ledger.NewUpdate(ledger.State(rootHash), keys, values)
+			require.NoError(t, err)
+
+			newState, _, err := l.Set(update)
+			require.NoError(t, err)
+
+			rootHash = ledger.RootHash(newState)
+		}
+
+		// wait for the bound-checking observer to confirm checkpoints have been made
+		select {
+		case <-co.done:
+			// continue
+		case <-time.After(60 * time.Second):
+			assert.FailNow(t, "timed out")
+		}
+
+		// Shutdown ledger and compactor
+		<-l.Done()
+		<-compactor.Done()
+
+		checkpointer, err := wal.NewCheckpointer()
+		require.NoError(t, err)
+
+		// checkpoint 0 and 2 are kept, because they are the base checkpoints of checkpoint 4
+		nums, err := checkpointer.Checkpoints()
+		require.NoError(t, err)
+		require.Equal(t, []int{0, 2, 4, 6}, nums)
+
+		for num, expectedBase := range map[int]string{
+			0: "",
+			2: realWAL.NumberToFilename(0),
+			4: realWAL.NumberToFilename(2),
+			6: "",
+		} {
+			base, isDelta, err := realWAL.ReadDeltaCheckpointBase(dir, realWAL.NumberToFilename(num))
+			require.NoError(t, err)
+			require.Equal(t, expectedBase != "", isDelta, "checkpoint %d", num)
+			require.Equal(t, expectedBase, base, "checkpoint %d", num)
+		}
+
+		testCheckpointedTriesMatchReplayedTriesFromSegments(t, checkpointer, 4, dir, true)
+		testCheckpointedTriesMatchReplayedTriesFromSegments(t, checkpointer, 6, dir, true)
+	})
+}
+
+// TestCompactorCheckpointKind tests that requested delta checkpoints fall back to full checkpoints if
+// there is no base checkpoint, or the chain of delta checkpoints has reached its maximum length.
+func TestCompactorCheckpointKind(t *testing.T) {
+	const maxDeltaCheckpoints = 2
+	baseTries := []*trie.MTrie{trie.NewEmptyMTrie()}
+
+	for _, tc := range []struct {
+		name             string
+		baseTries        []*trie.MTrie
+		deltaCheckpoints uint
+		requested        CheckpointKind
+		expected         CheckpointKind
+	}{
+		{"default without base", nil, 0, CheckpointKindDefault, CheckpointKindFull},
+		{"delta without base", nil, 0, CheckpointKindDelta, CheckpointKindFull},
+		{"default below cap", baseTries, 1, CheckpointKindDefault, CheckpointKindDelta},
+		{"default at cap", baseTries, maxDeltaCheckpoints, CheckpointKindDefault, CheckpointKindFull},
+		{"delta below cap", baseTries, 1, CheckpointKindDelta, CheckpointKindDelta},
+		{"delta at cap", baseTries, maxDeltaCheckpoints, CheckpointKindDelta, CheckpointKindFull},
+		{"full below cap", baseTries, 0, CheckpointKindFull, CheckpointKindFull},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Compactor{
+				logger:              zerolog.Nop(),
+				maxDeltaCheckpoints: maxDeltaCheckpoints,
+				lastCheckpointTries: tc.baseTries,
+				deltaCheckpoints:    tc.deltaCheckpoints,
+			}
+			require.Equal(t, tc.expected, c.checkpointKind(tc.requested))
+		})
+	}
+}
+
 // TestCompactorConcurrency expects checkpointed tries to
 // match replayed tries in sequence with concurrent updates.
 // Replayed tries are tries updated by replaying all WAL segments
@@ -0,0 +1,203 @@
+package shipping
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+)
+
+const (
+	checkpointsPrefix = "checkpoints/"
+	segmentsPrefix    = "segments/"
+	manifestName      = "manifest.json"
+)
+
+// Manifest describes the files of a shipped checkpoint or WAL segment. The manifest is uploaded
+// after all the files, so a checkpoint or segment is only considered shipped once its manifest
+// exists.
+type Manifest struct {
+	Name  string         `json:"name"`
+	Files []ManifestFile `json:"files"`
+}
+
+// ManifestFile is a shipped file, with its size and CRC32 checksum (see wal.NewCRC32Writer).
+type ManifestFile struct {
+	Name  string `json:"name"`
+	Size  int64  `json:"size"`
+	CRC32 uint32 `json:"crc32"`
+}
+
+func objectKey(prefix string, name string, fileName string) string {
+	return prefix + name + "/" + fileName
+}
+
+// listShipped returns the names of the checkpoints or segments with the given prefix, whose
+// manifest has been uploaded.
+func listShipped(ctx context.Context, store ObjectStore, prefix string) ([]string, error) {
+	keys, err := store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, key := range keys {
+		name, ok := strings.CutSuffix(strings.TrimPrefix(key, prefix), "/"+manifestName)
+		if ok && !strings.Contains(name, "/") {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// upload uploads the given files of the directory, followed by the manifest.
+func upload(ctx context.Context, store ObjectStore, prefix string, dir string, name string, fileNames []string) error {
+	manifest := Manifest{
+		Name:  name,
+		Files: make([]ManifestFile, 0, len(fileNames)),
+	}
+
+	for _, fileName := range fileNames {
+		file, err := uploadFile(ctx, store, objectKey(prefix, name, fileName), filepath.Join(dir, fileName))
+		if err != nil {
+			return err
+		}
+		file.Name = fileName
+		manifest.Files = append(manifest.Files, file)
+	}
+
+	encoded, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("could not encode manifest of %v: %w", name, err)
+	}
+
+	err = store.Put(ctx, objectKey(prefix, name, manifestName), bytes.NewReader(encoded))
+	if err != nil {
+		return fmt.Errorf("could not upload manifest of %v: %w", name, err)
+	}
+
+	return nil
+}
+
+func uploadFile(ctx context.Context, store ObjectStore, key string, filePath string) (ManifestFile, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return ManifestFile{}, fmt.Errorf("could not open file %v: %w", filePath, err)
+	}
+	defer file.Close()
+
+	counter := &countingWriter{}
+	crcWriter := wal.NewCRC32Writer(counter)
+
+	err = store.Put(ctx, key, io.TeeReader(file, crcWriter))
+	if err != nil {
+		return ManifestFile{}, fmt.Errorf("could not upload file %v: %w", filePath, err)
+	}
+
+	return ManifestFile{
+		Size:  counter.n,
+		CRC32: crcWriter.Crc32(),
+	}, nil
+}
+
+// download downloads the files listed in the manifest into the directory, and verifies their size
+// and checksum. Each file is downloaded into a temporary file first, so that only verified files
+// are written into the directory.
+func download(ctx context.Context, store ObjectStore, prefix string, dir string, name string) (*Manifest, error) {
+	manifest, err := readManifest(ctx, store, prefix, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range manifest.Files {
+		if file.Name != filepath.Base(file.Name) {
+			return nil, fmt.Errorf("invalid file name %v in manifest of %v", file.Name, name)
+		}
+
+		err = downloadFile(ctx, store, objectKey(prefix, name, file.Name), dir, file)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+func readManifest(ctx context.Context, store ObjectStore, prefix string, name string) (*Manifest, error) {
+	body, err := store.Get(ctx, objectKey(prefix, name, manifestName))
+	if err != nil {
+		return nil, fmt.Errorf("could not download manifest of %v: %w", name, err)
+	}
+	defer body.Close()
+
+	var manifest Manifest
+	err = json.NewDecoder(body).Decode(&manifest)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode manifest of %v: %w", name, err)
+	}
+	return &manifest, nil
+}
+
+func downloadFile(ctx context.Context, store ObjectStore, key string, dir string, expected ManifestFile) (errToReturn error) {
+	body, err := store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	tmpFile, err := os.CreateTemp(dir, fmt.Sprintf("downloading-%v-*", expected.Name))
+	if err != nil {
+		return fmt.Errorf("could not create temporary file for %v: %w", expected.Name, err)
+	}
+	defer func() {
+		if errToReturn != nil {
+			_ = tmpFile.Close()
+			_ = os.Remove(tmpFile.Name())
+		}
+	}()
+
+	crcWriter := wal.NewCRC32Writer(tmpFile)
+	size, err := io.Copy(crcWriter, body)
+	if err != nil {
+		return fmt.Errorf("could not download %v: %w", key, err)
+	}
+
+	if size != expected.Size {
+		return fmt.Errorf("size mismatch for %v: manifest has %d bytes, but downloaded %d bytes", key, expected.Size, size)
+	}
+	if checksum := crcWriter.Crc32(); checksum != expected.CRC32 {
+		return fmt.Errorf("checksum mismatch for %v: manifest has crc32 %x, but downloaded file has crc32 %x", key, expected.CRC32, checksum)
+	}
+
+	err = tmpFile.Sync()
+	if err != nil {
+		return fmt.Errorf("could not sync %v: %w", expected.Name, err)
+	}
+	err = tmpFile.Close()
+	if err != nil {
+		return fmt.Errorf("could not close %v: %w", expected.Name, err)
+	}
+
+	err = os.Rename(tmpFile.Name(), filepath.Join(dir, expected.Name))
+	if err != nil {
+		return fmt.Errorf("could not rename %v: %w", expected.Name, err)
+	}
+
+	return nil
+}
+
+// countingWriter counts the bytes written to it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,117 @@
+package shipping
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	prometheusWAL "github.com/onflow/wal/wal"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+)
+
+// ErrDirNotEmpty is returned by Restore when the directory already has checkpoints or segments.
+var ErrDirNotEmpty = errors.New("directory already has checkpoints or segments")
+
+// Restore restores the ledger directory from the latest checkpoint shipped to the object store,
+// followed by the WAL segments shipped since then. Delta checkpoints are restored along with the
+// checkpoints they are based on. The size and checksum of every downloaded file is verified.
+//
+// The directory must not contain any checkpoint or segment, to never mix restored files with
+// the files of the node, ErrDirNotEmpty is returned otherwise.
+// It returns the number of the restored checkpoint, and the number of the last restored segment,
+// which is -1 if no segment was shipped after the checkpoint.
+func Restore(ctx context.Context, log zerolog.Logger, store ObjectStore, dir string) (int, int, error) {
+	checkpoints, err := wal.Checkpoints(dir)
+	if err != nil {
+		return 0, 0, err
+	}
+	_, lastSegment, err := prometheusWAL.Segments(dir)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not list segments: %w", err)
+	}
+	if len(checkpoints) > 0 || lastSegment >= 0 {
+		return 0, 0, fmt.Errorf("could not restore into %v: %w", dir, ErrDirNotEmpty)
+	}
+
+	checkpointNum, err := latestShippedCheckpoint(ctx, store)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// restore the checkpoint, and the chain of checkpoints it is based on
+	name := wal.NumberToFilename(checkpointNum)
+	for {
+		_, err = download(ctx, store, checkpointsPrefix, dir, name)
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not restore checkpoint %v: %w", name, err)
+		}
+		log.Info().Str("checkpoint", name).Msg("restored checkpoint")
+
+		baseName, isDelta, err := wal.ReadDeltaCheckpointBase(dir, name)
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not read restored checkpoint %v: %w", name, err)
+		}
+		if !isDelta {
+			break
+		}
+		name = baseName
+	}
+
+	// restore the segments written since the checkpoint. The segment with the number of the
+	// checkpoint is restored too if available, so that the WAL keeps numbering the segments from it.
+	segments, err := listShipped(ctx, store, segmentsPrefix)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not list shipped segments: %w", err)
+	}
+	shipped := toSet(segments)
+
+	num := checkpointNum
+	if _, ok := shipped[wal.NumberToFilenamePart(num)]; !ok {
+		num++
+	}
+	lastSegment = -1
+	for ; ; num++ {
+		segmentName := wal.NumberToFilenamePart(num)
+		if _, ok := shipped[segmentName]; !ok {
+			break
+		}
+
+		_, err = download(ctx, store, segmentsPrefix, dir, segmentName)
+		if err != nil {
+			return 0, 0, fmt.Errorf("could not restore segment %v: %w", segmentName, err)
+		}
+		lastSegment = num
+	}
+
+	log.Info().
+		Int("checkpoint", checkpointNum).
+		Int("last_segment", lastSegment).
+		Msg("restored ledger from object store")
+
+	return checkpointNum, lastSegment, nil
+}
+
+// latestShippedCheckpoint returns the number of the latest checkpoint shipped to the object store.
+func latestShippedCheckpoint(ctx context.Context, store ObjectStore) (int, error) {
+	names, err := listShipped(ctx, store, checkpointsPrefix)
+	if err != nil {
+		return 0, fmt.Errorf("could not list shipped checkpoints: %w", err)
+	}
+
+	latest := -1
+	for _, name := range names {
+		num, err := strconv.Atoi(name[strings.LastIndex(name, ".")+1:])
+		if err != nil || wal.NumberToFilename(num) != name {
+			continue
+		}
+		latest = max(latest, num)
+	}
+	if latest < 0 {
+		return 0, fmt.Errorf("no checkpoint found in object store")
+	}
+	return latest, nil
+}
@@ -0,0 +1,55 @@
+package shipping
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestRestoreCorruptedObject tests that a checkpoint whose shipped files don't match the checksums
+// of its manifest is not restored.
+func TestRestoreCorruptedObject(t *testing.T) {
+	dir := t.TempDir()
+	remoteDir := t.TempDir()
+	restoreDir := t.TempDir()
+
+	ctx := context.Background()
+	store := NewLocalObjectStore(remoteDir)
+
+	name := wal.NumberToFilename(1)
+	require.NoError(t, wal.StoreCheckpointV6Concurrently([]*trie.MTrie{trie.NewEmptyMTrie()}, dir, name, unittest.Logger()))
+
+	files, err := wal.CheckpointFiles(dir, name)
+	require.NoError(t, err)
+	require.NoError(t, upload(ctx, store, checkpointsPrefix, dir, name, files))
+
+	// flip a byte of the first file of the checkpoint
+	objectPath := filepath.Join(remoteDir, checkpointsPrefix, name, files[0])
+	content, err := os.ReadFile(objectPath)
+	require.NoError(t, err)
+	content[len(content)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(objectPath, content, 0644))
+
+	_, _, err = Restore(ctx, unittest.Logger(), store, restoreDir)
+	require.ErrorContains(t, err, "checksum mismatch")
+
+	// the corrupted file is not written into the directory
+	entries, err := os.ReadDir(restoreDir)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+// TestRestoreWithoutCheckpoint tests that restoring fails if no checkpoint was shipped.
+func TestRestoreWithoutCheckpoint(t *testing.T) {
+	store := NewLocalObjectStore(t.TempDir())
+
+	_, _, err := Restore(context.Background(), unittest.Logger(), store, t.TempDir())
+	require.Error(t, err)
+}
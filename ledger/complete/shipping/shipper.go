@@ -0,0 +1,209 @@
+package shipping
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	prometheusWAL "github.com/onflow/wal/wal"
+	"github.com/rs/zerolog"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/module/component"
+	"github.com/onflow/flow-go/module/irrecoverable"
+)
+
+// DefaultRetryInterval is the interval at which the Shipper retries to ship the files that
+// could not be shipped, for instance because the object store was unavailable.
+const DefaultRetryInterval = time.Minute
+
+var _ complete.WALShipper = (*Shipper)(nil)
+
+// Shipper copies the finished WAL segments and the checkpoints of the ledger directory to an
+// object store, so that an execution node can be restored from the object store (see Restore).
+//
+// Each WAL segment and checkpoint is uploaded with a manifest listing the checksums of its files.
+// The manifest is uploaded last, so that partially shipped files are never used for restoring.
+// Failures to ship are logged and retried, they never affect the ledger.
+type Shipper struct {
+	*component.ComponentManager
+	log           zerolog.Logger
+	store         ObjectStore
+	dir           string
+	retryInterval time.Duration
+	notifier      engine.Notifier
+
+	// highest segment reported as finished by the compactor
+	finishedSegment *atomic.Int64
+
+	// names of the shipped segments and checkpoints, only accessed by the worker
+	shippedSegments    map[string]struct{}
+	shippedCheckpoints map[string]struct{}
+	// segments below this number were finished when the shipper started
+	startupSegment int
+}
+
+// NewShipper creates a Shipper copying the WAL segments and checkpoints of the given ledger directory.
+func NewShipper(log zerolog.Logger, store ObjectStore, dir string, retryInterval time.Duration) *Shipper {
+	s := &Shipper{
+		log:             log.With().Str("component", "ledger_shipper").Logger(),
+		store:           store,
+		dir:             dir,
+		retryInterval:   retryInterval,
+		notifier:        engine.NewNotifier(),
+		finishedSegment: atomic.NewInt64(-1),
+		startupSegment:  -1,
+	}
+
+	s.ComponentManager = component.NewComponentManagerBuilder().
+		AddWorker(s.shippingLoop).
+		Build()
+	return s
+}
+
+// OnSegmentFinished is called by the compactor once no more records are appended to the segment.
+// This call is non-blocking.
+func (s *Shipper) OnSegmentFinished(segmentNum int) {
+	for {
+		finished := s.finishedSegment.Load()
+		if int64(segmentNum) <= finished || s.finishedSegment.CompareAndSwap(finished, int64(segmentNum)) {
+			break
+		}
+	}
+	s.notifier.Notify()
+}
+
+// OnCheckpointCreated is called by the compactor once a checkpoint is written.
+// This call is non-blocking.
+func (s *Shipper) OnCheckpointCreated(int) {
+	s.notifier.Notify()
+}
+
+func (s *Shipper) shippingLoop(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	ready()
+
+	ticker := time.NewTicker(s.retryInterval)
+	defer ticker.Stop()
+
+	notifier := s.notifier.Channel()
+	for {
+		err := s.sync(ctx)
+		if err != nil {
+			s.log.Warn().Err(err).Msg("failed to ship ledger files, will retry")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-notifier:
+		case <-ticker.C:
+		}
+	}
+}
+
+// sync ships the finished segments and the checkpoints which are not shipped yet.
+// No errors are expected during normal operations, returned errors are retried at the next sync.
+func (s *Shipper) sync(ctx context.Context) error {
+	if s.shippedSegments == nil || s.shippedCheckpoints == nil {
+		err := s.loadShipped(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := s.syncSegments(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.syncCheckpoints(ctx)
+}
+
+func (s *Shipper) loadShipped(ctx context.Context) error {
+	segments, err := listShipped(ctx, s.store, segmentsPrefix)
+	if err != nil {
+		return fmt.Errorf("could not list shipped segments: %w", err)
+	}
+	checkpoints, err := listShipped(ctx, s.store, checkpointsPrefix)
+	if err != nil {
+		return fmt.Errorf("could not list shipped checkpoints: %w", err)
+	}
+
+	s.shippedSegments = toSet(segments)
+	s.shippedCheckpoints = toSet(checkpoints)
+	return nil
+}
+
+// syncSegments ships the finished segments. All the segments before the last one are finished
+// when the shipper starts, since the WAL always appends to a new segment when opened.
+func (s *Shipper) syncSegments(ctx context.Context) error {
+	first, last, err := prometheusWAL.Segments(s.dir)
+	if err != nil {
+		return fmt.Errorf("could not list segments: %w", err)
+	}
+	if first < 0 {
+		return nil
+	}
+
+	if s.startupSegment < 0 {
+		s.startupSegment = last
+	}
+	finished := max(s.startupSegment-1, int(s.finishedSegment.Load()))
+
+	for num := first; num <= finished && num <= last; num++ {
+		name := wal.NumberToFilenamePart(num)
+		if _, ok := s.shippedSegments[name]; ok {
+			continue
+		}
+
+		err = upload(ctx, s.store, segmentsPrefix, s.dir, name, []string{name})
+		if err != nil {
+			return fmt.Errorf("could not ship segment %v: %w", name, err)
+		}
+		s.shippedSegments[name] = struct{}{}
+
+		s.log.Info().Str("segment", name).Msg("shipped segment")
+	}
+	return nil
+}
+
+// syncCheckpoints ships the checkpoints in ascending order, so that the base of a delta checkpoint
+// is always shipped before the delta checkpoint.
+func (s *Shipper) syncCheckpoints(ctx context.Context) error {
+	checkpoints, err := wal.Checkpoints(s.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, num := range checkpoints {
+		name := wal.NumberToFilename(num)
+		if _, ok := s.shippedCheckpoints[name]; ok {
+			continue
+		}
+
+		files, err := wal.CheckpointFiles(s.dir, name)
+		if err != nil {
+			return fmt.Errorf("could not list files of checkpoint %v: %w", name, err)
+		}
+
+		err = upload(ctx, s.store, checkpointsPrefix, s.dir, name, files)
+		if err != nil {
+			return fmt.Errorf("could not ship checkpoint %v: %w", name, err)
+		}
+		s.shippedCheckpoints[name] = struct{}{}
+
+		s.log.Info().Str("checkpoint", name).Int("files", len(files)).Msg("shipped checkpoint")
+	}
+	return nil
+}
+
+func toSet(names []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		set[name] = struct{}{}
+	}
+	return set
+}
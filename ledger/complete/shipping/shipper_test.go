@@ -0,0 +1,160 @@
+package shipping
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/testutils"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+type checkpointObserver struct {
+	fromBound int
+	done      chan struct{}
+}
+
+func (o *checkpointObserver) OnNext(val interface{}) {
+	if num, ok := val.(int); ok && num >= o.fromBound {
+		o.done <- struct{}{}
+	}
+}
+
+func (o *checkpointObserver) OnError(error) {}
+
+func (o *checkpointObserver) OnComplete() {
+	close(o.done)
+}
+
+// TestShipAndRestore tests that the checkpoints and segments shipped while the ledger is updated
+// can be restored into an empty directory, and that the ledger can be loaded from the restored files.
+func TestShipAndRestore(t *testing.T) {
+	const (
+		numInsPerStep       = 2
+		pathByteSize        = 32
+		minPayloadByteSize  = 2<<11 - 256 // 3840 bytes
+		maxPayloadByteSize  = 2 << 11     // 4096 bytes
+		checkpointDistance  = 2
+		checkpointsToKeep   = 0 // keep all
+		maxDeltaCheckpoints = 2
+		forestCapacity      = 500
+		segmentSize         = 32 * 1024
+	)
+
+	dir := t.TempDir()
+	remoteDir := t.TempDir()
+	restoreDir := t.TempDir()
+
+	store := NewLocalObjectStore(remoteDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+
+	shipper := NewShipper(unittest.Logger(), store, dir, 100*time.Millisecond)
+	shipper.Start(signalerCtx)
+	unittest.RequireCloseBefore(t, shipper.Ready(), time.Second, "shipper not ready")
+
+	diskWAL, err := wal.NewDiskWAL(unittest.Logger(), nil, metrics.NewNoopCollector(), dir, forestCapacity, pathByteSize, segmentSize)
+	require.NoError(t, err)
+
+	l, err := complete.NewLedger(diskWAL, forestCapacity, &metrics.NoopCollector{}, unittest.Logger(), complete.DefaultPathFinderVersion)
+	require.NoError(t, err)
+
+	compactor, err := complete.NewCompactor(l, diskWAL, unittest.Logger(), forestCapacity, checkpointDistance, checkpointsToKeep, atomic.NewBool(true), metrics.NewNoopCollector(),
+		complete.WithDeltaCheckpoints(maxDeltaCheckpoints),
+		complete.WithWALShipper(shipper))
+	require.NoError(t, err)
+
+	// checkpoint 0 is a full checkpoint, checkpoint 2 and 4 are delta checkpoints
+	co := &checkpointObserver{fromBound: 4, done: make(chan struct{})}
+	compactor.Subscribe(co)
+	<-compactor.Ready()
+
+	// 2 trie updates fill a segment file, 12 trie updates finish segment 4
+	states := make([]ledger.State, 0)
+	state := ledger.State(trie.EmptyTrieRootHash())
+	for i := 0; i < 12; i++ {
+		// slow down updating the ledger, so that checkpointing keeps up with the segments
+		time.Sleep(500 * time.Millisecond)
+
+		payloads := testutils.RandomPayloads(numInsPerStep, minPayloadByteSize, maxPayloadByteSize)
+		keys := make([]ledger.Key, len(payloads))
+		values := make([]ledger.Value, len(payloads))
+		for j, p := range payloads {
+			k, err := p.Key()
+			require.NoError(t, err)
+			keys[j] = k
+			values[j] = p.Value()
+		}
+
+		update, err := ledger.NewUpdate(state, keys, values)
+		require.NoError(t, err)
+
+		state, _, err = l.Set(update)
+		require.NoError(t, err)
+		states = append(states, state)
+	}
+
+	unittest.RequireReturnsBefore(t, func() { <-co.done }, 60*time.Second, "checkpoint 4 not created")
+
+	<-l.Done()
+	<-compactor.Done()
+
+	// wait until the checkpoint and the segments up to the checkpoint are shipped
+	require.Eventually(t, func() bool {
+		checkpoints, err := listShipped(ctx, store, checkpointsPrefix)
+		require.NoError(t, err)
+		segments, err := listShipped(ctx, store, segmentsPrefix)
+		require.NoError(t, err)
+		return len(segments) >= 5 && len(checkpoints) == 3
+	}, 10*time.Second, 100*time.Millisecond)
+
+	cancel()
+	unittest.RequireCloseBefore(t, shipper.Done(), time.Second, "shipper not done")
+
+	checkpointNum, lastSegment, err := Restore(context.Background(), unittest.Logger(), store, restoreDir)
+	require.NoError(t, err)
+	require.Equal(t, 4, checkpointNum)
+	require.GreaterOrEqual(t, lastSegment, 4)
+
+	// the checkpoint 4 and its bases are restored, along with the segments since checkpoint 4
+	checkpoints, err := wal.Checkpoints(restoreDir)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 2, 4}, checkpoints)
+
+	entries, err := os.ReadDir(restoreDir)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	for _, entry := range entries {
+		restored, err := os.ReadFile(filepath.Join(restoreDir, entry.Name()))
+		require.NoError(t, err)
+		original, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		require.NoError(t, err)
+		require.Equal(t, original, restored, "file %v", entry.Name())
+	}
+
+	// restoring again is refused
+	_, _, err = Restore(context.Background(), unittest.Logger(), store, restoreDir)
+	require.ErrorIs(t, err, ErrDirNotEmpty)
+
+	// the ledger is loaded from the restored files
+	restoredWAL, err := wal.NewDiskWAL(unittest.Logger(), nil, metrics.NewNoopCollector(), restoreDir, forestCapacity, pathByteSize, segmentSize)
+	require.NoError(t, err)
+
+	restoredLedger, err := complete.NewLedger(restoredWAL, forestCapacity, &metrics.NoopCollector{}, unittest.Logger(), complete.DefaultPathFinderVersion)
+	require.NoError(t, err)
+	<-restoredWAL.Done()
+
+	require.True(t, restoredLedger.HasState(states[7]))
+}
@@ -0,0 +1,204 @@
+package shipping
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// ObjectStore is an S3-compatible object store, keys are slash separated paths.
+type ObjectStore interface {
+	// Put stores the content of the reader as the object with the given key.
+	Put(ctx context.Context, key string, body io.Reader) error
+
+	// Get returns the content of the object with the given key.
+	// The caller is responsible for closing the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// List returns the keys of all the objects whose key starts with the given prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+var _ ObjectStore = (*S3ObjectStore)(nil)
+
+// S3ObjectStore is an ObjectStore backed by an S3 bucket. All the keys are stored below the
+// configured prefix of the bucket.
+type S3ObjectStore struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// NewS3ObjectStore returns a new S3ObjectStore storing objects in the given bucket, below the
+// given prefix.
+func NewS3ObjectStore(client *s3.Client, bucket string, prefix string) *S3ObjectStore {
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &S3ObjectStore{
+		client: client,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+// Put uploads the object, large objects are uploaded in multiple parts.
+func (s *S3ObjectStore) Put(ctx context.Context, key string, body io.Reader) error {
+	fullKey := s.prefix + key
+	_, err := manager.NewUploader(s.client).Upload(ctx, &s3.PutObjectInput{
+		Bucket: &s.bucket,
+		Key:    &fullKey,
+		Body:   body,
+	})
+	if err != nil {
+		return fmt.Errorf("could not upload object %v: %w", fullKey, err)
+	}
+	return nil
+}
+
+func (s *S3ObjectStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	fullKey := s.prefix + key
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.bucket,
+		Key:    &fullKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not download object %v: %w", fullKey, err)
+	}
+	return output.Body, nil
+}
+
+func (s *S3ObjectStore) List(ctx context.Context, prefix string) ([]string, error) {
+	fullPrefix := s.prefix + prefix
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: &s.bucket,
+		Prefix: &fullPrefix,
+	})
+
+	var keys []string
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not list objects with prefix %v: %w", fullPrefix, err)
+		}
+		for _, object := range page.Contents {
+			keys = append(keys, strings.TrimPrefix(*object.Key, s.prefix))
+		}
+	}
+	return keys, nil
+}
+
+var _ ObjectStore = (*LocalObjectStore)(nil)
+
+// LocalObjectStore is an ObjectStore backed by a local directory, in the same way as a MinIO
+// server backed by a file system. Each object is stored in a file, the key being the path of
+// the file relative to the directory. It can be used for testing, or to ship to a mounted
+// network file system.
+type LocalObjectStore struct {
+	dir string
+}
+
+// NewLocalObjectStore returns a new LocalObjectStore storing objects in the given directory.
+func NewLocalObjectStore(dir string) *LocalObjectStore {
+	return &LocalObjectStore{
+		dir: dir,
+	}
+}
+
+// Put writes the object into a temporary file first, so that a partially written object is never
+// visible.
+func (s *LocalObjectStore) Put(_ context.Context, key string, body io.Reader) (errToReturn error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		return fmt.Errorf("could not create directory for object %v: %w", key, err)
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), "writing-*")
+	if err != nil {
+		return fmt.Errorf("could not create temporary file for object %v: %w", key, err)
+	}
+	defer func() {
+		if errToReturn != nil {
+			_ = tmpFile.Close()
+			_ = os.Remove(tmpFile.Name())
+		}
+	}()
+
+	_, err = io.Copy(tmpFile, body)
+	if err != nil {
+		return fmt.Errorf("could not write object %v: %w", key, err)
+	}
+
+	err = tmpFile.Close()
+	if err != nil {
+		return fmt.Errorf("could not close object %v: %w", key, err)
+	}
+
+	err = os.Rename(tmpFile.Name(), filePath)
+	if err != nil {
+		return fmt.Errorf("could not rename object %v: %w", key, err)
+	}
+
+	return nil
+}
+
+func (s *LocalObjectStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not open object %v: %w", key, err)
+	}
+	return file, nil
+}
+
+func (s *LocalObjectStore) List(_ context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := filepath.WalkDir(s.dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), "writing-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.dir, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("could not list objects with prefix %v: %w", prefix, err)
+	}
+	return keys, nil
+}
+
+func (s *LocalObjectStore) path(key string) (string, error) {
+	cleaned := path.Clean(key)
+	if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("invalid object key: %v", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(cleaned)), nil
+}
@@ -0,0 +1,713 @@
+package wal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/bitutils"
+	"github.com/onflow/flow-go/ledger/common/hash"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/flattener"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+)
+
+// A delta checkpoint only stores the trie nodes which are not part of a base checkpoint. The base
+// checkpoint is either a full checkpoint or another delta checkpoint, so that delta checkpoints
+// form a chain ending with a full checkpoint. The base checkpoint must be stored in the same
+// directory as the delta checkpoint.
+//
+// Each trie of the delta checkpoint is compared with the last trie of the base checkpoint: a subtrie
+// which has the same hash as the subtrie at the same position of the base trie is stored as a
+// reference to the base trie, instead of storing all of its nodes. A trie of the delta checkpoint
+// which is also a trie of the base checkpoint is stored as a reference to its root node.
+//
+// The delta checkpoint is stored in a single file, which contains:
+//   - magic bytes and version (2+2 bytes)
+//   - length of the base checkpoint file name (2 bytes) and the base checkpoint file name
+//   - number of tries of the base checkpoint (2 bytes)
+//   - nodes in descendants-first order, each node is prefixed with its type (1 byte):
+//     a new node is encoded by flattener.EncodeNode, a node of the base checkpoint
+//     is encoded as trie index (2 bytes), height (2 bytes), path (32 bytes) and hash (32 bytes)
+//   - tries encoded by flattener.EncodeTrie
+//   - footer: node count (8 bytes) and trie count (2 bytes)
+//   - CRC32 checksum (4 bytes)
+//
+// Compatibility:
+//   - full checkpoints are not affected, delta checkpoints are only created when enabled on the Compactor.
+//   - a delta checkpoint has its own magic bytes (MagicBytesCheckpointDelta), so readers which do not
+//     support delta checkpoints reject it as an unknown file format instead of misreading it.
+//   - all the V6 readers of this package (LoadCheckpoint, OpenAndReadCheckpointV6, ReadTriesRootHash,
+//     CheckpointHasRootHash and OpenAndReadLeafNodesFromCheckpointV6) resolve the chain of base checkpoints.
+//   - references to base nodes include the node hash, and the number of base tries is verified, so a
+//     base checkpoint which was replaced by another checkpoint is detected when reading.
+//   - MergeDeltaCheckpoint replaces a delta checkpoint by a full checkpoint holding the same tries, for
+//     tools which do not support delta checkpoints, or to remove the base checkpoints.
+
+const (
+	deltaNodeTypeNew  byte = 0
+	deltaNodeTypeBase byte = 1
+
+	encFileNameLengthSize = 2
+	encDeltaNodeTypeSize  = 1
+	encHeightSize         = 2
+	encBaseNodeSize       = encTrieCountSize + encHeightSize + ledger.PathLen + hash.HashLen
+)
+
+// StoreDeltaCheckpointV6 stores the given tries as a delta checkpoint of the base checkpoint, the
+// base tries must be the tries stored in the base checkpoint file.
+func StoreDeltaCheckpointV6(
+	baseTries []*trie.MTrie,
+	baseFile string,
+	tries []*trie.MTrie,
+	outputDir string,
+	outputFile string,
+	logger zerolog.Logger,
+) error {
+	err := storeDeltaCheckpointV6(baseTries, baseFile, tries, outputDir, outputFile, logger)
+	if err != nil {
+		cleanupErr := deleteCheckpointFiles(outputDir, outputFile)
+		if cleanupErr != nil {
+			return fmt.Errorf("fail to cleanup temp file %s, after running into error: %w", cleanupErr, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func storeDeltaCheckpointV6(
+	baseTries []*trie.MTrie,
+	baseFile string,
+	tries []*trie.MTrie,
+	outputDir string,
+	outputFile string,
+	logger zerolog.Logger,
+) (errToReturn error) {
+	if len(baseTries) == 0 {
+		return fmt.Errorf("base checkpoint %v has no tries", baseFile)
+	}
+	if baseFile == outputFile || strings.ContainsRune(baseFile, filepath.Separator) {
+		return fmt.Errorf("invalid base checkpoint file name: %v", baseFile)
+	}
+	if len(baseTries) > math.MaxUint16 || len(tries) > math.MaxUint16 {
+		return fmt.Errorf("too many tries to store in delta checkpoint: %v base tries, %v tries", len(baseTries), len(tries))
+	}
+
+	lg := logger.With().
+		Str("checkpoint_file", path.Join(outputDir, outputFile)).
+		Str("base_checkpoint_file", baseFile).
+		Int("trie_count", len(tries)).
+		Logger()
+
+	lg.Info().Msg("storing delta checkpoint")
+
+	matched, err := findCheckpointPartFiles(outputDir, outputFile)
+	if err != nil {
+		return fmt.Errorf("fail to check if checkpoint file already exist: %w", err)
+	}
+	if len(matched) != 0 {
+		return fmt.Errorf("checkpoint file already exists: %v", matched)
+	}
+
+	closable, err := createClosableWriter(outputDir, outputFile, logger)
+	if err != nil {
+		return fmt.Errorf("could not create writer for delta checkpoint: %w", err)
+	}
+	defer func() {
+		errToReturn = closeAndMergeError(closable, errToReturn)
+	}()
+
+	writer := NewCRC32Writer(closable)
+
+	_, err = writer.Write(encodeVersion(MagicBytesCheckpointDelta, VersionV6))
+	if err != nil {
+		return fmt.Errorf("cannot write delta checkpoint header: %w", err)
+	}
+
+	_, err = writer.Write(encodeBaseCheckpoint(baseFile, uint16(len(baseTries))))
+	if err != nil {
+		return fmt.Errorf("cannot write base checkpoint: %w", err)
+	}
+
+	w := &deltaNodeWriter{
+		writer:       writer,
+		visitedNodes: make(map[*node.Node]uint64),
+		nodeCounter:  1, // index 0 means nil
+		baseRoots:    make(map[ledger.RootHash]uint16, len(baseTries)),
+		baseTrie:     baseTries[len(baseTries)-1],
+		baseIndex:    uint16(len(baseTries) - 1),
+		scratch:      make([]byte, 1024*4),
+	}
+	for i, t := range baseTries {
+		w.baseRoots[t.RootHash()] = uint16(i)
+	}
+
+	rootIndices := make([]uint64, len(tries))
+	for i, t := range tries {
+		rootIndices[i], err = w.storeTrie(t)
+		if err != nil {
+			return fmt.Errorf("could not store nodes of trie %v: %w", i, err)
+		}
+	}
+
+	nodeCount := w.nodeCounter - 1
+	lg.Info().Uint64("base_node_count", w.baseNodeCount).Msgf("delta checkpoint nodes have been stored. node count: %v", nodeCount)
+
+	for i, t := range tries {
+		_, err = writer.Write(flattener.EncodeTrie(t, rootIndices[i], w.scratch))
+		if err != nil {
+			return fmt.Errorf("cannot serialize trie %v: %w", i, err)
+		}
+	}
+
+	_, err = storeTopLevelTrieFooter(nodeCount, uint16(len(tries)), writer)
+	if err != nil {
+		return fmt.Errorf("could not store footer: %w", err)
+	}
+
+	lg.Info().Msg("delta checkpoint file has been successfully stored")
+
+	return nil
+}
+
+// deltaNodeWriter writes the nodes of a delta checkpoint.
+type deltaNodeWriter struct {
+	writer        io.Writer
+	visitedNodes  map[*node.Node]uint64
+	nodeCounter   uint64
+	baseNodeCount uint64
+	baseRoots     map[ledger.RootHash]uint16
+	baseTrie      *trie.MTrie
+	baseIndex     uint16
+	scratch       []byte
+}
+
+// storeTrie stores the nodes of the given trie which have not been stored yet, and returns the
+// index of its root node.
+func (w *deltaNodeWriter) storeTrie(t *trie.MTrie) (uint64, error) {
+	root := t.RootNode()
+	if root == nil {
+		return 0, nil
+	}
+	if index, ok := w.visitedNodes[root]; ok {
+		return index, nil
+	}
+
+	// the trie is a trie of the base checkpoint
+	if baseIndex, ok := w.baseRoots[t.RootHash()]; ok {
+		return w.storeBaseNode(root, baseIndex, ledger.Path{})
+	}
+
+	return w.storeNode(root, w.baseTrie.RootNode(), ledger.Path{})
+}
+
+// storeNode stores the subtrie of n in descendants-first order, and returns the index of n.
+// base is the node at the same position in the base trie, or nil if there is no such node.
+func (w *deltaNodeWriter) storeNode(n *node.Node, base *node.Node, nodePath ledger.Path) (uint64, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if index, ok := w.visitedNodes[n]; ok {
+		return index, nil
+	}
+
+	if base != nil && base.Height() == n.Height() && base.Hash() == n.Hash() {
+		return w.storeBaseNode(n, w.baseIndex, nodePath)
+	}
+
+	var lchildIndex, rchildIndex uint64
+	if !n.IsLeaf() {
+		var baseLeft, baseRight *node.Node
+		if base != nil && !base.IsLeaf() && base.Height() == n.Height() {
+			baseLeft, baseRight = base.LeftChild(), base.RightChild()
+		}
+
+		var err error
+		lchildIndex, err = w.storeNode(n.LeftChild(), baseLeft, nodePath)
+		if err != nil {
+			return 0, err
+		}
+
+		rightPath := nodePath
+		bitutils.SetBit(rightPath[:], ledger.NodeMaxHeight-n.Height())
+		rchildIndex, err = w.storeNode(n.RightChild(), baseRight, rightPath)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	_, err := w.writer.Write([]byte{deltaNodeTypeNew})
+	if err != nil {
+		return 0, fmt.Errorf("cannot serialize node type: %w", err)
+	}
+
+	_, err = w.writer.Write(flattener.EncodeNode(n, lchildIndex, rchildIndex, w.scratch))
+	if err != nil {
+		return 0, fmt.Errorf("cannot serialize node: %w", err)
+	}
+
+	return w.visited(n), nil
+}
+
+// storeBaseNode stores a reference to the node at the given position of a base trie.
+func (w *deltaNodeWriter) storeBaseNode(n *node.Node, baseIndex uint16, nodePath ledger.Path) (uint64, error) {
+	buf := w.scratch[:encDeltaNodeTypeSize+encBaseNodeSize]
+	buf[0] = deltaNodeTypeBase
+	pos := encDeltaNodeTypeSize
+	binary.BigEndian.PutUint16(buf[pos:], baseIndex)
+	pos += encTrieCountSize
+	binary.BigEndian.PutUint16(buf[pos:], uint16(n.Height()))
+	pos += encHeightSize
+	copy(buf[pos:], nodePath[:])
+	pos += ledger.PathLen
+	nodeHash := n.Hash()
+	copy(buf[pos:], nodeHash[:])
+
+	_, err := w.writer.Write(buf)
+	if err != nil {
+		return 0, fmt.Errorf("cannot serialize base node: %w", err)
+	}
+
+	w.baseNodeCount++
+	return w.visited(n), nil
+}
+
+func (w *deltaNodeWriter) visited(n *node.Node) uint64 {
+	index := w.nodeCounter
+	w.visitedNodes[n] = index
+	w.nodeCounter++
+	return index
+}
+
+// readCheckpointChainV6 reads the checkpoints of the given chain, as returned by CheckpointChain,
+// and returns the tries of the first checkpoint of the chain. The full checkpoint at the end of the
+// chain is read first, then each delta checkpoint is read on top of the tries of its base checkpoint.
+func readCheckpointChainV6(dir string, chain []string, logger zerolog.Logger) ([]*trie.MTrie, error) {
+	baseFile := chain[len(chain)-1]
+	tries, err := LoadCheckpoint(filePathCheckpointHeader(dir, baseFile), logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not load base checkpoint %v: %w", baseFile, err)
+	}
+
+	for i := len(chain) - 2; i >= 0; i-- {
+		baseTries := tries
+		err = withFile(logger, filePathCheckpointHeader(dir, chain[i]), func(file *os.File) error {
+			var err error
+			tries, err = readDeltaCheckpointV6(file, chain[i+1], baseTries, logger)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not read delta checkpoint %v: %w", chain[i], err)
+		}
+	}
+
+	return tries, nil
+}
+
+// readDeltaCheckpointV6 reads a delta checkpoint file on top of the tries of its base checkpoint,
+// and returns the tries of the delta checkpoint.
+func readDeltaCheckpointV6(f *os.File, expectedBaseFile string, baseTries []*trie.MTrie, logger zerolog.Logger) ([]*trie.MTrie, error) {
+	_, fileName := filepath.Split(f.Name())
+
+	lg := logger.With().Str("checkpoint_file", f.Name()).Logger()
+	lg.Info().Msg("reading delta checkpoint file")
+
+	scratch := make([]byte, 1024*4) // must not be less than 1024
+
+	// footer offset: nodes count (8 bytes) + tries count (2 bytes) + CRC32 sum (4 bytes)
+	const footerOffset = encNodeCountSize + encTrieCountSize + crc32SumSize
+	const footerSize = encNodeCountSize + encTrieCountSize // footer doesn't include crc32 sum
+
+	_, err := f.Seek(-footerOffset, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("cannot seek to footer: %w", err)
+	}
+
+	footer := make([]byte, footerSize)
+	_, err = io.ReadFull(f, footer)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read footer: %w", err)
+	}
+
+	nodesCount, triesCount, err := decodeTopLevelNodesAndTriesFooter(footer)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode footer: %w", err)
+	}
+
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("cannot seek to start of file: %w", err)
+	}
+
+	var bufReader io.Reader = bufio.NewReaderSize(f, defaultBufioReadSize)
+	crcReader := NewCRC32Reader(bufReader)
+	var reader io.Reader = crcReader
+
+	err = validateFileHeader(MagicBytesCheckpointDelta, VersionV6, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFile, baseTriesCount, err := readBaseCheckpoint(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if baseFile != expectedBaseFile {
+		return nil, fmt.Errorf("delta checkpoint %v has base checkpoint %v, but %v is expected", fileName, baseFile, expectedBaseFile)
+	}
+	if len(baseTries) != int(baseTriesCount) {
+		return nil, fmt.Errorf("base checkpoint %v has %v tries, but delta checkpoint %v expects %v",
+			baseFile, len(baseTries), fileName, baseTriesCount)
+	}
+
+	// nodes's element at index 0 is a special, meaning nil.
+	nodes := make([]*node.Node, nodesCount+1) //+1 for 0 index meaning nil
+	for i := uint64(1); i <= nodesCount; i++ {
+		n, err := readDeltaNode(reader, scratch, baseTries, func(nodeIndex uint64) (*node.Node, error) {
+			if nodeIndex >= i {
+				return nil, fmt.Errorf("sequence of serialized nodes does not satisfy Descendents-First-Relationship")
+			}
+			return nodes[nodeIndex], nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot read node %d: %w", i, err)
+		}
+		nodes[i] = n
+	}
+
+	tries := make([]*trie.MTrie, triesCount)
+	for i := uint16(0); i < triesCount; i++ {
+		tries[i], err = flattener.ReadTrie(reader, scratch, func(nodeIndex uint64) (*node.Node, error) {
+			if nodeIndex >= uint64(len(nodes)) {
+				return nil, fmt.Errorf("sequence of stored nodes doesn't contain node")
+			}
+			return nodes[nodeIndex], nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot read trie %d: %w", i, err)
+		}
+	}
+
+	// read footer again for crc32 computation
+	_, err = io.ReadFull(reader, footer)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read footer: %w", err)
+	}
+
+	expectedSum, err := readCRC32Sum(bufReader)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read checksum: %w", err)
+	}
+
+	if actualSum := crcReader.Crc32(); actualSum != expectedSum {
+		return nil, fmt.Errorf("checkpoint checksum failed! File contains %x but calculated crc32 is %x", expectedSum, actualSum)
+	}
+
+	err = ensureReachedEOF(bufReader)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read delta checkpoint file: %w", err)
+	}
+
+	lg.Info().Msgf("finish reading delta checkpoint, node count: %v, trie root count: %v", nodesCount, len(tries))
+
+	return tries, nil
+}
+
+// readDeltaTriesRootHash reads the root hashes of the tries of a delta checkpoint file. The root
+// hashes are stored in the delta checkpoint itself, so the base checkpoints are not read.
+func readDeltaTriesRootHash(logger zerolog.Logger, dir string, fileName string) (
+	trieRootsToReturn []ledger.RootHash,
+	errToReturn error,
+) {
+	errToReturn = withFile(logger, filePathCheckpointHeader(dir, fileName), func(file *os.File) error {
+		err := validateFileHeader(MagicBytesCheckpointDelta, VersionV6, file)
+		if err != nil {
+			return err
+		}
+
+		const footerOffset = encNodeCountSize + encTrieCountSize + crc32SumSize
+		_, err = file.Seek(-footerOffset, io.SeekEnd)
+		if err != nil {
+			return fmt.Errorf("cannot seek to footer: %w", err)
+		}
+
+		footer := make([]byte, encNodeCountSize+encTrieCountSize)
+		_, err = io.ReadFull(file, footer)
+		if err != nil {
+			return fmt.Errorf("cannot read footer: %w", err)
+		}
+
+		_, triesCount, err := decodeTopLevelNodesAndTriesFooter(footer)
+		if err != nil {
+			return fmt.Errorf("cannot decode footer: %w", err)
+		}
+
+		trieRootOffset := footerOffset + flattener.EncodedTrieSize*int(triesCount)
+		_, err = file.Seek(int64(-trieRootOffset), io.SeekEnd)
+		if err != nil {
+			return fmt.Errorf("cannot seek to trie roots: %w", err)
+		}
+
+		reader := bufio.NewReaderSize(file, defaultBufioReadSize)
+		trieRoots := make([]ledger.RootHash, 0, triesCount)
+		scratch := make([]byte, 1024*4) // must not be less than 1024
+		for i := 0; i < int(triesCount); i++ {
+			trieRootNode, err := flattener.ReadEncodedTrie(reader, scratch)
+			if err != nil {
+				return fmt.Errorf("could not read trie root node: %w", err)
+			}
+
+			trieRoots = append(trieRoots, ledger.RootHash(trieRootNode.RootHash))
+		}
+
+		trieRootsToReturn = trieRoots
+		return nil
+	})
+	return trieRootsToReturn, errToReturn
+}
+
+// validateDeltaCheckpointFile verifies the header and the checksum of a delta checkpoint file.
+func validateDeltaCheckpointFile(logger zerolog.Logger, dir string, fileName string) error {
+	return withFile(logger, filePathCheckpointHeader(dir, fileName), func(file *os.File) error {
+		fileInfo, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("cannot stat delta checkpoint file: %w", err)
+		}
+		if fileInfo.Size() < crc32SumSize {
+			return fmt.Errorf("delta checkpoint file is too small: %v bytes", fileInfo.Size())
+		}
+
+		bufReader := bufio.NewReaderSize(file, defaultBufioReadSize)
+		crcReader := NewCRC32Reader(bufReader)
+
+		err = validateFileHeader(MagicBytesCheckpointDelta, VersionV6, crcReader)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.CopyN(io.Discard, crcReader, fileInfo.Size()-crc32SumSize-encMagicSize-encVersionSize)
+		if err != nil {
+			return fmt.Errorf("cannot read delta checkpoint file: %w", err)
+		}
+
+		expectedSum, err := readCRC32Sum(bufReader)
+		if err != nil {
+			return fmt.Errorf("cannot read checksum: %w", err)
+		}
+
+		if actualSum := crcReader.Crc32(); actualSum != expectedSum {
+			return fmt.Errorf("delta checkpoint checksum failed! File contains %x but calculated crc32 is %x", expectedSum, actualSum)
+		}
+
+		return nil
+	})
+}
+
+// readDeltaNode reads a node of a delta checkpoint, nodes of the base checkpoint are looked up in
+// the base tries.
+func readDeltaNode(
+	reader io.Reader,
+	scratch []byte,
+	baseTries []*trie.MTrie,
+	getNode func(nodeIndex uint64) (*node.Node, error),
+) (*node.Node, error) {
+	_, err := io.ReadFull(reader, scratch[:encDeltaNodeTypeSize])
+	if err != nil {
+		return nil, fmt.Errorf("cannot read node type: %w", err)
+	}
+
+	switch scratch[0] {
+	case deltaNodeTypeNew:
+		return flattener.ReadNode(reader, scratch, getNode)
+	case deltaNodeTypeBase:
+		return readBaseNode(reader, scratch, baseTries)
+	default:
+		return nil, fmt.Errorf("unknown node type %d", scratch[0])
+	}
+}
+
+// readBaseNode reads the position of a node of the base checkpoint, and returns the node found at
+// this position.
+func readBaseNode(reader io.Reader, scratch []byte, baseTries []*trie.MTrie) (*node.Node, error) {
+	buf := scratch[:encBaseNodeSize]
+	_, err := io.ReadFull(reader, buf)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read base node: %w", err)
+	}
+
+	pos := 0
+	trieIndex := binary.BigEndian.Uint16(buf[pos:])
+	pos += encTrieCountSize
+	height := int(binary.BigEndian.Uint16(buf[pos:]))
+	pos += encHeightSize
+	nodePath, err := ledger.ToPath(buf[pos : pos+ledger.PathLen])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode base node path: %w", err)
+	}
+	pos += ledger.PathLen
+	nodeHash, err := hash.ToHash(buf[pos : pos+hash.HashLen])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode base node hash: %w", err)
+	}
+
+	if int(trieIndex) >= len(baseTries) {
+		return nil, fmt.Errorf("base trie index %d is out of range, base checkpoint has %d tries", trieIndex, len(baseTries))
+	}
+
+	n := baseTries[trieIndex].RootNode()
+	for n != nil && n.Height() > height && !n.IsLeaf() {
+		if bitutils.ReadBit(nodePath[:], ledger.NodeMaxHeight-n.Height()) == 0 {
+			n = n.LeftChild()
+		} else {
+			n = n.RightChild()
+		}
+	}
+
+	if n == nil || n.Height() != height || n.Hash() != nodeHash {
+		return nil, fmt.Errorf("base trie %d has no node with hash %v at height %d of path %v",
+			trieIndex, nodeHash, height, nodePath)
+	}
+
+	return n, nil
+}
+
+func encodeBaseCheckpoint(baseFile string, baseTriesCount uint16) []byte {
+	buf := make([]byte, encFileNameLengthSize+len(baseFile)+encTrieCountSize)
+	binary.BigEndian.PutUint16(buf, uint16(len(baseFile)))
+	copy(buf[encFileNameLengthSize:], baseFile)
+	binary.BigEndian.PutUint16(buf[encFileNameLengthSize+len(baseFile):], baseTriesCount)
+	return buf
+}
+
+func readBaseCheckpoint(reader io.Reader) (string, uint16, error) {
+	buf := make([]byte, encFileNameLengthSize)
+	_, err := io.ReadFull(reader, buf)
+	if err != nil {
+		return "", 0, fmt.Errorf("cannot read base checkpoint file name length: %w", err)
+	}
+
+	buf = make([]byte, int(binary.BigEndian.Uint16(buf))+encTrieCountSize)
+	_, err = io.ReadFull(reader, buf)
+	if err != nil {
+		return "", 0, fmt.Errorf("cannot read base checkpoint: %w", err)
+	}
+
+	baseFile := string(buf[:len(buf)-encTrieCountSize])
+	baseTriesCount := binary.BigEndian.Uint16(buf[len(buf)-encTrieCountSize:])
+	return baseFile, baseTriesCount, nil
+}
+
+// ReadDeltaCheckpointBase returns the file name of the base checkpoint of the given checkpoint.
+// It returns false if the checkpoint is not a delta checkpoint.
+func ReadDeltaCheckpointBase(dir string, fileName string) (string, bool, error) {
+	var baseFile string
+	var isDelta bool
+	err := withFile(zerolog.Nop(), filePathCheckpointHeader(dir, fileName), func(file *os.File) error {
+		reader := bufio.NewReader(file)
+		magic, version, err := readFileHeader(reader)
+		if err != nil {
+			return err
+		}
+		if magic != MagicBytesCheckpointDelta {
+			return nil
+		}
+		if version != VersionV6 {
+			return fmt.Errorf("unsupported delta checkpoint version %x", version)
+		}
+
+		isDelta = true
+		baseFile, _, err = readBaseCheckpoint(reader)
+		return err
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("could not read base of checkpoint %v: %w", fileName, err)
+	}
+
+	return baseFile, isDelta, nil
+}
+
+// CheckpointChain returns the file name of the given checkpoint, followed by the file names of all
+// the base checkpoints it depends on. A full checkpoint does not depend on any other checkpoint.
+func CheckpointChain(dir string, fileName string) ([]string, error) {
+	chain := []string{fileName}
+	for {
+		baseFile, isDelta, err := ReadDeltaCheckpointBase(dir, chain[len(chain)-1])
+		if err != nil {
+			return nil, err
+		}
+		if !isDelta {
+			return chain, nil
+		}
+		for _, f := range chain {
+			if f == baseFile {
+				return nil, fmt.Errorf("checkpoint %v depends on itself", baseFile)
+			}
+		}
+		chain = append(chain, baseFile)
+	}
+}
+
+// MergeDeltaCheckpoint replaces a delta checkpoint by a full checkpoint holding the same tries, so
+// that it no longer depends on its base checkpoints. It is a no-op for a full checkpoint.
+// The full checkpoint is written to a temporary directory first, and the delta checkpoint file is
+// only replaced once all the checkpoint part files have been moved into the checkpoint directory.
+func MergeDeltaCheckpoint(dir string, fileName string, logger zerolog.Logger, nWorker uint) (errToReturn error) {
+	_, isDelta, err := ReadDeltaCheckpointBase(dir, fileName)
+	if err != nil {
+		return err
+	}
+	if !isDelta {
+		logger.Info().Str("checkpoint_file", fileName).Msg("checkpoint is already a full checkpoint")
+		return nil
+	}
+
+	tries, err := LoadCheckpoint(filePathCheckpointHeader(dir, fileName), logger)
+	if err != nil {
+		return fmt.Errorf("could not load delta checkpoint %v: %w", fileName, err)
+	}
+	if len(tries) == 0 {
+		return fmt.Errorf("delta checkpoint %v has no tries", fileName)
+	}
+
+	tmpDir, err := os.MkdirTemp(dir, fmt.Sprintf("merging-%v-*", fileName))
+	if err != nil {
+		return fmt.Errorf("could not create temporary directory: %w", err)
+	}
+	defer func() {
+		err := os.RemoveAll(tmpDir)
+		if err != nil {
+			errToReturn = multierror.Append(errToReturn, err).ErrorOrNil()
+		}
+	}()
+
+	err = StoreCheckpointV6(tries, tmpDir, fileName, logger, nWorker)
+	if err != nil {
+		return fmt.Errorf("could not store full checkpoint %v: %w", fileName, err)
+	}
+
+	// move the part files first, the delta checkpoint is replaced atomically by the header file.
+	tmpPaths := allFilePaths(tmpDir, fileName)
+	paths := allFilePaths(dir, fileName)
+	for i := len(paths) - 1; i >= 0; i-- {
+		err = os.Rename(tmpPaths[i], paths[i])
+		if err != nil {
+			return fmt.Errorf("could not move checkpoint file %v: %w", paths[i], err)
+		}
+	}
+
+	logger.Info().Str("checkpoint_file", fileName).Msg("merged delta checkpoint into a full checkpoint")
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package wal
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// updateRandomTries returns count tries, each created by updating random registers of the previous trie.
+func updateRandomTries(t *testing.T, activeTrie *trie.MTrie, count int) []*trie.MTrie {
+	tries := make([]*trie.MTrie, 0, count)
+	for i := 0; i < count; i++ {
+		paths, payloads := randNPathPayloads(10)
+		var err error
+		activeTrie, _, err = trie.NewTrieWithUpdatedRegisters(activeTrie, paths, payloads, false)
+		require.NoError(t, err, "update registers")
+		tries = append(tries, activeTrie)
+	}
+	return tries
+}
+
+func TestWriteAndReadDeltaCheckpointV6(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		logger := unittest.Logger()
+
+		baseTries := createMultipleRandomTries(t)
+		baseFile := NumberToFilename(1)
+		require.NoError(t, StoreCheckpointV6Concurrently(baseTries, dir, baseFile, logger))
+
+		// the delta checkpoint holds some of the base tries, and the tries updated since then
+		tries := slices.Concat(
+			baseTries[len(baseTries)-2:],
+			updateRandomTries(t, baseTries[len(baseTries)-1], 3),
+			[]*trie.MTrie{trie.NewEmptyMTrie()},
+		)
+		deltaFile := NumberToFilename(2)
+		require.NoError(t, StoreDeltaCheckpointV6(baseTries, baseFile, tries, dir, deltaFile, logger))
+
+		decoded, err := LoadCheckpoint(filepath.Join(dir, deltaFile), logger)
+		require.NoError(t, err)
+		requireTriesEqual(t, tries, decoded)
+
+		// the delta checkpoint only holds the updated nodes
+		baseSize, err := ReadCheckpointFileSize(dir, baseFile)
+		require.NoError(t, err)
+		deltaSize, err := ReadCheckpointFileSize(dir, deltaFile)
+		require.NoError(t, err)
+		require.Less(t, deltaSize*10, baseSize)
+
+		// a delta checkpoint of the delta checkpoint
+		nextTries := slices.Concat(tries[len(tries)-2:len(tries)-1], updateRandomTries(t, tries[len(tries)-2], 2))
+		nextFile := NumberToFilename(3)
+		require.NoError(t, StoreDeltaCheckpointV6(tries, deltaFile, nextTries, dir, nextFile, logger))
+
+		decoded, err = LoadCheckpoint(filepath.Join(dir, nextFile), logger)
+		require.NoError(t, err)
+		requireTriesEqual(t, nextTries, decoded)
+
+		chain, err := CheckpointChain(dir, nextFile)
+		require.NoError(t, err)
+		require.Equal(t, []string{nextFile, deltaFile, baseFile}, chain)
+	})
+}
+
+func TestMergeDeltaCheckpoint(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		logger := unittest.Logger()
+
+		baseTries, lastTrie := createMultipleRandomTriesMini(t)
+		baseFile := NumberToFilename(1)
+		require.NoError(t, StoreCheckpointV6Concurrently(baseTries, dir, baseFile, logger))
+
+		tries := updateRandomTries(t, lastTrie, 3)
+		deltaFile := NumberToFilename(2)
+		require.NoError(t, StoreDeltaCheckpointV6(baseTries, baseFile, tries, dir, deltaFile, logger))
+
+		require.NoError(t, MergeDeltaCheckpoint(dir, deltaFile, logger, 16))
+
+		_, isDelta, err := ReadDeltaCheckpointBase(dir, deltaFile)
+		require.NoError(t, err)
+		require.False(t, isDelta)
+
+		// the merged checkpoint doesn't depend on the base checkpoint anymore
+		require.NoError(t, deleteCheckpointFiles(dir, baseFile))
+		decoded, err := LoadCheckpoint(filepath.Join(dir, deltaFile), logger)
+		require.NoError(t, err)
+		requireTriesEqual(t, tries, decoded)
+
+		// merging a full checkpoint is a no-op
+		require.NoError(t, MergeDeltaCheckpoint(dir, deltaFile, logger, 16))
+
+		// no temporary files are left
+		matched, err := filepath.Glob(filepath.Join(dir, "merging-*"))
+		require.NoError(t, err)
+		require.Empty(t, matched)
+	})
+}
+
+func TestReadDeltaCheckpointV6MissingBase(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		logger := unittest.Logger()
+
+		baseTries, lastTrie := createMultipleRandomTriesMini(t)
+		baseFile := NumberToFilename(1)
+		require.NoError(t, StoreCheckpointV6Concurrently(baseTries, dir, baseFile, logger))
+
+		tries := updateRandomTries(t, lastTrie, 1)
+		deltaFile := NumberToFilename(2)
+		require.NoError(t, StoreDeltaCheckpointV6(baseTries, baseFile, tries, dir, deltaFile, logger))
+
+		require.NoError(t, os.Remove(filepath.Join(dir, baseFile)))
+
+		_, err := LoadCheckpoint(filepath.Join(dir, deltaFile), logger)
+		require.Error(t, err)
+	})
+}
+
+func TestReadDeltaCheckpointV6WrongBase(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		logger := unittest.Logger()
+
+		baseTries, lastTrie := createMultipleRandomTriesMini(t)
+		baseFile := NumberToFilename(1)
+		require.NoError(t, StoreCheckpointV6Concurrently(baseTries, dir, baseFile, logger))
+
+		tries := updateRandomTries(t, lastTrie, 1)
+		deltaFile := NumberToFilename(2)
+		require.NoError(t, StoreDeltaCheckpointV6(baseTries, baseFile, tries, dir, deltaFile, logger))
+
+		// replace the base checkpoint by a checkpoint with other tries
+		otherTries, _ := createMultipleRandomTriesMini(t)
+		require.NoError(t, deleteCheckpointFiles(dir, baseFile))
+		require.NoError(t, StoreCheckpointV6Concurrently(otherTries, dir, baseFile, logger))
+
+		_, err := LoadCheckpoint(filepath.Join(dir, deltaFile), logger)
+		require.Error(t, err)
+	})
+}
+
+// storeDeltaCheckpointChain stores a full checkpoint, a delta checkpoint of the full checkpoint, and
+// a delta checkpoint of the delta checkpoint holding the given number of tries. It returns the file
+// name and the tries of the last delta checkpoint.
+func storeDeltaCheckpointChain(t *testing.T, dir string, trieCount int) (string, []*trie.MTrie) {
+	logger := unittest.Logger()
+
+	baseTries, lastTrie := createMultipleRandomTriesMini(t)
+	baseFile := NumberToFilename(1)
+	require.NoError(t, StoreCheckpointV6Concurrently(baseTries, dir, baseFile, logger))
+
+	deltaTries := updateRandomTries(t, lastTrie, 2)
+	deltaFile := NumberToFilename(2)
+	require.NoError(t, StoreDeltaCheckpointV6(baseTries, baseFile, deltaTries, dir, deltaFile, logger))
+
+	tries := updateRandomTries(t, deltaTries[len(deltaTries)-1], trieCount)
+	fileName := NumberToFilename(3)
+	require.NoError(t, StoreDeltaCheckpointV6(deltaTries, deltaFile, tries, dir, fileName, logger))
+
+	return fileName, tries
+}
+
+func TestOpenAndReadDeltaCheckpointV6(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		fileName, tries := storeDeltaCheckpointChain(t, dir, 3)
+
+		decoded, err := OpenAndReadCheckpointV6(dir, fileName, unittest.Logger())
+		require.NoError(t, err)
+		requireTriesEqual(t, tries, decoded)
+	})
+}
+
+func TestReadDeltaCheckpointRootHash(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		logger := unittest.Logger()
+		fileName, tries := storeDeltaCheckpointChain(t, dir, 3)
+
+		trieRoots, err := ReadTriesRootHash(logger, dir, fileName)
+		require.NoError(t, err)
+		require.Len(t, trieRoots, len(tries))
+		for i, root := range trieRoots {
+			require.Equal(t, tries[i].RootHash(), root)
+			require.NoError(t, CheckpointHasRootHash(logger, dir, fileName, root))
+		}
+
+		nonExist := ledger.RootHash(unittest.StateCommitmentFixture())
+		require.Error(t, CheckpointHasRootHash(logger, dir, fileName, nonExist))
+	})
+}
+
+func TestReadDeltaCheckpointRootHashValidateChain(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		logger := unittest.Logger()
+		fileName, _ := storeDeltaCheckpointChain(t, dir, 1)
+
+		// add a wrong checksum to the delta checkpoint in the middle of the chain
+		deltaPath := filepath.Join(dir, NumberToFilename(2))
+		file, err := os.OpenFile(deltaPath, os.O_RDWR, 0644)
+		require.NoError(t, err)
+		fileInfo, err := file.Stat()
+		require.NoError(t, err)
+		_, err = file.WriteAt(encodeCRC32Sum(10), fileInfo.Size()-crc32SumSize)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		_, err = ReadTriesRootHash(logger, dir, fileName)
+		require.Error(t, err)
+
+		// the full checkpoint at the end of the chain is missing
+		require.NoError(t, deleteCheckpointFiles(dir, NumberToFilename(1)))
+		_, err = ReadTriesRootHash(logger, dir, NumberToFilename(2))
+		require.Error(t, err)
+	})
+}
+
+func TestReadDeltaCheckpointV6Leaf(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		logger := unittest.Logger()
+		fileName, tries := storeDeltaCheckpointChain(t, dir, 1)
+
+		leafNodesCh := make(chan *LeafNode, 5)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- OpenAndReadLeafNodesFromCheckpointV6(leafNodesCh, dir, fileName, tries[0].RootHash(), logger)
+		}()
+
+		payloads := make([]*ledger.Payload, 0)
+		for leafNode := range leafNodesCh {
+			payloads = append(payloads, leafNode.Payload)
+		}
+		require.NoError(t, <-errCh)
+		require.ElementsMatch(t, tries[0].AllPayloads(), payloads)
+	})
+}
+
+func TestReadDeltaCheckpointV6LeafMultipleTriesFail(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		fileName, tries := storeDeltaCheckpointChain(t, dir, 2)
+
+		leafNodesCh := make(chan *LeafNode, 5)
+		require.Error(t, OpenAndReadLeafNodesFromCheckpointV6(leafNodesCh, dir, fileName, tries[0].RootHash(), unittest.Logger()))
+	})
+}
@@ -30,6 +30,7 @@ func nodeToLeaf(leaf *node.Node) *LeafNode {
 // the given checkpoint file specified by dir and fileName.
 // It returns when finish reading the checkpoint file and the input channel can be closed.
 // It requires the checkpoint file only has one trie.
+// The leaf nodes of a delta checkpoint are read from the trie loaded along with its base checkpoints.
 func OpenAndReadLeafNodesFromCheckpointV6(
 	allLeafNodesCh chan<- *LeafNode,
 	dir string,
@@ -47,6 +48,14 @@ func OpenAndReadLeafNodesFromCheckpointV6(
 		return fmt.Errorf("fail to check checkpoint has single root hash: %w", err)
 	}
 
+	_, isDelta, err := ReadDeltaCheckpointBase(dir, fileName)
+	if err != nil {
+		return err
+	}
+	if isDelta {
+		return readDeltaCheckpointLeafNodes(allLeafNodesCh, dir, fileName, logger)
+	}
+
 	filepath := filePathCheckpointHeader(dir, fileName)
 
 	f, err := os.Open(filepath)
@@ -106,3 +115,22 @@ func readCheckpointSubTrieLeafNodes(leafNodesCh chan<- *LeafNode, dir string, fi
 			return nil
 		})
 }
+
+// readDeltaCheckpointLeafNodes pushes the leaf nodes of the single trie of a delta checkpoint to leafNodesCh.
+func readDeltaCheckpointLeafNodes(leafNodesCh chan<- *LeafNode, dir string, fileName string, logger zerolog.Logger) error {
+	tries, err := OpenAndReadCheckpointV6(dir, fileName, logger)
+	if err != nil {
+		return fmt.Errorf("could not read delta checkpoint: %w", err)
+	}
+	if len(tries) != 1 {
+		return fmt.Errorf("expected 1 trie in delta checkpoint, but got %v", len(tries))
+	}
+
+	for itr := flattener.NewNodeIterator(tries[0].RootNode()); itr.Next(); {
+		n := itr.Value()
+		if n.IsLeaf() {
+			leafNodesCh <- nodeToLeaf(n)
+		}
+	}
+	return nil
+}
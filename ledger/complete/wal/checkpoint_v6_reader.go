@@ -20,21 +20,54 @@ import (
 // ErrEOFNotReached for indicating end of file not reached error
 var ErrEOFNotReached = errors.New("expect to reach EOF, but actually didn't")
 
+// ReadTriesRootHash validates the given checkpoint file and returns the root hashes of its tries.
+// A delta checkpoint is validated along with the chain of its base checkpoints, but its root hashes
+// are read from the delta checkpoint file only.
 func ReadTriesRootHash(logger zerolog.Logger, dir string, fileName string) (
 	[]ledger.RootHash,
 	error,
 ) {
-	err := validateCheckpointFile(logger, dir, fileName)
+	chain, err := CheckpointChain(dir, fileName)
 	if err != nil {
 		return nil, err
 	}
+
+	for _, delta := range chain[:len(chain)-1] {
+		err = validateDeltaCheckpointFile(logger, dir, delta)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delta checkpoint %v: %w", delta, err)
+		}
+	}
+	err = validateCheckpointFile(logger, dir, chain[len(chain)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chain) > 1 {
+		return readDeltaTriesRootHash(logger, dir, fileName)
+	}
 	return readTriesRootHash(logger, dir, fileName)
 }
 
 var CheckpointHasRootHash = checkpointHasRootHash
 var CheckpointHasSingleRootHash = checkpointHasSingleRootHash
 
-// readCheckpointV6 reads checkpoint file from a main file and 17 file parts.
+// readCheckpointV6 reads a V6 checkpoint, which is either a full checkpoint or a delta checkpoint.
+// A delta checkpoint is read along with the chain of its base checkpoints, see CheckpointChain.
+func readCheckpointV6(headerFile *os.File, logger zerolog.Logger) ([]*trie.MTrie, error) {
+	dir, fileName := filepath.Split(headerFile.Name())
+	chain, err := CheckpointChain(dir, fileName)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve checkpoint chain: %w", err)
+	}
+
+	if len(chain) > 1 {
+		return readCheckpointChainV6(dir, chain, logger)
+	}
+	return readFullCheckpointV6(headerFile, logger)
+}
+
+// readFullCheckpointV6 reads checkpoint file from a main file and 17 file parts.
 // the main file stores:
 //   - version
 //   - checksum of each part file (17 in total)
@@ -46,7 +79,7 @@ var CheckpointHasSingleRootHash = checkpointHasSingleRootHash
 // it returns (nil, os.ErrNotExist) if a certain file is missing, use (os.IsNotExist to check)
 // it returns (nil, ErrEOFNotReached) if a certain part file is malformed
 // it returns (nil, err) if running into any exception
-func readCheckpointV6(headerFile *os.File, logger zerolog.Logger) ([]*trie.MTrie, error) {
+func readFullCheckpointV6(headerFile *os.File, logger zerolog.Logger) ([]*trie.MTrie, error) {
 	// the full path of header file
 	headerPath := headerFile.Name()
 	dir, fileName := filepath.Split(headerPath)
@@ -97,7 +130,8 @@ func readCheckpointV6(headerFile *os.File, logger zerolog.Logger) ([]*trie.MTrie
 	return tries, nil
 }
 
-// OpenAndReadCheckpointV6 open the checkpoint file and read it with readCheckpointV6
+// OpenAndReadCheckpointV6 open the checkpoint file and read it with readCheckpointV6.
+// A delta checkpoint is read along with the chain of its base checkpoints.
 func OpenAndReadCheckpointV6(dir string, fileName string, logger zerolog.Logger) (
 	triesToReturn []*trie.MTrie,
 	errToReturn error,
@@ -116,9 +150,18 @@ func OpenAndReadCheckpointV6(dir string, fileName string, logger zerolog.Logger)
 	return triesToReturn, errToReturn
 }
 
-// ReadCheckpointFileSize returns the total size of the checkpoint file
+// ReadCheckpointFileSize returns the total size of the checkpoint file.
+// The size of a delta checkpoint doesn't include the size of its base checkpoints.
 func ReadCheckpointFileSize(dir string, fileName string) (uint64, error) {
 	paths := allFilePaths(dir, fileName)
+	_, isDelta, err := ReadDeltaCheckpointBase(dir, fileName)
+	if err != nil {
+		return 0, err
+	}
+	if isDelta {
+		paths = paths[:1]
+	}
+
 	totalSize := uint64(0)
 	for _, path := range paths {
 		fileInfo, err := os.Stat(path)
@@ -144,6 +187,24 @@ func allFilePaths(dir string, fileName string) []string {
 	return paths
 }
 
+// CheckpointFiles returns the names of the files of the given checkpoint, the checkpoint
+// header file is the last one. A delta checkpoint is stored in a single file.
+func CheckpointFiles(dir string, fileName string) ([]string, error) {
+	_, isDelta, err := ReadDeltaCheckpointBase(dir, fileName)
+	if err != nil {
+		return nil, err
+	}
+	if isDelta {
+		return []string{fileName}, nil
+	}
+
+	files := make([]string, 0, subtrieCount+2)
+	for i := 0; i <= subtrieCount; i++ {
+		files = append(files, partFileName(fileName, i))
+	}
+	return append(files, fileName), nil
+}
+
 func filePathCheckpointHeader(dir string, fileName string) string {
 	return path.Join(dir, fileName)
 }
@@ -36,6 +36,7 @@ const (
 	MagicBytesCheckpointSubtrie uint16 = 0x2136
 	MagicBytesCheckpointToptrie uint16 = 0x2135
 	MagicBytesPayloadHeader     uint16 = 0x2138
+	MagicBytesCheckpointDelta   uint16 = 0x2139
 )
 
 const VersionV1 uint16 = 0x01
@@ -684,6 +685,13 @@ func readCheckpoint(f *os.File, logger zerolog.Logger) ([]*trie.MTrie, error) {
 		return nil, fmt.Errorf("cannot seek to start of file: %w", err)
 	}
 
+	if magicBytes == MagicBytesCheckpointDelta {
+		if version != VersionV6 {
+			return nil, fmt.Errorf("unsupported delta checkpoint version %x", version)
+		}
+		return readCheckpointV6(f, logger)
+	}
+
 	if magicBytes != MagicBytesCheckpointHeader {
 		return nil, fmt.Errorf("unknown file format. Magic constant %x does not match expected %x", magicBytes, MagicBytesCheckpointHeader)
 	}
@@ -0,0 +1,49 @@
+package access
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// AccountStateDiff describes how the state of an account changed between two sealed block heights.
+// The state at the start height is compared with the state at the end height, so changes which were
+// reverted within the range are not included.
+type AccountStateDiff struct {
+	Address     flow.Address
+	StartHeight uint64
+	EndHeight   uint64
+	// ExistedBefore and ExistsAfter report whether the account existed at the start and end heights.
+	ExistedBefore bool
+	ExistsAfter   bool
+	// BalanceBefore and BalanceAfter are the balances of the account, in UFix64 units (1e-8 FLOW).
+	BalanceBefore uint64
+	BalanceAfter  uint64
+	// StorageUsedBefore and StorageUsedAfter are the storage used by the account, in bytes.
+	StorageUsedBefore uint64
+	StorageUsedAfter  uint64
+	// KeysAdded are the keys added to the account within the range.
+	KeysAdded []flow.AccountPublicKey
+	// KeysRevoked are the keys which were revoked within the range, including keys which were added
+	// and revoked within the range.
+	KeysRevoked []flow.AccountPublicKey
+	// ContractsAdded, ContractsUpdated and ContractsRemoved are the names of the contracts deployed,
+	// updated and removed within the range, sorted by name.
+	ContractsAdded   []string
+	ContractsUpdated []string
+	ContractsRemoved []string
+	// ChangedRegisters are the IDs of the registers owned by the account which have a different value
+	// at the end height, including the registers created or removed within the range, sorted by ID.
+	ChangedRegisters []flow.RegisterID
+	// Events are the account status core events emitted for the account within the range, excluding
+	// the start height, in execution order.
+	Events []flow.Event
+}
+
+// BalanceDelta returns the change of the balance within the range.
+func (d *AccountStateDiff) BalanceDelta() int64 {
+	return int64(d.BalanceAfter) - int64(d.BalanceBefore)
+}
+
+// StorageUsedDelta returns the change of the storage used within the range.
+func (d *AccountStateDiff) StorageUsedDelta() int64 {
+	return int64(d.StorageUsedAfter) - int64(d.StorageUsedBefore)
+}
@@ -0,0 +1,20 @@
+package access
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// AccountTransactionCursor identifies a position within the account transaction index.
+// It is used to request the next page of results for an account, starting with the referenced entry.
+type AccountTransactionCursor struct {
+	BlockHeight      uint64
+	TransactionIndex uint32
+}
+
+// AccountTransactionsPage represents a single page of transactions which touched an account,
+// ordered by descending block height and transaction index.
+type AccountTransactionsPage struct {
+	Transactions []flow.AccountTransaction
+	// NextCursor references the first entry of the next page, or is nil if there are no more entries.
+	NextCursor *AccountTransactionCursor
+}
@@ -0,0 +1,16 @@
+package access
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ContractVersion is a version of a contract deployed to an account.
+type ContractVersion struct {
+	Address flow.Address
+	Name    string
+	// Code is the code of the contract, or nil if the contract was removed.
+	Code []byte
+	// Update is the deployment, update or removal which produced this version. It is nil if the version
+	// was deployed before the lowest height indexed by the node.
+	Update *flow.ContractUpdate
+}
@@ -0,0 +1,21 @@
+package access
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// EventCursor identifies the position of an event within a range of blocks.
+// It is used to request the next page of results of an event query, starting with the referenced event.
+type EventCursor struct {
+	BlockHeight      uint64
+	TransactionIndex uint32
+	EventIndex       uint32
+}
+
+// EventsPage represents a single page of events matching an event query, grouped by block and ordered
+// by ascending block height, transaction index and event index. Blocks without matching events are omitted.
+type EventsPage struct {
+	BlockEvents []flow.BlockEvents
+	// NextCursor references the first event of the next page, or is nil if there are no more events.
+	NextCursor *EventCursor
+}
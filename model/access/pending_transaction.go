@@ -0,0 +1,104 @@
+package access
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// PendingTransactionReason describes why a transaction which was submitted to the network has not
+// been included in a finalized block yet, as reported by the collection nodes of its cluster.
+type PendingTransactionReason uint32
+
+const (
+	// PendingReasonUnknown indicates the collection node does not know the transaction. It was either
+	// never received, or was already included in a collection or removed from the mempool.
+	PendingReasonUnknown PendingTransactionReason = iota
+	// PendingReasonInMempool indicates the transaction is waiting in the collection node's mempool
+	// to be included in a collection.
+	PendingReasonInMempool
+	// PendingReasonRateLimited indicates the transaction was rejected, because its payer is rate limited
+	// by the collection node.
+	PendingReasonRateLimited
+	// PendingReasonRejected indicates the transaction was rejected by the collection node, because it
+	// failed validation.
+	PendingReasonRejected
+	// PendingReasonUnreachable indicates the collection node could not be queried.
+	PendingReasonUnreachable
+	// PendingReasonExpired indicates the transaction expired before it was included in a collection.
+	// It was either rejected by the collection node, or dropped from its mempool.
+	PendingReasonExpired
+)
+
+// String returns the string representation of the reason.
+func (r PendingTransactionReason) String() string {
+	switch r {
+	case PendingReasonUnknown:
+		return "UNKNOWN"
+	case PendingReasonInMempool:
+		return "IN_MEMPOOL"
+	case PendingReasonRateLimited:
+		return "RATE_LIMITED"
+	case PendingReasonRejected:
+		return "REJECTED"
+	case PendingReasonUnreachable:
+		return "UNREACHABLE"
+	case PendingReasonExpired:
+		return "EXPIRED"
+	default:
+		return "INVALID"
+	}
+}
+
+// CollectionNodeTransactionStatus is the status of a pending transaction reported by a single
+// collection node.
+type CollectionNodeTransactionStatus struct {
+	NodeID  flow.Identifier
+	Reason  PendingTransactionReason
+	Message string
+}
+
+// PendingTransactionDetails aggregates the status of a pending transaction reported by the
+// collection nodes of its cluster.
+type PendingTransactionDetails struct {
+	// Reason is the most informative reason reported by any of the collection nodes.
+	Reason PendingTransactionReason
+	// Message is the message reported along with Reason.
+	Message string
+	// Nodes holds the status reported by each of the queried collection nodes.
+	Nodes []CollectionNodeTransactionStatus
+}
+
+// NewPendingTransactionDetails aggregates the status reported by each of the collection nodes.
+// A transaction in the mempool of any node takes precedence over a rejection or an expiry, which
+// take precedence over an unknown transaction.
+func NewPendingTransactionDetails(nodes []CollectionNodeTransactionStatus) *PendingTransactionDetails {
+	details := &PendingTransactionDetails{
+		Reason: PendingReasonUnreachable,
+		Nodes:  nodes,
+	}
+	for _, node := range nodes {
+		if pendingReasonPrecedence(node.Reason) > pendingReasonPrecedence(details.Reason) {
+			details.Reason = node.Reason
+			details.Message = node.Message
+		}
+	}
+	return details
+}
+
+// pendingReasonPrecedence returns the precedence of the reason when aggregating the status of
+// multiple collection nodes.
+func pendingReasonPrecedence(r PendingTransactionReason) int {
+	switch r {
+	case PendingReasonInMempool:
+		return 5
+	case PendingReasonExpired:
+		return 4
+	case PendingReasonRateLimited:
+		return 3
+	case PendingReasonRejected:
+		return 2
+	case PendingReasonUnknown:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,17 @@
+package access
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// RegisterProofs is a batch proof of registers against the state commitment of an executed block.
+type RegisterProofs struct {
+	// BlockID is the ID of the block the state commitment is the final state of. It is flow.ZeroID
+	// when the proofs were requested for a state commitment.
+	BlockID flow.Identifier
+	// StateCommitment is the state commitment the registers are proven against.
+	StateCommitment flow.StateCommitment
+	// Proof is the encoded batch proof of the registers, which can be verified with the
+	// ledger/common/registerproof package.
+	Proof []byte
+}
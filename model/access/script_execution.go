@@ -0,0 +1,23 @@
+package access
+
+// ScriptExecutionReport contains the resources used while executing a script.
+type ScriptExecutionReport struct {
+	ComputationUsed uint64
+	MemoryEstimate  uint64
+	// RegisterReads is the number of distinct registers read by the script.
+	RegisterReads uint64
+	// BytesRead is the total size of the registers read by the script.
+	BytesRead uint64
+	// ContractsLoaded are the location IDs of the contracts loaded by the script (e.g. A.1654653399040a61.FlowToken).
+	ContractsLoaded []string
+	// ExecutedLocally is true if the script was executed using the node's local execution state.
+	// Execution nodes only report the computation used, so all other resources are empty when
+	// the script was executed on an execution node.
+	ExecutedLocally bool
+}
+
+// ScriptExecutionResult is the encoded value returned by a script along with the resources used to execute it.
+type ScriptExecutionResult struct {
+	Value  []byte
+	Report ScriptExecutionReport
+}
@@ -0,0 +1,31 @@
+package access
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// TransactionDryRunOptions configures the checks performed when dry-running a transaction.
+// By default, the transaction is executed without verifying its signatures or proposal key
+// sequence number, so unsigned transactions can be dry-run.
+type TransactionDryRunOptions struct {
+	// VerifySignatures enables verification of the transaction signatures and of the key
+	// weights of the proposer, payer and authorizers.
+	VerifySignatures bool
+	// CheckSequenceNumber enables the check of the proposal key sequence number.
+	CheckSequenceNumber bool
+}
+
+// TransactionDryRunResult is the result of executing a transaction against a block without
+// submitting it to the network.
+type TransactionDryRunResult struct {
+	BlockID     flow.Identifier
+	BlockHeight uint64
+	// StatusCode is 0 if the transaction succeeded and 1 if it failed.
+	StatusCode      uint
+	ErrorMessage    string
+	ComputationUsed uint64
+	MemoryEstimate  uint64
+	// Fees is the amount that would be charged to the payer, in UFix64 units (1e-8 FLOW).
+	Fees   uint64
+	Events []flow.Event
+}
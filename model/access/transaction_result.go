@@ -14,6 +14,9 @@ type TransactionResult struct {
 	TransactionID flow.Identifier
 	CollectionID  flow.Identifier
 	BlockHeight   uint64
+	// PendingDetails holds the status reported by the collection nodes for a pending transaction.
+	// It is nil unless the transaction is pending and querying collection nodes is enabled.
+	PendingDetails *PendingTransactionDetails
 }
 
 func (r *TransactionResult) IsExecuted() bool {
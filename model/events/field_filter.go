@@ -0,0 +1,214 @@
+package events
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/encoding/ccf"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// MaxFieldFilters is the maximum number of field filters that can be applied to a single query.
+const MaxFieldFilters = 20
+
+// FieldOperator is the comparison operator of a FieldFilter.
+type FieldOperator string
+
+const (
+	FieldOperatorEqual              FieldOperator = "eq"
+	FieldOperatorNotEqual           FieldOperator = "ne"
+	FieldOperatorLessThan           FieldOperator = "lt"
+	FieldOperatorLessThanOrEqual    FieldOperator = "lte"
+	FieldOperatorGreaterThan        FieldOperator = "gt"
+	FieldOperatorGreaterThanOrEqual FieldOperator = "gte"
+)
+
+// IsRange returns true if the operator compares the order of values, which is only defined for numbers.
+func (o FieldOperator) IsRange() bool {
+	switch o {
+	case FieldOperatorLessThan, FieldOperatorLessThanOrEqual, FieldOperatorGreaterThan, FieldOperatorGreaterThanOrEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+func (o FieldOperator) valid() bool {
+	return o == FieldOperatorEqual || o == FieldOperatorNotEqual || o.IsRange()
+}
+
+// FieldFilter matches events by the value of a field of their decoded payload.
+//
+// Equality operators compare the string representation of the field value. String fields are compared
+// without quotes, address fields are compared with and without the 0x prefix, and number fields are
+// compared numerically. Range operators are only defined for number fields, and events whose field is
+// not a number do not match them.
+type FieldFilter struct {
+	Field    string
+	Operator FieldOperator
+	Value    string
+
+	// number is the parsed value of numeric filters, nil if the value is not a number
+	number *big.Rat
+}
+
+// NewFieldFilter returns a new validated field filter.
+// Expected errors during normal operations:
+//   - error if the field is empty, the operator is unknown, or a range operator is used with a non numeric value.
+func NewFieldFilter(field string, operator FieldOperator, value string) (FieldFilter, error) {
+	if field == "" {
+		return FieldFilter{}, fmt.Errorf("field name must not be empty")
+	}
+	if !operator.valid() {
+		return FieldFilter{}, fmt.Errorf("invalid operator %q: must be one of eq, ne, lt, lte, gt, gte", operator)
+	}
+
+	filter := FieldFilter{
+		Field:    field,
+		Operator: operator,
+		Value:    value,
+	}
+	if number, ok := new(big.Rat).SetString(value); ok {
+		filter.number = number
+	}
+	if operator.IsRange() && filter.number == nil {
+		return FieldFilter{}, fmt.Errorf("invalid value %q for operator %s: must be a number", value, operator)
+	}
+
+	return filter, nil
+}
+
+// ParseFieldFilter parses a field filter in the format "field:operator:value". The value may contain colons.
+// Expected errors during normal operations:
+//   - error if the filter is malformed or invalid.
+func ParseFieldFilter(raw string) (FieldFilter, error) {
+	parts := strings.SplitN(raw, ":", 3)
+	if len(parts) != 3 {
+		return FieldFilter{}, fmt.Errorf("invalid field filter %q: must be in the format field:operator:value", raw)
+	}
+
+	filter, err := NewFieldFilter(parts[0], FieldOperator(parts[1]), parts[2])
+	if err != nil {
+		return FieldFilter{}, fmt.Errorf("invalid field filter %q: %w", raw, err)
+	}
+	return filter, nil
+}
+
+// String returns the filter in the format accepted by ParseFieldFilter.
+func (f FieldFilter) String() string {
+	return fmt.Sprintf("%s:%s:%s", f.Field, f.Operator, f.Value)
+}
+
+// FieldFilters is a list of field filters. An event matches the list if it matches all of its filters.
+type FieldFilters []FieldFilter
+
+// ParseFieldFilters parses a list of field filters in the format "field:operator:value".
+// Expected errors during normal operations:
+//   - error if there are more than MaxFieldFilters filters, or any of the filters is malformed or invalid.
+func ParseFieldFilters(raw []string) (FieldFilters, error) {
+	if len(raw) > MaxFieldFilters {
+		return nil, fmt.Errorf("too many field filters (%d). use %d or fewer", len(raw), MaxFieldFilters)
+	}
+
+	filters := make(FieldFilters, len(raw))
+	for i, r := range raw {
+		filter, err := ParseFieldFilter(r)
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = filter
+	}
+	return filters, nil
+}
+
+// Match decodes the CCF encoded payload of the event, and returns true if its fields match all filters.
+// Events without one of the filtered fields do not match. An empty list of filters matches all events
+// without decoding them.
+// Expected errors during normal operations:
+//   - error if the payload of the event cannot be decoded as a CCF encoded event.
+func (f FieldFilters) Match(event flow.Event) (bool, error) {
+	if len(f) == 0 {
+		return true, nil
+	}
+
+	data, err := ccf.Decode(nil, event.Payload)
+	if err != nil {
+		return false, fmt.Errorf("could not decode payload of event %s: %w", event.Type, err)
+	}
+
+	cdcEvent, ok := data.(cadence.Event)
+	if !ok {
+		return false, fmt.Errorf("payload of event %s is not an event: %T", event.Type, data)
+	}
+
+	return f.MatchFields(cadence.FieldsMappedByName(cdcEvent)), nil
+}
+
+// MatchFields returns true if the given decoded event fields match all filters.
+func (f FieldFilters) MatchFields(fields map[string]cadence.Value) bool {
+	for _, filter := range f {
+		value, ok := fields[filter.Field]
+		if !ok || !filter.matchValue(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchValue returns true if the given field value matches the filter.
+func (f FieldFilter) matchValue(value cadence.Value) bool {
+	if optional, ok := value.(cadence.Optional); ok && optional.Value != nil {
+		value = optional.Value
+	}
+
+	if number, ok := value.(cadence.NumberValue); ok {
+		fieldNumber, ok := new(big.Rat).SetString(number.String())
+		if !ok || f.number == nil {
+			// only numbers are equal to numbers
+			return f.Operator == FieldOperatorNotEqual
+		}
+		return f.compare(fieldNumber.Cmp(f.number))
+	}
+
+	if f.Operator.IsRange() {
+		return false
+	}
+
+	var equal bool
+	switch v := value.(type) {
+	case cadence.String:
+		equal = string(v) == f.Value
+	case cadence.Address:
+		equal = flow.Address(v) == flow.HexToAddress(f.Value)
+	default:
+		equal = value.String() == f.Value
+	}
+
+	if f.Operator == FieldOperatorEqual {
+		return equal
+	}
+	return !equal
+}
+
+// compare returns true if the result of comparing the field value with the filter value satisfies the operator.
+func (f FieldFilter) compare(cmp int) bool {
+	switch f.Operator {
+	case FieldOperatorEqual:
+		return cmp == 0
+	case FieldOperatorNotEqual:
+		return cmp != 0
+	case FieldOperatorLessThan:
+		return cmp < 0
+	case FieldOperatorLessThanOrEqual:
+		return cmp <= 0
+	case FieldOperatorGreaterThan:
+		return cmp > 0
+	case FieldOperatorGreaterThanOrEqual:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,145 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/encoding/ccf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/events"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// transferEvent returns a CCF encoded event with the given field values.
+func transferEvent(t *testing.T, amount string, to flow.Address, memo string, id *int) flow.Event {
+	location := common.NewAddressLocation(nil, common.Address{0x1}, "Token")
+
+	ufix, err := cadence.NewUFix64(amount)
+	require.NoError(t, err)
+
+	var optionalID cadence.Optional
+	if id != nil {
+		optionalID = cadence.NewOptional(cadence.NewInt(*id))
+	} else {
+		optionalID = cadence.NewOptional(nil)
+	}
+
+	cdcEvent := cadence.NewEvent([]cadence.Value{
+		ufix,
+		cadence.NewAddress(to),
+		cadence.String(memo),
+		optionalID,
+	}).WithType(cadence.NewEventType(
+		location,
+		"Token.Transfer",
+		[]cadence.Field{
+			{Identifier: "amount", Type: cadence.UFix64Type},
+			{Identifier: "to", Type: cadence.AddressType},
+			{Identifier: "memo", Type: cadence.StringType},
+			{Identifier: "id", Type: cadence.NewOptionalType(cadence.IntType)},
+		},
+		nil,
+	))
+
+	payload, err := ccf.Encode(cdcEvent)
+	require.NoError(t, err)
+
+	event := unittest.EventFixture(flow.EventType(location.TypeID(nil, "Token.Transfer")), 0, 0, unittest.IdentifierFixture(), 0)
+	event.Payload = payload
+	return event
+}
+
+func TestParseFieldFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		filter, err := events.ParseFieldFilter("memo:eq:a:b")
+		require.NoError(t, err)
+		assert.Equal(t, "memo", filter.Field)
+		assert.Equal(t, events.FieldOperatorEqual, filter.Operator)
+		assert.Equal(t, "a:b", filter.Value)
+		assert.Equal(t, "memo:eq:a:b", filter.String())
+
+		filter, err = events.ParseFieldFilter("amount:lte:-1.5")
+		require.NoError(t, err)
+		assert.True(t, filter.Operator.IsRange())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, raw := range []string{"", "amount", "amount:gt", ":eq:1", "amount:between:1", "amount:gt:abc"} {
+			_, err := events.ParseFieldFilter(raw)
+			assert.Error(t, err, "filter: %s", raw)
+		}
+	})
+
+	t.Run("too many filters", func(t *testing.T) {
+		raw := make([]string, events.MaxFieldFilters+1)
+		for i := range raw {
+			raw[i] = "amount:gt:1"
+		}
+		_, err := events.ParseFieldFilters(raw)
+		assert.Error(t, err)
+	})
+}
+
+func TestFieldFilters_Match(t *testing.T) {
+	t.Parallel()
+
+	to := flow.HexToAddress("0x0000000000000002")
+	id := 7
+	event := transferEvent(t, "10.5", to, "hello:world", &id)
+	noID := transferEvent(t, "10.5", to, "hello:world", nil)
+
+	tests := []struct {
+		filters []string
+		event   flow.Event
+		match   bool
+	}{
+		{nil, event, true},
+		{[]string{"amount:eq:10.5"}, event, true},
+		{[]string{"amount:eq:10.50000000"}, event, true},
+		{[]string{"amount:ne:10.5"}, event, false},
+		{[]string{"amount:gt:10"}, event, true},
+		{[]string{"amount:gte:10.5"}, event, true},
+		{[]string{"amount:lt:10.5"}, event, false},
+		{[]string{"amount:lte:11"}, event, true},
+		{[]string{"amount:eq:abc"}, event, false},
+		{[]string{"amount:ne:abc"}, event, true},
+		{[]string{"to:eq:0x0000000000000002"}, event, true},
+		{[]string{"to:eq:0000000000000002"}, event, true},
+		{[]string{"to:ne:0x0000000000000002"}, event, false},
+		{[]string{"to:gt:1"}, event, false},
+		{[]string{"memo:eq:hello:world"}, event, true},
+		{[]string{"memo:eq:\"hello:world\""}, event, false},
+		{[]string{"id:eq:7"}, event, true},
+		{[]string{"id:gt:6"}, event, true},
+		{[]string{"id:eq:nil"}, noID, true},
+		{[]string{"id:gt:6"}, noID, false},
+		{[]string{"missing:eq:1"}, event, false},
+		{[]string{"amount:gt:10", "memo:eq:hello:world"}, event, true},
+		{[]string{"amount:gt:10", "memo:eq:other"}, event, false},
+	}
+
+	for _, test := range tests {
+		filters, err := events.ParseFieldFilters(test.filters)
+		require.NoError(t, err)
+
+		matched, err := filters.Match(test.event)
+		require.NoError(t, err)
+		assert.Equal(t, test.match, matched, "filters: %v", test.filters)
+	}
+
+	t.Run("invalid payload", func(t *testing.T) {
+		filters, err := events.ParseFieldFilters([]string{"amount:eq:1"})
+		require.NoError(t, err)
+
+		invalid := event
+		invalid.Payload = []byte("invalid")
+		_, err = filters.Match(invalid)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,61 @@
+package flow
+
+import (
+	"fmt"
+)
+
+// TransactionRole describes how an account participated in a transaction.
+type TransactionRole uint8
+
+const (
+	// TransactionRoleAuthorizer is used for accounts which authorized the transaction.
+	TransactionRoleAuthorizer TransactionRole = iota + 1
+	// TransactionRolePayer is used for the account which paid the fees for the transaction.
+	TransactionRolePayer
+	// TransactionRoleProposer is used for the account which provided the proposal key of the transaction.
+	TransactionRoleProposer
+	// TransactionRoleEventEmitter is used for accounts whose contracts emitted events during the
+	// execution of the transaction.
+	TransactionRoleEventEmitter
+)
+
+// String returns the string representation of the transaction role.
+func (r TransactionRole) String() string {
+	switch r {
+	case TransactionRoleAuthorizer:
+		return "authorizer"
+	case TransactionRolePayer:
+		return "payer"
+	case TransactionRoleProposer:
+		return "proposer"
+	case TransactionRoleEventEmitter:
+		return "event_emitter"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(r))
+	}
+}
+
+// AccountTransaction is an entry of the account transaction index. It references a transaction
+// that touched the given account and records the roles the account had in the transaction.
+type AccountTransaction struct {
+	// Address is the account the transaction is indexed for.
+	Address Address
+	// BlockHeight is the height of the block which contains the transaction.
+	BlockHeight uint64
+	// TransactionID is the ID of the transaction.
+	TransactionID Identifier
+	// TransactionIndex is the index of the transaction within its block.
+	TransactionIndex uint32
+	// Roles lists all roles the account had in the transaction, without duplicates.
+	Roles []TransactionRole
+}
+
+// HasRole returns true if the account had the given role in the transaction.
+func (a *AccountTransaction) HasRole(role TransactionRole) bool {
+	for _, r := range a.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package flow
+
+import (
+	"fmt"
+)
+
+// ContractUpdateType describes how a contract was changed.
+type ContractUpdateType uint8
+
+const (
+	// ContractUpdateTypeAdded is used for contracts which were deployed to an account.
+	ContractUpdateTypeAdded ContractUpdateType = iota + 1
+	// ContractUpdateTypeUpdated is used for contracts whose code was updated.
+	ContractUpdateTypeUpdated
+	// ContractUpdateTypeRemoved is used for contracts which were removed from an account.
+	ContractUpdateTypeRemoved
+)
+
+// String returns the string representation of the contract update type.
+func (t ContractUpdateType) String() string {
+	switch t {
+	case ContractUpdateTypeAdded:
+		return "added"
+	case ContractUpdateTypeUpdated:
+		return "updated"
+	case ContractUpdateTypeRemoved:
+		return "removed"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// ContractUpdate is an entry of the contract update index. It records a deployment, update or removal
+// of a contract, as reported by the AccountContractAdded, AccountContractUpdated and AccountContractRemoved
+// core events.
+type ContractUpdate struct {
+	// Address is the account the contract is deployed to.
+	Address Address
+	// ContractName is the name of the contract.
+	ContractName string
+	// Type is the kind of change.
+	Type ContractUpdateType
+	// BlockHeight is the height of the block which contains the transaction that changed the contract.
+	BlockHeight uint64
+	// TransactionID is the ID of the transaction that changed the contract.
+	TransactionID Identifier
+	// TransactionIndex is the index of the transaction within its block.
+	TransactionIndex uint32
+	// EventIndex is the index of the core event within the transaction.
+	EventIndex uint32
+	// CodeHash is the SHA3-256 hash of the contract code after the change, or of the removed code.
+	CodeHash []byte
+}
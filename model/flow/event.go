@@ -13,6 +13,10 @@ import (
 const (
 	EventAccountCreated EventType = "flow.AccountCreated"
 	EventAccountUpdated EventType = "flow.AccountUpdated"
+
+	EventAccountContractAdded   EventType = "flow.AccountContractAdded"
+	EventAccountContractUpdated EventType = "flow.AccountContractUpdated"
+	EventAccountContractRemoved EventType = "flow.AccountContractRemoved"
 )
 
 type EventType string
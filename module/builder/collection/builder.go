@@ -9,6 +9,7 @@ import (
 	"github.com/dgraph-io/badger/v2"
 	"github.com/rs/zerolog"
 
+	"github.com/onflow/flow-go/model/access"
 	"github.com/onflow/flow-go/model/cluster"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module"
@@ -360,6 +361,7 @@ func (b *Builder) populateFinalizedAncestryLookup(ctx *blockBuildContext) error
 }
 
 // buildPayload constructs a valid payload based on transactions available in the mempool.
+// Transactions are considered in the order they are returned by the mempool.
 // If the mempool is empty, an empty payload will be returned.
 // No errors are expected during normal operation.
 func (b *Builder) buildPayload(buildCtx *blockBuildContext) (*cluster.Payload, error) {
@@ -436,6 +438,11 @@ func (b *Builder) buildPayload(buildCtx *blockBuildContext) (*cluster.Payload, e
 		if refHeader.Height < buildCtx.lowestPossibleReferenceBlockHeight() {
 			// the transaction is expired, it will never be valid
 			b.transactions.Remove(txID)
+			if b.config.RejectedTransactions != nil {
+				b.config.RejectedTransactions.Add(txID, access.PendingReasonExpired, fmt.Sprintf(
+					"transaction expired: reference block height %d is below the lowest possible reference block height %d",
+					refHeader.Height, buildCtx.lowestPossibleReferenceBlockHeight()))
+			}
 			continue
 		}
 
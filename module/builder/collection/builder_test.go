@@ -14,11 +14,13 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	hotstuffmodel "github.com/onflow/flow-go/consensus/hotstuff/model"
+	"github.com/onflow/flow-go/model/access"
 	model "github.com/onflow/flow-go/model/cluster"
 	"github.com/onflow/flow-go/model/flow"
 	builder "github.com/onflow/flow-go/module/builder/collection"
 	"github.com/onflow/flow-go/module/mempool"
 	"github.com/onflow/flow-go/module/mempool/herocache"
+	"github.com/onflow/flow-go/module/mempool/stdmap"
 	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/module/trace"
 	"github.com/onflow/flow-go/state/cluster"
@@ -705,7 +707,10 @@ func (suite *BuilderSuite) TestBuildOn_ExpiredTransaction() {
 
 	// reset the pool and builder
 	suite.pool = herocache.NewTransactions(10, unittest.Logger(), metrics.NewNoopCollector())
-	suite.builder, _ = builder.NewBuilder(suite.db, trace.NewNoopTracer(), suite.protoState, suite.state, suite.headers, suite.headers, suite.payloads, suite.pool, unittest.Logger(), suite.epochCounter)
+	rejected, err := stdmap.NewRejectedTransactions(10)
+	suite.Require().NoError(err)
+	suite.builder, _ = builder.NewBuilder(suite.db, trace.NewNoopTracer(), suite.protoState, suite.state, suite.headers, suite.headers, suite.payloads, suite.pool, unittest.Logger(), suite.epochCounter,
+		builder.WithRejectedTransactions(rejected))
 
 	// insert a transaction referring genesis (now expired)
 	tx1 := unittest.TransactionBodyFixture(func(tx *flow.TransactionBody) {
@@ -741,6 +746,12 @@ func (suite *BuilderSuite) TestBuildOn_ExpiredTransaction() {
 	suite.Assert().True(collectionContains(builtCollection, tx2.ID()))
 	// the expired transaction should have been removed from the mempool
 	suite.Assert().False(suite.pool.Has(tx1.ID()))
+	// and recorded as expired
+	reason, _, ok := rejected.ByID(tx1.ID())
+	suite.Assert().True(ok)
+	suite.Assert().Equal(access.PendingReasonExpired, reason)
+	_, _, ok = rejected.ByID(tx2.ID())
+	suite.Assert().False(ok)
 }
 
 func (suite *BuilderSuite) TestBuildOn_EmptyMempool() {
@@ -970,6 +981,51 @@ func (suite *BuilderSuite) TestBuildOn_UnlimitedPayer() {
 	}
 }
 
+// TestBuildOn_RoundRobinOrdering tests that the builder includes transactions in the order of
+// the mempool, so a payer with many pending transactions does not crowd out other payers when
+// the mempool uses round-robin ordering.
+func (suite *BuilderSuite) TestBuildOn_RoundRobinOrdering() {
+	suite.pool = stdmap.NewOrderedTransactions(1000, 0, stdmap.RoundRobinOrdering{}, unittest.Logger())
+
+	// create builder with no rate limit and max 10 tx/collection
+	suite.builder, _ = builder.NewBuilder(suite.db, trace.NewNoopTracer(), suite.protoState, suite.state, suite.headers, suite.headers, suite.payloads, suite.pool, unittest.Logger(), suite.epochCounter,
+		builder.WithMaxCollectionSize(10),
+		builder.WithMaxPayerTransactionRate(0),
+	)
+
+	newPayerTx := func(payer flow.Address) func() *flow.TransactionBody {
+		return func() *flow.TransactionBody {
+			tx := unittest.TransactionBodyFixture()
+			tx.ReferenceBlockID = suite.ProtoStateRoot().ID()
+			tx.Payer = payer
+			return &tx
+		}
+	}
+
+	// fill the pool with 100 transactions from a single payer, followed by 5 transactions from other payers
+	bot := unittest.RandomAddressFixture()
+	suite.FillPool(100, newPayerTx(bot))
+	others := make(map[flow.Identifier]struct{})
+	for i := 0; i < 5; i++ {
+		tx := newPayerTx(unittest.RandomAddressFixture())()
+		suite.Require().True(suite.pool.Add(tx))
+		others[tx.ID()] = struct{}{}
+	}
+
+	header, err := suite.builder.BuildOn(suite.genesis.ID(), noopSetter, noopSigner)
+	suite.Require().NoError(err)
+
+	// the first collection should include the transactions of all other payers
+	var built model.Block
+	err = suite.db.View(procedure.RetrieveClusterBlock(header.ID(), &built))
+	suite.Require().NoError(err)
+	suite.Assert().Len(built.Payload.Collection.Transactions, 10)
+	for _, tx := range built.Payload.Collection.Transactions {
+		delete(others, tx.ID())
+	}
+	suite.Assert().Empty(others)
+}
+
 // TestBuildOn_RateLimitDryRun tests that rate limiting rules aren't enforced
 // if dry-run is enabled.
 func (suite *BuilderSuite) TestBuildOn_RateLimitDryRun() {
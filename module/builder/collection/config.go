@@ -2,6 +2,7 @@ package collection
 
 import (
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/mempool"
 )
 
 const (
@@ -44,6 +45,10 @@ type Config struct {
 
 	// MaxCollectionTotalGas is the maximum of total of gas per collection (sum of maxGasLimit over transactions)
 	MaxCollectionTotalGas uint64
+
+	// RejectedTransactions records the transactions dropped from the mempool because they expired,
+	// so their status can be reported. Nil if dropped transactions are not recorded.
+	RejectedTransactions mempool.RejectedTransactions
 }
 
 func DefaultConfig() Config {
@@ -108,3 +113,9 @@ func WithMaxCollectionTotalGas(limit uint64) Opt {
 		c.MaxCollectionTotalGas = limit
 	}
 }
+
+func WithRejectedTransactions(rejected mempool.RejectedTransactions) Opt {
+	return func(c *Config) {
+		c.RejectedTransactions = rejected
+	}
+}
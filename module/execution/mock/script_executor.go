@@ -8,6 +8,8 @@ import (
 	flow "github.com/onflow/flow-go/model/flow"
 
 	mock "github.com/stretchr/testify/mock"
+
+	query "github.com/onflow/flow-go/engine/execution/computation/query"
 )
 
 // ScriptExecutor is an autogenerated mock type for the ScriptExecutor type
@@ -15,6 +17,36 @@ type ScriptExecutor struct {
 	mock.Mock
 }
 
+// DryRunTransaction provides a mock function with given fields: ctx, tx, options, height
+func (_m *ScriptExecutor) DryRunTransaction(ctx context.Context, tx *flow.TransactionBody, options query.TransactionDryRunOptions, height uint64) (*query.TransactionDryRunResult, error) {
+	ret := _m.Called(ctx, tx, options, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DryRunTransaction")
+	}
+
+	var r0 *query.TransactionDryRunResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, query.TransactionDryRunOptions, uint64) (*query.TransactionDryRunResult, error)); ok {
+		return rf(ctx, tx, options, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, query.TransactionDryRunOptions, uint64) *query.TransactionDryRunResult); ok {
+		r0 = rf(ctx, tx, options, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*query.TransactionDryRunResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *flow.TransactionBody, query.TransactionDryRunOptions, uint64) error); ok {
+		r1 = rf(ctx, tx, options, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExecuteAtBlockHeight provides a mock function with given fields: ctx, script, arguments, height
 func (_m *ScriptExecutor) ExecuteAtBlockHeight(ctx context.Context, script []byte, arguments [][]byte, height uint64) ([]byte, error) {
 	ret := _m.Called(ctx, script, arguments, height)
@@ -45,6 +77,45 @@ func (_m *ScriptExecutor) ExecuteAtBlockHeight(ctx context.Context, script []byt
 	return r0, r1
 }
 
+// ExecuteAtBlockHeightWithReport provides a mock function with given fields: ctx, script, arguments, height
+func (_m *ScriptExecutor) ExecuteAtBlockHeightWithReport(ctx context.Context, script []byte, arguments [][]byte, height uint64) ([]byte, *query.ScriptExecutionReport, error) {
+	ret := _m.Called(ctx, script, arguments, height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteAtBlockHeightWithReport")
+	}
+
+	var r0 []byte
+	var r1 *query.ScriptExecutionReport
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, uint64) ([]byte, *query.ScriptExecutionReport, error)); ok {
+		return rf(ctx, script, arguments, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, uint64) []byte); ok {
+		r0 = rf(ctx, script, arguments, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, [][]byte, uint64) *query.ScriptExecutionReport); ok {
+		r1 = rf(ctx, script, arguments, height)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*query.ScriptExecutionReport)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, [][]byte, uint64) error); ok {
+		r2 = rf(ctx, script, arguments, height)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetAccountAtBlockHeight provides a mock function with given fields: ctx, address, height
 func (_m *ScriptExecutor) GetAccountAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (*flow.Account, error) {
 	ret := _m.Called(ctx, address, height)
@@ -58,6 +58,25 @@ func (r *RegistersAsyncStore) RegisterValues(ids flow.RegisterIDs, height uint64
 	return result, nil
 }
 
+// RegistersByOwner gets the values of all the registers of the given owner from the underlying
+// storage.RegisterIndex
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the store is still bootstrapping
+//   - storage.ErrHeightNotIndexed if the values at the height is not indexed yet
+//   - storage.ErrLimitExceeded if the owner has more than limit registers at the height
+func (r *RegistersAsyncStore) RegistersByOwner(owner string, height uint64, limit uint) (flow.RegisterEntries, error) {
+	registerStore, err := r.getRegisterStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if height > registerStore.LatestHeight() || height < registerStore.FirstHeight() {
+		return nil, storage.ErrHeightNotIndexed
+	}
+
+	return registerStore.ByOwner(owner, height, limit)
+}
+
 func (r *RegistersAsyncStore) getRegisterStore() (storage.RegisterIndex, error) {
 	registerStore := r.registerIndex.Load()
 	if registerStore == nil {
@@ -8,7 +8,7 @@ import (
 	"github.com/onflow/flow-go/engine/execution/computation"
 	"github.com/onflow/flow-go/engine/execution/computation/query"
 	"github.com/onflow/flow-go/fvm"
-	"github.com/onflow/flow-go/fvm/environment"
+	"github.com/onflow/flow-go/fvm/initialize"
 	"github.com/onflow/flow-go/fvm/storage/derived"
 	"github.com/onflow/flow-go/fvm/storage/snapshot"
 	"github.com/onflow/flow-go/model/flow"
@@ -38,6 +38,19 @@ type ScriptExecutor interface {
 		height uint64,
 	) ([]byte, error)
 
+	// ExecuteAtBlockHeightWithReport executes provided script against the block height.
+	// A result value is returned encoded as byte array, along with a report of the resources
+	// used by the script. An error will be returned if script doesn't successfully execute.
+	// Expected errors:
+	// - storage.ErrNotFound if block or register value at height was not found.
+	// - storage.ErrHeightNotIndexed if the data for the block height is not available
+	ExecuteAtBlockHeightWithReport(
+		ctx context.Context,
+		script []byte,
+		arguments [][]byte,
+		height uint64,
+	) ([]byte, *query.ScriptExecutionReport, error)
+
 	// GetAccountAtBlockHeight returns a Flow account by the provided address and block height.
 	// Expected errors:
 	// - storage.ErrHeightNotIndexed if the data for the block height is not available
@@ -62,6 +75,12 @@ type ScriptExecutor interface {
 	// Expected errors:
 	// - storage.ErrHeightNotIndexed if the data for the block height is not available
 	GetAccountKey(ctx context.Context, address flow.Address, keyIndex uint32, height uint64) (*flow.AccountPublicKey, error)
+
+	// DryRunTransaction executes the provided transaction against the block height without committing
+	// any of its changes. A failed transaction is reported in the returned result, not as an error.
+	// Expected errors:
+	// - storage.ErrHeightNotIndexed if the data for the block height is not available
+	DryRunTransaction(ctx context.Context, tx *flow.TransactionBody, options query.TransactionDryRunOptions, height uint64) (*query.TransactionDryRunResult, error)
 }
 
 var _ ScriptExecutor = (*Scripts)(nil)
@@ -86,8 +105,9 @@ func NewScripts(
 	vm := fvm.NewVirtualMachine()
 
 	options := computation.DefaultFVMOptions(chainID, false, false)
-	blocks := environment.NewBlockFinder(header)
-	options = append(options, fvm.WithBlocks(blocks)) // add blocks for getBlocks calls in scripts
+	// use the same chain specific options as execution nodes. this adds blocks for getBlocks calls in scripts,
+	// and enables transaction fees and storage limits for transaction dry runs
+	options = append(options, initialize.InitFvmOptions(chainID, header)...)
 	options = append(options, fvm.WithMetricsReporter(metrics))
 	options = append(options, fvm.WithAllowProgramCacheWritesInScriptsEnabled(enableProgramCacheWrites))
 	vmCtx := fvm.NewContext(options...)
@@ -133,6 +153,26 @@ func (s *Scripts) ExecuteAtBlockHeight(
 	return value, err
 }
 
+// ExecuteAtBlockHeightWithReport executes provided script against the block height.
+// A result value is returned encoded as byte array, along with a report of the resources
+// used by the script. An error will be returned if script doesn't successfully execute.
+// Expected errors:
+// - Script execution related errors
+// - storage.ErrHeightNotIndexed if the data for the block height is not available
+func (s *Scripts) ExecuteAtBlockHeightWithReport(
+	ctx context.Context,
+	script []byte,
+	arguments [][]byte,
+	height uint64,
+) ([]byte, *query.ScriptExecutionReport, error) {
+	snap, header, err := s.snapshotWithBlock(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.executor.ExecuteScriptWithReport(ctx, script, arguments, header, snap)
+}
+
 // GetAccountAtBlockHeight returns a Flow account by the provided address and block height.
 // Expected errors:
 // - Script execution related errors
@@ -198,6 +238,24 @@ func (s *Scripts) GetAccountKey(ctx context.Context, address flow.Address, keyIn
 	return s.executor.GetAccountKey(ctx, address, keyIndex, header, snap)
 }
 
+// DryRunTransaction executes the provided transaction against the block height without committing
+// any of its changes.
+// Expected errors:
+// - storage.ErrHeightNotIndexed if the data for the block height is not available
+func (s *Scripts) DryRunTransaction(
+	ctx context.Context,
+	tx *flow.TransactionBody,
+	options query.TransactionDryRunOptions,
+	height uint64,
+) (*query.TransactionDryRunResult, error) {
+	snap, header, err := s.snapshotWithBlock(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.executor.DryRunTransaction(ctx, tx, options, header, snap)
+}
+
 // snapshotWithBlock is a common function for executing scripts and get account functionality.
 // It creates a storage snapshot that is needed by the FVM to execute scripts.
 func (s *Scripts) snapshotWithBlock(height uint64) (snapshot.StorageSnapshot, *flow.Header, error) {
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
 	"github.com/onflow/cadence/encoding/ccf"
 	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/cadence/stdlib"
@@ -110,6 +111,30 @@ func (s *scriptTestSuite) TestScriptExecution() {
 	})
 }
 
+func (s *scriptTestSuite) TestScriptExecutionReport() {
+	sc := systemcontracts.SystemContractsForChain(s.chain.ChainID())
+
+	code := []byte(fmt.Sprintf(`
+		import FlowToken from 0x%s
+
+		access(all) fun main(): UFix64 {
+			return FlowToken.totalSupply
+		}`, sc.FlowToken.Address.Hex()))
+
+	result, report, err := s.scripts.ExecuteAtBlockHeightWithReport(context.Background(), code, nil, s.height)
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+
+	s.Assert().NotZero(report.ComputationUsed)
+	s.Assert().NotZero(report.MemoryEstimate)
+	s.Assert().NotZero(report.RegisterReads)
+	s.Assert().NotZero(report.BytesRead)
+	s.Assert().Contains(report.ContractsLoaded, common.AddressLocation{
+		Address: common.Address(sc.FlowToken.Address),
+		Name:    sc.FlowToken.Name,
+	})
+}
+
 func (s *scriptTestSuite) TestGetAccount() {
 	s.Run("Get Service Account", func() {
 		address := s.chain.ServiceAddress()
@@ -152,6 +177,96 @@ func (s *scriptTestSuite) TestGetAccountKeys() {
 
 }
 
+func (s *scriptTestSuite) TestDryRunTransaction() {
+	s.Run("Successful Transaction", func() {
+		serviceAddress := s.chain.ServiceAddress()
+		var transferAmount uint64 = 100000000
+
+		balanceBefore, err := s.scripts.GetAccountBalance(context.Background(), serviceAddress, s.height)
+		s.Require().NoError(err)
+
+		txBody := transferTokensTx(s.chain).
+			AddArgument(jsoncdc.MustEncode(cadence.UFix64(transferAmount))).
+			AddArgument(jsoncdc.MustEncode(cadence.Address(serviceAddress))).
+			SetProposalKey(serviceAddress, 0, 0).
+			SetPayer(serviceAddress).
+			AddAuthorizer(serviceAddress)
+
+		result, err := s.scripts.DryRunTransaction(context.Background(), txBody, query.TransactionDryRunOptions{}, s.height)
+		s.Require().NoError(err)
+		s.Require().Nil(result.Err)
+		s.Assert().NotZero(result.ComputationUsed)
+		s.Assert().NotZero(result.MemoryEstimate)
+		s.Assert().NotEmpty(result.Events)
+
+		// the state changes done by the dry run must not be persisted
+		balanceAfter, err := s.scripts.GetAccountBalance(context.Background(), serviceAddress, s.height)
+		s.Require().NoError(err)
+		s.Assert().Equal(balanceBefore, balanceAfter)
+	})
+
+	s.Run("Failed Transaction", func() {
+		txBody := flow.NewTransactionBody().
+			SetScript([]byte(`transaction { execute { panic("failed") } }`)).
+			SetProposalKey(s.chain.ServiceAddress(), 0, 0).
+			SetPayer(s.chain.ServiceAddress())
+
+		result, err := s.scripts.DryRunTransaction(context.Background(), txBody, query.TransactionDryRunOptions{}, s.height)
+		s.Require().NoError(err)
+		s.Require().NotNil(result.Err)
+		s.Assert().Equal(errors.ErrCodeCadenceRunTimeError, result.Err.Code())
+	})
+
+	s.Run("Invalid Signature", func() {
+		txBody := flow.NewTransactionBody().
+			SetScript([]byte(`transaction { execute {} }`)).
+			SetProposalKey(s.chain.ServiceAddress(), 0, 0).
+			SetPayer(s.chain.ServiceAddress())
+
+		options := query.TransactionDryRunOptions{AuthorizationChecksEnabled: true}
+		result, err := s.scripts.DryRunTransaction(context.Background(), txBody, options, s.height)
+		s.Require().NoError(err)
+		s.Require().NotNil(result.Err)
+		s.Assert().Equal(errors.ErrCodeInvalidProposalSignatureError, result.Err.Code())
+	})
+
+	s.Run("Skip Body Execution", func() {
+		newTx := func(seqNumber uint64) *flow.TransactionBody {
+			return flow.NewTransactionBody().
+				SetScript([]byte(`transaction { execute { panic("failed") } }`)).
+				SetProposalKey(s.chain.ServiceAddress(), 0, seqNumber).
+				SetPayer(s.chain.ServiceAddress())
+		}
+		options := query.TransactionDryRunOptions{SequenceNumberCheckEnabled: true, SkipBodyExecution: true}
+
+		// the transaction body is not executed, so only the sequence number is checked
+		result, err := s.scripts.DryRunTransaction(context.Background(), newTx(0), options, s.height)
+		s.Require().NoError(err)
+		s.Assert().Nil(result.Err)
+		s.Assert().Empty(result.Events)
+
+		result, err = s.scripts.DryRunTransaction(context.Background(), newTx(5), options, s.height)
+		s.Require().NoError(err)
+		s.Require().NotNil(result.Err)
+		s.Assert().Equal(errors.ErrCodeInvalidProposalSeqNumberError, result.Err.Code())
+	})
+
+	s.Run("Cancelled", func() {
+		txBody := flow.NewTransactionBody().
+			SetScript([]byte(`transaction { execute { var i = 0; while true { i = i + 1 } } }`)).
+			SetProposalKey(s.chain.ServiceAddress(), 0, 0).
+			SetPayer(s.chain.ServiceAddress())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// the execution is interrupted like for scripts, and no result is returned for the transaction
+		result, err := s.scripts.DryRunTransaction(ctx, txBody, query.TransactionDryRunOptions{}, s.height)
+		s.Require().ErrorIs(err, context.Canceled)
+		s.Assert().Nil(result)
+	})
+}
+
 func (s *scriptTestSuite) SetupTest() {
 	logger := unittest.LoggerForTest(s.Suite.T(), zerolog.InfoLevel)
 	entropyProvider := testutil.ProtocolStateWithSourceFixture(nil)
@@ -187,6 +302,8 @@ func (s *scriptTestSuite) SetupTest() {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 		flow.Testnet.Chain(),
 		derivedChainData,
 		nil,
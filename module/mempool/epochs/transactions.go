@@ -3,6 +3,7 @@ package epochs
 import (
 	"sync"
 
+	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/mempool"
 )
 
@@ -61,3 +62,19 @@ func (t *TransactionPools) CombinedSize() uint {
 
 	return size
 }
+
+// ByTransactionID returns the counter of the epoch whose transaction pool holds the transaction
+// with the given ID, and the transaction pool. It returns false if no pool holds the transaction.
+func (t *TransactionPools) ByTransactionID(txID flow.Identifier) (uint64, mempool.Transactions, bool) {
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for epoch, pool := range t.pools {
+		if pool.Has(txID) {
+			return epoch, pool, true
+		}
+	}
+
+	return 0, nil, false
+}
@@ -82,3 +82,24 @@ func TestCombinedSize(t *testing.T) {
 
 	assert.Equal(t, expected, pools.CombinedSize())
 }
+
+func TestByTransactionID(t *testing.T) {
+
+	create := func(_ uint64) mempool.Transactions {
+		return herocache.NewTransactions(100, unittest.Logger(), metrics.NewNoopCollector())
+	}
+	pools := epochs.NewTransactionPools(create)
+
+	tx1 := unittest.TransactionBodyFixture()
+	tx2 := unittest.TransactionBodyFixture()
+	pools.ForEpoch(1).Add(&tx1)
+	pools.ForEpoch(2).Add(&tx2)
+
+	epoch, pool, ok := pools.ByTransactionID(tx2.ID())
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), epoch)
+	assert.Equal(t, pools.ForEpoch(2), pool)
+
+	_, _, ok = pools.ByTransactionID(unittest.IdentifierFixture())
+	assert.False(t, ok)
+}
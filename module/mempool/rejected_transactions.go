@@ -0,0 +1,19 @@
+package mempool
+
+import (
+	"github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// RejectedTransactions represents a concurrency-safe memory pool of the reasons why the most
+// recently rejected or dropped transactions will not be included in a collection.
+type RejectedTransactions interface {
+
+	// Add records why the transaction was rejected or dropped, along with a human-readable message
+	// describing it. A previously recorded reason is replaced.
+	Add(txID flow.Identifier, reason access.PendingTransactionReason, message string)
+
+	// ByID returns why the transaction was rejected or dropped, and the message describing it.
+	// It returns false if no reason is recorded for the transaction.
+	ByID(txID flow.Identifier) (access.PendingTransactionReason, string, bool)
+}
@@ -0,0 +1,222 @@
+package stdmap
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/mempool"
+	"github.com/onflow/flow-go/utils/logging"
+)
+
+// orderedTransaction is a transaction stored in the OrderedTransactions mempool.
+type orderedTransaction struct {
+	tx *flow.TransactionBody
+	// seq is the insertion sequence number of the transaction, used to return transactions
+	// in the order they were added.
+	seq uint64
+}
+
+// OrderedTransactions implements a transactions memory pool for collection nodes, which returns
+// transactions in the order of a TransactionOrdering and bounds the number of transactions of
+// each payer.
+//
+// When the mempool is full, the most recently added transaction of the payer with the most
+// transactions is ejected to make room for a transaction of another payer. This ensures a single
+// payer submitting many transactions can not push the transactions of other payers out of the
+// mempool.
+type OrderedTransactions struct {
+	mu          sync.RWMutex
+	log         zerolog.Logger
+	ordering    TransactionOrdering
+	limit       uint
+	maxPerPayer uint
+	nextSeq     uint64
+	txs         map[flow.Identifier]*orderedTransaction
+	// byPayer holds the transactions of each payer, in insertion order
+	byPayer map[flow.Address][]*orderedTransaction
+}
+
+var _ mempool.Transactions = (*OrderedTransactions)(nil)
+
+// NewOrderedTransactions creates a new transactions mempool holding at most limit transactions,
+// and at most maxPerPayer transactions of each payer. A maxPerPayer of 0 disables the per-payer limit.
+func NewOrderedTransactions(limit uint, maxPerPayer uint, ordering TransactionOrdering, log zerolog.Logger) *OrderedTransactions {
+	return &OrderedTransactions{
+		log:         log.With().Str("mempool", "ordered_transactions").Logger(),
+		ordering:    ordering,
+		limit:       limit,
+		maxPerPayer: maxPerPayer,
+		txs:         make(map[flow.Identifier]*orderedTransaction),
+		byPayer:     make(map[flow.Address][]*orderedTransaction),
+	}
+}
+
+// Has checks whether the transaction with the given ID is currently in the mempool.
+func (t *OrderedTransactions) Has(txID flow.Identifier) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	_, ok := t.txs[txID]
+	return ok
+}
+
+// Add adds a transaction to the mempool. It returns false if the transaction is already in the
+// mempool, if it would exceed the per-payer limit of its payer, or if the mempool is full and its
+// payer would end up with more transactions than the payer with the most transactions.
+func (t *OrderedTransactions) Add(tx *flow.TransactionBody) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	txID := tx.ID()
+	if _, ok := t.txs[txID]; ok {
+		return false
+	}
+
+	payerTxs := t.byPayer[tx.Payer]
+	if t.maxPerPayer > 0 && uint(len(payerTxs))+1 > t.maxPerPayer {
+		t.log.Debug().
+			Hex("tx_id", logging.ID(txID)).
+			Str("payer", tx.Payer.String()).
+			Msg("transaction rejected: payer reached the maximum number of pending transactions")
+		return false
+	}
+
+	if uint(len(t.txs)) >= t.limit {
+		// eject the most recently added transaction of the largest payer, unless the payer of
+		// the new transaction would end up with more transactions than the largest payer
+		largest, count := t.largestPayer()
+		if uint(len(payerTxs))+1 > count {
+			t.log.Debug().
+				Hex("tx_id", logging.ID(txID)).
+				Str("payer", tx.Payer.String()).
+				Msg("transaction rejected: mempool is full")
+			return false
+		}
+
+		largestTxs := t.byPayer[largest]
+		ejected := largestTxs[len(largestTxs)-1].tx
+		ejectedID := ejected.ID()
+		t.remove(ejectedID, ejected.Payer)
+
+		t.log.Debug().
+			Hex("tx_id", logging.ID(ejectedID)).
+			Str("payer", ejected.Payer.String()).
+			Msg("transaction ejected from full mempool")
+	}
+
+	entry := &orderedTransaction{
+		tx:  tx,
+		seq: t.nextSeq,
+	}
+	t.nextSeq++
+	t.txs[txID] = entry
+	t.byPayer[tx.Payer] = append(payerTxs, entry)
+
+	return true
+}
+
+// largestPayer returns the payer with the most transactions in the mempool, and the number of its
+// transactions. Ties are broken in favour of the payer with the most recently added transaction.
+func (t *OrderedTransactions) largestPayer() (flow.Address, uint) {
+	var largest flow.Address
+	var count uint
+	var latest uint64
+	for payer, txs := range t.byPayer {
+		n := uint(len(txs))
+		last := txs[len(txs)-1].seq
+		if n > count || (n == count && last > latest) {
+			largest = payer
+			count = n
+			latest = last
+		}
+	}
+	return largest, count
+}
+
+// Remove removes the transaction with the given ID from the mempool. It returns true if the
+// transaction was known and removed.
+func (t *OrderedTransactions) Remove(txID flow.Identifier) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry, ok := t.txs[txID]
+	if !ok {
+		return false
+	}
+	t.remove(txID, entry.tx.Payer)
+	return true
+}
+
+// remove removes the transaction from the mempool. The transaction must be in the mempool.
+// Must be called with the lock held.
+func (t *OrderedTransactions) remove(txID flow.Identifier, payer flow.Address) {
+	entry := t.txs[txID]
+	delete(t.txs, txID)
+
+	payerTxs := t.byPayer[payer]
+	for i, e := range payerTxs {
+		if e == entry {
+			payerTxs = append(payerTxs[:i], payerTxs[i+1:]...)
+			break
+		}
+	}
+	if len(payerTxs) == 0 {
+		delete(t.byPayer, payer)
+		return
+	}
+	t.byPayer[payer] = payerTxs
+}
+
+// ByID returns the transaction with the given ID from the mempool.
+func (t *OrderedTransactions) ByID(txID flow.Identifier) (*flow.TransactionBody, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	entry, ok := t.txs[txID]
+	if !ok {
+		return nil, false
+	}
+	return entry.tx, true
+}
+
+// Size returns the number of transactions in the mempool.
+func (t *OrderedTransactions) Size() uint {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return uint(len(t.txs))
+}
+
+// All returns all transactions in the mempool, in the order of the mempool's transaction ordering.
+func (t *OrderedTransactions) All() []*flow.TransactionBody {
+	t.mu.RLock()
+	entries := make([]*orderedTransaction, 0, len(t.txs))
+	for _, entry := range t.txs {
+		entries = append(entries, entry)
+	}
+	t.mu.RUnlock()
+
+	// the ordering is given the transactions in insertion order
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].seq < entries[j].seq
+	})
+	txs := make([]*flow.TransactionBody, 0, len(entries))
+	for _, entry := range entries {
+		txs = append(txs, entry.tx)
+	}
+	t.ordering.Order(txs)
+
+	return txs
+}
+
+// Clear removes all transactions from the mempool.
+func (t *OrderedTransactions) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.txs = make(map[flow.Identifier]*orderedTransaction)
+	t.byPayer = make(map[flow.Address][]*orderedTransaction)
+}
@@ -0,0 +1,180 @@
+package stdmap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/mempool/stdmap"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// payerTx returns a transaction fixture with the given payer and gas limit.
+func payerTx(payer flow.Address, gasLimit uint64) *flow.TransactionBody {
+	tx := unittest.TransactionBodyFixture(func(tx *flow.TransactionBody) {
+		tx.Payer = payer
+		tx.GasLimit = gasLimit
+	})
+	return &tx
+}
+
+func TestOrderedTransactions(t *testing.T) {
+	payerA := unittest.RandomAddressFixture()
+	payerB := unittest.RandomAddressFixture()
+	payerC := unittest.RandomAddressFixture()
+
+	t.Run("basic operations", func(t *testing.T) {
+		pool := stdmap.NewOrderedTransactions(10, 0, stdmap.FIFOOrdering{}, unittest.Logger())
+
+		tx1 := payerTx(payerA, 100)
+		tx2 := payerTx(payerA, 100)
+		assert.True(t, pool.Add(tx1))
+		assert.True(t, pool.Add(tx2))
+		assert.False(t, pool.Add(tx1))
+		assert.EqualValues(t, 2, pool.Size())
+
+		got, ok := pool.ByID(tx1.ID())
+		require.True(t, ok)
+		assert.Equal(t, tx1, got)
+		assert.True(t, pool.Has(tx2.ID()))
+
+		assert.True(t, pool.Remove(tx1.ID()))
+		assert.False(t, pool.Remove(tx1.ID()))
+		assert.Equal(t, []*flow.TransactionBody{tx2}, pool.All())
+
+		pool.Clear()
+		assert.Zero(t, pool.Size())
+		assert.Empty(t, pool.All())
+	})
+
+	t.Run("per payer limit", func(t *testing.T) {
+		pool := stdmap.NewOrderedTransactions(10, 2, stdmap.FIFOOrdering{}, unittest.Logger())
+
+		tx1 := payerTx(payerA, 100)
+		assert.True(t, pool.Add(tx1))
+		assert.True(t, pool.Add(payerTx(payerA, 100)))
+		assert.False(t, pool.Add(payerTx(payerA, 100)))
+		assert.True(t, pool.Add(payerTx(payerB, 100)))
+
+		// removing a transaction frees a slot for the payer
+		assert.True(t, pool.Remove(tx1.ID()))
+		assert.True(t, pool.Add(payerTx(payerA, 100)))
+	})
+
+	t.Run("full mempool ejects transactions of the largest payer", func(t *testing.T) {
+		pool := stdmap.NewOrderedTransactions(4, 0, stdmap.FIFOOrdering{}, unittest.Logger())
+
+		txA1 := payerTx(payerA, 100)
+		txA2 := payerTx(payerA, 100)
+		txA3 := payerTx(payerA, 100)
+		txB1 := payerTx(payerB, 100)
+		for _, tx := range []*flow.TransactionBody{txA1, txA2, txA3, txB1} {
+			require.True(t, pool.Add(tx))
+		}
+
+		// the largest payer can not add more transactions
+		assert.False(t, pool.Add(payerTx(payerA, 100)))
+
+		// other payers eject the most recent transaction of the largest payer
+		txC1 := payerTx(payerC, 100)
+		assert.True(t, pool.Add(txC1))
+		assert.False(t, pool.Has(txA3.ID()))
+		assert.Equal(t, []*flow.TransactionBody{txA1, txA2, txB1, txC1}, pool.All())
+
+		// a payer can eject transactions of the largest payer to catch up with it
+		txB2 := payerTx(payerB, 100)
+		assert.True(t, pool.Add(txB2))
+		assert.False(t, pool.Has(txA2.ID()))
+		assert.Equal(t, []*flow.TransactionBody{txA1, txB1, txC1, txB2}, pool.All())
+
+		// but can not eject transactions to hold more transactions than the largest payer
+		assert.False(t, pool.Add(payerTx(payerB, 100)))
+		assert.EqualValues(t, 4, pool.Size())
+	})
+
+	t.Run("full mempool accepts the first transaction of a new payer", func(t *testing.T) {
+		pool := stdmap.NewOrderedTransactions(2, 1, stdmap.FIFOOrdering{}, unittest.Logger())
+
+		// every payer holds one transaction
+		txA1 := payerTx(payerA, 100)
+		txB1 := payerTx(payerB, 100)
+		require.True(t, pool.Add(txA1))
+		require.True(t, pool.Add(txB1))
+
+		// the first transaction of a new payer ejects the most recently added transaction
+		txC1 := payerTx(payerC, 100)
+		assert.True(t, pool.Add(txC1))
+		assert.False(t, pool.Has(txB1.ID()))
+		assert.Equal(t, []*flow.TransactionBody{txA1, txC1}, pool.All())
+
+		// the second transaction of a payer is still bounded by the per-payer limit
+		assert.False(t, pool.Add(payerTx(payerC, 100)))
+		assert.EqualValues(t, 2, pool.Size())
+	})
+
+	t.Run("mempool of a single transaction", func(t *testing.T) {
+		pool := stdmap.NewOrderedTransactions(1, 0, stdmap.FIFOOrdering{}, unittest.Logger())
+
+		txA1 := payerTx(payerA, 100)
+		require.True(t, pool.Add(txA1))
+
+		// the payer can not eject its own transaction
+		assert.False(t, pool.Add(payerTx(payerA, 100)))
+
+		txB1 := payerTx(payerB, 100)
+		assert.True(t, pool.Add(txB1))
+		assert.Equal(t, []*flow.TransactionBody{txB1}, pool.All())
+	})
+}
+
+func TestTransactionOrdering(t *testing.T) {
+	payerA := unittest.RandomAddressFixture()
+	payerB := unittest.RandomAddressFixture()
+	payerC := unittest.RandomAddressFixture()
+
+	txA1 := payerTx(payerA, 300)
+	txA2 := payerTx(payerA, 100)
+	txA3 := payerTx(payerA, 100)
+	txB1 := payerTx(payerB, 200)
+	txB2 := payerTx(payerB, 400)
+	txC1 := payerTx(payerC, 100)
+
+	ordered := func(t *testing.T, name string) []*flow.TransactionBody {
+		ordering, err := stdmap.ParseTransactionOrdering(name)
+		require.NoError(t, err)
+
+		pool := stdmap.NewOrderedTransactions(10, 0, ordering, unittest.Logger())
+		for _, tx := range []*flow.TransactionBody{txA1, txA2, txA3, txB1, txB2, txC1} {
+			require.True(t, pool.Add(tx))
+		}
+		return pool.All()
+	}
+
+	t.Run("fifo", func(t *testing.T) {
+		assert.Equal(t, []*flow.TransactionBody{txA1, txA2, txA3, txB1, txB2, txC1}, ordered(t, stdmap.TransactionOrderingFIFO))
+	})
+
+	t.Run("gas limit", func(t *testing.T) {
+		// transactions with the same priority are returned in insertion order
+		assert.Equal(t, []*flow.TransactionBody{txB2, txA1, txB1, txA2, txA3, txC1}, ordered(t, stdmap.TransactionOrderingGasLimit))
+	})
+
+	t.Run("inclusion effort", func(t *testing.T) {
+		txs := ordered(t, stdmap.TransactionOrderingInclusionEffort)
+		require.Len(t, txs, 6)
+		for i := 1; i < len(txs); i++ {
+			assert.GreaterOrEqual(t, txs[i-1].InclusionEffort(), txs[i].InclusionEffort())
+		}
+	})
+
+	t.Run("round robin", func(t *testing.T) {
+		assert.Equal(t, []*flow.TransactionBody{txA1, txB1, txC1, txA2, txB2, txA3}, ordered(t, stdmap.TransactionOrderingRoundRobin))
+	})
+
+	t.Run("unknown ordering", func(t *testing.T) {
+		_, err := stdmap.ParseTransactionOrdering("unknown")
+		assert.Error(t, err)
+	})
+}
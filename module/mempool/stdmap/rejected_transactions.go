@@ -0,0 +1,54 @@
+package stdmap
+
+import (
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/onflow/flow-go/model/access"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/mempool"
+)
+
+// DefaultRejectedTransactionsLimit is the default number of rejected or dropped transactions
+// whose reason is remembered.
+const DefaultRejectedTransactionsLimit = 10_000
+
+// RejectedTransactions remembers why the most recently rejected or dropped transactions will not be
+// included in a collection. When full, the reason of the least recently added transaction is evicted.
+type RejectedTransactions struct {
+	cache *lru.Cache[flow.Identifier, rejectedTransaction]
+}
+
+var _ mempool.RejectedTransactions = (*RejectedTransactions)(nil)
+
+// rejectedTransaction is the reason a transaction was rejected or dropped.
+type rejectedTransaction struct {
+	reason  access.PendingTransactionReason
+	message string
+}
+
+// NewRejectedTransactions creates a new memory pool remembering the reason of at most limit transactions.
+// No errors are expected during normal operation.
+func NewRejectedTransactions(limit uint) (*RejectedTransactions, error) {
+	cache, err := lru.New[flow.Identifier, rejectedTransaction](int(limit))
+	if err != nil {
+		return nil, fmt.Errorf("could not create rejected transactions cache: %w", err)
+	}
+	return &RejectedTransactions{cache: cache}, nil
+}
+
+// Add records why the transaction was rejected or dropped. A previously recorded reason is replaced.
+func (r *RejectedTransactions) Add(txID flow.Identifier, reason access.PendingTransactionReason, message string) {
+	r.cache.Add(txID, rejectedTransaction{reason: reason, message: message})
+}
+
+// ByID returns why the transaction was rejected or dropped, and the message describing it.
+// It returns false if no reason is recorded for the transaction.
+func (r *RejectedTransactions) ByID(txID flow.Identifier) (access.PendingTransactionReason, string, bool) {
+	rejected, ok := r.cache.Get(txID)
+	if !ok {
+		return access.PendingReasonUnknown, "", false
+	}
+	return rejected.reason, rejected.message, true
+}
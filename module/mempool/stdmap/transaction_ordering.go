@@ -0,0 +1,100 @@
+package stdmap
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const (
+	// TransactionOrderingFIFO returns transactions in the order they were added.
+	TransactionOrderingFIFO = "fifo"
+	// TransactionOrderingInclusionEffort returns transactions with the highest inclusion effort first.
+	TransactionOrderingInclusionEffort = "inclusion-effort"
+	// TransactionOrderingGasLimit returns transactions with the highest gas limit first.
+	TransactionOrderingGasLimit = "gas-limit"
+	// TransactionOrderingRoundRobin returns one transaction of each payer at a time.
+	TransactionOrderingRoundRobin = "round-robin"
+)
+
+// TransactionOrdering determines the order in which the transactions of an OrderedTransactions
+// mempool are returned, and consequently the order in which they are included in collections.
+type TransactionOrdering interface {
+	// Order sorts the given transactions in place. The transactions are given in the order
+	// they were added to the mempool.
+	Order(txs []*flow.TransactionBody)
+}
+
+// ParseTransactionOrdering returns the transaction ordering with the given name.
+// Expected errors during normal operations:
+//   - error if the name is not one of the supported orderings.
+func ParseTransactionOrdering(name string) (TransactionOrdering, error) {
+	switch name {
+	case TransactionOrderingFIFO:
+		return FIFOOrdering{}, nil
+	case TransactionOrderingInclusionEffort:
+		return PriorityOrdering{Priority: (*flow.TransactionBody).InclusionEffort}, nil
+	case TransactionOrderingGasLimit:
+		return PriorityOrdering{Priority: func(tx *flow.TransactionBody) uint64 { return tx.GasLimit }}, nil
+	case TransactionOrderingRoundRobin:
+		return RoundRobinOrdering{}, nil
+	default:
+		return nil, fmt.Errorf("invalid transaction ordering %q: must be one of %s, %s, %s, %s", name,
+			TransactionOrderingFIFO, TransactionOrderingInclusionEffort, TransactionOrderingGasLimit, TransactionOrderingRoundRobin)
+	}
+}
+
+// FIFOOrdering returns transactions in the order they were added.
+type FIFOOrdering struct{}
+
+var _ TransactionOrdering = FIFOOrdering{}
+
+func (FIFOOrdering) Order([]*flow.TransactionBody) {}
+
+// PriorityOrdering returns transactions with the highest priority first. Transactions with the
+// same priority are returned in the order they were added.
+type PriorityOrdering struct {
+	Priority func(tx *flow.TransactionBody) uint64
+}
+
+var _ TransactionOrdering = PriorityOrdering{}
+
+func (o PriorityOrdering) Order(txs []*flow.TransactionBody) {
+	priorities := make(map[*flow.TransactionBody]uint64, len(txs))
+	for _, tx := range txs {
+		priorities[tx] = o.Priority(tx)
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		return priorities[txs[i]] > priorities[txs[j]]
+	})
+}
+
+// RoundRobinOrdering returns one transaction of each payer at a time, so that payers with many
+// pending transactions can not crowd out the transactions of other payers. Payers are visited in
+// the order their oldest transaction was added, and the transactions of a payer are returned in
+// the order they were added.
+type RoundRobinOrdering struct{}
+
+var _ TransactionOrdering = RoundRobinOrdering{}
+
+func (RoundRobinOrdering) Order(txs []*flow.TransactionBody) {
+	var payers []flow.Address
+	byPayer := make(map[flow.Address][]*flow.TransactionBody)
+	for _, tx := range txs {
+		if _, ok := byPayer[tx.Payer]; !ok {
+			payers = append(payers, tx.Payer)
+		}
+		byPayer[tx.Payer] = append(byPayer[tx.Payer], tx)
+	}
+
+	i := 0
+	for round := 0; i < len(txs); round++ {
+		for _, payer := range payers {
+			if round < len(byPayer[payer]) {
+				txs[i] = byPayer[payer][round]
+				i++
+			}
+		}
+	}
+}
@@ -27,7 +27,8 @@ type Transactions interface {
 	Size() uint
 
 	// All will retrieve all transactions that are currently in the memory pool
-	// as a slice.
+	// as a slice. The order of the transactions is defined by the implementation,
+	// and is the order in which collection builders consider them for inclusion.
 	All() []*flow.TransactionBody
 
 	// Clear removes all transactions from the mempool.
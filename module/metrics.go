@@ -186,6 +186,8 @@ type LocalGossipSubRouterMetrics interface {
 
 // UnicastManagerMetrics unicast manager metrics.
 type UnicastManagerMetrics interface {
+	UnicastCompressionMetrics
+
 	// OnStreamCreated tracks the overall time it takes to create a stream successfully and the number of retry attempts.
 	OnStreamCreated(duration time.Duration, attempts int)
 	// OnStreamCreationFailure tracks the amount of time taken and number of retry attempts used when the unicast manager fails to create a stream.
@@ -216,6 +218,16 @@ type UnicastManagerMetrics interface {
 	OnStreamCreationRetryBudgetResetToDefault()
 }
 
+// UnicastCompressionMetrics metrics for the compressed unicast streams.
+type UnicastCompressionMetrics interface {
+	// OnUnicastCompressed tracks the size of the data written on a compressed unicast stream before and after compression,
+	// and the time spent compressing it, excluding the time spent writing on the underlying stream.
+	OnUnicastCompressed(protocol string, uncompressedSize int, compressedSize int, duration time.Duration)
+	// OnUnicastDecompressed tracks the size of the data read from a compressed unicast stream before and after decompression,
+	// and the time spent decompressing it, excluding the time spent reading from the underlying stream.
+	OnUnicastDecompressed(protocol string, compressedSize int, decompressedSize int, duration time.Duration)
+}
+
 type GossipSubMetrics interface {
 	GossipSubScoringMetrics
 	GossipSubRpcInspectorMetrics
@@ -1003,6 +1015,12 @@ type ExecutionMetrics interface {
 	// ExecutionTargetChunkDataPackPrunedHeight reports the target height for chunk data pack to be pruned
 	ExecutionTargetChunkDataPackPrunedHeight(height uint64)
 
+	// ExecutionLastPrunedHeight reports the last height pruned for the given type of data
+	ExecutionLastPrunedHeight(data string, height uint64)
+
+	// ExecutionTargetPrunedHeight reports the target height for the given type of data to be pruned
+	ExecutionTargetPrunedHeight(data string, height uint64)
+
 	// ExecutionBlockExecuted reports the total time and computation spent on executing a block
 	ExecutionBlockExecuted(dur time.Duration, stats BlockExecutionResultStats)
 
@@ -1070,6 +1088,13 @@ type BackendScriptsMetrics interface {
 	ScriptExecutionNotIndexed()
 }
 
+// NodeScoreMetrics reports the scores the Access API backend assigns to the upstream nodes it sends requests to.
+type NodeScoreMetrics interface {
+	// NodeScoreUpdated reports the latency percentiles, error rate, executed height lag and resulting score
+	// of an upstream node. Lower scores are better.
+	NodeScoreUpdated(nodeID flow.Identifier, latencyP50, latencyP99 time.Duration, errorRate float64, heightLag uint64, score float64)
+}
+
 type TransactionMetrics interface {
 	// Record the round trip time while getting a transaction result
 	TransactionResultFetched(dur time.Duration, size int)
@@ -22,6 +22,8 @@ type ExecutionCollector struct {
 	lastFinalizedExecutedBlockHeightGauge   prometheus.Gauge
 	lastChunkDataPackPrunedHeightGauge      prometheus.Gauge
 	targetChunkDataPackPrunedHeightGauge    prometheus.Gauge
+	lastPrunedHeightGauge                   *prometheus.GaugeVec
+	targetPrunedHeightGauge                 *prometheus.GaugeVec
 	stateStorageDiskTotal                   prometheus.Gauge
 	storageStateCommitment                  prometheus.Gauge
 	checkpointSize                          prometheus.Gauge
@@ -669,6 +671,20 @@ func NewExecutionCollector(tracer module.Tracer) *ExecutionCollector {
 			Help:      "the target height for pruning chunk data pack",
 		}),
 
+		lastPrunedHeightGauge: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespaceExecution,
+			Subsystem: subsystemRuntime,
+			Name:      "last_pruned_height",
+			Help:      "the last height that was pruned for each type of data",
+		}, []string{LabelResource}),
+
+		targetPrunedHeightGauge: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespaceExecution,
+			Subsystem: subsystemRuntime,
+			Name:      "target_pruned_height",
+			Help:      "the target height for pruning each type of data",
+		}, []string{LabelResource}),
+
 		stateStorageDiskTotal: promauto.NewGauge(prometheus.GaugeOpts{
 			Namespace: namespaceExecution,
 			Subsystem: subsystemStateStorage,
@@ -917,6 +933,16 @@ func (ec *ExecutionCollector) ExecutionTargetChunkDataPackPrunedHeight(height ui
 	ec.targetChunkDataPackPrunedHeightGauge.Set(float64(height))
 }
 
+// ExecutionLastPrunedHeight reports the last height pruned for the given type of data
+func (ec *ExecutionCollector) ExecutionLastPrunedHeight(data string, height uint64) {
+	ec.lastPrunedHeightGauge.WithLabelValues(data).Set(float64(height))
+}
+
+// ExecutionTargetPrunedHeight reports the target height for the given type of data to be pruned
+func (ec *ExecutionCollector) ExecutionTargetPrunedHeight(data string, height uint64) {
+	ec.targetPrunedHeightGauge.WithLabelValues(data).Set(float64(height))
+}
+
 // ForestApproxMemorySize records approximate memory usage of forest (all in-memory trees)
 func (ec *ExecutionCollector) ForestApproxMemorySize(bytes uint64) {
 	ec.forestApproxMemorySize.Set(float64(bytes))
@@ -171,6 +171,8 @@ const (
 	InvalidSignature            = "invalid_signature"
 	DuplicatedSignature         = "duplicate_signature"
 	InsufficientBalance         = "payer_insufficient_balance"
+	InvalidAuthorization        = "invalid_authorization"
+	InvalidSequenceNumber       = "invalid_sequence_number"
 )
 
 const ExecutionDataRequestRetryable = "retryable"
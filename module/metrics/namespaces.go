@@ -47,6 +47,7 @@ const (
 	subsystemTransactionValidation = "transaction_validation"
 	subsystemConnectionPool        = "connection_pool"
 	subsystemHTTP                  = "http"
+	subsystemNodeSelection         = "node_selection"
 )
 
 // Observer subsystem
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+)
+
+// NodeScoreCollector the metrics for the scores of the upstream nodes used by the Access API backend
+type NodeScoreCollector struct {
+	latencyP50 *prometheus.GaugeVec
+	latencyP99 *prometheus.GaugeVec
+	errorRate  *prometheus.GaugeVec
+	heightLag  *prometheus.GaugeVec
+	score      *prometheus.GaugeVec
+}
+
+// interface check
+var _ module.NodeScoreMetrics = (*NodeScoreCollector)(nil)
+
+// NewNodeScoreCollector creates new instance of NodeScoreCollector
+func NewNodeScoreCollector() *NodeScoreCollector {
+	return &NodeScoreCollector{
+		latencyP50: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name:      "latency_p50_seconds",
+			Namespace: namespaceAccess,
+			Subsystem: subsystemNodeSelection,
+			Help:      "the median latency of the recent requests sent to the upstream node",
+		}, []string{LabelNodeID}),
+		latencyP99: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name:      "latency_p99_seconds",
+			Namespace: namespaceAccess,
+			Subsystem: subsystemNodeSelection,
+			Help:      "the 99th percentile latency of the recent requests sent to the upstream node",
+		}, []string{LabelNodeID}),
+		errorRate: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name:      "error_rate",
+			Namespace: namespaceAccess,
+			Subsystem: subsystemNodeSelection,
+			Help:      "the exponentially weighted rate of failed requests sent to the upstream node",
+		}, []string{LabelNodeID}),
+		heightLag: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name:      "executed_height_lag",
+			Namespace: namespaceAccess,
+			Subsystem: subsystemNodeSelection,
+			Help:      "the number of blocks the executed height of the execution node lags behind the other execution nodes",
+		}, []string{LabelNodeID}),
+		score: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name:      "score",
+			Namespace: namespaceAccess,
+			Subsystem: subsystemNodeSelection,
+			Help:      "the score of the upstream node used to order requests, lower is better",
+		}, []string{LabelNodeID}),
+	}
+}
+
+// NodeScoreUpdated reports the latency percentiles, error rate, executed height lag and score of an upstream node
+func (nc *NodeScoreCollector) NodeScoreUpdated(
+	nodeID flow.Identifier,
+	latencyP50, latencyP99 time.Duration,
+	errorRate float64,
+	heightLag uint64,
+	score float64,
+) {
+	id := nodeID.String()
+	nc.latencyP50.WithLabelValues(id).Set(latencyP50.Seconds())
+	nc.latencyP99.WithLabelValues(id).Set(latencyP99.Seconds())
+	nc.errorRate.WithLabelValues(id).Set(errorRate)
+	nc.heightLag.WithLabelValues(id).Set(float64(heightLag))
+	nc.score.WithLabelValues(id).Set(score)
+}
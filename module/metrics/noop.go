@@ -170,6 +170,8 @@ func (nc *NoopCollector) ExecutionBlockExecuted(_ time.Duration, _ module.BlockE
 }
 func (nc *NoopCollector) ExecutionLastChunkDataPackPrunedHeight(height uint64)   {}
 func (nc *NoopCollector) ExecutionTargetChunkDataPackPrunedHeight(height uint64) {}
+func (nc *NoopCollector) ExecutionLastPrunedHeight(data string, height uint64)   {}
+func (nc *NoopCollector) ExecutionTargetPrunedHeight(data string, height uint64) {}
 
 func (nc *NoopCollector) ExecutionCollectionExecuted(_ time.Duration, _ module.CollectionExecutionResultStats) {
 }
@@ -283,6 +285,8 @@ func (nc *NoopCollector) OnDialRetryBudgetUpdated(budget uint64)
 func (nc *NoopCollector) OnStreamCreationRetryBudgetUpdated(budget uint64)              {}
 func (nc *NoopCollector) OnDialRetryBudgetResetToDefault()                              {}
 func (nc *NoopCollector) OnStreamCreationRetryBudgetResetToDefault()                    {}
+func (nc *NoopCollector) OnUnicastCompressed(string, int, int, time.Duration)           {}
+func (nc *NoopCollector) OnUnicastDecompressed(string, int, int, time.Duration)         {}
 
 var _ module.HeroCacheMetrics = (*NoopCollector)(nil)
 
@@ -392,3 +396,8 @@ func (nc *NoopCollector) RecommendedMinBalance(bal float64)  {}
 func (nc *NoopCollector) IsMisconfigured(misconfigured bool) {}
 
 var _ module.MachineAccountMetrics = (*NoopCollector)(nil)
+
+var _ module.NodeScoreMetrics = (*NoopCollector)(nil)
+
+func (nc *NoopCollector) NodeScoreUpdated(flow.Identifier, time.Duration, time.Duration, float64, uint64, float64) {
+}
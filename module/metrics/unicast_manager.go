@@ -31,6 +31,14 @@ type UnicastManagerMetrics struct {
 	dialRetryBudgetResetToDefault prometheus.Counter
 	// Tracks the number of times the stream creation retry budget is reset to default.
 	streamCreationRetryBudgetResetToDefault prometheus.Counter
+	// Tracks the size of the data exchanged on compressed streams before compression.
+	uncompressedBytes *prometheus.CounterVec
+	// Tracks the size of the data exchanged on compressed streams after compression.
+	compressedBytes *prometheus.CounterVec
+	// Tracks the ratio between the compressed and uncompressed sizes of the data written on compressed streams.
+	compressionRatio *prometheus.HistogramVec
+	// Tracks the time spent compressing and decompressing the data exchanged on compressed streams.
+	compressionTime *prometheus.CounterVec
 
 	prefix string
 }
@@ -136,6 +144,43 @@ func NewUnicastManagerMetrics(prefix string) *UnicastManagerMetrics {
 			Help:      "the number of times the dial retry budget is reset to default by the unicast manager",
 		})
 
+	uc.uncompressedBytes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespaceNetwork,
+			Subsystem: subsystemGossip,
+			Name:      uc.prefix + "unicast_uncompressed_bytes_total",
+			Help:      "the size of the data exchanged on compressed unicast streams before compression",
+		}, []string{LabelProtocol, LabelConnectionDirection},
+	)
+
+	uc.compressedBytes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespaceNetwork,
+			Subsystem: subsystemGossip,
+			Name:      uc.prefix + "unicast_compressed_bytes_total",
+			Help:      "the size of the data exchanged on compressed unicast streams after compression",
+		}, []string{LabelProtocol, LabelConnectionDirection},
+	)
+
+	uc.compressionRatio = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespaceNetwork,
+			Subsystem: subsystemGossip,
+			Name:      uc.prefix + "unicast_compression_ratio",
+			Help:      "the ratio between the compressed and uncompressed sizes of the data written on compressed unicast streams",
+			Buckets:   []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1, 1.5},
+		}, []string{LabelProtocol},
+	)
+
+	uc.compressionTime = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespaceNetwork,
+			Subsystem: subsystemGossip,
+			Name:      uc.prefix + "unicast_compression_seconds_total",
+			Help:      "the time spent compressing (outbound) and decompressing (inbound) the data exchanged on compressed unicast streams",
+		}, []string{LabelProtocol, LabelConnectionDirection},
+	)
+
 	return uc
 }
 
@@ -198,3 +243,22 @@ func (u *UnicastManagerMetrics) OnDialRetryBudgetResetToDefault() {
 func (u *UnicastManagerMetrics) OnStreamCreationRetryBudgetResetToDefault() {
 	u.streamCreationRetryBudgetResetToDefault.Inc()
 }
+
+// OnUnicastCompressed tracks the size of the data written on a compressed unicast stream before and after compression,
+// and the time spent compressing it, excluding the time spent writing on the underlying stream.
+func (u *UnicastManagerMetrics) OnUnicastCompressed(protocol string, uncompressedSize int, compressedSize int, duration time.Duration) {
+	u.uncompressedBytes.WithLabelValues(protocol, "outbound").Add(float64(uncompressedSize))
+	u.compressedBytes.WithLabelValues(protocol, "outbound").Add(float64(compressedSize))
+	u.compressionTime.WithLabelValues(protocol, "outbound").Add(duration.Seconds())
+	if uncompressedSize > 0 {
+		u.compressionRatio.WithLabelValues(protocol).Observe(float64(compressedSize) / float64(uncompressedSize))
+	}
+}
+
+// OnUnicastDecompressed tracks the size of the data read from a compressed unicast stream before and after decompression,
+// and the time spent decompressing it, excluding the time spent reading from the underlying stream.
+func (u *UnicastManagerMetrics) OnUnicastDecompressed(protocol string, compressedSize int, decompressedSize int, duration time.Duration) {
+	u.uncompressedBytes.WithLabelValues(protocol, "inbound").Add(float64(decompressedSize))
+	u.compressedBytes.WithLabelValues(protocol, "inbound").Add(float64(compressedSize))
+	u.compressionTime.WithLabelValues(protocol, "inbound").Add(duration.Seconds())
+}
@@ -101,6 +101,11 @@ func (_m *ExecutionMetrics) ExecutionLastFinalizedExecutedBlockHeight(height uin
 	_m.Called(height)
 }
 
+// ExecutionLastPrunedHeight provides a mock function with given fields: data, height
+func (_m *ExecutionMetrics) ExecutionLastPrunedHeight(data string, height uint64) {
+	_m.Called(data, height)
+}
+
 // ExecutionScriptExecuted provides a mock function with given fields: dur, compUsed, memoryUsed, memoryEstimate
 func (_m *ExecutionMetrics) ExecutionScriptExecuted(dur time.Duration, compUsed uint64, memoryUsed uint64, memoryEstimate uint64) {
 	_m.Called(dur, compUsed, memoryUsed, memoryEstimate)
@@ -121,6 +126,11 @@ func (_m *ExecutionMetrics) ExecutionTargetChunkDataPackPrunedHeight(height uint
 	_m.Called(height)
 }
 
+// ExecutionTargetPrunedHeight provides a mock function with given fields: data, height
+func (_m *ExecutionMetrics) ExecutionTargetPrunedHeight(data string, height uint64) {
+	_m.Called(data, height)
+}
+
 // ExecutionTransactionExecuted provides a mock function with given fields: dur, stats, info
 func (_m *ExecutionMetrics) ExecutionTransactionExecuted(dur time.Duration, stats module.TransactionExecutionResultStats, info module.TransactionExecutionResultInfo) {
 	_m.Called(dur, stats, info)
@@ -437,6 +437,16 @@ func (_m *LibP2PMetrics) OnUndeliveredMessage() {
 	_m.Called()
 }
 
+// OnUnicastCompressed provides a mock function with given fields: _a0, uncompressedSize, compressedSize, duration
+func (_m *LibP2PMetrics) OnUnicastCompressed(_a0 string, uncompressedSize int, compressedSize int, duration time.Duration) {
+	_m.Called(_a0, uncompressedSize, compressedSize, duration)
+}
+
+// OnUnicastDecompressed provides a mock function with given fields: _a0, compressedSize, decompressedSize, duration
+func (_m *LibP2PMetrics) OnUnicastDecompressed(_a0 string, compressedSize int, decompressedSize int, duration time.Duration) {
+	_m.Called(_a0, compressedSize, decompressedSize, duration)
+}
+
 // OnUnstakedPeerInspectionFailed provides a mock function with given fields:
 func (_m *LibP2PMetrics) OnUnstakedPeerInspectionFailed() {
 	_m.Called()
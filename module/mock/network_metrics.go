@@ -482,6 +482,16 @@ func (_m *NetworkMetrics) OnUndeliveredMessage() {
 	_m.Called()
 }
 
+// OnUnicastCompressed provides a mock function with given fields: _a0, uncompressedSize, compressedSize, duration
+func (_m *NetworkMetrics) OnUnicastCompressed(_a0 string, uncompressedSize int, compressedSize int, duration time.Duration) {
+	_m.Called(_a0, uncompressedSize, compressedSize, duration)
+}
+
+// OnUnicastDecompressed provides a mock function with given fields: _a0, compressedSize, decompressedSize, duration
+func (_m *NetworkMetrics) OnUnicastDecompressed(_a0 string, compressedSize int, decompressedSize int, duration time.Duration) {
+	_m.Called(_a0, compressedSize, decompressedSize, duration)
+}
+
 // OnUnstakedPeerInspectionFailed provides a mock function with given fields:
 func (_m *NetworkMetrics) OnUnstakedPeerInspectionFailed() {
 	_m.Called()
@@ -63,6 +63,16 @@ func (_m *UnicastManagerMetrics) OnStreamEstablished(duration time.Duration, att
 	_m.Called(duration, attempts)
 }
 
+// OnUnicastCompressed provides a mock function with given fields: protocol, uncompressedSize, compressedSize, duration
+func (_m *UnicastManagerMetrics) OnUnicastCompressed(protocol string, uncompressedSize int, compressedSize int, duration time.Duration) {
+	_m.Called(protocol, uncompressedSize, compressedSize, duration)
+}
+
+// OnUnicastDecompressed provides a mock function with given fields: protocol, compressedSize, decompressedSize, duration
+func (_m *UnicastManagerMetrics) OnUnicastDecompressed(protocol string, compressedSize int, decompressedSize int, duration time.Duration) {
+	_m.Called(protocol, compressedSize, decompressedSize, duration)
+}
+
 // NewUnicastManagerMetrics creates a new instance of UnicastManagerMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUnicastManagerMetrics(t interface {
@@ -0,0 +1,46 @@
+package pruners
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/onflow/flow-go/model/chunks"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation"
+)
+
+// ChunkLocatorsPruner removes the locators of the chunks of the execution result of a block.
+type ChunkLocatorsPruner struct {
+	results storage.ExecutionResults
+}
+
+func NewChunkLocatorsPruner(results storage.ExecutionResults) *ChunkLocatorsPruner {
+	return &ChunkLocatorsPruner{
+		results: results,
+	}
+}
+
+func (p *ChunkLocatorsPruner) PruneByBlockID(blockID flow.Identifier, batchWriter storage.ReaderBatchWriter) error {
+	result, err := p.results.ByBlockID(blockID)
+
+	// result not found, then chunk locators must not exist either
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get execution result by block ID: %w", err)
+	}
+
+	resultID := result.ID()
+	for _, chunk := range result.Chunks {
+		locatorID := chunks.ChunkLocatorID(resultID, chunk.Index)
+		err := operation.RemoveChunkLocator(batchWriter.Writer(), locatorID)
+		if err != nil {
+			return fmt.Errorf("could not remove chunk locator %v for block id %v: %w", locatorID, blockID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package pruners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/chunks"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
+	"github.com/onflow/flow-go/storage/store"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestChunkLocatorsPruner(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		results := store.NewExecutionResults(metrics.NewNoopCollector(), db)
+
+		result := unittest.ExecutionResultFixture()
+		require.NoError(t, results.Store(result))
+		require.NoError(t, results.Index(result.BlockID, result.ID()))
+
+		locators := make([]chunks.Locator, 0, len(result.Chunks))
+		for _, chunk := range result.Chunks {
+			locator := chunks.Locator{ResultID: result.ID(), Index: chunk.Index}
+			locators = append(locators, locator)
+			require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+				return operation.InsertChunkLocator(w.Writer(), &locator)
+			}))
+		}
+		require.NotEmpty(t, locators)
+
+		pruner := NewChunkLocatorsPruner(results)
+		require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+			return pruner.PruneByBlockID(result.BlockID, w)
+		}))
+
+		// verify the locators are pruned
+		for _, locator := range locators {
+			exists, err := operation.ExistChunkLocator(db.Reader(), locator.ID())
+			require.NoError(t, err)
+			require.False(t, exists)
+		}
+
+		// prune block without result should not return error
+		require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+			return pruner.PruneByBlockID(unittest.IdentifierFixture(), w)
+		}))
+	})
+}
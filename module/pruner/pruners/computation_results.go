@@ -0,0 +1,24 @@
+package pruners
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation"
+)
+
+// ComputationResultsPruner removes the upload status of the computation result of a block.
+type ComputationResultsPruner struct{}
+
+func NewComputationResultsPruner() *ComputationResultsPruner {
+	return &ComputationResultsPruner{}
+}
+
+func (p *ComputationResultsPruner) PruneByBlockID(blockID flow.Identifier, batchWriter storage.ReaderBatchWriter) error {
+	err := operation.RemoveComputationResultUploadStatus(batchWriter.Writer(), blockID)
+	if err != nil {
+		return fmt.Errorf("could not remove computation result upload status for block id %v: %w", blockID, err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package pruners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
+	"github.com/onflow/flow-go/storage/store"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestComputationResultsPruner(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		statuses := store.NewComputationResultUploadStatus(db)
+
+		blockID := unittest.IdentifierFixture()
+		require.NoError(t, statuses.Upsert(blockID, true))
+
+		pruner := NewComputationResultsPruner()
+		require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+			return pruner.PruneByBlockID(blockID, w)
+		}))
+
+		_, err := statuses.ByID(blockID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
@@ -0,0 +1,46 @@
+package pruners
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// EventsPruner removes the events emitted by the transactions of a block.
+type EventsPruner struct {
+	events storage.Events
+}
+
+func NewEventsPruner(events storage.Events) *EventsPruner {
+	return &EventsPruner{
+		events: events,
+	}
+}
+
+func (p *EventsPruner) PruneByBlockID(blockID flow.Identifier, batchWriter storage.ReaderBatchWriter) error {
+	err := p.events.BatchRemoveByBlockID(blockID, batchWriter)
+	if err != nil {
+		return fmt.Errorf("could not remove events for block id %v: %w", blockID, err)
+	}
+	return nil
+}
+
+// ServiceEventsPruner removes the service events emitted by the transactions of a block.
+type ServiceEventsPruner struct {
+	serviceEvents storage.ServiceEvents
+}
+
+func NewServiceEventsPruner(serviceEvents storage.ServiceEvents) *ServiceEventsPruner {
+	return &ServiceEventsPruner{
+		serviceEvents: serviceEvents,
+	}
+}
+
+func (p *ServiceEventsPruner) PruneByBlockID(blockID flow.Identifier, batchWriter storage.ReaderBatchWriter) error {
+	err := p.serviceEvents.BatchRemoveByBlockID(blockID, batchWriter)
+	if err != nil {
+		return fmt.Errorf("could not remove service events for block id %v: %w", blockID, err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package pruners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
+	"github.com/onflow/flow-go/storage/store"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestEventsPruner(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		events := store.NewEvents(metrics.NewNoopCollector(), db)
+
+		blockID := unittest.IdentifierFixture()
+		otherBlockID := unittest.IdentifierFixture()
+		require.NoError(t, events.Store(blockID, []flow.EventsList{unittest.EventsFixture(3)}))
+		require.NoError(t, events.Store(otherBlockID, []flow.EventsList{unittest.EventsFixture(2)}))
+
+		pruner := NewEventsPruner(events)
+		require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+			return pruner.PruneByBlockID(blockID, w)
+		}))
+
+		// verify the events of the block are pruned
+		pruned, err := events.ByBlockID(blockID)
+		require.NoError(t, err)
+		require.Empty(t, pruned)
+
+		// verify the events of other blocks are kept
+		kept, err := events.ByBlockID(otherBlockID)
+		require.NoError(t, err)
+		require.Len(t, kept, 2)
+
+		// prune non-exist block should not return error
+		require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+			return pruner.PruneByBlockID(unittest.IdentifierFixture(), w)
+		}))
+	})
+}
+
+func TestServiceEventsPruner(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		serviceEvents := store.NewServiceEvents(metrics.NewNoopCollector(), db)
+
+		blockID := unittest.IdentifierFixture()
+		require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+			return serviceEvents.BatchStore(blockID, unittest.EventsFixture(2), w)
+		}))
+
+		pruner := NewServiceEventsPruner(serviceEvents)
+
+		// the removal is only applied when the batch is committed
+		require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+			err := pruner.PruneByBlockID(blockID, w)
+			require.NoError(t, err)
+
+			stored, err := serviceEvents.ByBlockID(blockID)
+			require.NoError(t, err)
+			require.Len(t, stored, 2)
+			return nil
+		}))
+
+		pruned, err := serviceEvents.ByBlockID(blockID)
+		require.NoError(t, err)
+		require.Empty(t, pruned)
+	})
+}
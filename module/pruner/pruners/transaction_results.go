@@ -0,0 +1,28 @@
+package pruners
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// TransactionResultsPruner removes the results of the transactions of a block, and their index by
+// transaction index.
+type TransactionResultsPruner struct {
+	transactionResults storage.TransactionResults
+}
+
+func NewTransactionResultsPruner(transactionResults storage.TransactionResults) *TransactionResultsPruner {
+	return &TransactionResultsPruner{
+		transactionResults: transactionResults,
+	}
+}
+
+func (p *TransactionResultsPruner) PruneByBlockID(blockID flow.Identifier, batchWriter storage.ReaderBatchWriter) error {
+	err := p.transactionResults.BatchRemoveByBlockID(blockID, batchWriter)
+	if err != nil {
+		return fmt.Errorf("could not remove transaction results for block id %v: %w", blockID, err)
+	}
+	return nil
+}
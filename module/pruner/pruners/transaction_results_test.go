@@ -0,0 +1,41 @@
+package pruners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
+	"github.com/onflow/flow-go/storage/store"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestTransactionResultsPruner(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		results := store.NewTransactionResults(metrics.NewNoopCollector(), db, 10)
+
+		blockID := unittest.IdentifierFixture()
+		txResults := unittest.TransactionResultsFixture(3)
+		require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+			return results.BatchStore(blockID, txResults, w)
+		}))
+
+		pruner := NewTransactionResultsPruner(results)
+		require.NoError(t, db.WithReaderBatchWriter(func(w storage.ReaderBatchWriter) error {
+			return pruner.PruneByBlockID(blockID, w)
+		}))
+
+		// verify the results and their index are pruned
+		pruned, err := results.ByBlockID(blockID)
+		require.NoError(t, err)
+		require.Empty(t, pruned)
+
+		_, err = results.ByBlockIDTransactionID(blockID, txResults[0].TransactionID)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		_, err = results.ByBlockIDTransactionIndex(blockID, 0)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
@@ -0,0 +1,87 @@
+package indexer
+
+import (
+	"github.com/onflow/flow-go/model/events"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/executiondatasync/execution_data"
+)
+
+// accountTransactionEntries builds the account transaction index entries for all transactions
+// within the provided block execution data, including the system chunk.
+//
+// An account is considered to be touched by a transaction if it is the payer, the proposer,
+// one of the authorizers or if one of its contracts emitted an event during the transaction.
+// The returned entries are ordered by transaction index, and by the order in which the account
+// was first encountered within the transaction.
+func accountTransactionEntries(height uint64, data *execution_data.BlockExecutionDataEntity) []flow.AccountTransaction {
+	entries := make([]flow.AccountTransaction, 0)
+
+	txIndex := uint32(0)
+	for _, chunk := range data.ChunkExecutionDatas {
+		if chunk.Collection == nil {
+			continue
+		}
+
+		// events of a chunk only reference transactions of the same chunk, so it's sufficient
+		// to track the entries of the current chunk's transactions
+		chunkEntries := make(map[uint32]map[flow.Address]int)
+
+		addRole := func(tx *flow.TransactionBody, index uint32, address flow.Address, role flow.TransactionRole) {
+			if address == flow.EmptyAddress {
+				return
+			}
+
+			txEntries, ok := chunkEntries[index]
+			if !ok {
+				txEntries = make(map[flow.Address]int)
+				chunkEntries[index] = txEntries
+			}
+
+			pos, ok := txEntries[address]
+			if !ok {
+				pos = len(entries)
+				txEntries[address] = pos
+				entries = append(entries, flow.AccountTransaction{
+					Address:          address,
+					BlockHeight:      height,
+					TransactionID:    tx.ID(),
+					TransactionIndex: index,
+				})
+			}
+
+			if !entries[pos].HasRole(role) {
+				entries[pos].Roles = append(entries[pos].Roles, role)
+			}
+		}
+
+		chunkTxs := make(map[uint32]*flow.TransactionBody, len(chunk.Collection.Transactions))
+		for _, tx := range chunk.Collection.Transactions {
+			chunkTxs[txIndex] = tx
+
+			addRole(tx, txIndex, tx.Payer, flow.TransactionRolePayer)
+			addRole(tx, txIndex, tx.ProposalKey.Address, flow.TransactionRoleProposer)
+			for _, authorizer := range tx.Authorizers {
+				addRole(tx, txIndex, authorizer, flow.TransactionRoleAuthorizer)
+			}
+
+			txIndex++
+		}
+
+		for _, event := range chunk.Events {
+			tx, ok := chunkTxs[event.TransactionIndex]
+			if !ok {
+				continue
+			}
+
+			parsed, err := events.ParseEvent(event.Type)
+			if err != nil || parsed.Type != events.AccountEventType {
+				// protocol events and malformed event types are not attributed to any account
+				continue
+			}
+
+			addRole(tx, event.TransactionIndex, flow.HexToAddress(parsed.Address), flow.TransactionRoleEventEmitter)
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,93 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/encoding/ccf"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/executiondatasync/execution_data"
+)
+
+// contractUpdateTypes maps the core contract events to the type of contract update they report.
+var contractUpdateTypes = map[flow.EventType]flow.ContractUpdateType{
+	flow.EventAccountContractAdded:   flow.ContractUpdateTypeAdded,
+	flow.EventAccountContractUpdated: flow.ContractUpdateTypeUpdated,
+	flow.EventAccountContractRemoved: flow.ContractUpdateTypeRemoved,
+}
+
+// contractUpdateEntries builds the contract update index entries for all core contract events within
+// the provided block execution data, in execution order.
+//
+// No errors are expected during normal operations.
+func contractUpdateEntries(height uint64, data *execution_data.BlockExecutionDataEntity) ([]flow.ContractUpdate, error) {
+	entries := make([]flow.ContractUpdate, 0)
+
+	for _, chunk := range data.ChunkExecutionDatas {
+		for _, event := range chunk.Events {
+			updateType, ok := contractUpdateTypes[event.Type]
+			if !ok {
+				continue
+			}
+
+			update, err := decodeContractUpdate(event)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode %s event of transaction %s: %w", event.Type, event.TransactionID, err)
+			}
+
+			update.Type = updateType
+			update.BlockHeight = height
+			entries = append(entries, update)
+		}
+	}
+
+	return entries, nil
+}
+
+// decodeContractUpdate decodes the address, contract name and code hash of a core contract event.
+func decodeContractUpdate(event flow.Event) (flow.ContractUpdate, error) {
+	value, err := ccf.Decode(nil, event.Payload)
+	if err != nil {
+		return flow.ContractUpdate{}, err
+	}
+
+	cdcEvent, ok := value.(cadence.Event)
+	if !ok {
+		return flow.ContractUpdate{}, fmt.Errorf("unexpected payload type %T", value)
+	}
+
+	fields := cadence.FieldsMappedByName(cdcEvent)
+
+	address, ok := fields["address"].(cadence.Address)
+	if !ok {
+		return flow.ContractUpdate{}, fmt.Errorf("missing address field")
+	}
+
+	name, ok := fields["contract"].(cadence.String)
+	if !ok {
+		return flow.ContractUpdate{}, fmt.Errorf("missing contract field")
+	}
+
+	codeHash, ok := fields["codeHash"].(cadence.Array)
+	if !ok {
+		return flow.ContractUpdate{}, fmt.Errorf("missing codeHash field")
+	}
+	hash := make([]byte, len(codeHash.Values))
+	for i, v := range codeHash.Values {
+		b, ok := v.(cadence.UInt8)
+		if !ok {
+			return flow.ContractUpdate{}, fmt.Errorf("invalid codeHash field")
+		}
+		hash[i] = byte(b)
+	}
+
+	return flow.ContractUpdate{
+		Address:          flow.Address(address),
+		ContractName:     string(name),
+		TransactionID:    event.TransactionID,
+		TransactionIndex: event.TransactionIndex,
+		EventIndex:       event.EventIndex,
+		CodeHash:         hash,
+	}, nil
+}
@@ -29,6 +29,8 @@ type IndexerCore struct {
 	collections  storage.Collections
 	transactions storage.Transactions
 	results      storage.LightTransactionResults
+	accountTxs   storage.AccountTransactions
+	contracts    storage.ContractUpdates
 	protocolDB   storage.DB
 
 	collectionExecutedMetric module.CollectionExecutedMetric
@@ -50,6 +52,8 @@ func New(
 	collections storage.Collections,
 	transactions storage.Transactions,
 	results storage.LightTransactionResults,
+	accountTxs storage.AccountTransactions,
+	contracts storage.ContractUpdates,
 	chain flow.Chain,
 	derivedChainData *derived.DerivedChainData,
 	collectionExecutedMetric module.CollectionExecutedMetric,
@@ -72,6 +76,8 @@ func New(
 		transactions:     transactions,
 		events:           events,
 		results:          results,
+		accountTxs:       accountTxs,
+		contracts:        contracts,
 		serviceAddress:   chain.ServiceAddress(),
 		derivedChainData: derivedChainData,
 
@@ -156,6 +162,21 @@ func (c *IndexerCore) IndexBlockData(data *execution_data.BlockExecutionDataEnti
 			return fmt.Errorf("could not index transaction results at height %d: %w", header.Height, err)
 		}
 
+		accountTxs := accountTransactionEntries(header.Height, data)
+		err = c.accountTxs.BatchStore(accountTxs, batch)
+		if err != nil {
+			return fmt.Errorf("could not index account transactions at height %d: %w", header.Height, err)
+		}
+
+		contractUpdates, err := contractUpdateEntries(header.Height, data)
+		if err != nil {
+			return fmt.Errorf("could not build contract updates at height %d: %w", header.Height, err)
+		}
+		err = c.contracts.BatchStore(contractUpdates, batch)
+		if err != nil {
+			return fmt.Errorf("could not index contract updates at height %d: %w", header.Height, err)
+		}
+
 		err = batch.Commit()
 		if err != nil {
 			return fmt.Errorf("batch flush error: %w", err)
@@ -167,6 +188,8 @@ func (c *IndexerCore) IndexBlockData(data *execution_data.BlockExecutionDataEnti
 		lg.Debug().
 			Int("event_count", eventCount).
 			Int("result_count", resultCount).
+			Int("account_transaction_count", len(accountTxs)).
+			Int("contract_update_count", len(contractUpdates)).
 			Dur("duration_ms", time.Since(start)).
 			Msg("indexed badger data")
 
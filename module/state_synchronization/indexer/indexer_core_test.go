@@ -28,6 +28,7 @@ import (
 	"github.com/onflow/flow-go/storage/operation/badgerimpl"
 	pebbleStorage "github.com/onflow/flow-go/storage/pebble"
 	"github.com/onflow/flow-go/utils/unittest"
+	"github.com/onflow/flow-go/utils/unittest/generator"
 )
 
 type indexCoreTest struct {
@@ -39,6 +40,8 @@ type indexCoreTest struct {
 	collections      *storagemock.Collections
 	transactions     *storagemock.Transactions
 	results          *storagemock.LightTransactionResults
+	accountTxs       *storagemock.AccountTransactions
+	contractUpdates  *storagemock.ContractUpdates
 	headers          *storagemock.Headers
 	ctx              context.Context
 	blocks           []*flow.Block
@@ -59,17 +62,19 @@ func newIndexCoreTest(
 	collection := unittest.CollectionFixture(0)
 
 	return &indexCoreTest{
-		t:            t,
-		registers:    storagemock.NewRegisterIndex(t),
-		events:       storagemock.NewEvents(t),
-		collection:   &collection,
-		results:      storagemock.NewLightTransactionResults(t),
-		collections:  storagemock.NewCollections(t),
-		transactions: storagemock.NewTransactions(t),
-		blocks:       blocks,
-		ctx:          context.Background(),
-		data:         exeData,
-		headers:      newBlockHeadersStorage(blocks).(*storagemock.Headers), // convert it back to mock type for tests,
+		t:               t,
+		registers:       storagemock.NewRegisterIndex(t),
+		events:          storagemock.NewEvents(t),
+		collection:      &collection,
+		results:         storagemock.NewLightTransactionResults(t),
+		accountTxs:      storagemock.NewAccountTransactions(t),
+		contractUpdates: storagemock.NewContractUpdates(t),
+		collections:     storagemock.NewCollections(t),
+		transactions:    storagemock.NewTransactions(t),
+		blocks:          blocks,
+		ctx:             context.Background(),
+		data:            exeData,
+		headers:         newBlockHeadersStorage(blocks).(*storagemock.Headers), // convert it back to mock type for tests,
 	}
 }
 
@@ -151,6 +156,26 @@ func (i *indexCoreTest) setStoreTransactionResults(f func(*testing.T, flow.Ident
 	return i
 }
 
+func (i *indexCoreTest) setStoreAccountTransactions(f func(*testing.T, []flow.AccountTransaction) error) *indexCoreTest {
+	i.accountTxs.
+		On("BatchStore", mock.AnythingOfType("[]flow.AccountTransaction"), mock.Anything).
+		Return(func(txs []flow.AccountTransaction, batch storage.ReaderBatchWriter) error {
+			require.NotNil(i.t, batch)
+			return f(i.t, txs)
+		})
+	return i
+}
+
+func (i *indexCoreTest) setStoreContractUpdates(f func(*testing.T, []flow.ContractUpdate) error) *indexCoreTest {
+	i.contractUpdates.
+		On("BatchStore", mock.AnythingOfType("[]flow.ContractUpdate"), mock.Anything).
+		Return(func(updates []flow.ContractUpdate, batch storage.ReaderBatchWriter) error {
+			require.NotNil(i.t, batch)
+			return f(i.t, updates)
+		})
+	return i
+}
+
 func (i *indexCoreTest) setGetRegisters(f func(t *testing.T, ID flow.RegisterID, height uint64) (flow.RegisterValue, error)) *indexCoreTest {
 	i.registers.
 		On("Get", mock.AnythingOfType("flow.RegisterID"), mock.AnythingOfType("uint64")).
@@ -182,6 +207,20 @@ func (i *indexCoreTest) useDefaultTransactionResults() *indexCoreTest {
 	return i
 }
 
+func (i *indexCoreTest) useDefaultAccountTransactions() *indexCoreTest {
+	i.accountTxs.
+		On("BatchStore", mock.AnythingOfType("[]flow.AccountTransaction"), mock.Anything).
+		Return(nil)
+	return i
+}
+
+func (i *indexCoreTest) useDefaultContractUpdates() *indexCoreTest {
+	i.contractUpdates.
+		On("BatchStore", mock.AnythingOfType("[]flow.ContractUpdate"), mock.Anything).
+		Return(nil)
+	return i
+}
+
 func (i *indexCoreTest) initIndexer() *indexCoreTest {
 	db, dbDir := unittest.TempBadgerDB(i.t)
 	i.t.Cleanup(func() {
@@ -228,6 +267,8 @@ func (i *indexCoreTest) initIndexer() *indexCoreTest {
 		i.collections,
 		i.transactions,
 		i.results,
+		i.accountTxs,
+		i.contractUpdates,
 		flow.Testnet.Chain(),
 		derivedChainData,
 		collectionExecutedMetric,
@@ -269,6 +310,8 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
+			useDefaultContractUpdates().
 			useDefaultEvents().
 			useDefaultTransactionResults().
 			// make sure update registers match in length and are same as block data ledger payloads
@@ -318,6 +361,8 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 		testRegisterFound := false
 		err = newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
+			useDefaultContractUpdates().
 			useDefaultEvents().
 			useDefaultStorageMocks().
 			useDefaultTransactionResults().
@@ -360,6 +405,8 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
+			useDefaultContractUpdates().
 			useDefaultStorageMocks().
 			// make sure all events are stored at once in order
 			setStoreEvents(func(t *testing.T, actualBlockID flow.Identifier, actualEvents []flow.EventsList) error {
@@ -408,6 +455,8 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
+			useDefaultContractUpdates().
 			useDefaultStorageMocks().
 			// make sure an empty set of events were stored
 			setStoreEvents(func(t *testing.T, actualBlockID flow.Identifier, actualEvents []flow.EventsList) error {
@@ -448,6 +497,8 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 		execData := execution_data.NewBlockExecutionDataEntity(block.ID(), ed)
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
+			useDefaultContractUpdates().
 			useDefaultStorageMocks().
 			// make sure an empty set of events were stored
 			setStoreEvents(func(t *testing.T, actualBlockID flow.Identifier, actualEvents []flow.EventsList) error {
@@ -503,6 +554,8 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 		execData := execution_data.NewBlockExecutionDataEntity(block.ID(), ed)
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
+			useDefaultContractUpdates().
 			useDefaultStorageMocks().
 			// make sure all events are stored at once in order
 			setStoreEvents(func(t *testing.T, actualBlockID flow.Identifier, actualEvents []flow.EventsList) error {
@@ -537,6 +590,151 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Index Account Transactions", func(t *testing.T) {
+		payer := unittest.RandomAddressFixture()
+		proposer := unittest.RandomAddressFixture()
+		authorizer := unittest.RandomAddressFixture()
+		emitter := unittest.RandomAddressFixture()
+
+		tx1 := unittest.TransactionBodyFixture(func(tx *flow.TransactionBody) {
+			tx.Payer = payer
+			tx.ProposalKey.Address = payer
+			tx.Authorizers = []flow.Address{authorizer}
+		})
+		tx2 := unittest.TransactionBodyFixture(func(tx *flow.TransactionBody) {
+			tx.Payer = payer
+			tx.ProposalKey.Address = proposer
+			tx.Authorizers = []flow.Address{authorizer, proposer}
+		})
+		systemTx := unittest.TransactionBodyFixture(func(tx *flow.TransactionBody) {
+			tx.Payer = flow.EmptyAddress
+			tx.ProposalKey = flow.ProposalKey{}
+			tx.Authorizers = []flow.Address{authorizer}
+		})
+
+		emitterEventType := flow.EventType(fmt.Sprintf("A.%s.Contract.Event", emitter.Hex()))
+		authorizerEventType := flow.EventType(fmt.Sprintf("A.%s.Contract.Event", authorizer.Hex()))
+
+		ed := &execution_data.BlockExecutionData{
+			BlockID: block.ID(),
+			ChunkExecutionDatas: []*execution_data.ChunkExecutionData{
+				{
+					Collection: &flow.Collection{Transactions: []*flow.TransactionBody{&tx1, &tx2}},
+					Events: []flow.Event{
+						unittest.EventFixture(emitterEventType, 0, 0, tx1.ID(), 0),
+						unittest.EventFixture(authorizerEventType, 1, 1, tx2.ID(), 0),
+						unittest.EventFixture(flow.EventAccountCreated, 1, 2, tx2.ID(), 0),
+					},
+				},
+				{
+					Collection: &flow.Collection{Transactions: []*flow.TransactionBody{&systemTx}},
+					Events: []flow.Event{
+						unittest.EventFixture(emitterEventType, 2, 0, systemTx.ID(), 0),
+					},
+				},
+			},
+		}
+		execData := execution_data.NewBlockExecutionDataEntity(block.ID(), ed)
+
+		height := block.Header.Height
+		expected := []flow.AccountTransaction{
+			{Address: payer, BlockHeight: height, TransactionID: tx1.ID(), TransactionIndex: 0, Roles: []flow.TransactionRole{flow.TransactionRolePayer, flow.TransactionRoleProposer}},
+			{Address: authorizer, BlockHeight: height, TransactionID: tx1.ID(), TransactionIndex: 0, Roles: []flow.TransactionRole{flow.TransactionRoleAuthorizer}},
+			{Address: payer, BlockHeight: height, TransactionID: tx2.ID(), TransactionIndex: 1, Roles: []flow.TransactionRole{flow.TransactionRolePayer}},
+			{Address: proposer, BlockHeight: height, TransactionID: tx2.ID(), TransactionIndex: 1, Roles: []flow.TransactionRole{flow.TransactionRoleProposer, flow.TransactionRoleAuthorizer}},
+			{Address: authorizer, BlockHeight: height, TransactionID: tx2.ID(), TransactionIndex: 1, Roles: []flow.TransactionRole{flow.TransactionRoleAuthorizer, flow.TransactionRoleEventEmitter}},
+			{Address: emitter, BlockHeight: height, TransactionID: tx1.ID(), TransactionIndex: 0, Roles: []flow.TransactionRole{flow.TransactionRoleEventEmitter}},
+			{Address: authorizer, BlockHeight: height, TransactionID: systemTx.ID(), TransactionIndex: 2, Roles: []flow.TransactionRole{flow.TransactionRoleAuthorizer}},
+			{Address: emitter, BlockHeight: height, TransactionID: systemTx.ID(), TransactionIndex: 2, Roles: []flow.TransactionRole{flow.TransactionRoleEventEmitter}},
+		}
+
+		err := newIndexCoreTest(t, blocks, execData).
+			initIndexer().
+			useDefaultStorageMocks().
+			useDefaultEvents().
+			useDefaultTransactionResults().
+			useDefaultContractUpdates().
+			// make sure all account transactions are stored at once
+			setStoreAccountTransactions(func(t *testing.T, actual []flow.AccountTransaction) error {
+				assert.Equal(t, expected, actual)
+				return nil
+			}).
+			setStoreRegisters(func(t *testing.T, entries flow.RegisterEntries, height uint64) error {
+				return nil
+			}).
+			runIndexBlockData()
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Index Contract Updates", func(t *testing.T) {
+		address := unittest.RandomAddressFixture()
+
+		added := generator.GenerateAccountContractEvent(t, "AccountContractAdded", address)
+		added.TransactionIndex = 0
+		added.EventIndex = 1
+		updated := generator.GenerateAccountContractEvent(t, "AccountContractUpdated", address)
+		updated.TransactionIndex = 1
+		updated.EventIndex = 0
+
+		ed := &execution_data.BlockExecutionData{
+			BlockID: block.ID(),
+			ChunkExecutionDatas: []*execution_data.ChunkExecutionData{
+				{
+					Collection: &flow.Collection{},
+					Events: []flow.Event{
+						unittest.EventFixture(flow.EventAccountCreated, 0, 0, added.TransactionID, 0),
+						added,
+						updated,
+					},
+				},
+			},
+		}
+		execData := execution_data.NewBlockExecutionDataEntity(block.ID(), ed)
+
+		codeHash := []byte{111, 43, 164, 202, 220, 174, 148, 17, 253, 161, 9, 124, 237, 83, 227, 75, 115, 149, 141, 83, 129, 145, 252, 68, 122, 137, 80, 155, 89, 233, 136, 213}
+		expected := []flow.ContractUpdate{
+			{
+				Address:          address,
+				ContractName:     "EventContract",
+				Type:             flow.ContractUpdateTypeAdded,
+				BlockHeight:      block.Header.Height,
+				TransactionID:    added.TransactionID,
+				TransactionIndex: 0,
+				EventIndex:       1,
+				CodeHash:         codeHash,
+			},
+			{
+				Address:          address,
+				ContractName:     "EventContract",
+				Type:             flow.ContractUpdateTypeUpdated,
+				BlockHeight:      block.Header.Height,
+				TransactionID:    updated.TransactionID,
+				TransactionIndex: 1,
+				EventIndex:       0,
+				CodeHash:         codeHash,
+			},
+		}
+
+		err := newIndexCoreTest(t, blocks, execData).
+			initIndexer().
+			useDefaultStorageMocks().
+			useDefaultEvents().
+			useDefaultTransactionResults().
+			useDefaultAccountTransactions().
+			// make sure all contract updates are stored at once in execution order
+			setStoreContractUpdates(func(t *testing.T, actual []flow.ContractUpdate) error {
+				assert.Equal(t, expected, actual)
+				return nil
+			}).
+			setStoreRegisters(func(t *testing.T, entries flow.RegisterEntries, height uint64) error {
+				return nil
+			}).
+			runIndexBlockData()
+
+		assert.NoError(t, err)
+	})
+
 	// this test makes sure we get correct error when we try to index block that is not
 	// within the range of indexed heights.
 	t.Run("Invalid Heights", func(t *testing.T) {
@@ -706,6 +904,8 @@ func TestIndexerIntegration_StoreAndGet(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 				flow.Testnet.Chain(),
 				derivedChainData,
 				nil,
@@ -740,6 +940,8 @@ func TestIndexerIntegration_StoreAndGet(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 				flow.Testnet.Chain(),
 				derivedChainData,
 				nil,
@@ -767,6 +969,8 @@ func TestIndexerIntegration_StoreAndGet(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 				flow.Testnet.Chain(),
 				derivedChainData,
 				nil,
@@ -811,6 +1015,8 @@ func TestIndexerIntegration_StoreAndGet(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 				flow.Testnet.Chain(),
 				derivedChainData,
 				nil,
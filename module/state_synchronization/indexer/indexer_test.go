@@ -57,6 +57,8 @@ func newIndexerTest(t *testing.T, availableBlocks int, lastIndexedIndex int) *in
 		useDefaultBlockByHeight().
 		useDefaultEvents().
 		useDefaultTransactionResults().
+		useDefaultAccountTransactions().
+		useDefaultContractUpdates().
 		initIndexer()
 
 	executionData := mempool.NewExecutionData(t)
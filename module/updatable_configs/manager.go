@@ -55,6 +55,7 @@ type (
 	SetBoolConfigFunc           func(bool) error
 	SetDurationConfigFunc       func(time.Duration) error
 	SetIdentifierListConfigFunc func(flow.IdentifierList) error
+	SetUintMapConfigFunc        func(map[string]uint) error
 
 	// Get*ConfigFunc is a getter function for a single updatable config field.
 
@@ -62,6 +63,7 @@ type (
 	GetBoolConfigFunc           func() bool
 	GetDurationConfigFunc       func() time.Duration
 	GetIdentifierListConfigFunc func() flow.IdentifierList
+	GetUintMapConfigFunc        func() map[string]uint
 )
 
 // Field represents one dynamically configurable config field.
@@ -139,6 +141,9 @@ type Registrar interface {
 	// RegisterIdentifierListConfig registers a new []Identifier config
 	// Returns ErrAlreadyRegistered if a config is already registered with name.
 	RegisterIdentifierListConfig(name string, get GetIdentifierListConfigFunc, set SetIdentifierListConfigFunc) error
+	// RegisterUintMapConfig registers a new map[string]uint config
+	// Returns ErrAlreadyRegistered if a config is already registered with name.
+	RegisterUintMapConfig(name string, get GetUintMapConfigFunc, set SetUintMapConfigFunc) error
 }
 
 // RegisterBoolConfig registers a new bool config.
@@ -273,3 +278,45 @@ func (m *Manager) RegisterIdentifierListConfig(name string, get GetIdentifierLis
 	m.fields[field.Name] = field
 	return nil
 }
+
+// RegisterUintMapConfig registers a new map[string]uint config
+// Setter inputs must be map[string]any-typed values, with float64 elements which will be truncated if not integral.
+// Returns ErrAlreadyRegistered if a config is already registered with name.
+func (m *Manager) RegisterUintMapConfig(name string, get GetUintMapConfigFunc, set SetUintMapConfigFunc) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.fields[name]; exists {
+		return fmt.Errorf("can't register config %s: %w", name, ErrAlreadyRegistered)
+	}
+
+	field := Field{
+		Name:     name,
+		TypeName: "map[string]uint",
+		Get: func() any {
+			val := get()
+			detyped := make(map[string]any, len(val))
+			for key, elem := range val {
+				detyped[key] = elem
+			}
+			return detyped
+		},
+		Set: func(val any) error {
+			mval, ok := val.(map[string]any)
+			if !ok {
+				return NewValidationErrorf("invalid type for map[string]uint config: %T", val)
+			}
+			uvals := make(map[string]uint, len(mval))
+			for key, elem := range mval {
+				fval, ok := elem.(float64) // JSON numbers always parse to float64
+				if !ok || fval < 0 {
+					return NewValidationErrorf("invalid element %v for key %s in map[string]uint config - should be a non-negative number", elem, key)
+				}
+				uvals[key] = uint(fval)
+			}
+			return set(uvals)
+		},
+	}
+	m.fields[field.Name] = field
+	return nil
+}
@@ -152,3 +152,38 @@ func TestManager_RegisterIdentifierListConfig(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, util.CheckClosed(fieldSet))
 }
+
+func TestManager_RegisterUintMapConfig(t *testing.T) {
+	mgr := updatable_configs.NewManager()
+
+	// should be able to register config
+	var fieldVal map[string]uint
+	err := mgr.RegisterUintMapConfig("field",
+		func() map[string]uint { return map[string]uint{"a": 1} },
+		func(val map[string]uint) error { fieldVal = val; return nil })
+	require.NoError(t, err)
+
+	// should be able to get the field
+	field, ok := mgr.GetField("field")
+	assert.True(t, ok)
+	// field must be parseable by structpb (otherwise admin server will error)
+	_, err = structpb.NewValue(field.Get())
+	require.NoError(t, err)
+
+	// should fail to set incorrect type
+	err = field.Set(struct{}{})
+	assert.Error(t, err)
+	assert.True(t, updatable_configs.IsValidationError(err))
+	// should fail to set with correct type, but invalid elements
+	err = field.Set(map[string]any{"a": "1"})
+	assert.Error(t, err)
+	assert.True(t, updatable_configs.IsValidationError(err))
+	err = field.Set(map[string]any{"a": float64(-1)})
+	assert.Error(t, err)
+	assert.True(t, updatable_configs.IsValidationError(err))
+
+	// should succeed setting correct type
+	err = field.Set(map[string]any{"a": float64(1), "b": float64(2)}) // JSON uints parse to float64
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]uint{"a": 1, "b": 2}, fieldVal)
+}
@@ -39,6 +39,9 @@ var (
 	// ErrHeartBeatIntervalNotSet is returned when the heartbeat interval is not set, it is a fatal irrecoverable error,
 	// and the ALSP module cannot be initialized.
 	ErrHeartBeatIntervalNotSet = errors.New("heartbeat interval is not set")
+	// ErrSnapshotIntervalNotSet is returned when the spam record store is set but the snapshot interval is not set, it is a fatal irrecoverable error,
+	// and the ALSP module cannot be initialized.
+	ErrSnapshotIntervalNotSet = errors.New("snapshot interval is not set")
 )
 
 type SpamRecordCacheFactory func(zerolog.Logger, uint32, module.HeroCacheMetrics) alsp.SpamRecordCache
@@ -91,9 +94,16 @@ type MisbehaviorReportManager struct {
 
 	// decayFunc is the function that calculates the decay of the spam record.
 	decayFunc SpamRecordDecayFunc
+
+	// store is the persistent store for the snapshots of the spam records. When nil, the spam records are kept in memory only.
+	store alsp.SpamRecordStore
+	// heartBeatInterval is the interval between the heartbeats, it is used to apply the decay for the downtime of the node
+	// when restoring the spam records from the store.
+	heartBeatInterval time.Duration
 }
 
 var _ network.MisbehaviorReportManager = (*MisbehaviorReportManager)(nil)
+var _ alsp.SpamRecordAdmin = (*MisbehaviorReportManager)(nil)
 
 type MisbehaviorReportManagerConfig struct {
 	Logger zerolog.Logger
@@ -119,7 +129,14 @@ type MisbehaviorReportManagerConfig struct {
 	// HeartBeatInterval is the interval between the heartbeats. Heartbeat is a recurring event that is used to
 	// apply recurring actions, e.g., decay the penalty of the misbehaving nodes.
 	HeartBeatInterval time.Duration
-	Opts              []MisbehaviorReportManagerOption
+	// SpamRecordStore is the persistent store for the snapshots of the spam records. When set, the spam records are restored
+	// from the store upon startup (with the decay applied for the downtime of the node), and are snapshotted to the store
+	// periodically and upon shutdown. When nil, the spam records are kept in memory only and are lost upon restart.
+	SpamRecordStore alsp.SpamRecordStore
+	// SnapshotInterval is the interval between two consecutive snapshots of the spam records. It is only used when the
+	// SpamRecordStore is set.
+	SnapshotInterval time.Duration
+	Opts             []MisbehaviorReportManagerOption
 }
 
 // validate validates the MisbehaviorReportManagerConfig instance. It returns an error if the config is invalid.
@@ -142,6 +159,9 @@ func (c MisbehaviorReportManagerConfig) validate() error {
 	if c.HeartBeatInterval == 0 {
 		return ErrHeartBeatIntervalNotSet
 	}
+	if c.SpamRecordStore != nil && c.SnapshotInterval == 0 {
+		return ErrSnapshotIntervalNotSet
+	}
 	return nil
 }
 
@@ -172,6 +192,8 @@ func NewMisbehaviorReportManager(cfg *MisbehaviorReportManagerConfig, consumer n
 		disallowListingConsumer: consumer,
 		cacheFactory:            defaultSpamRecordCacheFactory(),
 		decayFunc:               defaultSpamRecordDecayFunc(),
+		store:                   cfg.SpamRecordStore,
+		heartBeatInterval:       cfg.HeartBeatInterval,
 	}
 
 	store := queue.NewHeroStore(
@@ -195,9 +217,23 @@ func NewMisbehaviorReportManager(cfg *MisbehaviorReportManagerConfig, consumer n
 
 	builder := component.NewComponentManagerBuilder()
 	builder.AddWorker(func(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+		if m.store != nil {
+			// spam records are restored before the first heartbeat, so that the decay of the restored records
+			// continues from where it was left off.
+			if err := m.restoreSpamRecords(); err != nil {
+				ctx.Throw(fmt.Errorf("failed to restore spam records: %w", err))
+				return
+			}
+		}
 		ready()
 		m.heartbeatLoop(ctx, cfg.HeartBeatInterval) // blocking call
 	})
+	if m.store != nil {
+		builder.AddWorker(func(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+			ready()
+			m.snapshotLoop(ctx, cfg.SnapshotInterval) // blocking call
+		})
+	}
 	for i := 0; i < defaultMisbehaviorReportManagerWorkers; i++ {
 		builder.AddWorker(m.workerPool.WorkerLogic())
 	}
@@ -393,6 +429,225 @@ func (m *MisbehaviorReportManager) onHeartbeat() error {
 	return nil
 }
 
+// snapshotLoop snapshots the spam records to the store at the given intervals. It is a blocking function, and
+// should be called in a separate goroutine. It returns when the context is canceled, after taking a final snapshot
+// so that the latest state of the spam records survives the shutdown of the node.
+// Args:
+//
+//	ctx: the context.
+//	interval: the interval between two snapshots.
+//
+// Returns:
+//
+//	none.
+func (m *MisbehaviorReportManager) snapshotLoop(ctx irrecoverable.SignalerContext, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	m.logger.Info().Dur("interval", interval).Msg("starting spam records snapshots")
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := m.snapshotSpamRecords(); err != nil {
+				// the node is shutting down, hence the error is logged rather than thrown.
+				m.logger.Error().Err(err).Msg("failed to snapshot spam records on shutdown")
+				return
+			}
+			m.logger.Debug().Msg("spam records snapshots stopped")
+			return
+		case <-ticker.C:
+			if err := m.snapshotSpamRecords(); err != nil {
+				// any error returned from snapshotSpamRecords is considered irrecoverable.
+				ctx.Throw(fmt.Errorf("failed to snapshot spam records: %w", err))
+			}
+		}
+	}
+}
+
+// snapshotSpamRecords persists a snapshot of the current spam records to the store.
+// Returns:
+//
+//	error: if an error occurs, it is returned. No error is expected during normal operation.
+func (m *MisbehaviorReportManager) snapshotSpamRecords() error {
+	records := m.SpamRecords()
+	err := m.store.Store(&model.SpamRecordsSnapshot{
+		Records:   records,
+		Timestamp: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store spam records snapshot: %w", err)
+	}
+	m.logger.Trace().Int("records", len(records)).Msg("spam records snapshotted")
+	return nil
+}
+
+// restoreSpamRecords restores the spam records from the last snapshot in the store, if any. The penalty of each
+// restored record is decayed for the downtime of the node, i.e., the time elapsed since the snapshot, as if the node
+// had kept performing the heartbeats. All records are restored, including the fully decayed ones, so that the cutoff
+// counter and the adjusted decay speed of the repeat offenders survive restarts. The nodes that are still disallow-listed
+// are reported to the disallow-listing consumer again, as the disallow-list of the networking layer does not survive restarts.
+// Returns:
+//
+//	error: if an error occurs, it is returned. No error is expected during normal operation. Any returned error must
+//	be considered as irrecoverable.
+func (m *MisbehaviorReportManager) restoreSpamRecords() error {
+	snapshot, ok, err := m.store.Retrieve()
+	if err != nil {
+		return fmt.Errorf("failed to retrieve spam records snapshot: %w", err)
+	}
+	if !ok {
+		m.logger.Info().Msg("no spam records snapshot found, starting with empty spam records")
+		return nil
+	}
+
+	downtime := time.Since(snapshot.Timestamp)
+	heartbeats := uint64(0)
+	if downtime > 0 {
+		heartbeats = uint64(downtime / m.heartBeatInterval)
+	}
+
+	for _, persisted := range snapshot.Records {
+		record := m.decayForDowntime(persisted, heartbeats)
+
+		_, err := m.cache.AdjustWithInit(record.OriginId, func(model.ProtocolSpamRecord) (model.ProtocolSpamRecord, error) {
+			return record, nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to restore spam record %x: %w", record.OriginId, err)
+		}
+
+		if record.DisallowListed {
+			m.logger.Warn().
+				Str("key", logging.KeySuspicious).
+				Hex("identifier", logging.ID(record.OriginId)).
+				Float64("penalty", record.Penalty).
+				Uint64("cutoff_counter", record.CutoffCounter).
+				Float64("decay_speed", record.Decay).
+				Msg("restored spam record of a disallow-listed node, re-initiating disallow listing")
+			m.disallowListingConsumer.OnDisallowListNotification(&network.DisallowListingUpdate{
+				FlowIds: flow.IdentifierList{record.OriginId},
+				Cause:   network.DisallowListedCauseAlsp,
+			})
+		}
+	}
+
+	m.logger.Info().
+		Int("restored_records", len(snapshot.Records)).
+		Dur("downtime", downtime).
+		Msg("spam records restored from snapshot")
+	return nil
+}
+
+// decayForDowntime returns the given spam record decayed by the given number of heartbeats, using the same decay function
+// as the heartbeats. The decay stops once the penalty no longer changes, e.g., once it is fully decayed to zero.
+// A record whose penalty is below the disallow-listing threshold but is not yet disallow-listed is returned as is, so that
+// the disallow-listing of the node is carried out by the next heartbeat.
+func (m *MisbehaviorReportManager) decayForDowntime(record model.ProtocolSpamRecord, heartbeats uint64) model.ProtocolSpamRecord {
+	if record.Penalty < model.DisallowListingThreshold && !record.DisallowListed {
+		return record
+	}
+	for i := uint64(0); i < heartbeats; i++ {
+		penalty := m.decayFunc(record)
+		if penalty == record.Penalty {
+			break
+		}
+		record.Penalty = penalty
+	}
+	if record.Penalty == float64(0) {
+		record.DisallowListed = false
+	}
+	return record
+}
+
+// SpamRecords returns a copy of all spam records currently tracked by the manager.
+// The implementation is thread-safe.
+func (m *MisbehaviorReportManager) SpamRecords() []model.ProtocolSpamRecord {
+	ids := m.cache.Identities()
+	records := make([]model.ProtocolSpamRecord, 0, len(ids))
+	for _, id := range ids {
+		record, ok := m.cache.Get(id)
+		if !ok {
+			// the record is removed in the meantime.
+			continue
+		}
+		records = append(records, *record)
+	}
+	return records
+}
+
+// DisallowListNode manually disallow-lists the given node. The penalty of the node is set to the disallow-listing threshold,
+// while its cutoff counter and decay speed are retained. Hence, the node is allow-listed again by the heartbeats once its penalty
+// decays back to zero, unless it is allow-listed manually before that.
+// The implementation is thread-safe.
+// No errors are expected during normal operations.
+func (m *MisbehaviorReportManager) DisallowListNode(nodeId flow.Identifier) error {
+	_, err := m.cache.AdjustWithInit(nodeId, func(record model.ProtocolSpamRecord) (model.ProtocolSpamRecord, error) {
+		record.Penalty = model.DisallowListingThreshold
+		record.DisallowListed = true
+		return record, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to disallow-list node %x: %w", nodeId, err)
+	}
+
+	m.logger.Warn().
+		Str("key", logging.KeySuspicious).
+		Hex("identifier", logging.ID(nodeId)).
+		Msg("node manually disallow-listed")
+	m.disallowListingConsumer.OnDisallowListNotification(&network.DisallowListingUpdate{
+		FlowIds: flow.IdentifierList{nodeId},
+		Cause:   network.DisallowListedCauseAlsp,
+	})
+	return nil
+}
+
+// AllowListNode manually allow-lists the given node. The penalty of the node is set to zero, while its cutoff counter and decay speed
+// are retained, so that subsequent misbehavior of the node is still treated as a repeated offence.
+// The implementation is thread-safe.
+// No errors are expected during normal operations.
+func (m *MisbehaviorReportManager) AllowListNode(nodeId flow.Identifier) error {
+	if _, ok := m.cache.Get(nodeId); ok {
+		_, err := m.cache.AdjustWithInit(nodeId, func(record model.ProtocolSpamRecord) (model.ProtocolSpamRecord, error) {
+			record.Penalty = 0
+			record.DisallowListed = false
+			return record, nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to allow-list node %x: %w", nodeId, err)
+		}
+	}
+
+	m.logger.Info().
+		Hex("identifier", logging.ID(nodeId)).
+		Msg("node manually allow-listed")
+	m.disallowListingConsumer.OnAllowListNotification(&network.AllowListingUpdate{
+		FlowIds: flow.IdentifierList{nodeId},
+		Cause:   network.DisallowListedCauseAlsp,
+	})
+	return nil
+}
+
+// ResetSpamRecord removes the spam record of the given node, giving the node a clean slate. If the node is disallow-listed,
+// it is allow-listed.
+// The implementation is thread-safe.
+// Returns true if the record existed and is removed, false otherwise.
+func (m *MisbehaviorReportManager) ResetSpamRecord(nodeId flow.Identifier) bool {
+	record, ok := m.cache.Get(nodeId)
+	removed := m.cache.Remove(nodeId)
+
+	if ok && record.DisallowListed {
+		m.disallowListingConsumer.OnAllowListNotification(&network.AllowListingUpdate{
+			FlowIds: flow.IdentifierList{nodeId},
+			Cause:   network.DisallowListedCauseAlsp,
+		})
+	}
+
+	m.logger.Info().
+		Hex("identifier", logging.ID(nodeId)).
+		Bool("removed", removed).
+		Msg("spam record manually reset")
+	return removed
+}
+
 // processMisbehaviorReport is the worker function that processes the misbehavior reports.
 // It is called by the worker pool.
 // It applies the penalty to the misbehaving node and updates the spam record cache.
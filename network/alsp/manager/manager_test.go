@@ -33,6 +33,8 @@ import (
 	p2ptest "github.com/onflow/flow-go/network/p2p/test"
 	"github.com/onflow/flow-go/network/slashing"
 	"github.com/onflow/flow-go/network/underlay"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -704,6 +706,16 @@ func TestMisbehaviorReportManager_InitializationError(t *testing.T) {
 		require.ErrorIs(t, err, alspmgr.ErrSpamRecordCacheSizeNotSet)
 		assert.Nil(t, m)
 	})
+
+	t.Run("missing snapshot interval", func(t *testing.T) {
+		cfg := managerCfgFixture(t)
+		cfg.SpamRecordStore = mockalsp.NewSpamRecordStore(t)
+		cfg.SnapshotInterval = 0
+		m, err := alspmgr.NewMisbehaviorReportManager(cfg, consumer)
+		require.Error(t, err)
+		require.ErrorIs(t, err, alspmgr.ErrSnapshotIntervalNotSet)
+		assert.Nil(t, m)
+	})
 }
 
 // TestHandleMisbehaviorReport_SinglePenaltyReport tests the handling of a single misbehavior report.
@@ -1740,6 +1752,291 @@ func TestDisallowListNotification(t *testing.T) {
 	}, 2*time.Second, 10*time.Millisecond, "ALSP manager did not handle the misbehavior report")
 }
 
+// TestRestoreSpamRecords_DowntimeDecay tests that the ALSP manager restores the spam records from the last snapshot upon startup,
+// with the penalties decayed for the downtime of the node. The test ensures that fully decayed records are restored along with their
+// cutoff counter and decay speed, the nodes that are still disallow-listed are reported to the network layer again, and the records
+// that are below the disallow-listing threshold but not yet disallow-listed are left to the heartbeats to disallow-list.
+func TestRestoreSpamRecords_DowntimeDecay(t *testing.T) {
+	cfg := managerCfgFixture(t)
+	consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+	store := mockalsp.NewSpamRecordStore(t)
+	cfg.SpamRecordStore = store
+	cfg.SnapshotInterval = time.Hour // only the final snapshot upon shutdown is taken during the test.
+
+	var cache alsp.SpamRecordCache
+	cfg.Opts = []alspmgr.MisbehaviorReportManagerOption{
+		alspmgr.WithSpamRecordsCacheFactory(func(logger zerolog.Logger, size uint32, metrics module.HeroCacheMetrics) alsp.SpamRecordCache {
+			cache = internal.NewSpamRecordCache(size, logger, metrics, model.SpamRecordFactory())
+			return cache
+		}),
+	}
+
+	// still disallow-listed after the downtime, as it decays by 1 per heartbeat.
+	disallowListed := model.ProtocolSpamRecord{
+		OriginId:       unittest.IdentifierFixture(),
+		Decay:          1,
+		CutoffCounter:  4,
+		DisallowListed: true,
+		Penalty:        model.DisallowListingThreshold,
+	}
+	// disallow-listed at the time of snapshot, but fully recovered during the downtime.
+	recovered := model.ProtocolSpamRecord{
+		OriginId:       unittest.IdentifierFixture(),
+		Decay:          model.InitialDecaySpeed,
+		CutoffCounter:  1,
+		DisallowListed: true,
+		Penalty:        -5 * model.InitialDecaySpeed,
+	}
+	// penalized at the time of snapshot, but fully recovered during the downtime.
+	penalized := model.ProtocolSpamRecord{
+		OriginId: unittest.IdentifierFixture(),
+		Decay:    model.InitialDecaySpeed,
+		Penalty:  model.DefaultPenaltyValue,
+	}
+	// dropped below the threshold right before the snapshot, but not yet disallow-listed by a heartbeat.
+	pending := model.ProtocolSpamRecord{
+		OriginId: unittest.IdentifierFixture(),
+		Decay:    model.InitialDecaySpeed,
+		Penalty:  2 * model.DisallowListingThreshold,
+	}
+
+	// the snapshot is taken 10 heartbeats (and a fraction) before the startup.
+	downtime := 10*cfg.HeartBeatInterval + cfg.HeartBeatInterval/2
+	store.On("Retrieve").Return(&model.SpamRecordsSnapshot{
+		Records:   []model.ProtocolSpamRecord{disallowListed, recovered, penalized, pending},
+		Timestamp: time.Now().Add(-downtime),
+	}, true, nil).Once()
+
+	consumer.On("OnDisallowListNotification", &network.DisallowListingUpdate{
+		FlowIds: flow.IdentifierList{disallowListed.OriginId},
+		Cause:   network.DisallowListedCauseAlsp,
+	}).Return().Once()
+	// the pending record is disallow-listed by the first heartbeat after the startup.
+	consumer.On("OnDisallowListNotification", &network.DisallowListingUpdate{
+		FlowIds: flow.IdentifierList{pending.OriginId},
+		Cause:   network.DisallowListedCauseAlsp,
+	}).Return().Once()
+
+	snapshotted := make(chan *model.SpamRecordsSnapshot, 1)
+	store.On("Store", mock.Anything).Run(func(args mock.Arguments) {
+		snapshotted <- args.Get(0).(*model.SpamRecordsSnapshot)
+	}).Return(nil).Once()
+
+	m, err := alspmgr.NewMisbehaviorReportManager(cfg, consumer)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+	m.Start(signalerCtx)
+	unittest.RequireCloseBefore(t, m.Ready(), 100*time.Millisecond, "ALSP manager did not start")
+
+	// the records are restored before the manager is ready, hence before the first heartbeat.
+	record, ok := cache.Get(disallowListed.OriginId)
+	require.True(t, ok)
+	require.True(t, record.DisallowListed)
+	require.Equal(t, disallowListed.Penalty+10*disallowListed.Decay, record.Penalty)
+	require.Equal(t, disallowListed.CutoffCounter, record.CutoffCounter)
+	require.Equal(t, disallowListed.Decay, record.Decay)
+
+	record, ok = cache.Get(pending.OriginId)
+	require.True(t, ok)
+	require.Equal(t, pending, *record)
+
+	// the fully decayed records are restored, the repeat offender keeps its cutoff counter and decay speed.
+	record, ok = cache.Get(recovered.OriginId)
+	require.True(t, ok)
+	require.False(t, record.DisallowListed)
+	require.Equal(t, float64(0), record.Penalty)
+	require.Equal(t, recovered.CutoffCounter, record.CutoffCounter)
+	require.Equal(t, recovered.Decay, record.Decay)
+
+	record, ok = cache.Get(penalized.OriginId)
+	require.True(t, ok)
+	require.Equal(t, float64(0), record.Penalty)
+	require.Equal(t, penalized.Decay, record.Decay)
+
+	// waits for the first heartbeat to disallow-list the pending record.
+	require.Eventually(t, func() bool {
+		record, ok := cache.Get(pending.OriginId)
+		return ok && record.DisallowListed
+	}, 2*time.Second, 10*time.Millisecond, "pending record was not disallow-listed")
+
+	// a final snapshot is taken upon shutdown.
+	cancel()
+	unittest.RequireCloseBefore(t, m.Done(), 100*time.Millisecond, "ALSP manager did not stop")
+	snapshot := <-snapshotted
+	snapshotIds := flow.IdentifierList{}
+	for _, record := range snapshot.Records {
+		snapshotIds = append(snapshotIds, record.OriginId)
+	}
+	require.ElementsMatch(t, flow.IdentifierList{disallowListed.OriginId, recovered.OriginId, penalized.OriginId, pending.OriginId}, snapshotIds)
+}
+
+// TestRestoreSpamRecords_DecayFunc tests that the ALSP manager decays the restored spam records for the downtime of the node
+// using the same decay function as the heartbeats.
+func TestRestoreSpamRecords_DecayFunc(t *testing.T) {
+	cfg := managerCfgFixture(t)
+	consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+	store := mockalsp.NewSpamRecordStore(t)
+	cfg.SpamRecordStore = store
+	cfg.SnapshotInterval = time.Hour // only the final snapshot upon shutdown is taken during the test.
+
+	var cache alsp.SpamRecordCache
+	cfg.Opts = []alspmgr.MisbehaviorReportManagerOption{
+		alspmgr.WithSpamRecordsCacheFactory(func(logger zerolog.Logger, size uint32, metrics module.HeroCacheMetrics) alsp.SpamRecordCache {
+			cache = internal.NewSpamRecordCache(size, logger, metrics, model.SpamRecordFactory())
+			return cache
+		}),
+		// decays the penalty by twice the decay speed per heartbeat.
+		alspmgr.WithDecayFunc(func(record model.ProtocolSpamRecord) float64 {
+			return math.Min(record.Penalty+2*record.Decay, 0)
+		}),
+	}
+
+	disallowListed := model.ProtocolSpamRecord{
+		OriginId:       unittest.IdentifierFixture(),
+		Decay:          1,
+		CutoffCounter:  4,
+		DisallowListed: true,
+		Penalty:        model.DisallowListingThreshold,
+	}
+	store.On("Retrieve").Return(&model.SpamRecordsSnapshot{
+		Records:   []model.ProtocolSpamRecord{disallowListed},
+		Timestamp: time.Now().Add(-(10*cfg.HeartBeatInterval + cfg.HeartBeatInterval/2)),
+	}, true, nil).Once()
+	store.On("Store", mock.Anything).Return(nil).Once()
+	consumer.On("OnDisallowListNotification", &network.DisallowListingUpdate{
+		FlowIds: flow.IdentifierList{disallowListed.OriginId},
+		Cause:   network.DisallowListedCauseAlsp,
+	}).Return().Once()
+
+	m, err := alspmgr.NewMisbehaviorReportManager(cfg, consumer)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.Start(irrecoverable.NewMockSignalerContext(t, ctx))
+	unittest.RequireCloseBefore(t, m.Ready(), 100*time.Millisecond, "ALSP manager did not start")
+
+	// the records are restored before the manager is ready, hence before the first heartbeat.
+	record, ok := cache.Get(disallowListed.OriginId)
+	require.True(t, ok)
+	require.True(t, record.DisallowListed)
+	require.Equal(t, disallowListed.Penalty+10*2*disallowListed.Decay, record.Penalty)
+
+	cancel()
+	unittest.RequireCloseBefore(t, m.Done(), 100*time.Millisecond, "ALSP manager did not stop")
+}
+
+// TestSpamRecordsSnapshot_Restart_Integration tests that the spam records of the ALSP manager survive a restart when backed by the
+// protocol database. The test disallow-lists a node on a manager, stops the manager, and ensures that a new manager backed by the same
+// database restores the spam record and reports the node to be disallow-listed again.
+func TestSpamRecordsSnapshot_Restart_Integration(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		cfg := managerCfgFixture(t)
+		cfg.SpamRecordStore = alspmgr.NewPersistentSpamRecordStore(db, network.PrivateNetwork)
+		cfg.SnapshotInterval = time.Hour // only the final snapshot upon shutdown is taken during the test.
+		originId := unittest.IdentifierFixture()
+		disallowListing := &network.DisallowListingUpdate{
+			FlowIds: flow.IdentifierList{originId},
+			Cause:   network.DisallowListedCauseAlsp,
+		}
+
+		// first run of the node; the node is disallow-listed manually.
+		consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+		consumer.On("OnDisallowListNotification", disallowListing).Return().Once()
+		m, err := alspmgr.NewMisbehaviorReportManager(cfg, consumer)
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		m.Start(irrecoverable.NewMockSignalerContext(t, ctx))
+		unittest.RequireCloseBefore(t, m.Ready(), 100*time.Millisecond, "ALSP manager did not start")
+
+		require.NoError(t, m.DisallowListNode(originId))
+
+		cancel()
+		unittest.RequireCloseBefore(t, m.Done(), 100*time.Millisecond, "ALSP manager did not stop")
+
+		// second run of the node; the spam record is restored and the node is disallow-listed again.
+		consumer = mocknetwork.NewDisallowListNotificationConsumer(t)
+		consumer.On("OnDisallowListNotification", disallowListing).Return().Once()
+		m, err = alspmgr.NewMisbehaviorReportManager(cfg, consumer)
+		require.NoError(t, err)
+		ctx, cancel = context.WithCancel(context.Background())
+		defer func() {
+			cancel()
+			unittest.RequireCloseBefore(t, m.Done(), 100*time.Millisecond, "ALSP manager did not stop")
+		}()
+		m.Start(irrecoverable.NewMockSignalerContext(t, ctx))
+		unittest.RequireCloseBefore(t, m.Ready(), 100*time.Millisecond, "ALSP manager did not start")
+
+		records := m.SpamRecords()
+		require.Len(t, records, 1)
+		require.Equal(t, originId, records[0].OriginId)
+		require.True(t, records[0].DisallowListed)
+		require.Less(t, records[0].Penalty, float64(0))
+	})
+}
+
+// TestSpamRecordAdmin tests the manual overrides of the spam records by the node operators. The test ensures that manually
+// disallow-listing, allow-listing, and resetting the spam record of a node update the spam record accordingly and emit the
+// respective notifications to the network layer.
+func TestSpamRecordAdmin(t *testing.T) {
+	cfg := managerCfgFixture(t)
+	consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+	m, err := alspmgr.NewMisbehaviorReportManager(cfg, consumer)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		unittest.RequireCloseBefore(t, m.Done(), 100*time.Millisecond, "ALSP manager did not stop")
+	}()
+	m.Start(irrecoverable.NewMockSignalerContext(t, ctx))
+	unittest.RequireCloseBefore(t, m.Ready(), 100*time.Millisecond, "ALSP manager did not start")
+
+	originId := unittest.IdentifierFixture()
+	disallowListing := &network.DisallowListingUpdate{
+		FlowIds: flow.IdentifierList{originId},
+		Cause:   network.DisallowListedCauseAlsp,
+	}
+	allowListing := &network.AllowListingUpdate{
+		FlowIds: flow.IdentifierList{originId},
+		Cause:   network.DisallowListedCauseAlsp,
+	}
+	recordOf := func() model.ProtocolSpamRecord {
+		for _, record := range m.SpamRecords() {
+			if record.OriginId == originId {
+				return record
+			}
+		}
+		require.Fail(t, "spam record not found")
+		return model.ProtocolSpamRecord{}
+	}
+
+	// manual disallow-listing sets the penalty to the threshold.
+	consumer.On("OnDisallowListNotification", disallowListing).Return().Once()
+	require.NoError(t, m.DisallowListNode(originId))
+	record := recordOf()
+	require.True(t, record.DisallowListed)
+	require.LessOrEqual(t, record.Penalty, model.DisallowListingThreshold+record.Decay) // tolerates a heartbeat in between.
+
+	// manual allow-listing clears the penalty.
+	consumer.On("OnAllowListNotification", allowListing).Return().Once()
+	require.NoError(t, m.AllowListNode(originId))
+	record = recordOf()
+	require.False(t, record.DisallowListed)
+	require.Equal(t, float64(0), record.Penalty)
+
+	// resetting the spam record of a disallow-listed node removes the record and allow-lists the node.
+	consumer.On("OnDisallowListNotification", disallowListing).Return().Once()
+	require.NoError(t, m.DisallowListNode(originId))
+	consumer.On("OnAllowListNotification", allowListing).Return().Once()
+	require.True(t, m.ResetSpamRecord(originId))
+	require.Empty(t, m.SpamRecords())
+
+	// resetting a non-existing spam record is a no-op.
+	require.False(t, m.ResetSpamRecord(originId))
+}
+
 // //////////////////////////// TEST HELPERS ///////////////////////////////////////////////////////////////////////////////
 // The following functions are helpers for the tests. It wasn't feasible to put them in a helper file in the alspmgr_test
 // package because that would break encapsulation of the ALSP manager and require making some fields exportable.
@@ -0,0 +1,57 @@
+package alspmgr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/alsp"
+	"github.com/onflow/flow-go/network/alsp/model"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation"
+)
+
+// PersistentSpamRecordStore is a SpamRecordStore that persists the snapshots of the spam records in the protocol database.
+// Snapshots are keyed by the networking type, so that the private and public networks of a node keep separate snapshots.
+type PersistentSpamRecordStore struct {
+	db          storage.DB
+	networkType network.NetworkingType
+}
+
+var _ alsp.SpamRecordStore = (*PersistentSpamRecordStore)(nil)
+
+// NewPersistentSpamRecordStore returns a new PersistentSpamRecordStore that persists the snapshots of the spam records of
+// the given networking type in the given database.
+func NewPersistentSpamRecordStore(db storage.DB, networkType network.NetworkingType) *PersistentSpamRecordStore {
+	return &PersistentSpamRecordStore{
+		db:          db,
+		networkType: networkType,
+	}
+}
+
+// Store persists the given snapshot of the spam records, overwriting any previously stored snapshot.
+// No errors are expected during normal operations.
+func (s *PersistentSpamRecordStore) Store(snapshot *model.SpamRecordsSnapshot) error {
+	err := s.db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+		return operation.UpsertAlspSpamRecords(rw.Writer(), uint8(s.networkType), snapshot)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to persist spam records snapshot: %w", err)
+	}
+	return nil
+}
+
+// Retrieve returns the last stored snapshot of the spam records.
+// Returns the snapshot and true if a snapshot exists, nil and false otherwise.
+// No errors are expected during normal operations.
+func (s *PersistentSpamRecordStore) Retrieve() (*model.SpamRecordsSnapshot, bool, error) {
+	var snapshot model.SpamRecordsSnapshot
+	err := operation.RetrieveAlspSpamRecords(s.db.Reader(), uint8(s.networkType), &snapshot)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to retrieve spam records snapshot: %w", err)
+	}
+	return &snapshot, true, nil
+}
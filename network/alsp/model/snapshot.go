@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+// SpamRecordsSnapshot is a point-in-time copy of the spam records of the ALSP module. It is persisted in the node
+// database so that the penalties of the misbehaving nodes survive restarts of the node.
+type SpamRecordsSnapshot struct {
+	// Records are the spam records at the time of the snapshot.
+	Records []ProtocolSpamRecord
+
+	// Timestamp is the time at which the snapshot is taken. Upon restoring the snapshot, the penalties of the records are
+	// decayed for the time elapsed since the snapshot, i.e., the downtime of the node.
+	Timestamp time.Time
+}
@@ -0,0 +1,43 @@
+package alsp
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/network/alsp/model"
+)
+
+// SpamRecordStore is a persistent store for the snapshots of the spam records of the ALSP module.
+// It allows the penalties of the misbehaving nodes to survive restarts of the node, so that a node under spam does not
+// give the spamming nodes a clean slate upon restart.
+type SpamRecordStore interface {
+	// Store persists the given snapshot of the spam records, overwriting any previously stored snapshot.
+	// No errors are expected during normal operations.
+	Store(snapshot *model.SpamRecordsSnapshot) error
+
+	// Retrieve returns the last stored snapshot of the spam records.
+	// Returns the snapshot and true if a snapshot exists, nil and false otherwise.
+	// No errors are expected during normal operations.
+	Retrieve() (*model.SpamRecordsSnapshot, bool, error)
+}
+
+// SpamRecordAdmin exposes the spam records of the ALSP module to the node operators, allowing them to inspect and
+// manually override the penalties of the nodes.
+type SpamRecordAdmin interface {
+	// SpamRecords returns a copy of all spam records currently tracked by the ALSP module.
+	SpamRecords() []model.ProtocolSpamRecord
+
+	// DisallowListNode manually disallow-lists the given node. The penalty of the node is set to the disallow-listing
+	// threshold, hence the node is allow-listed again once its penalty decays back to zero, unless it is allow-listed
+	// manually before that.
+	// No errors are expected during normal operations.
+	DisallowListNode(nodeId flow.Identifier) error
+
+	// AllowListNode manually allow-lists the given node. The penalty of the node is set to zero, while its cutoff counter
+	// and decay speed are retained, so that subsequent misbehavior of the node is still treated as a repeated offence.
+	// No errors are expected during normal operations.
+	AllowListNode(nodeId flow.Identifier) error
+
+	// ResetSpamRecord removes the spam record of the given node, giving the node a clean slate. If the node is
+	// disallow-listed, it is allow-listed.
+	// Returns true if the record existed and is removed, false otherwise.
+	ResetSpamRecord(nodeId flow.Identifier) bool
+}
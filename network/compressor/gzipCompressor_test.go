@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/flow-go/network"
 	"github.com/onflow/flow-go/network/compressor"
 )
 
@@ -47,3 +48,42 @@ func TestRoundTrip(t *testing.T) {
 	// we should read what we have written
 	require.Equal(t, b, textBytes)
 }
+
+// TestRoundTrip_AllCompressors evaluates that reading what has been written by each compressor yields in same result,
+// and that repetitive data is compressed when written, including when the writer is flushed after each write.
+func TestRoundTrip_AllCompressors(t *testing.T) {
+	compressors := map[string]network.Compressor{
+		"gzip":   compressor.GzipStreamCompressor{},
+		"lz4":    compressor.NewLz4Compressor(),
+		"snappy": compressor.NewSnappyCompressor(),
+		"zstd":   compressor.NewZstdCompressor(),
+	}
+
+	textBytes := bytes.Repeat([]byte("hello world, hello world!"), 100)
+
+	for name, comp := range compressors {
+		t.Run(name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+
+			w, err := comp.NewWriter(buf)
+			require.NoError(t, err)
+
+			for i := 0; i < 2; i++ {
+				n, err := w.Write(textBytes)
+				require.NoError(t, err)
+				require.Equal(t, len(textBytes), n)
+				require.NoError(t, w.Flush())
+			}
+			require.NoError(t, w.Close())
+			// written data on buffer should be compressed in size.
+			require.Less(t, buf.Len(), 2*len(textBytes))
+
+			r, err := comp.NewReader(buf)
+			require.NoError(t, err)
+			read, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.NoError(t, r.Close())
+			require.Equal(t, append(textBytes, textBytes...), read)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package compressor
+
+import (
+	"io"
+
+	"github.com/golang/snappy"
+
+	"github.com/onflow/flow-go/network"
+)
+
+var _ network.Compressor = (*SnappyCompressor)(nil)
+
+type SnappyCompressor struct{}
+
+func NewSnappyCompressor() *SnappyCompressor {
+	return &SnappyCompressor{}
+}
+
+func (snappyComp SnappyCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(snappy.NewReader(r)), nil
+}
+
+func (snappyComp SnappyCompressor) NewWriter(w io.Writer) (network.WriteCloseFlusher, error) {
+	return &snappyWriteCloseFlusher{w: snappy.NewBufferedWriter(w)}, nil
+}
+
+type snappyWriteCloseFlusher struct {
+	w *snappy.Writer
+}
+
+func (snappyW *snappyWriteCloseFlusher) Write(p []byte) (int, error) {
+	return snappyW.w.Write(p)
+}
+
+func (snappyW *snappyWriteCloseFlusher) Close() error {
+	return snappyW.w.Close()
+}
+
+func (snappyW *snappyWriteCloseFlusher) Flush() error {
+	return snappyW.w.Flush()
+}
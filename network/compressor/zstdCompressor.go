@@ -0,0 +1,50 @@
+package compressor
+
+import (
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/onflow/flow-go/network"
+)
+
+var _ network.Compressor = (*ZstdCompressor)(nil)
+
+type ZstdCompressor struct{}
+
+func NewZstdCompressor() *ZstdCompressor {
+	return &ZstdCompressor{}
+}
+
+func (zstdComp ZstdCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	// streams carry few messages, decoding them concurrently isn't worth the goroutines
+	d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return d.IOReadCloser(), nil
+}
+
+func (zstdComp ZstdCompressor) NewWriter(w io.Writer) (network.WriteCloseFlusher, error) {
+	e, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return &zstdWriteCloseFlusher{w: e}, nil
+}
+
+type zstdWriteCloseFlusher struct {
+	w *zstd.Encoder
+}
+
+func (zstdW *zstdWriteCloseFlusher) Write(p []byte) (int, error) {
+	return zstdW.w.Write(p)
+}
+
+func (zstdW *zstdWriteCloseFlusher) Close() error {
+	return zstdW.w.Close()
+}
+
+func (zstdW *zstdWriteCloseFlusher) Flush() error {
+	return zstdW.w.Flush()
+}
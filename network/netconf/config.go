@@ -26,7 +26,11 @@ type Config struct {
 	// TODO: solely a fallback mechanism, can be removed upon reliable behavior in production.
 	NetworkConnectionPruning bool `mapstructure:"networking-connection-pruning"`
 	// PreferredUnicastProtocols list of unicast protocols in preferred order
-	PreferredUnicastProtocols       []string      `mapstructure:"preferred-unicast-protocols"`
+	PreferredUnicastProtocols []string `mapstructure:"preferred-unicast-protocols"`
+	// UnicastCompressionPolicy list of per-channel preferred unicast protocols in the form of <channel>=<protocol>, e.g., a compression
+	// that fits the payloads of the channel. Channels that are not listed use the preferred unicast protocols. The protocol of each
+	// channel must be either the plain unicast protocol or one of the preferred unicast protocols.
+	UnicastCompressionPolicy        []string      `mapstructure:"unicast-compression-policy"`
 	NetworkReceivedMessageCacheSize uint32        `validate:"gt=0" mapstructure:"received-message-cache-size"`
 	PeerUpdateInterval              time.Duration `validate:"gt=0s" mapstructure:"peerupdate-interval"`
 
@@ -56,6 +60,10 @@ type AlspConfig struct {
 	// events that are used to perform critical ALSP tasks, such as updating the spam records cache.
 	HearBeatInterval time.Duration `mapstructure:"alsp-heart-beat-interval"`
 
+	// SnapshotInterval is the interval between two consecutive snapshots of the spam records to the node database.
+	// The snapshots allow the penalties of the misbehaving nodes to survive restarts of the node.
+	SnapshotInterval time.Duration `validate:"gt=0s" mapstructure:"alsp-snapshot-interval"`
+
 	SyncEngine SyncEngineAlspConfig `mapstructure:",squash"`
 }
 
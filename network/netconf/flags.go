@@ -15,6 +15,7 @@ const (
 	// network configuration
 	networkingConnectionPruning       = "networking-connection-pruning"
 	preferredUnicastsProtocols        = "preferred-unicast-protocols"
+	unicastCompressionPolicy          = "unicast-compression-policy"
 	receivedMessageCacheSize          = "received-message-cache-size"
 	peerUpdateInterval                = "peerupdate-interval"
 	dnsCacheTTL                       = "dns-cache-ttl"
@@ -40,6 +41,7 @@ const (
 	alspSpamRecordCacheSize            = "alsp-spam-record-cache-size"
 	alspSpamRecordQueueSize            = "alsp-spam-report-queue-size"
 	alspHearBeatInterval               = "alsp-heart-beat-interval"
+	alspSnapshotInterval               = "alsp-snapshot-interval"
 	alspSyncEngineBatchRequestBaseProb = "alsp-sync-engine-batch-request-base-prob"
 	alspSyncEngineRangeRequestBaseProb = "alsp-sync-engine-range-request-base-prob"
 	alspSyncEngineSyncRequestProb      = "alsp-sync-engine-sync-request-prob"
@@ -49,6 +51,7 @@ func AllFlagNames() []string {
 	allFlags := []string{
 		networkingConnectionPruning,
 		preferredUnicastsProtocols,
+		unicastCompressionPolicy,
 		receivedMessageCacheSize,
 		peerUpdateInterval,
 		BuildFlagName(unicastKey, MessageTimeoutKey),
@@ -74,6 +77,7 @@ func AllFlagNames() []string {
 		alspSpamRecordCacheSize,
 		alspSpamRecordQueueSize,
 		alspHearBeatInterval,
+		alspSnapshotInterval,
 		alspSyncEngineBatchRequestBaseProb,
 		alspSyncEngineRangeRequestBaseProb,
 		alspSyncEngineSyncRequestProb,
@@ -230,6 +234,8 @@ func InitializeNetworkFlags(flags *pflag.FlagSet, config *Config) {
 	flags.Duration(dnsCacheTTL, config.DNSCacheTTL, "time-to-live for dns cache")
 	flags.StringSlice(
 		preferredUnicastsProtocols, config.PreferredUnicastProtocols, "preferred unicast protocols in ascending order of preference")
+	flags.StringSlice(
+		unicastCompressionPolicy, config.UnicastCompressionPolicy, "per-channel preferred unicast protocols in the form of <channel>=<protocol>, e.g., request-chunks=zstd-compression; the protocol must be plain or one of the preferred unicast protocols")
 	flags.Uint32(receivedMessageCacheSize, config.NetworkReceivedMessageCacheSize, "incoming message cache size at networking layer")
 	flags.Uint32(
 		disallowListNotificationCacheSize,
@@ -315,6 +321,9 @@ func InitializeNetworkFlags(flags *pflag.FlagSet, config *Config) {
 	flags.Duration(alspHearBeatInterval,
 		config.AlspConfig.HearBeatInterval,
 		"interval between two consecutive heartbeat events at alsp, recommended to leave it as default unless you know what you are doing.")
+	flags.Duration(alspSnapshotInterval,
+		config.AlspConfig.SnapshotInterval,
+		"interval between two consecutive snapshots of the alsp spam records to the node database, the snapshots allow the penalties to survive restarts of the node")
 	flags.Float32(alspSyncEngineBatchRequestBaseProb,
 		config.AlspConfig.SyncEngine.BatchRequestBaseProb,
 		"base probability of creating a misbehavior report for a batch request message")
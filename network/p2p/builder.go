@@ -77,6 +77,12 @@ type GossipSubBuilder interface {
 	// CAUTION: Be careful setting this to a larger number as it will change the backpressure behavior of the system.
 	OverrideDefaultValidateQueueSize(int)
 
+	// AddInvCtrlMsgNotifConsumer adds a consumer of the invalid control message notifications of the RPC inspector.
+	// The added consumers are notified along with the primary consumer of the notifications (i.e., the score option when
+	// peer scoring is enabled), hence they must be non-blocking. They are meant for observing the notifications, e.g., for
+	// introspection, and must not take any action on the remote peers.
+	AddInvCtrlMsgNotifConsumer(GossipSubInvCtrlMsgNotifConsumer)
+
 	// Build creates a new GossipSub pubsub system.
 	// It returns the newly created GossipSub pubsub system and any errors encountered during its creation.
 	//
@@ -169,6 +175,14 @@ type NodeBuilder interface {
 	// CAUTION: Be careful setting this to a larger number as it will change the backpressure behavior of the system.
 	OverrideDefaultValidateQueueSize(int) NodeBuilder
 
+	// AddGossipSubInvCtrlMsgNotifConsumer adds a consumer of the invalid control message notifications of the GossipSub
+	// RPC inspector. The consumer is notified along with the score option, hence it must be non-blocking.
+	// Args:
+	// - consumer: the consumer to add.
+	// Returns:
+	// - NodeBuilder: the node builder
+	AddGossipSubInvCtrlMsgNotifConsumer(GossipSubInvCtrlMsgNotifConsumer) NodeBuilder
+
 	// Build creates a new libp2p node. It returns the newly created libp2p node and any errors encountered during its creation.
 	// Args:
 	// none
@@ -21,6 +21,8 @@ type ConnectionGaterConfig struct {
 	InterceptPeerDialFilters []p2p.PeerFilter
 	// InterceptSecuredFilters list of peer filters used to filter peers and accept or reject inbound connections in InterceptSecured callback.
 	InterceptSecuredFilters []p2p.PeerFilter
+	// DecisionConsumer is notified of the decisions of the connection gater on the connection attempts (optional).
+	DecisionConsumer p2p.ConnectionGaterDecisionConsumer
 }
 
 // PeerManagerConfig configuration parameters for the peer manager.
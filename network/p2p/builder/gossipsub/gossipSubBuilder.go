@@ -44,6 +44,9 @@ type Builder struct {
 	routingSystem     routing.Routing
 	gossipSubCfg      *p2pconfig.GossipSubParameters
 	validateQueueSize int
+	// invCtrlMsgNotifConsumers are the additional consumers of the invalid control message notifications, which are
+	// notified along with the primary consumer (i.e., the score option or the no-op consumer).
+	invCtrlMsgNotifConsumers []p2p.GossipSubInvCtrlMsgNotifConsumer
 }
 
 var _ p2p.GossipSubBuilder = (*Builder)(nil)
@@ -145,6 +148,12 @@ func (g *Builder) OverrideDefaultValidateQueueSize(size int) {
 	g.validateQueueSize = size
 }
 
+// AddInvCtrlMsgNotifConsumer adds a consumer of the invalid control message notifications of the RPC inspector.
+// The consumer is notified along with the primary consumer of the notifications, hence it must be non-blocking.
+func (g *Builder) AddInvCtrlMsgNotifConsumer(consumer p2p.GossipSubInvCtrlMsgNotifConsumer) {
+	g.invCtrlMsgNotifConsumers = append(g.invCtrlMsgNotifConsumers, consumer)
+}
+
 // NewGossipSubBuilder returns a new gossipsub builder.
 // Args:
 // - logger: the logger of the node.
@@ -341,6 +350,10 @@ func (g *Builder) Build(ctx irrecoverable.SignalerContext) (p2p.PubSubAdapter, e
 			Msg("gossipsub peer scoring is disabled, no-op consumer will be used for invalid control message notifications.")
 		consumer = scoring.NewNoopInvCtrlMsgNotifConsumer() // no-op consumer as peer scoring is disabled.
 	}
+	if len(g.invCtrlMsgNotifConsumers) > 0 {
+		// the additional consumers only observe the notifications, hence they are notified after the primary consumer.
+		consumer = invCtrlMsgNotifConsumers(append([]p2p.GossipSubInvCtrlMsgNotifConsumer{consumer}, g.invCtrlMsgNotifConsumers...))
+	}
 
 	rpcValidationInspector, err := g.rpcInspectorFactory(
 		g.logger,
@@ -378,3 +391,15 @@ func (g *Builder) Build(ctx irrecoverable.SignalerContext) (p2p.PubSubAdapter, e
 
 	return gossipSub, nil
 }
+
+// invCtrlMsgNotifConsumers is a list of invalid control message notification consumers that are notified in order.
+type invCtrlMsgNotifConsumers []p2p.GossipSubInvCtrlMsgNotifConsumer
+
+var _ p2p.GossipSubInvCtrlMsgNotifConsumer = (invCtrlMsgNotifConsumers)(nil)
+
+// OnInvalidControlMessageNotification notifies all the consumers of the given notification.
+func (c invCtrlMsgNotifConsumers) OnInvalidControlMessageNotification(notification *p2p.InvCtrlMsgNotif) {
+	for _, consumer := range c {
+		consumer.OnInvalidControlMessageNotification(notification)
+	}
+}
@@ -216,6 +216,13 @@ func (builder *LibP2PNodeBuilder) OverrideDefaultRpcInspectorFactory(factory p2p
 	return builder
 }
 
+// AddGossipSubInvCtrlMsgNotifConsumer adds a consumer of the invalid control message notifications of the GossipSub
+// RPC inspector. The consumer is notified along with the score option, hence it must be non-blocking.
+func (builder *LibP2PNodeBuilder) AddGossipSubInvCtrlMsgNotifConsumer(consumer p2p.GossipSubInvCtrlMsgNotifConsumer) p2p.NodeBuilder {
+	builder.gossipSubBuilder.AddInvCtrlMsgNotifConsumer(consumer)
+	return builder
+}
+
 // Build creates a new libp2p node using the configured options.
 func (builder *LibP2PNodeBuilder) Build() (p2p.LibP2PNode, error) {
 	var opts []libp2p.Option
@@ -453,11 +460,14 @@ func DefaultNodeBuilder(
 	peerFilter := notEjectedPeerFilter(idProvider)
 	peerFilters := []p2p.PeerFilter{peerFilter}
 
-	connGater := connection.NewConnGater(
-		logger,
-		idProvider,
+	connGaterOpts := []connection.ConnGaterOption{
 		connection.WithOnInterceptPeerDialFilters(append(peerFilters, connGaterCfg.InterceptPeerDialFilters...)),
-		connection.WithOnInterceptSecuredFilters(append(peerFilters, connGaterCfg.InterceptSecuredFilters...)))
+		connection.WithOnInterceptSecuredFilters(append(peerFilters, connGaterCfg.InterceptSecuredFilters...)),
+	}
+	if connGaterCfg.DecisionConsumer != nil {
+		connGaterOpts = append(connGaterOpts, connection.WithDecisionConsumer(connGaterCfg.DecisionConsumer))
+	}
+	connGater := connection.NewConnGater(logger, idProvider, connGaterOpts...)
 
 	builder := NewNodeBuilder(logger,
 		gossipCfg,
@@ -38,6 +38,14 @@ func WithOnInterceptSecuredFilters(filters []p2p.PeerFilter) ConnGaterOption {
 	}
 }
 
+// WithDecisionConsumer sets the consumer that is notified of the decisions of the connection gater on the
+// inbound and outbound connection attempts.
+func WithDecisionConsumer(consumer p2p.ConnectionGaterDecisionConsumer) ConnGaterOption {
+	return func(c *ConnGater) {
+		c.decisionConsumer = consumer
+	}
+}
+
 // ConnGater is the implementation of the libp2p connmgr.ConnectionGater interface
 // It provides node allowlisting by libp2p peer.ID which is derived from the node public networking key
 type ConnGater struct {
@@ -55,6 +63,9 @@ type ConnGater struct {
 	// to determine if a node should be allowed to connect.
 	identityProvider module.IdentityProvider
 	log              zerolog.Logger
+
+	// decisionConsumer is notified of the decisions of the connection gater on the connection attempts (optional).
+	decisionConsumer p2p.ConnectionGaterDecisionConsumer
 }
 
 func NewConnGater(log zerolog.Logger, identityProvider module.IdentityProvider, opts ...ConnGaterOption) *ConnGater {
@@ -79,12 +90,14 @@ func (c *ConnGater) InterceptPeerDial(p peer.ID) bool {
 		lg.Warn().
 			Str("disallow_list_causes", fmt.Sprintf("%v", disallowListCauses)).
 			Msg("outbound connection attempt to disallow listed peer is rejected")
+		c.onDecision(p, network.DirOutbound, fmt.Errorf("peer is disallow listed: %v", disallowListCauses))
 		return false
 	}
 
 	if len(c.onInterceptPeerDialFilters) == 0 {
 		lg.Warn().
 			Msg("outbound connection established with no intercept peer dial filters")
+		c.onDecision(p, network.DirOutbound, nil)
 		return true
 	}
 
@@ -106,10 +119,12 @@ func (c *ConnGater) InterceptPeerDial(p peer.ID) bool {
 		lg.Warn().
 			Err(err).
 			Msg("rejected outbound connection attempt")
+		c.onDecision(p, network.DirOutbound, err)
 		return false
 	}
 
 	lg.Debug().Msg("outbound connection established")
+	c.onDecision(p, network.DirOutbound, nil)
 	return true
 }
 
@@ -138,11 +153,13 @@ func (c *ConnGater) InterceptSecured(dir network.Direction, p peer.ID, addr netw
 			lg.Warn().
 				Str("disallow_list_causes", fmt.Sprintf("%v", disallowListCauses)).
 				Msg("inbound connection attempt to disallow listed peer is rejected")
+			c.onDecision(p, network.DirInbound, fmt.Errorf("peer is disallow listed: %v", disallowListCauses))
 			return false
 		}
 
 		if len(c.onInterceptSecuredFilters) == 0 {
 			lg.Warn().Msg("inbound connection established with no intercept secured filters")
+			c.onDecision(p, network.DirInbound, nil)
 			return true
 		}
 
@@ -166,10 +183,12 @@ func (c *ConnGater) InterceptSecured(dir network.Direction, p peer.ID, addr netw
 				Str("local_address", addr.LocalMultiaddr().String()).
 				Bool(logging.KeySuspicious, true).
 				Msg("rejected inbound connection")
+			c.onDecision(p, network.DirInbound, err)
 			return false
 		}
 
 		lg.Debug().Msg("inbound connection established")
+		c.onDecision(p, network.DirInbound, nil)
 		return true
 	default:
 		// outbound connection should have been already blocked before this call
@@ -182,6 +201,23 @@ func (c *ConnGater) InterceptUpgraded(network.Conn) (allow bool, reason control.
 	return true, 0
 }
 
+// onDecision notifies the decision consumer (if any) of the decision of the connection gater on a connection attempt.
+// Args:
+// - p: the remote peer of the connection attempt.
+// - dir: the direction of the connection attempt.
+// - reason: the reason for rejecting the connection attempt; nil if the connection attempt is allowed.
+func (c *ConnGater) onDecision(p peer.ID, dir network.Direction, reason error) {
+	if c.decisionConsumer == nil {
+		return
+	}
+	c.decisionConsumer.OnConnectionGaterDecision(&p2p.ConnectionGaterDecision{
+		PeerID:    p,
+		Direction: dir,
+		Allowed:   reason == nil,
+		Reason:    reason,
+	})
+}
+
 func (c *ConnGater) peerIDPassesAllFilters(p peer.ID, filters []p2p.PeerFilter) error {
 	for _, allowed := range filters {
 		if err := allowed(p); err != nil {
@@ -9,12 +9,14 @@ import (
 	"github.com/libp2p/go-libp2p/core/control"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/irrecoverable"
 	mockmodule "github.com/onflow/flow-go/module/mock"
+	flownet "github.com/onflow/flow-go/network"
 	"github.com/onflow/flow-go/network/channels"
 	"github.com/onflow/flow-go/network/internal/p2pfixtures"
 	"github.com/onflow/flow-go/network/p2p"
@@ -441,3 +443,78 @@ func ensureCommunicationOverAllProtocols(t *testing.T, ctx context.Context, spor
 	})
 	p2pfixtures.EnsureMessageExchangeOverUnicast(t, ctx, nodes, inbounds, p2pfixtures.LongStringMessageFactoryFixture(t))
 }
+
+// TestConnectionGater_DecisionConsumer tests that the connection gater notifies its decision consumer of the decisions on
+// the inbound and outbound connection attempts, along with the reason of the rejected ones.
+func TestConnectionGater_DecisionConsumer(t *testing.T) {
+	allowed, filtered, disallowListed := unittest.PeerIdFixture(t), unittest.PeerIdFixture(t), unittest.PeerIdFixture(t)
+
+	idProvider := mockmodule.NewIdentityProvider(t)
+	idProvider.On("ByPeerID", mock.Anything).Return(nil, false).Maybe()
+	oracle := mockp2p.NewDisallowListOracle(t)
+	oracle.On("IsDisallowListed", disallowListed).Return([]flownet.DisallowListedCause{flownet.DisallowListedCauseAdmin}, true)
+	oracle.On("IsDisallowListed", mock.Anything).Return(nil, false)
+	filters := []p2p.PeerFilter{func(p peer.ID) error {
+		if p == filtered {
+			return fmt.Errorf("peer is filtered")
+		}
+		return nil
+	}}
+
+	decisions := make(map[network.Direction]map[peer.ID]*p2p.ConnectionGaterDecision)
+	consumer := mockp2p.NewConnectionGaterDecisionConsumer(t)
+	consumer.On("OnConnectionGaterDecision", mock.Anything).Run(func(args mock.Arguments) {
+		decision := args.Get(0).(*p2p.ConnectionGaterDecision)
+		if decisions[decision.Direction] == nil {
+			decisions[decision.Direction] = make(map[peer.ID]*p2p.ConnectionGaterDecision)
+		}
+		decisions[decision.Direction][decision.PeerID] = decision
+	}).Times(6)
+
+	gater := connection.NewConnGater(
+		unittest.Logger(),
+		idProvider,
+		connection.WithOnInterceptPeerDialFilters(filters),
+		connection.WithOnInterceptSecuredFilters(filters),
+		connection.WithDecisionConsumer(consumer))
+	gater.SetDisallowListOracle(oracle)
+
+	addrs := connMultiaddrsFixture(t)
+	for _, p := range []peer.ID{allowed, filtered, disallowListed} {
+		require.Equal(t, p == allowed, gater.InterceptPeerDial(p))
+		require.Equal(t, p == allowed, gater.InterceptSecured(network.DirInbound, p, addrs))
+	}
+
+	for _, dir := range []network.Direction{network.DirOutbound, network.DirInbound} {
+		require.True(t, decisions[dir][allowed].Allowed)
+		require.NoError(t, decisions[dir][allowed].Reason)
+
+		require.False(t, decisions[dir][filtered].Allowed)
+		require.ErrorContains(t, decisions[dir][filtered].Reason, "peer is filtered")
+
+		require.False(t, decisions[dir][disallowListed].Allowed)
+		require.ErrorContains(t, decisions[dir][disallowListed].Reason, flownet.DisallowListedCauseAdmin.String())
+	}
+}
+
+// connMultiaddrs is a fixed implementation of network.ConnMultiaddrs for testing.
+type connMultiaddrs struct {
+	local  multiaddr.Multiaddr
+	remote multiaddr.Multiaddr
+}
+
+func (c connMultiaddrs) LocalMultiaddr() multiaddr.Multiaddr {
+	return c.local
+}
+
+func (c connMultiaddrs) RemoteMultiaddr() multiaddr.Multiaddr {
+	return c.remote
+}
+
+func connMultiaddrsFixture(t *testing.T) network.ConnMultiaddrs {
+	local, err := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/3569")
+	require.NoError(t, err)
+	remote, err := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/3570")
+	require.NoError(t, err)
+	return connMultiaddrs{local: local, remote: remote}
+}
@@ -1,6 +1,10 @@
 package p2p
 
-import "github.com/libp2p/go-libp2p/core/connmgr"
+import (
+	"github.com/libp2p/go-libp2p/core/connmgr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
 
 // ConnectionGater the customized interface for the connection gater in the p2p package.
 // It acts as a wrapper around the libp2p connmgr.ConnectionGater interface and adds some custom methods.
@@ -22,3 +26,22 @@ type ConnectionGater interface {
 	// 	if the disallow list oracle is already set.
 	SetDisallowListOracle(oracle DisallowListOracle)
 }
+
+// ConnectionGaterDecision is the decision of the connection gater on a connection attempt of a remote peer.
+type ConnectionGaterDecision struct {
+	// PeerID is the remote peer of the connection attempt.
+	PeerID peer.ID
+	// Direction is the direction of the connection attempt, i.e., inbound or outbound.
+	Direction network.Direction
+	// Allowed is true if the connection attempt is allowed, and false if it is rejected.
+	Allowed bool
+	// Reason is the reason for rejecting the connection attempt; nil if the connection attempt is allowed.
+	Reason error
+}
+
+// ConnectionGaterDecisionConsumer is the interface for the consumer of the connection gater decisions.
+type ConnectionGaterDecisionConsumer interface {
+	// OnConnectionGaterDecision is called when the connection gater allows or rejects a connection attempt.
+	// The implementation must be concurrency safe and non-blocking, as it is invoked on the connection establishment path.
+	OnConnectionGaterDecision(*ConnectionGaterDecision)
+}
@@ -0,0 +1,145 @@
+package introspection
+
+import (
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/network/p2p"
+	p2pconfig "github.com/onflow/flow-go/network/p2p/config"
+)
+
+// Node is the subset of the libp2p node that is introspected, i.e., the local mesh tracked by the GossipSub mesh tracer
+// and the local scoring table exposed by the GossipSub score tracer.
+type Node interface {
+	// GetLocalMesh returns the local mesh of the node for all topics.
+	GetLocalMesh() map[string][]peer.ID
+	// PeerScoreExposer returns the node's peer score exposer implementation; nil if the score tracer is not enabled.
+	PeerScoreExposer() p2p.PeerScoreExposer
+}
+
+// Introspector takes live snapshots of the GossipSub state of the node. It combines the local mesh tracked by the mesh
+// tracer, the peer scores tracked by the score tracer, and the recent events recorded by the recorder.
+type Introspector struct {
+	node       Node
+	recorder   *Recorder
+	idProvider module.IdentityProvider
+	// thresholds are the score thresholds of the GossipSub peer scoring; nil if peer scoring is disabled.
+	thresholds *p2pconfig.InternalScoringThresholds
+}
+
+// NewIntrospector creates a new introspector.
+// Args:
+// - node: the libp2p node to introspect.
+// - recorder: the recorder of the recent RPC inspector violations and connection gater decisions of the node.
+// - idProvider: the identity provider used to translate the peer ids to the Flow identities (if known).
+// - thresholds: the score thresholds of the GossipSub peer scoring; nil if peer scoring is disabled.
+// Returns:
+// - a new introspector.
+func NewIntrospector(
+	node Node,
+	recorder *Recorder,
+	idProvider module.IdentityProvider,
+	thresholds *p2pconfig.InternalScoringThresholds,
+) *Introspector {
+	return &Introspector{
+		node:       node,
+		recorder:   recorder,
+		idProvider: idProvider,
+		thresholds: thresholds,
+	}
+}
+
+// Snapshot takes a live snapshot of the GossipSub state of the node.
+// It is concurrency safe.
+func (i *Introspector) Snapshot() *Snapshot {
+	snapshot := &Snapshot{
+		Timestamp:           time.Now(),
+		Mesh:                make(map[string][]Peer),
+		PeerScores:          []PeerScore{},
+		InspectorViolations: i.recorder.InspectorViolations(),
+		ConnectionGater:     i.recorder.GaterDecisions(),
+	}
+
+	for topic, peers := range i.node.GetLocalMesh() {
+		meshPeers := make([]Peer, 0, len(peers))
+		for _, p := range peers {
+			meshPeers = append(meshPeers, i.peer(p))
+		}
+		sort.Slice(meshPeers, func(a, b int) bool {
+			return meshPeers[a].PeerID < meshPeers[b].PeerID
+		})
+		snapshot.Mesh[topic] = meshPeers
+	}
+
+	if i.thresholds != nil {
+		snapshot.ScoreThresholds = &ScoreThresholds{
+			Gossip:   i.thresholds.Gossip,
+			Publish:  i.thresholds.Publish,
+			Graylist: i.thresholds.Graylist,
+		}
+	}
+
+	exposer := i.node.PeerScoreExposer()
+	if exposer == nil {
+		// the score tracer is not enabled.
+		return snapshot
+	}
+	for p, score := range exposer.GetPeerScoreSnapshots() {
+		snapshot.PeerScores = append(snapshot.PeerScores, i.peerScore(p, score))
+	}
+	sort.Slice(snapshot.PeerScores, func(a, b int) bool {
+		if snapshot.PeerScores[a].Score != snapshot.PeerScores[b].Score {
+			return snapshot.PeerScores[a].Score < snapshot.PeerScores[b].Score
+		}
+		return snapshot.PeerScores[a].PeerID < snapshot.PeerScores[b].PeerID
+	})
+
+	return snapshot
+}
+
+// peer returns the given peer along with its Flow identity (if known).
+func (i *Introspector) peer(p peer.ID) Peer {
+	result := Peer{
+		PeerID: p.String(),
+	}
+	if identity, ok := i.idProvider.ByPeerID(p); ok {
+		result.NodeID = identity.NodeID.String()
+		result.Role = identity.Role.String()
+	}
+	return result
+}
+
+// peerScore returns the score of the given peer along with its components, and its state with respect to the score thresholds.
+func (i *Introspector) peerScore(p peer.ID, score p2p.PeerScoreSnapshot) PeerScore {
+	result := PeerScore{
+		Peer:               i.peer(p),
+		Score:              score.Score,
+		AppSpecificScore:   score.AppSpecificScore,
+		IPColocationFactor: score.IPColocationFactor,
+		BehaviourPenalty:   score.BehaviourPenalty,
+		Warning:            score.IsWarning(),
+	}
+
+	if len(score.Topics) > 0 {
+		result.Topics = make(map[string]TopicScore, len(score.Topics))
+		for topic, topicScore := range score.Topics {
+			result.Topics[topic] = TopicScore{
+				TimeInMesh:               topicScore.TimeInMesh,
+				FirstMessageDeliveries:   topicScore.FirstMessageDeliveries,
+				MeshMessageDeliveries:    topicScore.MeshMessageDeliveries,
+				InvalidMessageDeliveries: topicScore.InvalidMessageDeliveries,
+			}
+		}
+	}
+
+	if i.thresholds != nil {
+		result.BelowGossipThreshold = score.Score < i.thresholds.Gossip
+		result.BelowPublishThreshold = score.Score < i.thresholds.Publish
+		result.BelowGraylistThreshold = score.Score < i.thresholds.Graylist
+	}
+
+	return result
+}
@@ -0,0 +1,194 @@
+package introspection_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	mockmodule "github.com/onflow/flow-go/module/mock"
+	"github.com/onflow/flow-go/network/p2p"
+	p2pconfig "github.com/onflow/flow-go/network/p2p/config"
+	"github.com/onflow/flow-go/network/p2p/introspection"
+	p2pmsg "github.com/onflow/flow-go/network/p2p/message"
+	mockp2p "github.com/onflow/flow-go/network/p2p/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestRecorder_RecentEvents tests that the recorder keeps the most recent events ordered from the most recent to the
+// oldest, while counting all the events it has ever recorded.
+func TestRecorder_RecentEvents(t *testing.T) {
+	recorder := introspection.NewRecorder(3)
+	peers := unittest.PeerIdFixtures(t, 5)
+
+	for i, p := range peers {
+		var reason error
+		if i%2 == 0 {
+			reason = fmt.Errorf("rejected %d", i)
+		}
+		recorder.OnConnectionGaterDecision(&p2p.ConnectionGaterDecision{
+			PeerID:    p,
+			Direction: network.DirInbound,
+			Allowed:   reason == nil,
+			Reason:    reason,
+		})
+		recorder.OnInvalidControlMessageNotification(p2p.NewInvalidControlMessageNotification(
+			p,
+			p2pmsg.CtrlMsgGraft,
+			fmt.Errorf("invalid graft %d", i),
+			uint64(i),
+			p2p.CtrlMsgNonClusterTopicType))
+	}
+
+	decisions := recorder.GaterDecisions()
+	require.Equal(t, uint64(2), decisions.Allowed)
+	require.Equal(t, uint64(3), decisions.Rejected)
+	require.Len(t, decisions.Recent, 3)
+	for i, decision := range decisions.Recent {
+		// the most recent decisions are of the last peers, ordered from the most recent to the oldest.
+		index := len(peers) - 1 - i
+		require.Equal(t, peers[index].String(), decision.PeerID)
+		require.Equal(t, network.DirInbound.String(), decision.Direction)
+		require.Equal(t, index%2 != 0, decision.Allowed)
+		if decision.Allowed {
+			require.Empty(t, decision.Reason)
+		} else {
+			require.Equal(t, fmt.Sprintf("rejected %d", index), decision.Reason)
+		}
+	}
+
+	violations := recorder.InspectorViolations()
+	require.Equal(t, uint64(5), violations.Total)
+	require.Len(t, violations.Recent, 3)
+	for i, violation := range violations.Recent {
+		index := len(peers) - 1 - i
+		require.Equal(t, peers[index].String(), violation.PeerID)
+		require.Equal(t, string(p2pmsg.CtrlMsgGraft), violation.MsgType)
+		require.Equal(t, uint64(index), violation.Count)
+		require.Equal(t, p2p.CtrlMsgNonClusterTopicType.String(), violation.TopicType)
+		require.Equal(t, fmt.Sprintf("invalid graft %d", index), violation.Error)
+	}
+}
+
+// TestIntrospector_Snapshot tests that the snapshot combines the local mesh, the peer scores along with their state with
+// respect to the score thresholds, and the recent events of the recorder.
+func TestIntrospector_Snapshot(t *testing.T) {
+	known, unknown := unittest.PeerIdFixture(t), unittest.PeerIdFixture(t)
+	identity := unittest.IdentityFixture(unittest.WithRole(flow.RoleConsensus))
+	idProvider := mockmodule.NewIdentityProvider(t)
+	idProvider.On("ByPeerID", known).Return(identity, true)
+	idProvider.On("ByPeerID", unknown).Return(nil, false)
+
+	exposer := mockp2p.NewPeerScoreExposer(t)
+	exposer.On("GetPeerScoreSnapshots").Return(map[peer.ID]p2p.PeerScoreSnapshot{
+		known: {
+			Score:            10,
+			AppSpecificScore: 10,
+			Topics: map[string]*p2p.TopicScoreSnapshot{
+				"topic": {TimeInMesh: time.Minute, FirstMessageDeliveries: 5},
+			},
+		},
+		unknown: {
+			Score:            -150,
+			AppSpecificScore: -100,
+			BehaviourPenalty: -50,
+		},
+	})
+	node := mockp2p.NewLibP2PNode(t)
+	node.On("GetLocalMesh").Return(map[string][]peer.ID{"topic": {known}})
+	node.On("PeerScoreExposer").Return(exposer)
+
+	recorder := introspection.NewRecorder(introspection.DefaultRecentEventsCapacity)
+	recorder.OnConnectionGaterDecision(&p2p.ConnectionGaterDecision{
+		PeerID:    unknown,
+		Direction: network.DirOutbound,
+		Reason:    fmt.Errorf("peer is disallow listed"),
+	})
+
+	thresholds := &p2pconfig.InternalScoringThresholds{Gossip: -99, Publish: -99, Graylist: -200}
+	snapshot := introspection.NewIntrospector(node, recorder, idProvider, thresholds).Snapshot()
+
+	require.Equal(t, map[string][]introspection.Peer{
+		"topic": {{PeerID: known.String(), NodeID: identity.NodeID.String(), Role: flow.RoleConsensus.String()}},
+	}, snapshot.Mesh)
+	require.Equal(t, &introspection.ScoreThresholds{Gossip: -99, Publish: -99, Graylist: -200}, snapshot.ScoreThresholds)
+
+	// the peer scores are ordered from the lowest to the highest score.
+	require.Equal(t, []introspection.PeerScore{
+		{
+			Peer:                  introspection.Peer{PeerID: unknown.String()},
+			Score:                 -150,
+			AppSpecificScore:      -100,
+			BehaviourPenalty:      -50,
+			Warning:               true,
+			BelowGossipThreshold:  true,
+			BelowPublishThreshold: true,
+		},
+		{
+			Peer:             introspection.Peer{PeerID: known.String(), NodeID: identity.NodeID.String(), Role: flow.RoleConsensus.String()},
+			Score:            10,
+			AppSpecificScore: 10,
+			Topics: map[string]introspection.TopicScore{
+				"topic": {TimeInMesh: time.Minute, FirstMessageDeliveries: 5},
+			},
+		},
+	}, snapshot.PeerScores)
+
+	require.Equal(t, uint64(1), snapshot.ConnectionGater.Rejected)
+	require.Len(t, snapshot.ConnectionGater.Recent, 1)
+	require.Equal(t, unknown.String(), snapshot.ConnectionGater.Recent[0].PeerID)
+	require.Equal(t, uint64(0), snapshot.InspectorViolations.Total)
+	require.Empty(t, snapshot.InspectorViolations.Recent)
+}
+
+// TestIntrospector_ScoringDisabled tests that the snapshot does not contain any peer scores and thresholds when the peer
+// scoring and the score tracer are disabled.
+func TestIntrospector_ScoringDisabled(t *testing.T) {
+	node := mockp2p.NewLibP2PNode(t)
+	node.On("GetLocalMesh").Return(map[string][]peer.ID{})
+	node.On("PeerScoreExposer").Return(nil)
+
+	recorder := introspection.NewRecorder(introspection.DefaultRecentEventsCapacity)
+	snapshot := introspection.NewIntrospector(node, recorder, mockmodule.NewIdentityProvider(t), nil).Snapshot()
+
+	require.Empty(t, snapshot.Mesh)
+	require.Nil(t, snapshot.ScoreThresholds)
+	require.Empty(t, snapshot.PeerScores)
+}
+
+// TestIntrospector_ServeHTTP tests that the introspector serves the snapshot as JSON, and only on GET requests.
+func TestIntrospector_ServeHTTP(t *testing.T) {
+	p := unittest.PeerIdFixture(t)
+	idProvider := mockmodule.NewIdentityProvider(t)
+	idProvider.On("ByPeerID", p).Return(nil, false)
+
+	node := mockp2p.NewLibP2PNode(t)
+	node.On("GetLocalMesh").Return(map[string][]peer.ID{"topic": {p}})
+	node.On("PeerScoreExposer").Return(nil)
+
+	introspector := introspection.NewIntrospector(node, introspection.NewRecorder(introspection.DefaultRecentEventsCapacity), idProvider, nil)
+	server := httptest.NewServer(introspector)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + introspection.Endpoint)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	var snapshot introspection.Snapshot
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&snapshot))
+	require.Equal(t, map[string][]introspection.Peer{"topic": {{PeerID: p.String()}}}, snapshot.Mesh)
+
+	resp, err = http.Post(server.URL+introspection.Endpoint, "application/json", nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
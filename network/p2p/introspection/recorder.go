@@ -0,0 +1,132 @@
+package introspection
+
+import (
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go/network/p2p"
+)
+
+// DefaultRecentEventsCapacity is the default number of the most recent events of each kind (i.e., RPC inspector
+// violations and connection gater decisions) that are kept by the recorder.
+const DefaultRecentEventsCapacity = 100
+
+// Recorder keeps the most recent RPC inspector violations and connection gater decisions of the node, so that they can be
+// exposed through the introspection snapshot. It is a consumer of the invalid control message notifications of the GossipSub
+// RPC inspector as well as the decisions of the connection gater.
+// The recorder only observes the events, it does not take any action on the remote peers. It is concurrency safe and non-blocking.
+type Recorder struct {
+	violations *eventBuffer[InspectorViolation]
+	decisions  *eventBuffer[GaterDecision]
+}
+
+var _ p2p.GossipSubInvCtrlMsgNotifConsumer = (*Recorder)(nil)
+var _ p2p.ConnectionGaterDecisionConsumer = (*Recorder)(nil)
+
+// NewRecorder creates a new recorder that keeps up to the given number of the most recent events of each kind.
+func NewRecorder(capacity uint) *Recorder {
+	return &Recorder{
+		violations: newEventBuffer[InspectorViolation](capacity),
+		decisions:  newEventBuffer[GaterDecision](capacity),
+	}
+}
+
+// OnInvalidControlMessageNotification records the given invalid control message notification of the RPC inspector.
+func (r *Recorder) OnInvalidControlMessageNotification(notification *p2p.InvCtrlMsgNotif) {
+	violation := InspectorViolation{
+		Timestamp: time.Now(),
+		PeerID:    notification.PeerID.String(),
+		MsgType:   string(notification.MsgType),
+		Count:     notification.Count,
+		TopicType: notification.TopicType.String(),
+	}
+	if notification.Error != nil {
+		violation.Error = notification.Error.Error()
+	}
+	r.violations.add(violation, false)
+}
+
+// OnConnectionGaterDecision records the given decision of the connection gater.
+func (r *Recorder) OnConnectionGaterDecision(decision *p2p.ConnectionGaterDecision) {
+	d := GaterDecision{
+		Timestamp: time.Now(),
+		PeerID:    decision.PeerID.String(),
+		Direction: decision.Direction.String(),
+		Allowed:   decision.Allowed,
+	}
+	if decision.Reason != nil {
+		d.Reason = decision.Reason.Error()
+	}
+	r.decisions.add(d, !decision.Allowed)
+}
+
+// InspectorViolations returns the snapshot of the recent RPC inspector violations.
+func (r *Recorder) InspectorViolations() InspectorViolationsSnapshot {
+	recent, total, _ := r.violations.snapshot()
+	return InspectorViolationsSnapshot{
+		Total:  total,
+		Recent: recent,
+	}
+}
+
+// GaterDecisions returns the snapshot of the recent connection gater decisions.
+func (r *Recorder) GaterDecisions() GaterDecisionsSnapshot {
+	recent, total, rejected := r.decisions.snapshot()
+	return GaterDecisionsSnapshot{
+		Allowed:  total - rejected,
+		Rejected: rejected,
+		Recent:   recent,
+	}
+}
+
+// eventBuffer is a concurrency safe ring buffer that keeps the most recent events, along with the total number of the
+// events (and the flagged events among them) that have ever been added.
+type eventBuffer[T any] struct {
+	mu       sync.Mutex
+	events   []T
+	next     int    // index of the slot to write the next event into.
+	total    uint64 // total number of the events added.
+	flagged  uint64 // total number of the flagged events added.
+	capacity int
+}
+
+func newEventBuffer[T any](capacity uint) *eventBuffer[T] {
+	return &eventBuffer[T]{
+		events:   make([]T, 0, capacity),
+		capacity: int(capacity),
+	}
+}
+
+// add adds the given event to the buffer, evicting the oldest event if the buffer is full.
+// The flagged argument marks the event as flagged, e.g., a rejected connection attempt.
+func (b *eventBuffer[T]) add(event T, flagged bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.total++
+	if flagged {
+		b.flagged++
+	}
+	if b.capacity == 0 {
+		return
+	}
+	if len(b.events) < b.capacity {
+		b.events = append(b.events, event)
+	} else {
+		b.events[b.next] = event
+	}
+	b.next = (b.next + 1) % b.capacity
+}
+
+// snapshot returns the events in the buffer ordered from the most recent to the oldest, along with the total number of the
+// events and the flagged events that have ever been added.
+func (b *eventBuffer[T]) snapshot() ([]T, uint64, uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	events := make([]T, 0, len(b.events))
+	for i := 1; i <= len(b.events); i++ {
+		events = append(events, b.events[(b.next-i+len(b.events))%len(b.events)])
+	}
+	return events, b.total, b.flagged
+}
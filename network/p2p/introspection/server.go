@@ -0,0 +1,124 @@
+package introspection
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/module/component"
+	"github.com/onflow/flow-go/module/irrecoverable"
+)
+
+const (
+	// Endpoint is the http endpoint of the introspection server that serves the GossipSub snapshot of the node.
+	Endpoint = "/gossipsub"
+
+	// serverShutdownTimeout is the time to wait for the server to shut down gracefully.
+	serverShutdownTimeout = 5 * time.Second
+)
+
+// ServeHTTP serves the live GossipSub snapshot of the node as JSON.
+func (i *Introspector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(i.Snapshot()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Server is the optional http debug server that serves the live GossipSub snapshot of the node on the Endpoint.
+type Server struct {
+	component.Component
+
+	address string
+	server  *http.Server
+	log     zerolog.Logger
+}
+
+// NewServer creates a new server that will start on the given address, and serves the snapshots of the given introspector.
+func NewServer(log zerolog.Logger, address string, introspector *Introspector) *Server {
+	mux := http.NewServeMux()
+	mux.Handle(Endpoint, introspector)
+
+	s := &Server{
+		address: address,
+		server:  &http.Server{Addr: address, Handler: mux},
+		log: log.With().
+			Str("component", "gossipsub-introspection-server").
+			Str("address", address).
+			Str("endpoint", Endpoint).
+			Logger(),
+	}
+
+	s.Component = component.NewComponentManagerBuilder().
+		AddWorker(s.serve).
+		AddWorker(s.shutdownOnContextDone).
+		Build()
+
+	return s
+}
+
+func (s *Server) serve(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	s.log.Info().Msg("starting gossipsub introspection server on address")
+
+	l, err := net.Listen("tcp", s.address)
+	if err != nil {
+		s.log.Err(err).Msg("failed to start the gossipsub introspection server")
+		ctx.Throw(err)
+		return
+	}
+
+	ready()
+
+	// pass the signaler context to the server so that the signaler context
+	// can control the server's lifetime
+	s.server.BaseContext = func(_ net.Listener) context.Context {
+		return ctx
+	}
+
+	err = s.server.Serve(l) // blocking call
+	if err != nil {
+		if errors.Is(err, http.ErrServerClosed) {
+			return
+		}
+		s.log.Err(err).Msg("fatal error in the gossipsub introspection server")
+		ctx.Throw(err)
+	}
+}
+
+func (s *Server) shutdownOnContextDone(ictx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	ready()
+	<-ictx.Done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+	defer cancel()
+
+	// shutdown the server gracefully
+	err := s.server.Shutdown(ctx)
+	if err == nil {
+		s.log.Info().Msg("gossipsub introspection server graceful shutdown completed")
+		return
+	}
+
+	if errors.Is(err, ctx.Err()) {
+		s.log.Warn().Msg("gossipsub introspection server graceful shutdown timed out")
+		// shutdown the server forcefully
+		err := s.server.Close()
+		if err != nil {
+			s.log.Err(err).Msg("error closing gossipsub introspection server")
+		}
+	} else {
+		s.log.Err(err).Msg("error shutting down gossipsub introspection server")
+	}
+}
@@ -0,0 +1,104 @@
+package introspection
+
+import (
+	"time"
+)
+
+// Snapshot is the live snapshot of the GossipSub state of the node, i.e., its local mesh, the scores of the remote peers,
+// and the recent RPC inspector violations and connection gater decisions.
+// It is meant for debugging, e.g., to find out why the node fell out of a topic mesh.
+type Snapshot struct {
+	// Timestamp is the time at which the snapshot is taken.
+	Timestamp time.Time `json:"timestamp"`
+	// Mesh is the local mesh of the node keyed by topic.
+	Mesh map[string][]Peer `json:"mesh"`
+	// ScoreThresholds are the score thresholds of the GossipSub peer scoring; nil if peer scoring is disabled.
+	ScoreThresholds *ScoreThresholds `json:"score_thresholds,omitempty"`
+	// PeerScores are the scores of the remote peers, ordered from the lowest to the highest score; empty if the
+	// score tracer is not enabled.
+	PeerScores []PeerScore `json:"peer_scores"`
+	// InspectorViolations are the recent violations detected by the GossipSub RPC inspector.
+	InspectorViolations InspectorViolationsSnapshot `json:"inspector_violations"`
+	// ConnectionGater are the recent decisions of the connection gater.
+	ConnectionGater GaterDecisionsSnapshot `json:"connection_gater"`
+}
+
+// Peer is a remote peer along with its Flow identity (if known).
+type Peer struct {
+	PeerID string `json:"peer_id"`
+	NodeID string `json:"node_id,omitempty"`
+	Role   string `json:"role,omitempty"`
+}
+
+// ScoreThresholds are the score thresholds of the GossipSub peer scoring.
+type ScoreThresholds struct {
+	Gossip   float64 `json:"gossip"`
+	Publish  float64 `json:"publish"`
+	Graylist float64 `json:"graylist"`
+}
+
+// PeerScore is the score of a remote peer along with its components.
+type PeerScore struct {
+	Peer
+	Score              float64               `json:"score"`
+	AppSpecificScore   float64               `json:"app_specific_score"`
+	IPColocationFactor float64               `json:"ip_colocation_factor"`
+	BehaviourPenalty   float64               `json:"behaviour_penalty"`
+	Topics             map[string]TopicScore `json:"topics,omitempty"`
+	// Warning is true if any of the score components of the peer is in the warning state.
+	Warning bool `json:"warning"`
+	// BelowGossipThreshold is true if the score of the peer is below the gossip threshold, i.e., no gossip is emitted
+	// towards the peer and the gossip from the peer is ignored.
+	BelowGossipThreshold bool `json:"below_gossip_threshold"`
+	// BelowPublishThreshold is true if the score of the peer is below the publish threshold, i.e., the self-published
+	// messages are not propagated towards the peer.
+	BelowPublishThreshold bool `json:"below_publish_threshold"`
+	// BelowGraylistThreshold is true if the score of the peer is below the graylist threshold, i.e., the incoming
+	// RPCs from the peer are ignored.
+	BelowGraylistThreshold bool `json:"below_graylist_threshold"`
+}
+
+// TopicScore is the score components of a remote peer on a topic.
+type TopicScore struct {
+	TimeInMesh               time.Duration `json:"time_in_mesh"`
+	FirstMessageDeliveries   float64       `json:"first_message_deliveries"`
+	MeshMessageDeliveries    float64       `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries float64       `json:"invalid_message_deliveries"`
+}
+
+// InspectorViolationsSnapshot is the snapshot of the recent violations detected by the GossipSub RPC inspector.
+type InspectorViolationsSnapshot struct {
+	// Total is the total number of the violations since the node started.
+	Total uint64 `json:"total"`
+	// Recent are the most recent violations, ordered from the most recent to the oldest.
+	Recent []InspectorViolation `json:"recent"`
+}
+
+// InspectorViolation is a violation detected by the GossipSub RPC inspector on an RPC of a remote peer.
+type InspectorViolation struct {
+	Timestamp time.Time `json:"timestamp"`
+	PeerID    string    `json:"peer_id"`
+	MsgType   string    `json:"msg_type"`
+	Count     uint64    `json:"count"`
+	TopicType string    `json:"topic_type"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// GaterDecisionsSnapshot is the snapshot of the recent decisions of the connection gater.
+type GaterDecisionsSnapshot struct {
+	// Allowed is the total number of the allowed connection attempts since the node started.
+	Allowed uint64 `json:"allowed"`
+	// Rejected is the total number of the rejected connection attempts since the node started.
+	Rejected uint64 `json:"rejected"`
+	// Recent are the most recent decisions, ordered from the most recent to the oldest.
+	Recent []GaterDecision `json:"recent"`
+}
+
+// GaterDecision is a decision of the connection gater on a connection attempt of a remote peer.
+type GaterDecision struct {
+	Timestamp time.Time `json:"timestamp"`
+	PeerID    string    `json:"peer_id"`
+	Direction string    `json:"direction"`
+	Allowed   bool      `json:"allowed"`
+	Reason    string    `json:"reason,omitempty"`
+}
@@ -128,6 +128,11 @@ type PubSub interface {
 	// Returns:
 	// - []peer.ID: the list of peers in the local mesh for the given topic.
 	GetLocalMeshPeers(topic channels.Topic) []peer.ID
+
+	// GetLocalMesh returns the local mesh of the node for all topics.
+	// Returns:
+	// - map[string][]peer.ID: the list of peers in the local mesh keyed by topic.
+	GetLocalMesh() map[string][]peer.ID
 }
 
 // LibP2PNode represents a Flow libp2p node. It provides the network layer with the necessary interface to
@@ -20,6 +20,11 @@ type GossipSubBuilder struct {
 	mock.Mock
 }
 
+// AddInvCtrlMsgNotifConsumer provides a mock function with given fields: _a0
+func (_m *GossipSubBuilder) AddInvCtrlMsgNotifConsumer(_a0 p2p.GossipSubInvCtrlMsgNotifConsumer) {
+	_m.Called(_a0)
+}
+
 // Build provides a mock function with given fields: _a0
 func (_m *GossipSubBuilder) Build(_a0 irrecoverable.SignalerContext) (p2p.PubSubAdapter, error) {
 	ret := _m.Called(_a0)
@@ -116,6 +116,26 @@ func (_m *LibP2PNode) GetIPPort() (string, string, error) {
 	return r0, r1, r2
 }
 
+// GetLocalMesh provides a mock function with given fields:
+func (_m *LibP2PNode) GetLocalMesh() map[string][]peer.ID {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocalMesh")
+	}
+
+	var r0 map[string][]peer.ID
+	if rf, ok := ret.Get(0).(func() map[string][]peer.ID); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]peer.ID)
+		}
+	}
+
+	return r0
+}
+
 // GetLocalMeshPeers provides a mock function with given fields: topic
 func (_m *LibP2PNode) GetLocalMeshPeers(topic channels.Topic) []peer.ID {
 	ret := _m.Called(topic)
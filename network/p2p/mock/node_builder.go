@@ -27,6 +27,26 @@ type NodeBuilder struct {
 	mock.Mock
 }
 
+// AddGossipSubInvCtrlMsgNotifConsumer provides a mock function with given fields: _a0
+func (_m *NodeBuilder) AddGossipSubInvCtrlMsgNotifConsumer(_a0 p2p.GossipSubInvCtrlMsgNotifConsumer) p2p.NodeBuilder {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGossipSubInvCtrlMsgNotifConsumer")
+	}
+
+	var r0 p2p.NodeBuilder
+	if rf, ok := ret.Get(0).(func(p2p.GossipSubInvCtrlMsgNotifConsumer) p2p.NodeBuilder); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(p2p.NodeBuilder)
+		}
+	}
+
+	return r0
+}
+
 // Build provides a mock function with given fields:
 func (_m *NodeBuilder) Build() (p2p.LibP2PNode, error) {
 	ret := _m.Called()
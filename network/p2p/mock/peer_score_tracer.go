@@ -122,6 +122,26 @@ func (_m *PeerScoreTracer) GetIPColocationFactor(peerID peer.ID) (float64, bool)
 	return r0, r1
 }
 
+// GetPeerScoreSnapshots provides a mock function with given fields:
+func (_m *PeerScoreTracer) GetPeerScoreSnapshots() map[peer.ID]p2p.PeerScoreSnapshot {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeerScoreSnapshots")
+	}
+
+	var r0 map[peer.ID]p2p.PeerScoreSnapshot
+	if rf, ok := ret.Get(0).(func() map[peer.ID]p2p.PeerScoreSnapshot); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[peer.ID]p2p.PeerScoreSnapshot)
+		}
+	}
+
+	return r0
+}
+
 // GetScore provides a mock function with given fields: peerID
 func (_m *PeerScoreTracer) GetScore(peerID peer.ID) (float64, bool) {
 	ret := _m.Called(peerID)
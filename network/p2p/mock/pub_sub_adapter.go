@@ -45,6 +45,26 @@ func (_m *PubSubAdapter) Done() <-chan struct{} {
 	return r0
 }
 
+// GetLocalMesh provides a mock function with given fields:
+func (_m *PubSubAdapter) GetLocalMesh() map[string][]peer.ID {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocalMesh")
+	}
+
+	var r0 map[string][]peer.ID
+	if rf, ok := ret.Get(0).(func() map[string][]peer.ID); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]peer.ID)
+		}
+	}
+
+	return r0
+}
+
 // GetLocalMeshPeers provides a mock function with given fields: topic
 func (_m *PubSubAdapter) GetLocalMeshPeers(topic channels.Topic) []peer.ID {
 	ret := _m.Called(topic)
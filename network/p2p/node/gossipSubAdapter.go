@@ -225,6 +225,13 @@ func (g *GossipSubAdapter) GetLocalMeshPeers(topic channels.Topic) []peer.ID {
 	return g.localMeshTracer.GetLocalMeshPeers(topic)
 }
 
+// GetLocalMesh returns the local mesh of the node for all topics.
+// Returns:
+// - map[string][]peer.ID: the list of peers in the local mesh keyed by topic.
+func (g *GossipSubAdapter) GetLocalMesh() map[string][]peer.ID {
+	return g.localMeshTracer.GetLocalMesh()
+}
+
 // PeerScoreExposer returns the peer score exposer for the gossipsub adapter. The exposer is a read-only interface
 // for querying peer scores and returns the local scoring table of the underlying gossipsub node.
 // The exposer is only available if the gossipsub adapter was configured with a score tracer.
@@ -584,6 +584,13 @@ func (n *Node) GetLocalMeshPeers(topic channels.Topic) []peer.ID {
 	return n.pubSub.GetLocalMeshPeers(topic)
 }
 
+// GetLocalMesh returns the local mesh of the node for all topics.
+// Returns:
+// - map[string][]peer.ID: the list of peers in the local mesh keyed by topic.
+func (n *Node) GetLocalMesh() map[string][]peer.ID {
+	return n.pubSub.GetLocalMesh()
+}
+
 // SetComponentManager sets the component manager for the node.
 // SetComponentManager may be called at most once.
 func (n *Node) SetComponentManager(cm *component.ComponentManager) {
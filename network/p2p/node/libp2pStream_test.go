@@ -406,6 +406,75 @@ func TestUnicastOverStream_WithGzipStreamCompression(t *testing.T) {
 	testUnicastOverStream(t, p2ptest.WithPreferredUnicasts([]protocols.ProtocolName{protocols.GzipCompressionUnicast}))
 }
 
+// TestUnicastOverStream_WithZstdStreamCompression checks two nodes can send and receive unicast messages on zstd compressed streams
+// when both nodes have zstd stream compression enabled.
+func TestUnicastOverStream_WithZstdStreamCompression(t *testing.T) {
+	testUnicastOverStream(t, p2ptest.WithPreferredUnicasts([]protocols.ProtocolName{protocols.ZstdCompressionUnicast}))
+}
+
+// TestUnicastOverStream_WithSnappyStreamCompression checks two nodes can send and receive unicast messages on snappy compressed streams
+// when both nodes have snappy stream compression enabled.
+func TestUnicastOverStream_WithSnappyStreamCompression(t *testing.T) {
+	testUnicastOverStream(t, p2ptest.WithPreferredUnicasts([]protocols.ProtocolName{protocols.SnappyCompressionUnicast}))
+}
+
+// TestUnicastOverStream_WithLz4StreamCompression checks two nodes can send and receive unicast messages on lz4 compressed streams
+// when both nodes have lz4 stream compression enabled.
+func TestUnicastOverStream_WithLz4StreamCompression(t *testing.T) {
+	testUnicastOverStream(t, p2ptest.WithPreferredUnicasts([]protocols.ProtocolName{protocols.Lz4CompressionUnicast}))
+}
+
+// TestUnicastOverStream_WithCompressionNegotiation checks two nodes with different orders of preference over the same compressions
+// can negotiate compressed streams, and send and receive unicast messages on them.
+func TestUnicastOverStream_WithCompressionNegotiation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+
+	// Creates nodes
+	// node1: prefers zstd over snappy over gzip
+	// node2: prefers gzip over snappy, and does not support zstd
+	sporkId := unittest.IdentifierFixture()
+	idProvider := mockmodule.NewIdentityProvider(t)
+	streamHandler1, inbound1 := p2ptest.StreamHandlerFixture(t)
+	node1, id1 := p2ptest.NodeFixture(t,
+		sporkId,
+		t.Name(),
+		idProvider,
+		p2ptest.WithDefaultStreamHandler(streamHandler1),
+		p2ptest.WithPreferredUnicasts([]protocols.ProtocolName{
+			protocols.GzipCompressionUnicast,
+			protocols.SnappyCompressionUnicast,
+			protocols.ZstdCompressionUnicast,
+		}))
+
+	streamHandler2, inbound2 := p2ptest.StreamHandlerFixture(t)
+	node2, id2 := p2ptest.NodeFixture(t,
+		sporkId,
+		t.Name(),
+		idProvider,
+		p2ptest.WithDefaultStreamHandler(streamHandler2),
+		p2ptest.WithPreferredUnicasts([]protocols.ProtocolName{
+			protocols.SnappyCompressionUnicast,
+			protocols.GzipCompressionUnicast,
+		}))
+
+	ids := flow.IdentityList{&id1, &id2}
+	nodes := []p2p.LibP2PNode{node1, node2}
+	for i, node := range nodes {
+		idProvider.On("ByPeerID", node.ID()).Return(ids[i], true).Maybe()
+
+	}
+	p2ptest.StartNodes(t, signalerCtx, nodes)
+	defer p2ptest.StopNodes(t, nodes, cancel)
+
+	p2ptest.LetNodesDiscoverEachOther(t, ctx, nodes, ids)
+	p2pfixtures.EnsureMessageExchangeOverUnicast(
+		t,
+		ctx,
+		nodes,
+		[]chan string{inbound1, inbound2}, p2pfixtures.LongStringMessageFactoryFixture(t))
+}
+
 // testUnicastOverStream sends a message from node 1 to node 2 and then from node 2 to node 1 over a unicast stream.
 func testUnicastOverStream(t *testing.T, opts ...p2ptest.NodeFixtureParameterOption) {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -488,6 +557,101 @@ func TestUnicastOverStream_Fallback(t *testing.T) {
 		[]chan string{inbound1, inbound2}, p2pfixtures.LongStringMessageFactoryFixture(t))
 }
 
+// TestUnicastOverStream_LegacyUncompressedPeer checks that a node preferring compressed unicasts can exchange unicasts with a legacy
+// peer that only registers the plain unicast protocol, as all nodes did before unicast compression. The node offers all its protocols
+// in a single negotiation and must settle on the plain protocol. The legacy peer dials the node the old way, i.e., by opening a stream
+// on the plain protocol id alone.
+func TestUnicastOverStream_LegacyUncompressedPeer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+
+	type received struct {
+		protocol string
+		msg      string
+	}
+	streamHandlerFixture := func() (func(s network.Stream), chan received) {
+		ch := make(chan received, 1)
+		return func(s network.Stream) {
+			rw := bufio.NewReadWriter(bufio.NewReader(s), bufio.NewWriter(s))
+			str, err := rw.ReadString('\n')
+			require.NoError(t, err)
+			ch <- received{protocol: string(s.Protocol()), msg: str}
+		}, ch
+	}
+
+	// Creates nodes
+	// node: prefers zstd over snappy over gzip
+	// legacy: supports only the plain unicast protocol
+	sporkId := unittest.IdentifierFixture()
+	idProvider := mockmodule.NewIdentityProvider(t)
+	streamHandler, inbound := streamHandlerFixture()
+	node, id := p2ptest.NodeFixture(t,
+		sporkId,
+		t.Name(),
+		idProvider,
+		p2ptest.WithDefaultStreamHandler(streamHandler),
+		p2ptest.WithPreferredUnicasts([]protocols.ProtocolName{
+			protocols.GzipCompressionUnicast,
+			protocols.SnappyCompressionUnicast,
+			protocols.ZstdCompressionUnicast,
+		}))
+
+	legacyStreamHandler, legacyInbound := streamHandlerFixture()
+	legacy, legacyId := p2ptest.NodeFixture(t,
+		sporkId,
+		t.Name(),
+		idProvider,
+		p2ptest.WithDefaultStreamHandler(legacyStreamHandler))
+
+	ids := flow.IdentityList{&id, &legacyId}
+	nodes := []p2p.LibP2PNode{node, legacy}
+	for i, n := range nodes {
+		idProvider.On("ByPeerID", n.ID()).Return(ids[i], true).Maybe()
+	}
+	p2ptest.StartNodes(t, signalerCtx, nodes)
+	defer p2ptest.StopNodes(t, nodes, cancel)
+
+	p2ptest.LetNodesDiscoverEachOther(t, ctx, nodes, ids)
+	plainId := string(protocols.FlowProtocolID(sporkId))
+	messageFactory := p2pfixtures.LongStringMessageFactoryFixture(t)
+
+	// the node negotiates the plain protocol with the legacy peer
+	msg := messageFactory()
+	err := node.OpenAndWriteOnStream(ctx, legacy.ID(), t.Name(), func(s network.Stream) error {
+		w := bufio.NewWriter(s)
+		_, err := w.WriteString(msg)
+		require.NoError(t, err)
+		return w.Flush()
+	})
+	require.NoError(t, err)
+
+	select {
+	case rcv := <-legacyInbound:
+		require.Equal(t, plainId, rcv.protocol)
+		require.Equal(t, msg, rcv.msg)
+	case <-time.After(3 * time.Second):
+		require.Fail(t, "legacy peer did not receive the message")
+	}
+
+	// the legacy peer opens a stream on the plain protocol id alone
+	msg = messageFactory()
+	s, err := legacy.Host().NewStream(ctx, node.ID(), protocols.FlowProtocolID(sporkId))
+	require.NoError(t, err)
+	w := bufio.NewWriter(s)
+	_, err = w.WriteString(msg)
+	require.NoError(t, err)
+	require.NoError(t, w.Flush())
+	require.NoError(t, s.Close())
+
+	select {
+	case rcv := <-inbound:
+		require.Equal(t, plainId, rcv.protocol)
+		require.Equal(t, msg, rcv.msg)
+	case <-time.After(3 * time.Second):
+		require.Fail(t, "node did not receive the message from the legacy peer")
+	}
+}
+
 // TestCreateStreamTimeoutWithUnresponsiveNode tests that the CreateStream call does not block longer than the
 // timeout interval
 func TestCreateStreamTimeoutWithUnresponsiveNode(t *testing.T) {
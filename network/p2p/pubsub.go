@@ -62,6 +62,11 @@ type PubSubAdapter interface {
 	// - []peer.ID: the list of peers in the local mesh for the given topic.
 	GetLocalMeshPeers(topic channels.Topic) []peer.ID
 
+	// GetLocalMesh returns the local mesh of the node for all topics.
+	// Returns:
+	// - map[string][]peer.ID: the list of peers in the local mesh keyed by topic.
+	GetLocalMesh() map[string][]peer.ID
+
 	// PeerScoreExposer returns the peer score exposer for the gossipsub adapter. The exposer is a read-only interface
 	// for querying peer scores and returns the local scoring table of the underlying gossipsub node.
 	// The exposer is only available if the gossipsub adapter was configured with a score tracer.
@@ -181,6 +186,10 @@ type PubSubTracer interface {
 	// Returns:
 	// - []peer.ID: the list of peers in the mesh for the given topic.
 	GetLocalMeshPeers(topic channels.Topic) []peer.ID
+	// GetLocalMesh returns the local mesh of the node for all topics.
+	// Returns:
+	// - map[string][]peer.ID: the list of peers in the mesh keyed by topic.
+	GetLocalMesh() map[string][]peer.ID
 }
 
 // RpcControlTracking is the abstraction of the underlying libp2p control message tracker used to track message ids advertised by the iHave control messages.
@@ -300,4 +309,7 @@ type PeerScoreExposer interface {
 	// GetTopicScores returns the topic scores for the given peer for all topics.
 	// The returned map is keyed by topic name.
 	GetTopicScores(peerID peer.ID) (map[string]TopicScoreSnapshot, bool)
+	// GetPeerScoreSnapshots returns a copy of the latest score snapshot of all peers known to the local scoring table.
+	// The returned map is keyed by peer ID.
+	GetPeerScoreSnapshots() map[peer.ID]PeerScoreSnapshot
 }
@@ -12,8 +12,9 @@ import (
 // it can create libp2p streams with finer granularity.
 type StreamFactory interface {
 	SetStreamHandler(protocol.ID, network.StreamHandler)
-	// NewStream creates a new stream on the libp2p host.
+	// NewStream creates a new stream on the libp2p host. When more than one protocol id is given, the protocol of the stream
+	// is negotiated with the remote peer in the given order of preference.
 	// Expected errors during normal operations:
 	//   - ErrProtocolNotSupported this indicates remote node is running on a different spork.
-	NewStream(context.Context, peer.ID, protocol.ID) (network.Stream, error)
+	NewStream(context.Context, peer.ID, ...protocol.ID) (network.Stream, error)
 }
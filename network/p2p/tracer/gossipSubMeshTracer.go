@@ -149,6 +149,23 @@ func (t *GossipSubMeshTracer) GetLocalMeshPeers(topic channels.Topic) []peer.ID
 	return peers
 }
 
+// GetLocalMesh returns the local mesh peers for all topics.
+// Returns:
+// - map[string][]peer.ID: the local mesh peers keyed by topic.
+func (t *GossipSubMeshTracer) GetLocalMesh() map[string][]peer.ID {
+	t.topicMeshMu.RLock()
+	defer t.topicMeshMu.RUnlock()
+
+	mesh := make(map[string][]peer.ID, len(t.topicMeshMap))
+	for topic, peers := range t.topicMeshMap {
+		mesh[topic] = make([]peer.ID, 0, len(peers))
+		for p := range peers {
+			mesh[topic] = append(mesh[topic], p)
+		}
+	}
+	return mesh
+}
+
 // Graft is called by GossipSub when a peer is added to a topic mesh. The tracer uses this to track the mesh peers.
 func (t *GossipSubMeshTracer) Graft(p peer.ID, topic string) {
 	t.metrics.OnPeerGraftTopic(topic)
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -169,6 +170,11 @@ func TestGossipSubMeshTracer(t *testing.T) {
 		return topic1MeshSize == 2 && topic2MeshSize == 1
 	}, 2*time.Second, 10*time.Millisecond)
 
+	// the local mesh of all topics is consistent with the local mesh peers of each topic.
+	mesh := tracerNode.GetLocalMesh()
+	require.Subset(t, mesh[topic1.String()], []peer.ID{otherNode1.ID(), otherNode2.ID()})
+	require.Contains(t, mesh[topic2.String()], otherNode1.ID())
+
 	// eventually, we expect the meshTracer to log the mesh at least once.
 	assert.Eventually(t, func() bool {
 		return loggerCycle.Load() > 0 && warnLoggerCycle.Load() > 0
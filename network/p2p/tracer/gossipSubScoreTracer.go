@@ -150,6 +150,28 @@ func (g *GossipSubScoreTracer) GetTopicScores(peerID peer.ID) (map[string]p2p.To
 	return topicsSnapshot, true
 }
 
+// GetPeerScoreSnapshots returns a copy of the latest score snapshot of all peers.
+// The returned map is keyed by peer ID.
+func (g *GossipSubScoreTracer) GetPeerScoreSnapshots() map[peer.ID]p2p.PeerScoreSnapshot {
+	g.snapshotLock.RLock()
+	defer g.snapshotLock.RUnlock()
+
+	snapshots := make(map[peer.ID]p2p.PeerScoreSnapshot, len(g.snapshot))
+	for peerID, snapshot := range g.snapshot {
+		// copy the topic scores into a new map so that the returned snapshot does not share state with the tracer.
+		topics := make(map[string]*p2p.TopicScoreSnapshot, len(snapshot.Topics))
+		for topic, topicSnapshot := range snapshot.Topics {
+			topicCopy := *topicSnapshot
+			topics[topic] = &topicCopy
+		}
+		snapshotCopy := *snapshot
+		snapshotCopy.Topics = topics
+		snapshots[peerID] = snapshotCopy
+	}
+
+	return snapshots
+}
+
 func (g *GossipSubScoreTracer) logLoop(ctx irrecoverable.SignalerContext) {
 	g.logger.Debug().Msg("starting log loop")
 	for {
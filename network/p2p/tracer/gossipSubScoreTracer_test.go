@@ -258,6 +258,14 @@ func TestGossipSubScoreTracer(t *testing.T) {
 		return ok
 	}, 2*time.Second, 10*time.Millisecond)
 
+	// the score snapshots of all peers are consistent with the scores of each peer.
+	snapshots := tracerNode.PeerScoreExposer().GetPeerScoreSnapshots()
+	require.Contains(t, snapshots, consensusNode.ID())
+	require.Contains(t, snapshots, accessNode.ID())
+	require.Equal(t, consensusScore, snapshots[consensusNode.ID()].AppSpecificScore)
+	require.Equal(t, accessScore, snapshots[accessNode.ID()].AppSpecificScore)
+	require.Contains(t, snapshots[consensusNode.ID()].Topics, topic1.String())
+
 	time.Sleep(2 * time.Second)
 
 	// 8. Expects the score tracer to log the scores at least once.
@@ -131,7 +131,7 @@ func (m *Manager) Register(protocol protocols.ProtocolName) error {
 		return fmt.Errorf("could not translate protocol name into factory: %w", err)
 	}
 
-	u := factory(m.logger, m.sporkId, m.defaultHandler)
+	u := factory(m.logger, m.sporkId, m.defaultHandler, m.metrics)
 
 	m.protocols = append(m.protocols, u)
 	m.streamFactory.SetStreamHandler(u.ProtocolId(), u.Handler)
@@ -140,8 +140,10 @@ func (m *Manager) Register(protocol protocols.ProtocolName) error {
 	return nil
 }
 
-// CreateStream tries establishing a libp2p stream to the remote peer id. It tries creating streams in the descending order of preference until
-// it either creates a successful stream or runs out of options.
+// CreateStream tries establishing a libp2p stream to the remote peer id. The stream protocol is negotiated with the remote peer
+// in a single round in the descending order of preference of the registered protocols, i.e., the most preferred protocol that is
+// also supported by the remote peer is selected. If the context carries a preferred protocol (see protocols.WithPreferredProtocol),
+// it is prioritized over the registered order of preference.
 // Args:
 //   - ctx: context for the stream creation.
 //   - peerID: peer ID of the remote peer.
@@ -150,7 +152,6 @@ func (m *Manager) Register(protocol protocols.ProtocolName) error {
 //   - a new libp2p stream.
 //   - error if the stream creation fails; the error is benign and can be retried.
 func (m *Manager) CreateStream(ctx context.Context, peerID peer.ID) (libp2pnet.Stream, error) {
-	var errs error
 	dialCfg, err := m.getDialConfig(peerID)
 	if err != nil {
 		// TODO: technically, we better to return an error here, but the error must be irrecoverable, and we cannot
@@ -168,43 +169,64 @@ func (m *Manager) CreateStream(ctx context.Context, peerID peer.ID) (libp2pnet.S
 		Str("dial_config", fmt.Sprintf("%+v", dialCfg)).
 		Msg("dial config for the peer retrieved")
 
-	for i := len(m.protocols) - 1; i >= 0; i-- {
-		s, err := m.createStream(ctx, peerID, m.protocols[i], dialCfg)
-		if err != nil {
-			errs = multierror.Append(errs, err)
-			continue
-		}
-
-		// return first successful stream
+	s, err := m.createStream(ctx, peerID, m.protocolsByPreference(ctx), dialCfg)
+	if err == nil {
 		return s, nil
 	}
 
-	updatedCfg, err := m.adjustUnsuccessfulStreamAttempt(peerID)
-	if err != nil {
+	updatedCfg, adjustErr := m.adjustUnsuccessfulStreamAttempt(peerID)
+	if adjustErr != nil {
 		// TODO: technically, we better to return an error here, but the error must be irrecoverable, and we cannot
 		//       guarantee a clear distinction between recoverable and irrecoverable errors at the moment with CreateStream.
 		//       We have to revisit this once we studied the error handling paths in the unicast manager.
 		m.logger.Fatal().
-			Err(err).
+			Err(adjustErr).
 			Bool(logging.KeyNetworkingSecurity, true).
 			Str("peer_id", p2plogging.PeerId(peerID)).
 			Msg("failed to adjust dial config for peer id")
 	}
 
 	m.logger.Warn().
-		Err(errs).
+		Err(err).
 		Bool(logging.KeySuspicious, true).
 		Str("peer_id", p2plogging.PeerId(peerID)).
 		Str("dial_config", fmt.Sprintf("%+v", updatedCfg)).
 		Msg("failed to create stream to peer id, dial config adjusted")
 
-	return nil, fmt.Errorf("could not create stream on any available unicast protocol: %w", errs)
+	return nil, fmt.Errorf("could not create stream on any available unicast protocol: %w", err)
 }
 
-// createStream attempts to establish a new stream with a peer using the specified protocol. It employs
-// exponential backoff with a maximum number of attempts defined by dialCfg.StreamCreationRetryAttemptBudget.
-// If the stream cannot be established after the maximum attempts, it returns a compiled multierror of all
-// encountered errors. Errors related to in-progress dials trigger a retry until a connection is established
+// protocolsByPreference returns the registered protocols in the descending order of preference. If the context carries a
+// preferred protocol that is registered on this manager, it is moved to the front of the list.
+func (m *Manager) protocolsByPreference(ctx context.Context) []protocols.Protocol {
+	ordered := make([]protocols.Protocol, 0, len(m.protocols))
+	for i := len(m.protocols) - 1; i >= 0; i-- {
+		ordered = append(ordered, m.protocols[i])
+	}
+
+	preferred, ok := protocols.PreferredProtocol(ctx)
+	if !ok {
+		return ordered
+	}
+	for i, p := range ordered {
+		if p.Name() == preferred {
+			// shifts the more preferred protocols one position back and puts the preferred one in front.
+			copy(ordered[1:i+1], ordered[:i])
+			ordered[0] = p
+			return ordered
+		}
+	}
+
+	m.logger.Debug().
+		Str("preferred_protocol", string(preferred)).
+		Msg("preferred unicast protocol is not registered, falling back to the default order of preference")
+	return ordered
+}
+
+// createStream attempts to establish a new stream with a peer on one of the specified protocols, which are negotiated with
+// the remote peer in the given order of preference. It employs exponential backoff with a maximum number of attempts defined by
+// dialCfg.StreamCreationRetryAttemptBudget. If the stream cannot be established after the maximum attempts, it returns a compiled
+// multierror of all encountered errors. Errors related to in-progress dials trigger a retry until a connection is established
 // or the attempt budget is exhausted.
 //
 // The function increments the Config's ConsecutiveSuccessfulStream count upon success. In the case of
@@ -214,22 +236,30 @@ func (m *Manager) CreateStream(ctx context.Context, peerID peer.ID) (libp2pnet.S
 // Arguments:
 // - ctx: Context to control the lifecycle of the stream creation.
 // - peerID: The ID of the peer with which the stream is to be established.
-// - protocol: The specific protocol used for the stream.
+// - candidates: The protocols that can be used for the stream, in the descending order of preference.
 // - dialCfg: Configuration parameters for dialing and stream creation, including retry logic.
 //
 // Returns:
 // - libp2pnet.Stream: The successfully created stream, or nil if the stream creation fails.
 // - error: An aggregated multierror of all encountered errors during stream creation, or nil if successful; any returned error is benign and can be retried.
-func (m *Manager) createStream(ctx context.Context, peerID peer.ID, protocol protocols.Protocol, dialCfg *Config) (libp2pnet.Stream, error) {
-	var err error
-	var s libp2pnet.Stream
+func (m *Manager) createStream(ctx context.Context, peerID peer.ID, candidates []protocols.Protocol, dialCfg *Config) (libp2pnet.Stream, error) {
+	protocolIDs := make([]protocol.ID, 0, len(candidates))
+	for _, p := range candidates {
+		protocolIDs = append(protocolIDs, p.ProtocolId())
+	}
 
-	s, err = m.createStreamWithRetry(ctx, peerID, protocol.ProtocolId(), dialCfg)
+	s, err := m.createStreamWithRetry(ctx, peerID, protocolIDs, dialCfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a stream to peer: %w", err)
 	}
 
-	s, err = protocol.UpgradeRawStream(s)
+	negotiated, err := negotiatedProtocol(s, candidates)
+	if err != nil {
+		_ = s.Reset()
+		return nil, fmt.Errorf("failed to resolve negotiated protocol: %w", err)
+	}
+
+	s, err = negotiated.UpgradeRawStream(s)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upgrade raw stream: %w", err)
 	}
@@ -251,31 +281,47 @@ func (m *Manager) createStream(ctx context.Context, peerID peer.ID, protocol pro
 	}
 	m.logger.Debug().
 		Str("peer_id", p2plogging.PeerId(peerID)).
+		Str("protocol", string(negotiated.Name())).
 		Str("updated_dial_config", fmt.Sprintf("%+v", updatedConfig)).
 		Msg("stream created successfully")
 	return s, nil
 }
 
-// createStreamWithRetry attempts to create a new stream to the specified peer using the given protocolID.
+// negotiatedProtocol returns the protocol among the candidates that has been negotiated for the given stream.
+// When there is a single candidate, libp2p does not negotiate the protocol eagerly, hence the candidate is returned as is.
+// An error is returned if the negotiated protocol is not among the candidates.
+func negotiatedProtocol(s libp2pnet.Stream, candidates []protocols.Protocol) (protocols.Protocol, error) {
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+	for _, p := range candidates {
+		if p.ProtocolId() == s.Protocol() {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("stream negotiated on unknown protocol: %s", s.Protocol())
+}
+
+// createStreamWithRetry attempts to create a new stream to the specified peer using one of the given protocolIDs.
 // This function is streamlined for use-cases where retries are managed externally or
 // not required at all.
 //
 // Expected errors:
 //   - If the context expires before stream creation, it returns a context-related error with the number of attempts.
-//   - If the protocol ID is not supported, no retries are attempted and the error is returned immediately.
+//   - If none of the protocol IDs is supported, no retries are attempted and the error is returned immediately.
 //
 // Metrics are collected to monitor the duration and attempts of the stream creation process.
 //
 // Arguments:
 // - ctx: Context to control the lifecycle of the stream creation.
 // - peerID: The ID of the peer with which the stream is to be established.
-// - protocolID: The identifier for the protocol used for the stream.
+// - protocolIDs: The identifiers of the protocols that can be used for the stream, in the descending order of preference.
 // - dialCfg: Configuration parameters for dialing, including the retry attempt budget.
 //
 // Returns:
 // - libp2pnet.Stream: The successfully created stream, or nil if an error occurs.
 // - error: An error encountered during the stream creation, or nil if the stream is successfully established.
-func (m *Manager) createStreamWithRetry(ctx context.Context, peerID peer.ID, protocolID protocol.ID, dialCfg *Config) (libp2pnet.Stream, error) {
+func (m *Manager) createStreamWithRetry(ctx context.Context, peerID peer.ID, protocolIDs []protocol.ID, dialCfg *Config) (libp2pnet.Stream, error) {
 	// aggregated retryable errors that occur during retries, errs will be returned
 	// if retry context times out or maxAttempts have been made before a successful retry occurs
 	var errs error
@@ -290,8 +336,12 @@ func (m *Manager) createStreamWithRetry(ctx context.Context, peerID peer.ID, pro
 		}
 
 		var err error
-		// creates stream using stream factory
-		s, err = m.streamFactory.NewStream(ctx, peerID, protocolID)
+		// creates stream using stream factory. All protocol ids are offered in a single multistream-select negotiation
+		// on one stream, and the remote peer accepts the first one it has a handler for. A protocol the remote peer does
+		// not support costs one extra round trip within the negotiation, instead of a failed stream and its own retries.
+		// Peers running older versions only register the protocols they support, and are served unchanged, since each
+		// protocol id is still handled on its own. A single protocol id is selected lazily, as before.
+		s, err = m.streamFactory.NewStream(ctx, peerID, protocolIDs...)
 		if err != nil {
 			// if the stream creation failed due to invalid protocol id or no address, skip the re-attempt
 			if stream.IsErrProtocolNotSupported(err) ||
@@ -17,6 +17,7 @@ import (
 	p2ptest "github.com/onflow/flow-go/network/p2p/test"
 	"github.com/onflow/flow-go/network/p2p/unicast"
 	unicastcache "github.com/onflow/flow-go/network/p2p/unicast/cache"
+	"github.com/onflow/flow-go/network/p2p/unicast/protocols"
 	"github.com/onflow/flow-go/network/p2p/unicast/stream"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -289,7 +290,7 @@ func TestUnicastManager_StreamFactory_ErrProtocolNotSupported(t *testing.T) {
 
 	// mocks that upon creating a stream, it returns a protocol not supported error, the mock is set to once, meaning that it won't retry stream creation again.
 	streamFactory.On("NewStream", mock.Anything, peerID, mock.Anything).
-		Return(nil, stream.NewProtocolNotSupportedErr(peerID, protocol.ID("protocol-1"), fmt.Errorf("some error"))).
+		Return(nil, stream.NewProtocolNotSupportedErr(peerID, []protocol.ID{"protocol-1"}, fmt.Errorf("some error"))).
 		Once()
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -504,3 +505,62 @@ func TestUnicastManager_Stream_NoBackoff_When_Budget_Is_Zero(t *testing.T) {
 	require.Equal(t, uint64(0), unicastCfg.StreamCreationRetryAttemptBudget) // stream backoff budget must remain zero.
 	require.Equal(t, uint64(0), unicastCfg.ConsecutiveSuccessfulStream)      // consecutive successful stream must be set to zero.
 }
+
+// negotiatedStream is a mock stream that reports the given protocol as the negotiated protocol of the stream.
+type negotiatedStream struct {
+	*p2ptest.MockStream
+	protocol protocol.ID
+}
+
+func (n *negotiatedStream) Protocol() protocol.ID {
+	return n.protocol
+}
+
+// TestUnicastManager_ProtocolNegotiation tests that the unicast manager negotiates the stream protocol with the remote peer in a single
+// stream creation attempt, offering the registered protocols in the descending order of preference, and that a preferred protocol carried
+// by the context is offered first.
+func TestUnicastManager_ProtocolNegotiation(t *testing.T) {
+	mgr, streamFactory, _ := unicastManagerFixture(t)
+	peerID := unittest.PeerIdFixture(t)
+
+	streamFactory.On("SetStreamHandler", mock.AnythingOfType("protocol.ID"), mock.AnythingOfType("network.StreamHandler")).Return().Twice()
+	require.NoError(t, mgr.Register(protocols.GzipCompressionUnicast))
+	require.NoError(t, mgr.Register(protocols.ZstdCompressionUnicast))
+
+	// protocol ids of the plain, gzip and zstd unicasts in the order of registration.
+	require.Len(t, streamFactory.Calls, 3)
+	plainId := streamFactory.Calls[0].Arguments.Get(0).(protocol.ID)
+	gzipId := streamFactory.Calls[1].Arguments.Get(0).(protocol.ID)
+	zstdId := streamFactory.Calls[2].Arguments.Get(0).(protocol.ID)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	t.Run("registered order of preference", func(t *testing.T) {
+		// the remote peer does not support zstd, hence gzip is negotiated.
+		streamFactory.On("NewStream", mock.Anything, peerID, zstdId, gzipId, plainId).
+			Return(&negotiatedStream{MockStream: &p2ptest.MockStream{}, protocol: gzipId}, nil).Once()
+
+		s, err := mgr.CreateStream(ctx, peerID)
+		require.NoError(t, err)
+		require.NotNil(t, s)
+	})
+
+	t.Run("preferred protocol in context", func(t *testing.T) {
+		streamFactory.On("NewStream", mock.Anything, peerID, plainId, zstdId, gzipId).
+			Return(&negotiatedStream{MockStream: &p2ptest.MockStream{}, protocol: plainId}, nil).Once()
+
+		s, err := mgr.CreateStream(protocols.WithPreferredProtocol(ctx, protocols.PlainUnicast), peerID)
+		require.NoError(t, err)
+		require.NotNil(t, s)
+	})
+
+	t.Run("negotiated protocol is unknown", func(t *testing.T) {
+		streamFactory.On("NewStream", mock.Anything, peerID, zstdId, gzipId, plainId).
+			Return(&negotiatedStream{MockStream: &p2ptest.MockStream{}, protocol: "unknown"}, nil).Once()
+
+		s, err := mgr.CreateStream(ctx, peerID)
+		require.Error(t, err)
+		require.Nil(t, s)
+	})
+}
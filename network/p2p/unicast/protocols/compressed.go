@@ -0,0 +1,61 @@
+package protocols
+
+import (
+	libp2pnet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/p2p/unicast/protocols/internal"
+)
+
+// CompressedStream is a unicast protocol that creates and returns a compressed stream out of input stream using its compressor.
+type CompressedStream struct {
+	name           ProtocolName
+	protocolId     protocol.ID
+	compressor     network.Compressor
+	defaultHandler libp2pnet.StreamHandler
+	metrics        module.UnicastCompressionMetrics
+	logger         zerolog.Logger
+}
+
+func newCompressedUnicast(
+	logger zerolog.Logger,
+	name ProtocolName,
+	protocolId protocol.ID,
+	compressor network.Compressor,
+	defaultHandler libp2pnet.StreamHandler,
+	metrics module.UnicastCompressionMetrics) *CompressedStream {
+	return &CompressedStream{
+		name:           name,
+		protocolId:     protocolId,
+		compressor:     compressor,
+		defaultHandler: defaultHandler,
+		metrics:        metrics,
+		logger:         logger.With().Str("subsystem", string(name)+"-unicast").Logger(),
+	}
+}
+
+// UpgradeRawStream wraps compression and decompression around the plain libp2p stream.
+func (c CompressedStream) UpgradeRawStream(s libp2pnet.Stream) (libp2pnet.Stream, error) {
+	return internal.NewCompressedStream(s, c.compressor, string(c.name), c.metrics)
+}
+
+func (c CompressedStream) Handler(s libp2pnet.Stream) {
+	// converts native libp2p stream to compressed stream
+	s, err := c.UpgradeRawStream(s)
+	if err != nil {
+		c.logger.Error().Err(err).Msg("could not create compressed stream")
+		return
+	}
+	c.defaultHandler(s)
+}
+
+func (c CompressedStream) ProtocolId() protocol.ID {
+	return c.protocolId
+}
+
+func (c CompressedStream) Name() ProtocolName {
+	return c.name
+}
@@ -6,8 +6,8 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/network/compressor"
-	"github.com/onflow/flow-go/network/p2p/unicast/protocols/internal"
 )
 
 const GzipCompressionUnicast = ProtocolName("gzip-compression")
@@ -16,36 +16,7 @@ func FlowGzipProtocolId(sporkId flow.Identifier) protocol.ID {
 	return protocol.ID(FlowLibP2PProtocolGzipCompressedOneToOne + sporkId.String())
 }
 
-// GzipStream is a stream compression creates and returns a gzip-compressed stream out of input stream.
-type GzipStream struct {
-	protocolId     protocol.ID
-	defaultHandler libp2pnet.StreamHandler
-	logger         zerolog.Logger
-}
-
-func NewGzipCompressedUnicast(logger zerolog.Logger, sporkId flow.Identifier, defaultHandler libp2pnet.StreamHandler) *GzipStream {
-	return &GzipStream{
-		protocolId:     FlowGzipProtocolId(sporkId),
-		defaultHandler: defaultHandler,
-		logger:         logger.With().Str("subsystem", "gzip-unicast").Logger(),
-	}
-}
-
-// UpgradeRawStream wraps gzip compression and decompression around the plain libp2p stream.
-func (g GzipStream) UpgradeRawStream(s libp2pnet.Stream) (libp2pnet.Stream, error) {
-	return internal.NewCompressedStream(s, compressor.GzipStreamCompressor{})
-}
-
-func (g GzipStream) Handler(s libp2pnet.Stream) {
-	// converts native libp2p stream to gzip-compressed stream
-	s, err := g.UpgradeRawStream(s)
-	if err != nil {
-		g.logger.Error().Err(err).Msg("could not create compressed stream")
-		return
-	}
-	g.defaultHandler(s)
-}
-
-func (g GzipStream) ProtocolId() protocol.ID {
-	return g.protocolId
+// NewGzipCompressedUnicast creates a unicast protocol that creates and returns a gzip-compressed stream out of input stream.
+func NewGzipCompressedUnicast(logger zerolog.Logger, sporkId flow.Identifier, defaultHandler libp2pnet.StreamHandler, metrics module.UnicastCompressionMetrics) *CompressedStream {
+	return newCompressedUnicast(logger, GzipCompressionUnicast, FlowGzipProtocolId(sporkId), compressor.GzipStreamCompressor{}, defaultHandler, metrics)
 }
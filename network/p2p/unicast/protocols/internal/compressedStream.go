@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/libp2p/go-libp2p/core/network"
 	"go.uber.org/multierr"
 
+	"github.com/onflow/flow-go/module"
 	flownet "github.com/onflow/flow-go/network"
 )
 
@@ -20,19 +22,30 @@ type CompressedStream struct {
 	writeLock  sync.Mutex
 	readLock   sync.Mutex
 	compressor flownet.Compressor
-
-	r io.ReadCloser
-	w flownet.WriteCloseFlusher
+	// protocol is the name of the unicast protocol the stream belongs to, it is used as the metrics label.
+	protocol string
+	metrics  module.UnicastCompressionMetrics
+
+	r  io.ReadCloser
+	w  flownet.WriteCloseFlusher
+	mr *meteredReader
+	mw *meteredWriter
 }
 
-// NewCompressedStream creates a compressed stream with gzip as default compressor.
-func NewCompressedStream(s network.Stream, compressor flownet.Compressor) (*CompressedStream, error) {
+// NewCompressedStream creates a compressed stream that compresses and decompresses the data exchanged on the given stream
+// using the given compressor. The compression ratio and the time spent on compression are reported to the given metrics
+// under the given protocol name.
+func NewCompressedStream(s network.Stream, compressor flownet.Compressor, protocol string, metrics module.UnicastCompressionMetrics) (*CompressedStream, error) {
 	c := &CompressedStream{
 		Stream:     s,
 		compressor: compressor,
+		protocol:   protocol,
+		metrics:    metrics,
+		mr:         &meteredReader{r: s},
+		mw:         &meteredWriter{w: s},
 	}
 
-	w, err := c.compressor.NewWriter(s)
+	w, err := c.compressor.NewWriter(c.mw)
 	if err != nil {
 		return nil, fmt.Errorf("could not create compressor writer: %w", err)
 	}
@@ -46,17 +59,25 @@ func (c *CompressedStream) Write(b []byte) (int, error) {
 	c.writeLock.Lock()
 	defer c.writeLock.Unlock()
 
+	start := time.Now()
 	n, err := c.w.Write(b)
+	err = multierr.Combine(err, c.w.Flush())
+	duration := time.Since(start)
+
+	compressed, streamDuration := c.mw.reset()
+	c.metrics.OnUnicastCompressed(c.protocol, n, compressed, duration-streamDuration)
 
-	return n, multierr.Combine(err, c.w.Flush())
+	return n, err
 }
 
 func (c *CompressedStream) Read(b []byte) (int, error) {
 	c.readLock.Lock()
 	defer c.readLock.Unlock()
 
+	// the reader is lazily created on the first read, and creating it may already read from the stream, e.g., the gzip header.
+	start := time.Now()
 	if c.r == nil {
-		r, err := c.compressor.NewReader(c.Stream)
+		r, err := c.compressor.NewReader(c.mr)
 		if err != nil {
 			return 0, fmt.Errorf("could not create compressor reader: %w", err)
 		}
@@ -65,6 +86,11 @@ func (c *CompressedStream) Read(b []byte) (int, error) {
 	}
 
 	n, err := c.r.Read(b)
+	duration := time.Since(start)
+
+	compressed, streamDuration := c.mr.reset()
+	c.metrics.OnUnicastDecompressed(c.protocol, compressed, n, duration-streamDuration)
+
 	if err != nil {
 		c.r.Close()
 	}
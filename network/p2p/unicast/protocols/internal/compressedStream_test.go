@@ -1,13 +1,17 @@
 package internal_test
 
 import (
+	"bytes"
 	"io"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/flow-go/module/metrics"
+	mockmodule "github.com/onflow/flow-go/module/mock"
 	"github.com/onflow/flow-go/network/compressor"
 	p2ptest "github.com/onflow/flow-go/network/p2p/test"
 	"github.com/onflow/flow-go/network/p2p/unicast/protocols/internal"
@@ -98,6 +102,60 @@ func TestUnhappyPath(t *testing.T) {
 	unittest.RequireReturnsBefore(t, readWG.Wait, 1*time.Second, "timeout for reading from stream")
 }
 
+// TestCompressionMetrics evaluates that the compressed stream reports the uncompressed and compressed sizes of the data
+// written and read on it to the compression metrics.
+func TestCompressionMetrics(t *testing.T) {
+	textByte := bytes.Repeat([]byte("hello world, hello world!"), 100)
+	textByteLen := len(textByte)
+	protocol := "zstd-compression"
+
+	sa, sb := newStreamPair()
+
+	writerMetrics := mockmodule.NewUnicastCompressionMetrics(t)
+	writerMetrics.On("OnUnicastCompressed", protocol, textByteLen, mock.AnythingOfType("int"), mock.AnythingOfType("time.Duration")).
+		Run(func(args mock.Arguments) {
+			// repetitive data must be compressed in size.
+			require.Less(t, args.Int(2), textByteLen)
+			require.Greater(t, args.Int(2), 0)
+		}).Return().Once()
+	mca, err := internal.NewCompressedStream(sa, compressor.NewZstdCompressor(), protocol, writerMetrics)
+	require.NoError(t, err)
+
+	decompressed := 0
+	readerMetrics := mockmodule.NewUnicastCompressionMetrics(t)
+	readerMetrics.On("OnUnicastDecompressed", protocol, mock.AnythingOfType("int"), mock.AnythingOfType("int"), mock.AnythingOfType("time.Duration")).
+		Run(func(args mock.Arguments) {
+			decompressed += args.Int(2)
+		}).Return()
+	mcb, err := internal.NewCompressedStream(sb, compressor.NewZstdCompressor(), protocol, readerMetrics)
+	require.NoError(t, err)
+
+	writeWG := sync.WaitGroup{}
+	writeWG.Add(1)
+	go func() {
+		defer writeWG.Done()
+
+		n, err := mca.Write(textByte)
+		require.NoError(t, err)
+		require.Equal(t, textByteLen, n)
+	}()
+
+	readWG := sync.WaitGroup{}
+	readWG.Add(1)
+	go func() {
+		defer readWG.Done()
+
+		b := make([]byte, textByteLen)
+		_, err := io.ReadFull(mcb, b)
+		require.NoError(t, err)
+		require.Equal(t, textByte, b)
+	}()
+
+	unittest.RequireReturnsBefore(t, writeWG.Wait, 1*time.Second, "timeout for writing on stream")
+	unittest.RequireReturnsBefore(t, readWG.Wait, 1*time.Second, "timeout for reading from stream")
+	require.Equal(t, textByteLen, decompressed)
+}
+
 // newStreamPair is a test helper that creates a pair of compressed streams a and b such that
 // a reads what b writes and b reads what a writes.
 func newStreamPair() (*p2ptest.MockStream, *p2ptest.MockStream) {
@@ -115,10 +173,10 @@ func newStreamPair() (*p2ptest.MockStream, *p2ptest.MockStream) {
 func newCompressedStreamPair(t *testing.T) (*internal.CompressedStream, *p2ptest.MockStream, *internal.CompressedStream, *p2ptest.MockStream) {
 	sa, sb := newStreamPair()
 
-	mca, err := internal.NewCompressedStream(sa, compressor.GzipStreamCompressor{})
+	mca, err := internal.NewCompressedStream(sa, compressor.GzipStreamCompressor{}, "gzip-compression", metrics.NewNoopCollector())
 	require.NoError(t, err)
 
-	mcb, err := internal.NewCompressedStream(sb, compressor.GzipStreamCompressor{})
+	mcb, err := internal.NewCompressedStream(sb, compressor.GzipStreamCompressor{}, "gzip-compression", metrics.NewNoopCollector())
 	require.NoError(t, err)
 
 	return mca, sa, mcb, sb
@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"io"
+	"time"
+)
+
+// meteredWriter wraps the underlying stream writer of a compressed stream and keeps track of the number of compressed bytes
+// written on it, as well as the time spent on writing them. The latter is used to exclude the network time from the compression time.
+// meteredWriter is not concurrency safe, and it is guarded by the write lock of the compressed stream.
+type meteredWriter struct {
+	w        io.Writer
+	written  int
+	duration time.Duration
+}
+
+func (m *meteredWriter) Write(p []byte) (int, error) {
+	start := time.Now()
+	n, err := m.w.Write(p)
+	m.duration += time.Since(start)
+	m.written += n
+	return n, err
+}
+
+// reset resets the counters of the metered writer and returns their values prior to the reset.
+func (m *meteredWriter) reset() (int, time.Duration) {
+	written, duration := m.written, m.duration
+	m.written, m.duration = 0, 0
+	return written, duration
+}
+
+// meteredReader wraps the underlying stream reader of a compressed stream and keeps track of the number of compressed bytes
+// read from it, as well as the time spent on reading them. The latter is used to exclude the network time from the decompression time.
+// meteredReader is not concurrency safe, and it is guarded by the read lock of the compressed stream.
+type meteredReader struct {
+	r        io.Reader
+	read     int
+	duration time.Duration
+}
+
+func (m *meteredReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	n, err := m.r.Read(p)
+	m.duration += time.Since(start)
+	m.read += n
+	return n, err
+}
+
+// reset resets the counters of the metered reader and returns their values prior to the reset.
+func (m *meteredReader) reset() (int, time.Duration) {
+	read, duration := m.read, m.duration
+	m.read, m.duration = 0, 0
+	return read, duration
+}
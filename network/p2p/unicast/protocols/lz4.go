@@ -0,0 +1,22 @@
+package protocols
+
+import (
+	libp2pnet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/network/compressor"
+)
+
+const Lz4CompressionUnicast = ProtocolName("lz4-compression")
+
+func FlowLz4ProtocolId(sporkId flow.Identifier) protocol.ID {
+	return protocol.ID(FlowLibP2PProtocolLz4CompressedOneToOne + sporkId.String())
+}
+
+// NewLz4CompressedUnicast creates a unicast protocol that creates and returns a lz4-compressed stream out of input stream.
+func NewLz4CompressedUnicast(logger zerolog.Logger, sporkId flow.Identifier, defaultHandler libp2pnet.StreamHandler, metrics module.UnicastCompressionMetrics) *CompressedStream {
+	return newCompressedUnicast(logger, Lz4CompressionUnicast, FlowLz4ProtocolId(sporkId), compressor.NewLz4Compressor(), defaultHandler, metrics)
+}
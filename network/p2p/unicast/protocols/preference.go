@@ -0,0 +1,35 @@
+package protocols
+
+import (
+	"context"
+	"fmt"
+)
+
+// preferredProtocolKey is the context key for the preferred unicast protocol of the streams created with the context.
+type preferredProtocolKey struct{}
+
+// WithPreferredProtocol returns a copy of the parent context that carries the given unicast protocol as the most preferred
+// protocol for the streams created with it. This is how a per-channel compression policy is conveyed to the unicast manager,
+// which prioritizes the preferred protocol over its registered order of preference when negotiating the stream with the remote peer.
+// If the remote peer does not support the preferred protocol, the negotiation falls back to the registered order of preference.
+func WithPreferredProtocol(ctx context.Context, name ProtocolName) context.Context {
+	return context.WithValue(ctx, preferredProtocolKey{}, name)
+}
+
+// PreferredProtocol returns the preferred unicast protocol carried by the context, if any.
+func PreferredProtocol(ctx context.Context) (ProtocolName, bool) {
+	name, ok := ctx.Value(preferredProtocolKey{}).(ProtocolName)
+	return name, ok
+}
+
+// ValidateProtocolName returns an error if the given name does not refer to a known unicast protocol, i.e., neither the plain
+// unicast nor one of the protocols that can be registered on the unicast manager.
+func ValidateProtocolName(name ProtocolName) error {
+	if name == PlainUnicast {
+		return nil
+	}
+	if _, err := ToProtocolFactory(name); err != nil {
+		return fmt.Errorf("invalid unicast protocol name: %w", err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
 )
 
 // Flow Libp2p protocols
@@ -28,8 +29,21 @@ const (
 
 	// FlowLibP2PProtocolGzipCompressedOneToOne represents the protocol id for compressed streams under gzip compressor.
 	FlowLibP2PProtocolGzipCompressedOneToOne = FlowLibP2POneToOneProtocolIDPrefix + "/gzip/"
+
+	// FlowLibP2PProtocolZstdCompressedOneToOne represents the protocol id for compressed streams under zstd compressor.
+	FlowLibP2PProtocolZstdCompressedOneToOne = FlowLibP2POneToOneProtocolIDPrefix + "/zstd/"
+
+	// FlowLibP2PProtocolSnappyCompressedOneToOne represents the protocol id for compressed streams under snappy compressor.
+	FlowLibP2PProtocolSnappyCompressedOneToOne = FlowLibP2POneToOneProtocolIDPrefix + "/snappy/"
+
+	// FlowLibP2PProtocolLz4CompressedOneToOne represents the protocol id for compressed streams under lz4 compressor.
+	FlowLibP2PProtocolLz4CompressedOneToOne = FlowLibP2POneToOneProtocolIDPrefix + "/lz4/"
 )
 
+// PlainUnicast is the name of the default unicast protocol, which exchanges the data on the plain libp2p stream
+// without any compression.
+const PlainUnicast = ProtocolName("plain")
+
 // IsFlowProtocolStream returns true if the libp2p stream is for a Flow protocol
 func IsFlowProtocolStream(s libp2pnet.Stream) bool {
 	p := string(s.Protocol())
@@ -53,7 +67,7 @@ func PingProtocolId(sporkId flow.Identifier) protocol.ID {
 }
 
 type ProtocolName string
-type ProtocolFactory func(zerolog.Logger, flow.Identifier, libp2pnet.StreamHandler) Protocol
+type ProtocolFactory func(zerolog.Logger, flow.Identifier, libp2pnet.StreamHandler, module.UnicastCompressionMetrics) Protocol
 
 func ToProtocolNames(names []string) []ProtocolName {
 	p := make([]ProtocolName, 0)
@@ -66,8 +80,20 @@ func ToProtocolNames(names []string) []ProtocolName {
 func ToProtocolFactory(name ProtocolName) (ProtocolFactory, error) {
 	switch name {
 	case GzipCompressionUnicast:
-		return func(logger zerolog.Logger, sporkId flow.Identifier, handler libp2pnet.StreamHandler) Protocol {
-			return NewGzipCompressedUnicast(logger, sporkId, handler)
+		return func(logger zerolog.Logger, sporkId flow.Identifier, handler libp2pnet.StreamHandler, metrics module.UnicastCompressionMetrics) Protocol {
+			return NewGzipCompressedUnicast(logger, sporkId, handler, metrics)
+		}, nil
+	case ZstdCompressionUnicast:
+		return func(logger zerolog.Logger, sporkId flow.Identifier, handler libp2pnet.StreamHandler, metrics module.UnicastCompressionMetrics) Protocol {
+			return NewZstdCompressedUnicast(logger, sporkId, handler, metrics)
+		}, nil
+	case SnappyCompressionUnicast:
+		return func(logger zerolog.Logger, sporkId flow.Identifier, handler libp2pnet.StreamHandler, metrics module.UnicastCompressionMetrics) Protocol {
+			return NewSnappyCompressedUnicast(logger, sporkId, handler, metrics)
+		}, nil
+	case Lz4CompressionUnicast:
+		return func(logger zerolog.Logger, sporkId flow.Identifier, handler libp2pnet.StreamHandler, metrics module.UnicastCompressionMetrics) Protocol {
+			return NewLz4CompressedUnicast(logger, sporkId, handler, metrics)
 		}, nil
 	default:
 		return nil, fmt.Errorf("unknown unicast protocol name: %s", name)
@@ -85,4 +111,7 @@ type Protocol interface {
 	// ProtocolId returns the libp2p protocol id associated to this protocol. In libp2p
 	// streams running with the same protocol are identified with the same  protocol id.
 	ProtocolId() protocol.ID
+	// Name returns the name of this protocol, which is used to refer to it in the configuration, e.g., the
+	// per-channel compression policy, and in the metrics.
+	Name() ProtocolName
 }
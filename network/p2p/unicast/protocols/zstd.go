@@ -0,0 +1,22 @@
+package protocols
+
+import (
+	libp2pnet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/network/compressor"
+)
+
+const ZstdCompressionUnicast = ProtocolName("zstd-compression")
+
+func FlowZstdProtocolId(sporkId flow.Identifier) protocol.ID {
+	return protocol.ID(FlowLibP2PProtocolZstdCompressedOneToOne + sporkId.String())
+}
+
+// NewZstdCompressedUnicast creates a unicast protocol that creates and returns a zstd-compressed stream out of input stream.
+func NewZstdCompressedUnicast(logger zerolog.Logger, sporkId flow.Identifier, defaultHandler libp2pnet.StreamHandler, metrics module.UnicastCompressionMetrics) *CompressedStream {
+	return newCompressedUnicast(logger, ZstdCompressionUnicast, FlowZstdProtocolId(sporkId), compressor.NewZstdCompressor(), defaultHandler, metrics)
+}
@@ -34,7 +34,7 @@ func (l *LibP2PStreamFactory) SetStreamHandler(pid protocol.ID, handler network.
 	l.host.SetStreamHandler(pid, handler)
 }
 
-// NewStream establishes a new stream with the given peer using the provided protocol.ID on the libp2p host.
+// NewStream establishes a new stream with the given peer using one of the provided protocol.IDs on the libp2p host.
 // This function is a critical part of the network communication, facilitating the creation of a dedicated
 // bidirectional channel (stream) between two nodes in the network.
 // If there exists no connection between the two nodes, the function attempts to establish one before creating the stream.
@@ -44,12 +44,14 @@ func (l *LibP2PStreamFactory) SetStreamHandler(pid protocol.ID, handler network.
 // The function is intended to be used when there is a need to initiate a direct communication stream with a peer.
 // It is typically invoked in scenarios where a node wants to send a message or start a series of messages to another
 // node using a specific protocol. The protocol ID is used to ensure that both nodes communicate over the same
-// protocol, which defines the structure and semantics of the communication.
+// protocol, which defines the structure and semantics of the communication. When more than one protocol ID is provided,
+// libp2p negotiates the first one in the given order that is also supported by the remote node, and the negotiated
+// protocol ID is available through the Protocol method of the returned stream.
 //
 // Expected errors:
 // During normal operation, the function may encounter specific expected errors, which are handled as follows:
 //
-//   - ErrProtocolNotSupported: This error occurs when the remote node does not support the specified protocol ID,
+//   - ErrProtocolNotSupported: This error occurs when the remote node does not support any of the specified protocol IDs,
 //     which may indicate that the remote node is running a different version of the software or a different spork.
 //     The error contains details about the peer ID and the unsupported protocol, and it is generated when the
 //     underlying error message indicates a protocol mismatch. This is a critical error as it signifies that the
@@ -69,19 +71,19 @@ func (l *LibP2PStreamFactory) SetStreamHandler(pid protocol.ID, handler network.
 //   - ctx: A context.Context that governs the lifetime of the stream creation. It can be used to cancel the
 //     operation or to set deadlines.
 //   - p: The peer.ID of the target node with which the stream is to be established.
-//   - pid: The protocol.ID that specifies the communication protocol to be used for the stream.
+//   - pids: The protocol.IDs that specify the communication protocols to be used for the stream, in descending order of preference.
 //
 // Returns:
 //   - network.Stream: The successfully created stream, ready for reading and writing, or nil if an error occurs.
 //   - error: An error encountered during stream creation, wrapped in a contextually appropriate error type when necessary,
 //     or nil if the operation is successful.
-func (l *LibP2PStreamFactory) NewStream(ctx context.Context, p peer.ID, pid protocol.ID) (network.Stream, error) {
-	s, err := l.host.NewStream(ctx, p, pid)
+func (l *LibP2PStreamFactory) NewStream(ctx context.Context, p peer.ID, pids ...protocol.ID) (network.Stream, error) {
+	s, err := l.host.NewStream(ctx, p, pids...)
 	switch {
 	case err == nil:
 		return s, nil
 	case strings.Contains(err.Error(), protocolNotSupportedStr):
-		return nil, NewProtocolNotSupportedErr(p, pid, err)
+		return nil, NewProtocolNotSupportedErr(p, pids, err)
 	case strings.Contains(err.Error(), protocolNegotiationFailedStr):
 		return nil, NewSecurityProtocolNegotiationErr(p, err)
 	case errors.Is(err, swarm.ErrGaterDisallowedConnection):
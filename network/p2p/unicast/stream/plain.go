@@ -3,6 +3,8 @@ package stream
 import (
 	libp2pnet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/protocol"
+
+	"github.com/onflow/flow-go/network/p2p/unicast/protocols"
 )
 
 // PlainStream is a stream factory that reflects the same input stream without any modification.
@@ -36,3 +38,7 @@ func (p PlainStream) Handler(s libp2pnet.Stream) {
 func (p PlainStream) ProtocolId() protocol.ID {
 	return p.protocolId
 }
+
+func (p PlainStream) Name() protocols.ProtocolName {
+	return protocols.PlainUnicast
+}
@@ -18,9 +18,9 @@ type UnicastManager interface {
 	// over previously registered ones.
 	// All errors returned from this function can be considered benign.
 	Register(unicast protocols.ProtocolName) error
-	// CreateStream tries establishing a libp2p stream to the remote peer id. It tries creating streams in the descending order of preference until
-	// it either creates a successful stream or runs out of options. Creating stream on each protocol is tried at most `maxAttempts`, and then falls
-	// back to the less preferred one.
+	// CreateStream tries establishing a libp2p stream to the remote peer id. The stream protocol is negotiated with the remote peer in the
+	// descending order of preference of the registered protocols, and a preferred protocol carried by the context (see protocols.WithPreferredProtocol)
+	// is prioritized over the registered order.
 	// All errors returned from this function can be considered benign.
 	CreateStream(ctx context.Context, peerID peer.ID) (libp2pnet.Stream, error)
 }
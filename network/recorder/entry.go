@@ -0,0 +1,62 @@
+// Package recorder implements an opt-in recorder of the messages exchanged by the engines of a node
+// through the network layer, and a replayer feeding a recording back into engines through a stub network.
+//
+// Recordings are written to a directory as a sequence of files, each holding a stream of CBOR encoded
+// entries. The payload of each entry is the message encoded with the network CBOR codec, so that it can
+// be decoded back to the message type received or sent by the engine.
+package recorder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/network/channels"
+)
+
+// Direction is the direction of a recorded message.
+type Direction uint8
+
+const (
+	// Inbound messages are received by an engine of the recording node.
+	Inbound Direction = iota + 1
+	// Outbound messages are sent by an engine of the recording node.
+	Outbound
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Inbound:
+		return "inbound"
+	case Outbound:
+		return "outbound"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(d))
+	}
+}
+
+// Protocol is the way an outbound message is sent through the conduit.
+type Protocol string
+
+const (
+	ProtocolUnicast   Protocol = "unicast"
+	ProtocolPublish   Protocol = "publish"
+	ProtocolMulticast Protocol = "multicast"
+)
+
+// Entry is a recorded message.
+type Entry struct {
+	Direction Direction
+	// Protocol is the way the message is sent, it is empty for inbound messages.
+	Protocol Protocol
+	Channel  channels.Channel
+	// OriginID is the ID of the node which sent the message, it is the recording node for outbound messages.
+	OriginID flow.Identifier
+	// TargetIDs are the IDs of the nodes the message is sent to, they are empty for inbound messages.
+	TargetIDs flow.IdentifierList
+	// Num is the number of recipients of multicast messages.
+	Num       uint
+	Timestamp time.Time
+	// Payload is the message encoded with the network CBOR codec.
+	Payload []byte
+}
@@ -0,0 +1,137 @@
+package recorder
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/codec/cbor"
+	"github.com/onflow/flow-go/network/message"
+)
+
+// EntryWriter writes recorded entries.
+type EntryWriter interface {
+	// Write records the entry.
+	// Implementations must be safe for concurrent use.
+	Write(entry *Entry) error
+}
+
+// RecordingNetwork wraps an engine registry to record the messages received and sent by the registered engines.
+// Messages are recorded before they are handed to the engines or to the network, and failing to record a
+// message never prevents its delivery.
+type RecordingNetwork struct {
+	network.EngineRegistry
+	log    zerolog.Logger
+	me     flow.Identifier
+	codec  network.Codec
+	writer EntryWriter
+}
+
+var _ network.EngineRegistry = (*RecordingNetwork)(nil)
+
+// NewRecordingNetwork creates a network recording the messages of the engines registered on the given network
+// with the given writer. The ID of the local node is recorded as the origin of the outbound messages.
+func NewRecordingNetwork(log zerolog.Logger, net network.EngineRegistry, me flow.Identifier, writer EntryWriter) *RecordingNetwork {
+	return &RecordingNetwork{
+		EngineRegistry: net,
+		log:            log.With().Str("component", "network_recorder").Logger(),
+		me:             me,
+		codec:          cbor.NewCodec(),
+		writer:         writer,
+	}
+}
+
+// Register registers the engine with the underlying network, recording the messages it receives and sends.
+func (n *RecordingNetwork) Register(channel channels.Channel, messageProcessor network.MessageProcessor) (network.Conduit, error) {
+	con, err := n.EngineRegistry.Register(channel, &recordingProcessor{
+		MessageProcessor: messageProcessor,
+		net:              n,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordingConduit{
+		Conduit: con,
+		channel: channel,
+		net:     n,
+	}, nil
+}
+
+// record encodes the message and writes the entry, logging any failure.
+func (n *RecordingNetwork) record(entry *Entry, msg interface{}) {
+	payload, err := n.codec.Encode(msg)
+	if err != nil {
+		n.log.Warn().
+			Err(err).
+			Str("channel", entry.Channel.String()).
+			Str("direction", entry.Direction.String()).
+			Str("message_type", message.MessageType(msg)).
+			Msg("could not encode recorded message")
+		return
+	}
+
+	entry.Timestamp = time.Now()
+	entry.Payload = payload
+	err = n.writer.Write(entry)
+	if err != nil {
+		n.log.Warn().
+			Err(err).
+			Str("channel", entry.Channel.String()).
+			Str("direction", entry.Direction.String()).
+			Msg("could not write recorded message")
+	}
+}
+
+// recordingProcessor records the inbound messages before handing them to the engine.
+type recordingProcessor struct {
+	network.MessageProcessor
+	net *RecordingNetwork
+}
+
+func (p *recordingProcessor) Process(channel channels.Channel, originID flow.Identifier, message interface{}) error {
+	p.net.record(&Entry{
+		Direction: Inbound,
+		Channel:   channel,
+		OriginID:  originID,
+	}, message)
+	return p.MessageProcessor.Process(channel, originID, message)
+}
+
+// recordingConduit records the outbound messages before handing them to the network.
+type recordingConduit struct {
+	network.Conduit
+	channel channels.Channel
+	net     *RecordingNetwork
+}
+
+var _ network.Conduit = (*recordingConduit)(nil)
+
+func (c *recordingConduit) Publish(event interface{}, targetIDs ...flow.Identifier) error {
+	c.net.record(c.outbound(ProtocolPublish, targetIDs, 0), event)
+	return c.Conduit.Publish(event, targetIDs...)
+}
+
+func (c *recordingConduit) Unicast(event interface{}, targetID flow.Identifier) error {
+	c.net.record(c.outbound(ProtocolUnicast, flow.IdentifierList{targetID}, 0), event)
+	return c.Conduit.Unicast(event, targetID)
+}
+
+func (c *recordingConduit) Multicast(event interface{}, num uint, targetIDs ...flow.Identifier) error {
+	c.net.record(c.outbound(ProtocolMulticast, targetIDs, num), event)
+	return c.Conduit.Multicast(event, num, targetIDs...)
+}
+
+func (c *recordingConduit) outbound(protocol Protocol, targetIDs flow.IdentifierList, num uint) *Entry {
+	return &Entry{
+		Direction: Outbound,
+		Protocol:  protocol,
+		Channel:   c.channel,
+		OriginID:  c.net.me,
+		TargetIDs: targetIDs,
+		Num:       num,
+	}
+}
@@ -0,0 +1,131 @@
+package recorder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/messages"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/mocknetwork"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestRecordAndReplay tests that the messages received and sent by an engine are recorded, and that the
+// inbound messages are replayed to an engine registered on the replay network.
+func TestRecordAndReplay(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		me := unittest.IdentifierFixture()
+		origin := unittest.IdentifierFixture()
+		targets := unittest.IdentifierListFixture(2)
+
+		writer, err := NewWriter(dir, DefaultMaxFileSize, DefaultMaxFiles)
+		require.NoError(t, err)
+
+		// the engine registered on the recording network receives the messages and sends a response
+		var processor network.MessageProcessor
+		con := mocknetwork.NewConduit(t)
+		underlying := mocknetwork.NewEngineRegistry(t)
+		underlying.On("Register", channels.SyncCommittee, mock.Anything).
+			Run(func(args mock.Arguments) {
+				processor = args.Get(1).(network.MessageProcessor)
+			}).
+			Return(con, nil).
+			Once()
+
+		engine := mocknetwork.NewMessageProcessor(t)
+		net := NewRecordingNetwork(unittest.Logger(), underlying, me, writer)
+		recordingCon, err := net.Register(channels.SyncCommittee, engine)
+		require.NoError(t, err)
+
+		request := &messages.SyncRequest{Nonce: 1, Height: 10}
+		engine.On("Process", channels.SyncCommittee, origin, request).Return(nil).Once()
+		require.NoError(t, processor.Process(channels.SyncCommittee, origin, request))
+
+		response := &messages.SyncResponse{Nonce: 1, Height: 20}
+		con.On("Unicast", response, origin).Return(nil).Once()
+		require.NoError(t, recordingCon.Unicast(response, origin))
+
+		con.On("Multicast", response, uint(1), targets[0], targets[1]).Return(nil).Once()
+		require.NoError(t, recordingCon.Multicast(response, 1, targets...))
+
+		// messages which can't be encoded are delivered without being recorded
+		engine.On("Process", channels.SyncCommittee, origin, "unknown").Return(nil).Once()
+		require.NoError(t, processor.Process(channels.SyncCommittee, origin, "unknown"))
+
+		time.Sleep(10 * time.Millisecond)
+		second := &messages.SyncRequest{Nonce: 2, Height: 11}
+		engine.On("Process", channels.SyncCommittee, origin, second).Return(nil).Once()
+		require.NoError(t, processor.Process(channels.SyncCommittee, origin, second))
+		require.NoError(t, writer.Close())
+
+		entries := readAll(t, dir)
+		require.Len(t, entries, 4)
+		require.Equal(t, Inbound, entries[0].Direction)
+		require.Equal(t, origin, entries[0].OriginID)
+		require.Equal(t, Outbound, entries[1].Direction)
+		require.Equal(t, ProtocolUnicast, entries[1].Protocol)
+		require.Equal(t, me, entries[1].OriginID)
+		require.Equal(t, flow.IdentifierList{origin}, entries[1].TargetIDs)
+		require.Equal(t, ProtocolMulticast, entries[2].Protocol)
+		require.Equal(t, targets, entries[2].TargetIDs)
+		require.Equal(t, uint(1), entries[2].Num)
+		require.Equal(t, Inbound, entries[3].Direction)
+
+		t.Run("replay", func(t *testing.T) {
+			var outbound []Protocol
+			replayNet := NewReplayNetwork(unittest.Logger(), func(channel channels.Channel, protocol Protocol, event interface{}, targetIDs flow.IdentifierList) {
+				outbound = append(outbound, protocol)
+			})
+
+			replayEngine := mocknetwork.NewMessageProcessor(t)
+			replayCon, err := replayNet.Register(channels.SyncCommittee, replayEngine)
+			require.NoError(t, err)
+			_, err = replayNet.Register(channels.SyncCommittee, replayEngine)
+			require.Error(t, err)
+
+			replayEngine.On("Process", channels.SyncCommittee, origin, request).
+				Run(func(mock.Arguments) {
+					require.NoError(t, replayCon.Unicast(response, origin))
+				}).
+				Return(nil).
+				Once()
+			replayEngine.On("Process", channels.SyncCommittee, origin, second).Return(nil).Once()
+
+			reader, err := NewReader(dir)
+			require.NoError(t, err)
+			defer reader.Close()
+
+			start := time.Now()
+			replayed, err := replayNet.Replay(context.Background(), reader, 0.5)
+			require.NoError(t, err)
+			require.Equal(t, uint64(2), replayed)
+			require.Equal(t, []Protocol{ProtocolUnicast}, outbound)
+
+			// the delay between the two inbound messages is doubled
+			recordedDelay := entries[3].Timestamp.Sub(entries[0].Timestamp)
+			require.GreaterOrEqual(t, time.Since(start), 2*recordedDelay)
+		})
+
+		t.Run("cancelled replay", func(t *testing.T) {
+			replayNet := NewReplayNetwork(unittest.Logger(), nil)
+			replayEngine := mocknetwork.NewMessageProcessor(t)
+			_, err := replayNet.Register(channels.SyncCommittee, replayEngine)
+			require.NoError(t, err)
+
+			reader, err := NewReader(dir)
+			require.NoError(t, err)
+			defer reader.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err = replayNet.Replay(ctx, reader, 0)
+			require.ErrorIs(t, err, context.Canceled)
+		})
+	})
+}
@@ -0,0 +1,94 @@
+package recorder
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fxamacker/cbor/v2"
+
+	cborcodec "github.com/onflow/flow-go/model/encoding/cbor"
+)
+
+// Reader reads the entries of the recording files of a directory, in the order they were written.
+type Reader struct {
+	dir     string
+	indices []uint64
+	file    *os.File
+	dec     *cbor.Decoder
+}
+
+// NewReader creates a reader of the recording files of the directory.
+// No errors are expected during normal operations.
+func NewReader(dir string) (*Reader, error) {
+	indices, err := fileIndices(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("no recording files found in %s", dir)
+	}
+
+	return &Reader{
+		dir:     dir,
+		indices: indices,
+	}, nil
+}
+
+// Next returns the next entry of the recording.
+// An entry truncated at the end of a file, which happens when the node stopped while writing it,
+// is skipped.
+//
+// Expected errors during normal operations:
+//   - io.EOF if all the entries were read
+func (r *Reader) Next() (*Entry, error) {
+	for {
+		if r.dec == nil {
+			if len(r.indices) == 0 {
+				return nil, io.EOF
+			}
+			file, err := os.Open(filePath(r.dir, r.indices[0]))
+			if err != nil {
+				return nil, fmt.Errorf("could not open recording file: %w", err)
+			}
+			r.indices = r.indices[1:]
+			r.file = file
+			r.dec = cborcodec.DefaultDecMode.NewDecoder(file)
+		}
+
+		var entry Entry
+		err := r.dec.Decode(&entry)
+		if err == nil {
+			return &entry, nil
+		}
+		if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("could not decode entry of %s: %w", r.file.Name(), err)
+		}
+
+		// continue with the next file
+		err = r.closeFile()
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Close closes the file being read.
+// No errors are expected during normal operations.
+func (r *Reader) Close() error {
+	return r.closeFile()
+}
+
+func (r *Reader) closeFile() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	r.dec = nil
+	if err != nil {
+		return fmt.Errorf("could not close recording file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package recorder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/codec/cbor"
+	"github.com/onflow/flow-go/network/message"
+	"github.com/onflow/flow-go/utils/logging"
+)
+
+// OutboundHandler is called with the messages sent by the engines registered on the ReplayNetwork.
+type OutboundHandler func(channel channels.Channel, protocol Protocol, event interface{}, targetIDs flow.IdentifierList)
+
+// ReplayNetwork is a stub network feeding the inbound messages of a recording to the registered engines.
+// Messages sent by the engines are not delivered to any node, they are passed to the outbound handler.
+// Blob and ping services are not supported.
+type ReplayNetwork struct {
+	module.NoopComponent
+	log        zerolog.Logger
+	codec      network.Codec
+	onOutbound OutboundHandler
+
+	mu         sync.RWMutex
+	processors map[channels.Channel]network.MessageProcessor
+}
+
+var _ network.EngineRegistry = (*ReplayNetwork)(nil)
+
+// NewReplayNetwork creates a replay network. If the outbound handler is nil, the messages sent by the engines
+// are logged.
+func NewReplayNetwork(log zerolog.Logger, onOutbound OutboundHandler) *ReplayNetwork {
+	n := &ReplayNetwork{
+		log:        log.With().Str("component", "replay_network").Logger(),
+		codec:      cbor.NewCodec(),
+		onOutbound: onOutbound,
+		processors: make(map[channels.Channel]network.MessageProcessor),
+	}
+	if n.onOutbound == nil {
+		n.onOutbound = n.logOutbound
+	}
+	return n
+}
+
+// Register registers the engine on the channel. Only one engine can be registered on a channel.
+func (n *ReplayNetwork) Register(channel channels.Channel, messageProcessor network.MessageProcessor) (network.Conduit, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, ok := n.processors[channel]; ok {
+		return nil, fmt.Errorf("an engine is already registered on channel %s", channel)
+	}
+	n.processors[channel] = messageProcessor
+
+	return &replayConduit{
+		channel: channel,
+		net:     n,
+	}, nil
+}
+
+func (n *ReplayNetwork) RegisterBlobService(channel channels.Channel, _ datastore.Batching, _ ...network.BlobServiceOption) (network.BlobService, error) {
+	return nil, fmt.Errorf("blob service on channel %s is not supported by the replay network", channel)
+}
+
+func (n *ReplayNetwork) RegisterPingService(pingProtocolID protocol.ID, _ network.PingInfoProvider) (network.PingService, error) {
+	return nil, fmt.Errorf("ping service %s is not supported by the replay network", pingProtocolID)
+}
+
+// Replay feeds the inbound messages read from the reader to the engines registered on their channel, until
+// the end of the recording. Messages of channels without registered engine, and outbound messages, are skipped.
+//
+// Messages are delivered at the pace they were recorded, accelerated by the given speed factor. A speed of
+// zero delivers the messages without delay. Errors returned by the engines are logged, like the networking
+// layer does.
+//
+// It returns the number of replayed messages.
+// No errors are expected during normal operations, except the context error if it is cancelled.
+func (n *ReplayNetwork) Replay(ctx context.Context, reader *Reader, speed float64) (uint64, error) {
+	if speed < 0 {
+		return 0, fmt.Errorf("speed must not be negative, got %f", speed)
+	}
+
+	var (
+		replayed      uint64
+		firstRecorded time.Time
+		start         time.Time
+	)
+
+	for {
+		entry, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return replayed, nil
+		}
+		if err != nil {
+			return replayed, fmt.Errorf("could not read recording: %w", err)
+		}
+
+		if entry.Direction != Inbound {
+			continue
+		}
+
+		n.mu.RLock()
+		processor, ok := n.processors[entry.Channel]
+		n.mu.RUnlock()
+		if !ok {
+			continue
+		}
+
+		msg, err := n.codec.Decode(entry.Payload)
+		if err != nil {
+			n.log.Warn().
+				Err(err).
+				Str("channel", entry.Channel.String()).
+				Hex("origin_id", logging.ID(entry.OriginID)).
+				Msg("could not decode recorded message")
+			continue
+		}
+
+		if firstRecorded.IsZero() {
+			firstRecorded = entry.Timestamp
+			start = time.Now()
+		} else if speed > 0 {
+			delay := time.Until(start.Add(time.Duration(float64(entry.Timestamp.Sub(firstRecorded)) / speed)))
+			if delay > 0 {
+				select {
+				case <-ctx.Done():
+					return replayed, ctx.Err()
+				case <-time.After(delay):
+				}
+			}
+		}
+
+		if ctx.Err() != nil {
+			return replayed, ctx.Err()
+		}
+
+		err = processor.Process(entry.Channel, entry.OriginID, msg)
+		if err != nil {
+			n.log.Warn().
+				Err(err).
+				Str("channel", entry.Channel.String()).
+				Hex("origin_id", logging.ID(entry.OriginID)).
+				Str("message_type", message.MessageType(msg)).
+				Msg("engine failed to process replayed message")
+		}
+		replayed++
+	}
+}
+
+func (n *ReplayNetwork) logOutbound(channel channels.Channel, protocol Protocol, event interface{}, targetIDs flow.IdentifierList) {
+	n.log.Info().
+		Str("channel", channel.String()).
+		Str("protocol", string(protocol)).
+		Str("message_type", message.MessageType(event)).
+		Int("targets", len(targetIDs)).
+		Msg("engine sent message")
+}
+
+// replayConduit passes the messages sent by an engine to the outbound handler of the replay network.
+type replayConduit struct {
+	channel channels.Channel
+	net     *ReplayNetwork
+}
+
+var _ network.Conduit = (*replayConduit)(nil)
+
+func (c *replayConduit) ReportMisbehavior(report network.MisbehaviorReport) {
+	c.net.log.Info().
+		Str("channel", c.channel.String()).
+		Hex("origin_id", logging.ID(report.OriginId())).
+		Str("reason", report.Reason().String()).
+		Msg("engine reported misbehavior")
+}
+
+func (c *replayConduit) Publish(event interface{}, targetIDs ...flow.Identifier) error {
+	c.net.onOutbound(c.channel, ProtocolPublish, event, targetIDs)
+	return nil
+}
+
+func (c *replayConduit) Unicast(event interface{}, targetID flow.Identifier) error {
+	c.net.onOutbound(c.channel, ProtocolUnicast, event, flow.IdentifierList{targetID})
+	return nil
+}
+
+func (c *replayConduit) Multicast(event interface{}, num uint, targetIDs ...flow.Identifier) error {
+	c.net.onOutbound(c.channel, ProtocolMulticast, event, targetIDs)
+	return nil
+}
+
+func (c *replayConduit) Close() error {
+	c.net.mu.Lock()
+	defer c.net.mu.Unlock()
+
+	delete(c.net.processors, c.channel)
+	return nil
+}
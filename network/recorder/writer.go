@@ -0,0 +1,206 @@
+package recorder
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	cborcodec "github.com/onflow/flow-go/model/encoding/cbor"
+)
+
+const (
+	filePrefix = "recording-"
+	fileSuffix = ".cbor"
+
+	// DefaultMaxFileSize is the default size in bytes after which a recording file is rotated.
+	DefaultMaxFileSize = 64 * 1024 * 1024
+	// DefaultMaxFiles is the default number of recording files kept in the recording directory.
+	DefaultMaxFiles = 16
+)
+
+// Writer writes recorded entries to rotating files of a directory.
+// Once the current file exceeds the maximum file size, the next entries are written to a new file,
+// and the oldest files are removed to keep at most the maximum number of files.
+//
+// Writer is safe for concurrent use.
+type Writer struct {
+	mu          sync.Mutex
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+
+	index   uint64 // index of the current file
+	file    *os.File
+	buf     *bufio.Writer
+	written int64 // number of bytes written to the current file
+}
+
+// NewWriter creates a writer appending entries to a new file of the directory, which is created if needed.
+// Existing recording files are kept, and count toward the maximum number of files.
+// No errors are expected during normal operations.
+func NewWriter(dir string, maxFileSize int64, maxFiles int) (*Writer, error) {
+	if maxFileSize <= 0 {
+		return nil, fmt.Errorf("max file size must be positive, got %d", maxFileSize)
+	}
+	if maxFiles <= 0 {
+		return nil, fmt.Errorf("max number of files must be positive, got %d", maxFiles)
+	}
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("could not create recording directory %s: %w", dir, err)
+	}
+
+	indices, err := fileIndices(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Writer{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}
+	if len(indices) > 0 {
+		w.index = indices[len(indices)-1] + 1
+	}
+
+	err = w.openFile()
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Write appends the entry to the current file, rotating it if it exceeds the maximum file size.
+// The entry is flushed to the file before returning.
+// No errors are expected during normal operations.
+func (w *Writer) Write(entry *Entry) error {
+	data, err := cborcodec.EncMode.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("could not encode entry: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		return fmt.Errorf("recording writer is closed")
+	}
+
+	if w.written > 0 && w.written+int64(len(data)) > w.maxFileSize {
+		err = w.rotate()
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := w.buf.Write(data)
+	w.written += int64(n)
+	if err != nil {
+		return fmt.Errorf("could not write entry: %w", err)
+	}
+	err = w.buf.Flush()
+	if err != nil {
+		return fmt.Errorf("could not flush entry: %w", err)
+	}
+	return nil
+}
+
+// Close flushes and closes the current file.
+// No errors are expected during normal operations.
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.closeFile()
+}
+
+// rotate closes the current file, opens the next one and removes the oldest files.
+func (w *Writer) rotate() error {
+	err := w.closeFile()
+	if err != nil {
+		return err
+	}
+
+	w.index++
+	return w.openFile()
+}
+
+// openFile opens the file of the current index, and removes the oldest files so that at most
+// maxFiles files are kept including the new one.
+func (w *Writer) openFile() error {
+	file, err := os.OpenFile(filePath(w.dir, w.index), os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0644)
+	if err != nil {
+		return fmt.Errorf("could not create recording file: %w", err)
+	}
+	w.file = file
+	w.buf = bufio.NewWriter(file)
+	w.written = 0
+
+	indices, err := fileIndices(w.dir)
+	if err != nil {
+		return err
+	}
+	for len(indices) > w.maxFiles {
+		err = os.Remove(filePath(w.dir, indices[0]))
+		if err != nil {
+			return fmt.Errorf("could not remove recording file: %w", err)
+		}
+		indices = indices[1:]
+	}
+	return nil
+}
+
+func (w *Writer) closeFile() error {
+	if w.file == nil {
+		return nil
+	}
+
+	err := w.buf.Flush()
+	if err != nil {
+		_ = w.file.Close()
+		w.file = nil
+		return fmt.Errorf("could not flush recording file: %w", err)
+	}
+
+	err = w.file.Close()
+	w.file = nil
+	if err != nil {
+		return fmt.Errorf("could not close recording file: %w", err)
+	}
+	return nil
+}
+
+func filePath(dir string, index uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%s%020d%s", filePrefix, index, fileSuffix))
+}
+
+// fileIndices returns the indices of the recording files of the directory in increasing order.
+func fileIndices(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read recording directory %s: %w", dir, err)
+	}
+
+	var indices []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileSuffix) {
+			continue
+		}
+		index, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, filePrefix), fileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		indices = append(indices, index)
+	}
+
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	return indices, nil
+}
@@ -0,0 +1,142 @@
+package recorder
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func testEntry(i int) *Entry {
+	return &Entry{
+		Direction: Inbound,
+		Channel:   channels.SyncCommittee,
+		OriginID:  unittest.IdentifierFixture(),
+		Timestamp: time.Unix(1_700_000_000, int64(i)).UTC(),
+		Payload:   unittest.RandomBytes(100),
+	}
+}
+
+// readAll reads all the entries of the recording directory.
+func readAll(t *testing.T, dir string) []*Entry {
+	reader, err := NewReader(dir)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, reader.Close())
+	}()
+
+	var entries []*Entry
+	for {
+		entry, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return entries
+		}
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+}
+
+// TestWriter_RoundTrip tests that the entries written are read back in order, across writers.
+func TestWriter_RoundTrip(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		var written []*Entry
+		for run := 0; run < 2; run++ {
+			writer, err := NewWriter(dir, DefaultMaxFileSize, DefaultMaxFiles)
+			require.NoError(t, err)
+			for i := 0; i < 5; i++ {
+				entry := testEntry(run*5 + i)
+				entry.Direction = Outbound
+				entry.Protocol = ProtocolMulticast
+				entry.TargetIDs = unittest.IdentifierListFixture(3)
+				entry.Num = 2
+				require.NoError(t, writer.Write(entry))
+				written = append(written, entry)
+			}
+			require.NoError(t, writer.Close())
+		}
+
+		read := readAll(t, dir)
+		require.Len(t, read, len(written))
+		for i := range written {
+			require.True(t, written[i].Timestamp.Equal(read[i].Timestamp))
+			read[i].Timestamp = written[i].Timestamp
+			require.Equal(t, written[i], read[i])
+		}
+	})
+}
+
+// TestWriter_Rotation tests that files are rotated once they exceed the maximum size, and that only the most
+// recent files are kept.
+func TestWriter_Rotation(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		// every entry exceeds the half of the maximum size, so each file holds one entry
+		writer, err := NewWriter(dir, 250, 3)
+		require.NoError(t, err)
+
+		var written []*Entry
+		for i := 0; i < 10; i++ {
+			entry := testEntry(i)
+			require.NoError(t, writer.Write(entry))
+			written = append(written, entry)
+		}
+		require.NoError(t, writer.Close())
+
+		indices, err := fileIndices(dir)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{7, 8, 9}, indices)
+
+		read := readAll(t, dir)
+		require.Len(t, read, 3)
+		for i, entry := range read {
+			require.Equal(t, written[7+i].Payload, entry.Payload)
+		}
+
+		require.Error(t, writer.Write(testEntry(10)))
+	})
+}
+
+// TestReader_TruncatedEntry tests that an entry truncated at the end of a file is skipped.
+func TestReader_TruncatedEntry(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		writer, err := NewWriter(dir, DefaultMaxFileSize, DefaultMaxFiles)
+		require.NoError(t, err)
+		first := testEntry(0)
+		require.NoError(t, writer.Write(first))
+		require.NoError(t, writer.Write(testEntry(1)))
+		require.NoError(t, writer.Close())
+
+		// truncate the second entry
+		path := filePath(dir, 0)
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(path, info.Size()-10))
+
+		// entries of the next file are still read
+		writer, err = NewWriter(dir, DefaultMaxFileSize, DefaultMaxFiles)
+		require.NoError(t, err)
+		last := testEntry(2)
+		require.NoError(t, writer.Write(last))
+		require.NoError(t, writer.Close())
+
+		read := readAll(t, dir)
+		require.Len(t, read, 2)
+		require.Equal(t, first.Payload, read[0].Payload)
+		require.Equal(t, last.Payload, read[1].Payload)
+	})
+}
+
+// TestNewReader_NoRecording tests that a reader can't be created for a directory without recording files.
+func TestNewReader_NoRecording(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "other.cbor"), []byte{1}, 0644))
+		_, err := NewReader(dir)
+		require.Error(t, err)
+	})
+}
@@ -17,6 +17,8 @@ type Hub struct {
 	sync.RWMutex
 	networks map[flow.Identifier]*Network
 	Buffer   *Buffer
+	// simulator, when set, replaces the Buffer for the delivery of the messages between the networks of the Hub.
+	simulator *Simulator
 }
 
 // NewNetworkHub creates and returns a new Hub instance.
@@ -27,11 +29,34 @@ func NewNetworkHub() *Hub {
 	}
 }
 
+// NewSimulatedNetworkHub creates and returns a new Hub instance whose networks exchange messages through the given
+// Simulator, i.e., with the latency, loss, bandwidth and partitions of the simulated links, on the virtual clock of the
+// simulator. The Buffer of the returned Hub is not used.
+// Delivering the messages through the Hub, or any of its Network instances, runs the simulation until there is no
+// message in flight.
+func NewSimulatedNetworkHub(simulator *Simulator) *Hub {
+	h := NewNetworkHub()
+	h.simulator = simulator
+	simulator.hub = h
+	return h
+}
+
+// Simulator returns the Simulator of the Hub, or nil if the Hub delivers the messages instantly.
+func (h *Hub) Simulator() *Simulator {
+	return h.simulator
+}
+
 // DeliverAll delivers all the buffered messages in the Network instances attached to the Hub
 // to their destination.
 // Note that the delivery of messages is done in asynchronous mode, i.e., sender and receiver are
 // synchronized over delivery and not execution of the message.
+// For a simulated Hub, it runs the simulation until there is no message in flight, and the messages are
+// processed synchronously by the receivers.
 func (h *Hub) DeliverAll() {
+	if h.simulator != nil {
+		h.simulator.DeliverAll()
+		return
+	}
 	for _, network := range h.networks {
 		network.DeliverAll(false)
 	}
@@ -0,0 +1,82 @@
+package stub
+
+import (
+	"math/rand"
+	"time"
+)
+
+// LatencyDistribution is the distribution of the one-way latency of a simulated link.
+type LatencyDistribution interface {
+	// Sample draws a latency from the distribution using the given source of randomness.
+	// The returned latency is never negative.
+	Sample(rng *rand.Rand) time.Duration
+}
+
+// ConstantLatency is a latency distribution that always yields the same latency.
+type ConstantLatency time.Duration
+
+var _ LatencyDistribution = ConstantLatency(0)
+
+func (c ConstantLatency) Sample(_ *rand.Rand) time.Duration {
+	return time.Duration(c)
+}
+
+// UniformLatency is a latency distribution that yields latencies uniformly distributed in [Min, Max].
+type UniformLatency struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+var _ LatencyDistribution = UniformLatency{}
+
+func (u UniformLatency) Sample(rng *rand.Rand) time.Duration {
+	if u.Max <= u.Min {
+		return u.Min
+	}
+	return u.Min + time.Duration(rng.Int63n(int64(u.Max-u.Min)+1))
+}
+
+// NormalLatency is a latency distribution that yields normally distributed latencies with the given mean and
+// standard deviation, truncated at zero.
+type NormalLatency struct {
+	Mean   time.Duration
+	StdDev time.Duration
+}
+
+var _ LatencyDistribution = NormalLatency{}
+
+func (n NormalLatency) Sample(rng *rand.Rand) time.Duration {
+	latency := time.Duration(rng.NormFloat64()*float64(n.StdDev)) + n.Mean
+	if latency < 0 {
+		return 0
+	}
+	return latency
+}
+
+// LinkConfig is the configuration of a simulated directed link between two nodes.
+type LinkConfig struct {
+	// Latency is the distribution of the one-way latency of the link. A nil distribution means zero latency.
+	Latency LatencyDistribution
+	// LossRate is the probability in [0, 1] that a message sent over the link is lost.
+	LossRate float64
+	// Bandwidth is the capacity of the link in bytes per second. Messages sent over a link are transmitted one after the
+	// other, hence a message is delayed by the transmission of the messages sent before it on the same link.
+	// Zero means unlimited bandwidth.
+	Bandwidth uint64
+}
+
+// transmissionTime returns the time it takes to transmit a message of the given size over the link.
+func (c LinkConfig) transmissionTime(size int) time.Duration {
+	if c.Bandwidth == 0 {
+		return 0
+	}
+	return time.Duration(uint64(size) * uint64(time.Second) / c.Bandwidth)
+}
+
+// latency samples the latency of the link.
+func (c LinkConfig) latency(rng *rand.Rand) time.Duration {
+	if c.Latency == nil {
+		return 0
+	}
+	return c.Latency.Sample(rng)
+}
@@ -125,9 +125,7 @@ func (n *Network) submit(channel channels.Channel, event interface{}, targetIDs
 		TargetIDs: targetIDs,
 	}
 
-	n.buffer(m)
-
-	return nil
+	return n.buffer(m)
 }
 
 // unicast is called when the attached Engine to the channel is sending an event to a single target
@@ -140,8 +138,7 @@ func (n *Network) UnicastOnChannel(channel channels.Channel, event interface{},
 		TargetIDs: []flow.Identifier{targetID},
 	}
 
-	n.buffer(m)
-	return nil
+	return n.buffer(m)
 }
 
 // publish is called when the attached Engine is sending an event to a group of Engines attached to the
@@ -171,15 +168,30 @@ func (n *Network) MulticastOnChannel(channel channels.Channel, event interface{}
 // buffer saves the message into the pending buffer of the Network hub.
 // Buffering process of a message imitates its transmission over an unreliable Network.
 // In specific, it emulates the process of dispatching the message out of the sender.
-func (n *Network) buffer(msg *PendingMessage) {
+// For a simulated hub, the message is handed to the simulator instead. Only a simulated hub
+// can return an error here, when the message cannot be encoded into its deterministic event key.
+// Messages lost or partitioned by the simulator are counted in its stats and are not errors.
+func (n *Network) buffer(msg *PendingMessage) error {
+	if n.hub.simulator != nil {
+		return n.hub.simulator.submit(msg)
+	}
 	n.hub.Buffer.Save(msg)
+	return nil
 }
 
 // DeliverAll sends all pending messages to the receivers. The receivers
 // might be triggered to forward messages to its peers, so this function will
 // block until all receivers have done their forwarding, and there is no more message
 // in the Network to deliver.
+// For a simulated hub, it runs the simulation until there is no message in flight, and
+// syncOnProcess is ignored: messages are always processed synchronously, in virtual time order.
+// Messages that engines send from their own goroutines after DeliverAll returns stay in flight
+// until the next DeliverAll or Advance.
 func (n *Network) DeliverAll(syncOnProcess bool) {
+	if n.hub.simulator != nil {
+		n.hub.simulator.DeliverAll()
+		return
+	}
 	n.hub.Buffer.DeliverRecursive(func(m *PendingMessage) {
 		_ = n.sendToAllTargets(m, syncOnProcess)
 	})
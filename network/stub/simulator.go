@@ -0,0 +1,408 @@
+package stub
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/onflow/flow-go/model/encoding/json"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// simulationStart is the virtual time at which every simulation starts.
+var simulationStart = time.Unix(0, 0).UTC()
+
+// SimulatorStats are the counters of the messages handled by a Simulator.
+type SimulatorStats struct {
+	// Delivered is the number of messages delivered to their target.
+	Delivered uint64
+	// Lost is the number of messages dropped due to the loss rate of their link.
+	Lost uint64
+	// Partitioned is the number of messages dropped since their sender and target were partitioned either
+	// when the message was sent or when it was due for delivery.
+	Partitioned uint64
+	// Undeliverable is the number of messages dropped since their target has no network on the hub, or no engine
+	// registered on the channel of the message.
+	Undeliverable uint64
+}
+
+// Simulator is a deterministic network simulator for the networks attached to a Hub. Instead of delivering the messages
+// instantly and reliably, it schedules each message on a virtual clock according to the configuration of the link between
+// its sender and target, i.e., the latency distribution, loss rate and bandwidth of the link, and the scripted partitions
+// of the network.
+//
+// The simulation is reproducible from its seed: the fate of each message (latency and loss) is drawn from a source of
+// randomness that is derived from the seed and the message itself, rather than from the order in which the concurrent
+// engines happen to send their messages. Messages due at the same virtual time are delivered in a deterministic order,
+// and are processed synchronously by the receiving engines.
+//
+// The virtual clock only advances when the simulation is driven, i.e., by Advance and DeliverAll.
+type Simulator struct {
+	mu          sync.Mutex
+	hub         *Hub
+	seed        int64
+	elapsed     time.Duration           // virtual time elapsed since the start of the simulation.
+	queue       eventQueue              // pending messages and scripted actions ordered by their due time.
+	inFlight    int                     // number of messages in the queue.
+	seq         uint64                  // registration order of the scripted actions, used to break ties.
+	defaultLink LinkConfig              // configuration of the links that are not configured explicitly.
+	links       map[link]LinkConfig     // explicitly configured links.
+	busyUntil   map[link]time.Duration  // virtual time until which each link is busy transmitting.
+	occurrences map[string]uint64       // number of times each message has been sent to each target.
+	groups      map[flow.Identifier]int // partition group of each node, nil when the network is not partitioned.
+	stats       SimulatorStats
+}
+
+// link is a directed link between two nodes.
+type link struct {
+	from flow.Identifier
+	to   flow.Identifier
+}
+
+// SimulatorOption is a functional option for the Simulator.
+type SimulatorOption func(*Simulator)
+
+// WithDefaultLink sets the configuration of the links that are not configured explicitly. By default, links have
+// zero latency, no loss, and unlimited bandwidth.
+func WithDefaultLink(cfg LinkConfig) SimulatorOption {
+	return func(s *Simulator) {
+		s.defaultLink = cfg
+	}
+}
+
+// NewSimulator creates a new Simulator whose randomness is derived from the given seed.
+// The simulator is attached to a hub by NewSimulatedNetworkHub.
+func NewSimulator(seed int64, opts ...SimulatorOption) *Simulator {
+	s := &Simulator{
+		seed:        seed,
+		links:       make(map[link]LinkConfig),
+		busyUntil:   make(map[link]time.Duration),
+		occurrences: make(map[string]uint64),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Now returns the current virtual time of the simulation.
+func (s *Simulator) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return simulationStart.Add(s.elapsed)
+}
+
+// Elapsed returns the virtual time elapsed since the start of the simulation.
+func (s *Simulator) Elapsed() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.elapsed
+}
+
+// Stats returns the counters of the messages handled by the simulator so far.
+func (s *Simulator) Stats() SimulatorStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.stats
+}
+
+// SetLink sets the configuration of the directed link from one node to another.
+func (s *Simulator) SetLink(from flow.Identifier, to flow.Identifier, cfg LinkConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.links[link{from: from, to: to}] = cfg
+}
+
+// SetBidirectionalLink sets the configuration of the links between two nodes in both directions.
+func (s *Simulator) SetBidirectionalLink(a flow.Identifier, b flow.Identifier, cfg LinkConfig) {
+	s.SetLink(a, b, cfg)
+	s.SetLink(b, a, cfg)
+}
+
+// Partition partitions the network into the given groups of nodes with immediate effect. Nodes can only exchange
+// messages with the nodes of their own group, and the messages in flight between different groups are dropped.
+// All nodes that are not listed in any of the groups form an additional group together.
+func (s *Simulator) Partition(groups ...flow.IdentifierList) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.partition(groups)
+}
+
+// Heal heals all partitions of the network with immediate effect.
+func (s *Simulator) Heal() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.groups = nil
+}
+
+// PartitionAt schedules the partitioning of the network into the given groups at the given virtual time since the start
+// of the simulation. See Partition for the semantics of the groups.
+func (s *Simulator) PartitionAt(at time.Duration, groups ...flow.IdentifierList) {
+	s.ScheduleAt(at, func() {
+		s.Partition(groups...)
+	})
+}
+
+// HealAt schedules the healing of all partitions of the network at the given virtual time since the start of the simulation.
+func (s *Simulator) HealAt(at time.Duration) {
+	s.ScheduleAt(at, s.Heal)
+}
+
+// ScheduleAt schedules the given action to run at the given virtual time since the start of the simulation. Actions
+// due at the same virtual time run in the order of their scheduling, and before the messages due at that time are delivered.
+// An action scheduled in the past runs on the next step of the simulation.
+func (s *Simulator) ScheduleAt(at time.Duration, action func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.seq++
+	heap.Push(&s.queue, &scheduledEvent{
+		at:     at,
+		seq:    s.seq,
+		action: action,
+	})
+}
+
+// Advance runs the simulation for the given virtual duration, i.e., it delivers the messages and runs the scripted actions
+// that are due until then in the order of their due time, and then advances the virtual clock by the given duration.
+func (s *Simulator) Advance(d time.Duration) {
+	s.mu.Lock()
+	until := s.elapsed + d
+	s.mu.Unlock()
+
+	for s.step(until) {
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.elapsed < until {
+		s.elapsed = until
+	}
+}
+
+// DeliverAll runs the simulation until there is no message in flight, advancing the virtual clock to the due time of
+// the last delivered message. This includes the messages sent by the receiving engines while processing the delivered
+// messages, as long as they are sent synchronously. Scripted actions due before the last message run as part of the
+// simulation, while the ones due after it remain scheduled.
+func (s *Simulator) DeliverAll() {
+	for {
+		s.mu.Lock()
+		inFlight := s.inFlight
+		s.mu.Unlock()
+
+		if inFlight == 0 {
+			return
+		}
+		s.step(-1)
+	}
+}
+
+// step runs the earliest due event of the simulation if it is due by the given virtual time (a negative time means no limit),
+// advancing the virtual clock to its due time. It returns false if there is no such event.
+func (s *Simulator) step(until time.Duration) bool {
+	s.mu.Lock()
+	if s.queue.Len() == 0 || (until >= 0 && s.queue[0].at > until) {
+		s.mu.Unlock()
+		return false
+	}
+
+	event := heap.Pop(&s.queue).(*scheduledEvent)
+	if event.at > s.elapsed {
+		s.elapsed = event.at
+	}
+	if event.action != nil {
+		s.mu.Unlock()
+		event.action()
+		return true
+	}
+
+	s.inFlight--
+	if !s.reachable(event.msg.From, event.target) {
+		s.stats.Partitioned++
+		s.mu.Unlock()
+		return true
+	}
+	s.mu.Unlock()
+
+	// the message is processed without holding the lock, as the receiving engine may send messages synchronously.
+	delivered := s.deliver(event)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if delivered {
+		s.stats.Delivered++
+	} else {
+		s.stats.Undeliverable++
+	}
+	return true
+}
+
+// deliver delivers the message of the given event to its target, and returns true if the message was handed to an engine.
+func (s *Simulator) deliver(event *scheduledEvent) bool {
+	receiver, ok := s.hub.GetNetwork(event.target)
+	if !ok {
+		return false
+	}
+	msg := &PendingMessage{
+		From:      event.msg.From,
+		Channel:   event.msg.Channel,
+		Event:     event.msg.Event,
+		TargetIDs: []flow.Identifier{event.target},
+	}
+	return receiver.processWithEngine(true, event.key, msg) == nil
+}
+
+// submit schedules the delivery of the given message to each of its targets according to the links between the sender
+// and the targets. Messages that are lost, or sent across a partition, are dropped right away.
+// No errors are expected during normal operations.
+func (s *Simulator) submit(msg *PendingMessage) error {
+	key, err := eventKey(msg.From, msg.Channel, msg.Event)
+	if err != nil {
+		return fmt.Errorf("could not generate event key for event: %w", err)
+	}
+	payload, err := json.NewMarshaler().Marshal(msg.Event)
+	if err != nil {
+		return fmt.Errorf("could not encode event: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, target := range msg.TargetIDs {
+		if !s.reachable(msg.From, target) {
+			s.stats.Partitioned++
+			continue
+		}
+
+		l := link{from: msg.From, to: target}
+		cfg, ok := s.links[l]
+		if !ok {
+			cfg = s.defaultLink
+		}
+
+		rng := s.messageRand(key, target)
+		if cfg.LossRate > 0 && rng.Float64() < cfg.LossRate {
+			s.stats.Lost++
+			continue
+		}
+
+		// the message is transmitted once the link is done with the messages sent before it, and then
+		// travels over the link for the sampled latency.
+		start := s.elapsed
+		if busy := s.busyUntil[l]; busy > start {
+			start = busy
+		}
+		transmitted := start + cfg.transmissionTime(len(payload))
+		if cfg.Bandwidth > 0 {
+			s.busyUntil[l] = transmitted
+		}
+
+		heap.Push(&s.queue, &scheduledEvent{
+			at:     transmitted + cfg.latency(rng),
+			msg:    msg,
+			target: target,
+			key:    key,
+		})
+		s.inFlight++
+	}
+	return nil
+}
+
+// messageRand returns the source of randomness for the delivery of the message with the given key to the given target.
+// It is derived from the seed of the simulator, the message, its target, and the number of times the same message has been
+// sent to the same target, so that the fate of a message does not depend on the interleaving of the concurrent senders.
+// Must be called while holding the lock.
+func (s *Simulator) messageRand(key string, target flow.Identifier) *rand.Rand {
+	occurrence := key + target.String()
+	n := s.occurrences[occurrence]
+	s.occurrences[occurrence] = n + 1
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(occurrence))
+	_ = binary.Write(h, binary.BigEndian, n)
+	return rand.New(rand.NewSource(s.seed ^ int64(h.Sum64())))
+}
+
+// partition assigns the nodes of each group to the same partition group.
+// Must be called while holding the lock.
+func (s *Simulator) partition(groups []flow.IdentifierList) {
+	s.groups = make(map[flow.Identifier]int)
+	for i, group := range groups {
+		for _, nodeID := range group {
+			s.groups[nodeID] = i + 1 // zero is reserved for the nodes that are not listed in any group.
+		}
+	}
+}
+
+// reachable returns true if the given nodes are not partitioned from each other.
+// Must be called while holding the lock.
+func (s *Simulator) reachable(a flow.Identifier, b flow.Identifier) bool {
+	if s.groups == nil {
+		return true
+	}
+	return s.groups[a] == s.groups[b]
+}
+
+// scheduledEvent is either a message due for delivery to a single target, or a scripted action.
+type scheduledEvent struct {
+	at time.Duration // due time of the event since the start of the simulation.
+
+	// scripted action
+	seq    uint64
+	action func()
+
+	// message delivery
+	msg    *PendingMessage
+	target flow.Identifier
+	key    string
+}
+
+// before returns true if the event is due before the other one. Events due at the same time are ordered deterministically:
+// the scripted actions in the order of their scheduling come first, followed by the messages ordered by their key and target.
+func (e *scheduledEvent) before(other *scheduledEvent) bool {
+	if e.at != other.at {
+		return e.at < other.at
+	}
+	if (e.action != nil) != (other.action != nil) {
+		return e.action != nil
+	}
+	if e.action != nil {
+		return e.seq < other.seq
+	}
+	if e.key != other.key {
+		return e.key < other.key
+	}
+	return e.target.String() < other.target.String()
+}
+
+// eventQueue is a min-heap of the scheduled events ordered by their due time.
+type eventQueue []*scheduledEvent
+
+var _ heap.Interface = (*eventQueue)(nil)
+
+func (q eventQueue) Len() int           { return len(q) }
+func (q eventQueue) Less(i, j int) bool { return q[i].before(q[j]) }
+func (q eventQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *eventQueue) Push(x any) {
+	*q = append(*q, x.(*scheduledEvent))
+}
+
+func (q *eventQueue) Pop() any {
+	old := *q
+	n := len(old)
+	event := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return event
+}
@@ -0,0 +1,186 @@
+package stub_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/stub"
+)
+
+// delivery is a message received by a recorder, along with the virtual time of its delivery.
+type delivery struct {
+	from    flow.Identifier
+	message string
+	at      time.Duration
+}
+
+// recorder is a message processor that records the messages it receives along with the virtual time of their delivery.
+type recorder struct {
+	sync.Mutex
+	sim        *stub.Simulator
+	deliveries []delivery
+}
+
+var _ network.MessageProcessor = (*recorder)(nil)
+
+func (r *recorder) Process(_ channels.Channel, originID flow.Identifier, message interface{}) error {
+	r.Lock()
+	defer r.Unlock()
+
+	r.deliveries = append(r.deliveries, delivery{
+		from:    originID,
+		message: message.(string),
+		at:      r.sim.Elapsed(),
+	})
+	return nil
+}
+
+func (r *recorder) received() []delivery {
+	r.Lock()
+	defer r.Unlock()
+
+	return append([]delivery(nil), r.deliveries...)
+}
+
+// simulatedNodes creates a simulated hub with the given simulator, and the given number of nodes attached to it,
+// each with a recorder engine registered on the test channel. The node ids are fixed, so that the simulations are
+// reproducible across runs.
+func simulatedNodes(t *testing.T, sim *stub.Simulator, n int) ([]network.Conduit, []*recorder, flow.IdentifierList) {
+	hub := stub.NewSimulatedNetworkHub(sim)
+	conduits := make([]network.Conduit, n)
+	recorders := make([]*recorder, n)
+	ids := make(flow.IdentifierList, n)
+	for i := range ids {
+		ids[i] = flow.HashToID([]byte{byte(i)})
+	}
+	for i, id := range ids {
+		net := stub.NewNetwork(t, id, hub)
+		recorders[i] = &recorder{sim: sim}
+		con, err := net.Register(channels.TestNetworkChannel, recorders[i])
+		require.NoError(t, err)
+		conduits[i] = con
+	}
+	return conduits, recorders, ids
+}
+
+// TestSimulator_Latency tests that the messages are delivered after the latency of their link on the virtual clock.
+func TestSimulator_Latency(t *testing.T) {
+	sim := stub.NewSimulator(1, stub.WithDefaultLink(stub.LinkConfig{Latency: stub.ConstantLatency(100 * time.Millisecond)}))
+	conduits, recorders, ids := simulatedNodes(t, sim, 3)
+	// a slower link from the first to the third node.
+	sim.SetLink(ids[0], ids[2], stub.LinkConfig{Latency: stub.ConstantLatency(300 * time.Millisecond)})
+
+	require.NoError(t, conduits[0].Publish("hello", ids[1], ids[2]))
+
+	// the message is not delivered before its latency elapses on the virtual clock.
+	sim.Advance(50 * time.Millisecond)
+	require.Empty(t, recorders[1].received())
+
+	sim.Advance(50 * time.Millisecond)
+	require.Equal(t, []delivery{{from: ids[0], message: "hello", at: 100 * time.Millisecond}}, recorders[1].received())
+	require.Empty(t, recorders[2].received())
+
+	sim.DeliverAll()
+	require.Equal(t, []delivery{{from: ids[0], message: "hello", at: 300 * time.Millisecond}}, recorders[2].received())
+	require.Equal(t, 300*time.Millisecond, sim.Elapsed())
+	require.Equal(t, uint64(2), sim.Stats().Delivered)
+}
+
+// TestSimulator_Bandwidth tests that the messages sent over a link are delayed by the transmission of the messages sent
+// before them on the same link.
+func TestSimulator_Bandwidth(t *testing.T) {
+	// each of the messages below is encoded into 10 bytes, hence takes 10ms to transmit at 1KB/s.
+	sim := stub.NewSimulator(1, stub.WithDefaultLink(stub.LinkConfig{
+		Latency:   stub.ConstantLatency(5 * time.Millisecond),
+		Bandwidth: 1000,
+	}))
+	conduits, recorders, ids := simulatedNodes(t, sim, 2)
+
+	for _, message := range []string{"message1", "message2", "message3"} {
+		require.NoError(t, conduits[0].Unicast(message, ids[1]))
+	}
+	sim.DeliverAll()
+
+	require.Equal(t, []delivery{
+		{from: ids[0], message: "message1", at: 15 * time.Millisecond},
+		{from: ids[0], message: "message2", at: 25 * time.Millisecond},
+		{from: ids[0], message: "message3", at: 35 * time.Millisecond},
+	}, recorders[1].received())
+}
+
+// TestSimulator_PartitionAndHeal tests that the scripted partitions drop the messages sent across them, as well as the ones
+// in flight across them, and that the messages are delivered again once the partition is healed.
+func TestSimulator_PartitionAndHeal(t *testing.T) {
+	sim := stub.NewSimulator(1, stub.WithDefaultLink(stub.LinkConfig{Latency: stub.ConstantLatency(50 * time.Millisecond)}))
+	conduits, recorders, ids := simulatedNodes(t, sim, 3)
+	sim.PartitionAt(100*time.Millisecond, flow.IdentifierList{ids[0]}, flow.IdentifierList{ids[1]})
+	sim.HealAt(300 * time.Millisecond)
+
+	// delivered before the partition.
+	require.NoError(t, conduits[0].Unicast("before", ids[1]))
+	sim.Advance(80 * time.Millisecond)
+
+	// in flight when the partition starts.
+	require.NoError(t, conduits[0].Unicast("in-flight", ids[1]))
+	sim.Advance(50 * time.Millisecond)
+
+	// sent across the partition; the third node is not listed in any group, hence it is partitioned from both.
+	require.NoError(t, conduits[0].Publish("partitioned", ids[1], ids[2]))
+	sim.Advance(200 * time.Millisecond)
+
+	// sent after the partition is healed.
+	require.NoError(t, conduits[0].Unicast("after", ids[1]))
+	sim.DeliverAll()
+
+	require.Equal(t, []delivery{
+		{from: ids[0], message: "before", at: 50 * time.Millisecond},
+		{from: ids[0], message: "after", at: 380 * time.Millisecond},
+	}, recorders[1].received())
+	require.Empty(t, recorders[2].received())
+
+	stats := sim.Stats()
+	require.Equal(t, uint64(2), stats.Delivered)
+	require.Equal(t, uint64(3), stats.Partitioned)
+}
+
+// TestSimulator_Deterministic tests that a simulation with random latencies and losses is reproducible from its seed,
+// regardless of the interleaving of the concurrent senders.
+func TestSimulator_Deterministic(t *testing.T) {
+	run := func(seed int64) []delivery {
+		sim := stub.NewSimulator(seed, stub.WithDefaultLink(stub.LinkConfig{
+			Latency:  stub.UniformLatency{Min: 10 * time.Millisecond, Max: 500 * time.Millisecond},
+			LossRate: 0.3,
+		}))
+		conduits, recorders, ids := simulatedNodes(t, sim, 4)
+
+		// the first three nodes concurrently send messages to the last node.
+		wg := sync.WaitGroup{}
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 50; j++ {
+					require.NoError(t, conduits[i].Unicast(fmt.Sprintf("message-%d-%d", i, j), ids[3]))
+				}
+			}(i)
+		}
+		wg.Wait()
+		sim.DeliverAll()
+
+		stats := sim.Stats()
+		require.Equal(t, uint64(150), stats.Delivered+stats.Lost)
+		require.NotZero(t, stats.Lost)
+		return recorders[3].received()
+	}
+
+	first := run(42)
+	require.Equal(t, first, run(42))
+	require.NotEqual(t, first, run(43))
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/onflow/flow-go/module/component"
 	"github.com/onflow/flow-go/module/irrecoverable"
 	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/alsp"
 	alspmgr "github.com/onflow/flow-go/network/alsp/manager"
 	netcache "github.com/onflow/flow-go/network/cache"
 	"github.com/onflow/flow-go/network/channels"
@@ -113,6 +116,7 @@ type Network struct {
 	validators                  []network.MessageValidator
 	authorizedSenderValidator   *validator.AuthorizedSenderValidator
 	preferredUnicasts           []protocols.ProtocolName
+	unicastCompressionPolicy    map[channels.Channel]protocols.ProtocolName
 }
 
 var _ network.EngineRegistry = &Network{}
@@ -247,6 +251,58 @@ func WithPreferredUnicastProtocols(protocols ...protocols.ProtocolName) NetworkO
 	}
 }
 
+// WithUnicastCompressionPolicy sets the per-channel unicast compression policy for the network. The unicast messages sent on a
+// channel of the policy are sent over a stream that prefers the given protocol of the channel, and falls back to the preferred
+// unicast protocols of the network if the remote peer does not support it.
+func WithUnicastCompressionPolicy(policy map[channels.Channel]protocols.ProtocolName) NetworkOption {
+	return func(n *Network) {
+		n.unicastCompressionPolicy = policy
+	}
+}
+
+// ToUnicastCompressionPolicy converts the per-channel unicast compression policy from the network configuration, i.e., a list of
+// <channel>=<protocol> entries, into the policy of the network. The protocol of each entry must be either the plain unicast protocol
+// or one of the given preferred unicast protocols, i.e., a protocol that is registered on the unicast manager.
+// All errors returned from this function are benign and indicate an invalid configuration, i.e., a malformed entry, an unknown
+// channel or protocol, or a protocol that is not registered.
+func ToUnicastCompressionPolicy(entries []string, preferred []protocols.ProtocolName) (map[channels.Channel]protocols.ProtocolName, error) {
+	policy := make(map[channels.Channel]protocols.ProtocolName, len(entries))
+	for _, entry := range entries {
+		c, p, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed unicast compression policy entry, expected <channel>=<protocol>: %s", entry)
+		}
+		channel := channels.Channel(c)
+		if !channels.ChannelExists(channel) {
+			return nil, fmt.Errorf("unknown channel in unicast compression policy: %s", c)
+		}
+		name := protocols.ProtocolName(p)
+		if err := protocols.ValidateProtocolName(name); err != nil {
+			return nil, fmt.Errorf("invalid unicast compression policy for channel %s: %w", c, err)
+		}
+		policy[channel] = name
+	}
+
+	if err := validateUnicastCompressionPolicy(policy, preferred); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// validateUnicastCompressionPolicy checks that the protocol of each channel of the policy is registered on the unicast manager, i.e.,
+// it is either the plain unicast protocol or one of the given preferred unicast protocols. Otherwise, the unicast manager silently
+// falls back to its default order of preference for the channel.
+// All errors returned from this function are benign and indicate an invalid configuration.
+func validateUnicastCompressionPolicy(policy map[channels.Channel]protocols.ProtocolName, preferred []protocols.ProtocolName) error {
+	for channel, name := range policy {
+		if name != protocols.PlainUnicast && !slices.Contains(preferred, name) {
+			return fmt.Errorf("unicast compression policy of channel %s uses protocol %s, which is not among the preferred unicast protocols %v",
+				channel, name, preferred)
+		}
+	}
+	return nil
+}
+
 // WithMessageValidators sets the message validators for the network. It overrides the default
 // message validators.
 func WithMessageValidators(validators ...network.MessageValidator) NetworkOption {
@@ -296,6 +352,10 @@ func NewNetwork(param *NetworkConfig, opts ...NetworkOption) (*Network, error) {
 		opt(n)
 	}
 
+	if err := validateUnicastCompressionPolicy(n.unicastCompressionPolicy, n.preferredUnicasts); err != nil {
+		return nil, fmt.Errorf("invalid unicast compression policy: %w", err)
+	}
+
 	if err := n.conduitFactory.RegisterAdapter(n); err != nil {
 		return nil, fmt.Errorf("could not register network adapter: %w", err)
 	}
@@ -634,6 +694,9 @@ func (n *Network) UnicastOnChannel(channel channels.Channel, payload interface{}
 	// pass in a context with timeout to make the unicast call fail fast
 	ctx, cancel := context.WithTimeout(n.ctx, maxTimeout)
 	defer cancel()
+	if preferred, ok := n.unicastCompressionPolicy[channel]; ok {
+		ctx = protocols.WithPreferredProtocol(ctx, preferred)
+	}
 
 	// protect the underlying connection from being inadvertently pruned by the peer manager while the stream and
 	// connection creation is being attempted, and remove it from protected list once stream created.
@@ -799,6 +862,13 @@ func (n *Network) ReportMisbehaviorOnChannel(channel channels.Channel, report ne
 	n.misbehaviorReportManager.HandleMisbehaviorReport(channel, report)
 }
 
+// SpamRecordAdmin returns the operator controls over the spam records of the ALSP module of this network.
+// Returns false if the misbehavior report manager of this network does not expose them, e.g., a custom manager set by WithAlspManager.
+func (n *Network) SpamRecordAdmin() (alsp.SpamRecordAdmin, bool) {
+	admin, ok := n.misbehaviorReportManager.(alsp.SpamRecordAdmin)
+	return admin, ok
+}
+
 func DefaultValidators(log zerolog.Logger, flowID flow.Identifier) []network.MessageValidator {
 	return []network.MessageValidator{
 		validator.ValidateNotSender(flowID),   // validator to filter out messages sent by this node itself
@@ -0,0 +1,57 @@
+package underlay_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/p2p/unicast/protocols"
+	"github.com/onflow/flow-go/network/underlay"
+)
+
+// TestToUnicastCompressionPolicy tests that the per-channel unicast compression policy is converted from the network configuration,
+// and that misconfigured policies are rejected.
+func TestToUnicastCompressionPolicy(t *testing.T) {
+	preferred := []protocols.ProtocolName{protocols.GzipCompressionUnicast, protocols.ZstdCompressionUnicast}
+
+	t.Run("valid policy", func(t *testing.T) {
+		policy, err := underlay.ToUnicastCompressionPolicy([]string{
+			string(channels.RequestChunks) + "=" + string(protocols.ZstdCompressionUnicast),
+			string(channels.PushBlocks) + "=" + string(protocols.PlainUnicast),
+		}, preferred)
+		require.NoError(t, err)
+		require.Equal(t, map[channels.Channel]protocols.ProtocolName{
+			channels.RequestChunks: protocols.ZstdCompressionUnicast,
+			channels.PushBlocks:    protocols.PlainUnicast,
+		}, policy)
+	})
+
+	t.Run("malformed entry", func(t *testing.T) {
+		_, err := underlay.ToUnicastCompressionPolicy([]string{string(channels.RequestChunks)}, preferred)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown channel", func(t *testing.T) {
+		_, err := underlay.ToUnicastCompressionPolicy([]string{"unknown-channel=" + string(protocols.ZstdCompressionUnicast)}, preferred)
+		require.Error(t, err)
+	})
+
+	t.Run("unknown protocol", func(t *testing.T) {
+		_, err := underlay.ToUnicastCompressionPolicy([]string{string(channels.RequestChunks) + "=unknown-compression"}, preferred)
+		require.Error(t, err)
+	})
+
+	// a known protocol that is not registered on the unicast manager would be silently ignored by the unicast manager.
+	t.Run("protocol is not preferred", func(t *testing.T) {
+		_, err := underlay.ToUnicastCompressionPolicy([]string{
+			string(channels.RequestChunks) + "=" + string(protocols.Lz4CompressionUnicast),
+		}, preferred)
+		require.Error(t, err)
+
+		_, err = underlay.ToUnicastCompressionPolicy([]string{
+			string(channels.RequestChunks) + "=" + string(protocols.ZstdCompressionUnicast),
+		}, nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// AccountTransactionsReader provides read access to the account transaction index.
+type AccountTransactionsReader interface {
+	// ByAddress returns up to `limit` transactions which touched the given account, ordered by
+	// descending block height and transaction index. The lookup starts with the entry at the given
+	// height and transaction index (inclusive) and continues with older entries.
+	//
+	// No errors are expected during normal operation. If no entries are found, an empty slice is returned.
+	ByAddress(address flow.Address, startHeight uint64, startTxIndex uint32, limit uint32) ([]flow.AccountTransaction, error)
+}
+
+// AccountTransactions represents persistent storage for the account transaction index, which maps
+// account addresses to the transactions that touched them.
+type AccountTransactions interface {
+	AccountTransactionsReader
+
+	// BatchStore indexes the given account transactions in the provided batch.
+	// Indexing the same entries again overwrites the existing ones.
+	//
+	// No errors are expected during normal operation.
+	BatchStore(txs []flow.AccountTransaction, batch ReaderBatchWriter) error
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ContractUpdatesReader provides read access to the contract update index.
+type ContractUpdatesReader interface {
+	// ByContract returns the updates of the given contract, ordered by ascending block height,
+	// transaction index and event index.
+	//
+	// No errors are expected during normal operation. If no entries are found, an empty slice is returned.
+	ByContract(address flow.Address, name string) ([]flow.ContractUpdate, error)
+}
+
+// ContractUpdates represents persistent storage for the contract update index, which maps contracts
+// to the transactions that deployed, updated or removed them.
+type ContractUpdates interface {
+	ContractUpdatesReader
+
+	// BatchStore indexes the given contract updates in the provided batch.
+	// Indexing the same entries again overwrites the existing ones.
+	//
+	// No errors are expected during normal operation.
+	BatchStore(updates []flow.ContractUpdate, batch ReaderBatchWriter) error
+}
@@ -31,6 +31,9 @@ var (
 
 	// ErrNotBootstrapped is returned when the database has not been bootstrapped.
 	ErrNotBootstrapped = errors.New("pebble database not bootstrapped")
+
+	// ErrLimitExceeded is returned when a bounded scan finds more entries than the provided limit.
+	ErrLimitExceeded = errors.New("limit exceeded")
 )
 
 // InvalidDKGStateTransitionError is a sentinel error that is returned in case an invalid state transition is attempted.
@@ -12,6 +12,36 @@ type RegisterIndex struct {
 	mock.Mock
 }
 
+// ByOwner provides a mock function with given fields: owner, height, limit
+func (_m *RegisterIndex) ByOwner(owner string, height uint64, limit uint) (flow.RegisterEntries, error) {
+	ret := _m.Called(owner, height, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ByOwner")
+	}
+
+	var r0 flow.RegisterEntries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint64, uint) (flow.RegisterEntries, error)); ok {
+		return rf(owner, height, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint64, uint) flow.RegisterEntries); ok {
+		r0 = rf(owner, height, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(flow.RegisterEntries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint64, uint) error); ok {
+		r1 = rf(owner, height, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FirstHeight provides a mock function with given fields:
 func (_m *RegisterIndex) FirstHeight() uint64 {
 	ret := _m.Called()
@@ -0,0 +1,80 @@
+package operation
+
+import (
+	"errors"
+	"math"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// errAccountTransactionsLimitReached is used to stop the iteration once the requested number of
+// entries were collected.
+var errAccountTransactionsLimitReached = errors.New("account transactions limit reached")
+
+// accountTransactionKey builds the key of an account transaction index entry.
+// Height and transaction index are stored as their one's complement, so that iterating the keys
+// in ascending order yields the entries in descending order of (height, transaction index), i.e.
+// the most recent transactions first.
+func accountTransactionKey(address flow.Address, height uint64, txIndex uint32) []byte {
+	return MakePrefix(codeAccountTransaction, address, ^height, ^txIndex)
+}
+
+// IndexAccountTransaction indexes the given account transaction by its address, block height
+// and transaction index. Indexing the same entry again overwrites the existing one.
+// No errors are expected during normal operation.
+func IndexAccountTransaction(w storage.Writer, tx *flow.AccountTransaction) error {
+	return UpsertByKey(w, accountTransactionKey(tx.Address, tx.BlockHeight, tx.TransactionIndex), tx)
+}
+
+// LookupAccountTransactions retrieves up to `limit` transactions which touched the given account,
+// ordered by descending block height and transaction index. The lookup starts with the entry at
+// the given height and transaction index (inclusive) and continues with older entries.
+// No errors are expected during normal operation. If no entries are found, an empty slice is returned.
+func LookupAccountTransactions(
+	r storage.Reader,
+	address flow.Address,
+	startHeight uint64,
+	startTxIndex uint32,
+	limit uint32,
+	txs *[]flow.AccountTransaction,
+) error {
+	if limit == 0 {
+		return nil
+	}
+
+	iterationFunc := func() (CheckFunc, CreateFunc, HandleFunc) {
+		check := func(key []byte) (bool, error) {
+			if uint32(len(*txs)) >= limit {
+				return false, errAccountTransactionsLimitReached
+			}
+			return true, nil
+		}
+		var val flow.AccountTransaction
+		create := func() interface{} {
+			return &val
+		}
+		handle := func() error {
+			*txs = append(*txs, val)
+			return nil
+		}
+		return check, create, handle
+	}
+
+	startPrefix := accountTransactionKey(address, startHeight, startTxIndex)
+	endPrefix := accountTransactionKey(address, 0, 0)
+
+	err := IterateKeys(r, startPrefix, endPrefix, iterationFunc, storage.DefaultIteratorOptions())
+	if err != nil && !errors.Is(err, errAccountTransactionsLimitReached) {
+		return err
+	}
+
+	return nil
+}
+
+// LookupLatestAccountTransactions retrieves up to `limit` of the most recent transactions which
+// touched the given account, ordered by descending block height and transaction index.
+// No errors are expected during normal operation. If no entries are found, an empty slice is returned.
+func LookupLatestAccountTransactions(r storage.Reader, address flow.Address, limit uint32, txs *[]flow.AccountTransaction) error {
+	return LookupAccountTransactions(r, address, math.MaxUint64, math.MaxUint32, limit, txs)
+}
@@ -0,0 +1,26 @@
+package operation
+
+import (
+	"github.com/onflow/flow-go/network/alsp/model"
+	"github.com/onflow/flow-go/storage"
+)
+
+// UpsertAlspSpamRecords writes the snapshot of the ALSP spam records of the given networking type into the data base.
+// If an entry already exists, it is overwritten; otherwise a new entry is created.
+// No errors are expected during normal operations.
+func UpsertAlspSpamRecords(w storage.Writer, networkType uint8, snapshot *model.SpamRecordsSnapshot) error {
+	return UpsertByKey(w, MakePrefix(codeAlspSpamRecords, networkType), snapshot)
+}
+
+// RetrieveAlspSpamRecords reads the snapshot of the ALSP spam records of the given networking type from the data base.
+// Returns `storage.ErrNotFound` error in case no respective data base entry is present.
+func RetrieveAlspSpamRecords(r storage.Reader, networkType uint8, snapshot *model.SpamRecordsSnapshot) error {
+	return RetrieveByKey(r, MakePrefix(codeAlspSpamRecords, networkType), snapshot)
+}
+
+// RemoveAlspSpamRecords removes the snapshot of the ALSP spam records of the given networking type from the data base.
+// If no corresponding entry exists, this function is a no-op.
+// No errors are expected during normal operations.
+func RemoveAlspSpamRecords(w storage.Writer, networkType uint8) error {
+	return RemoveByKey(w, MakePrefix(codeAlspSpamRecords, networkType))
+}
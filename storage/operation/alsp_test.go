@@ -0,0 +1,125 @@
+package operation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/network/alsp/model"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestAlspSpamRecords tests the operations:
+//   - UpsertAlspSpamRecords(w, networkType uint8, snapshot *model.SpamRecordsSnapshot)
+//   - RetrieveAlspSpamRecords(r, networkType uint8, snapshot *model.SpamRecordsSnapshot)
+//   - RemoveAlspSpamRecords(w, networkType uint8)
+func TestAlspSpamRecords(t *testing.T) {
+	t.Run("Retrieving non-existing snapshot should return 'storage.ErrNotFound'", func(t *testing.T) {
+		dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+			var snapshot model.SpamRecordsSnapshot
+			err := operation.RetrieveAlspSpamRecords(db.Reader(), 1, &snapshot)
+			require.ErrorIs(t, err, storage.ErrNotFound)
+		})
+	})
+
+	t.Run("Persisting and read snapshot", func(t *testing.T) {
+		dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+			snapshot := spamRecordsSnapshotFixture(10)
+			err := db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+				return operation.UpsertAlspSpamRecords(rw.Writer(), 1, snapshot)
+			})
+			require.NoError(t, err)
+
+			var s model.SpamRecordsSnapshot
+			err = operation.RetrieveAlspSpamRecords(db.Reader(), 1, &s)
+			require.NoError(t, err)
+			requireSnapshotsEqual(t, snapshot, &s)
+		})
+	})
+
+	t.Run("Snapshots of different networking types are isolated", func(t *testing.T) {
+		dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+			snapshot1 := spamRecordsSnapshotFixture(5)
+			snapshot2 := spamRecordsSnapshotFixture(7)
+			err := db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+				err := operation.UpsertAlspSpamRecords(rw.Writer(), 1, snapshot1)
+				if err != nil {
+					return err
+				}
+				return operation.UpsertAlspSpamRecords(rw.Writer(), 2, snapshot2)
+			})
+			require.NoError(t, err)
+
+			var s model.SpamRecordsSnapshot
+			err = operation.RetrieveAlspSpamRecords(db.Reader(), 1, &s)
+			require.NoError(t, err)
+			requireSnapshotsEqual(t, snapshot1, &s)
+
+			err = operation.RetrieveAlspSpamRecords(db.Reader(), 2, &s)
+			require.NoError(t, err)
+			requireSnapshotsEqual(t, snapshot2, &s)
+		})
+	})
+
+	t.Run("Write & Remove & Write snapshot", func(t *testing.T) {
+		dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+			err := db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+				return operation.UpsertAlspSpamRecords(rw.Writer(), 1, spamRecordsSnapshotFixture(3))
+			})
+			require.NoError(t, err)
+
+			err = db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+				return operation.RemoveAlspSpamRecords(rw.Writer(), 1)
+			})
+			require.NoError(t, err)
+
+			var s model.SpamRecordsSnapshot
+			err = operation.RetrieveAlspSpamRecords(db.Reader(), 1, &s)
+			require.ErrorIs(t, err, storage.ErrNotFound)
+
+			// removing a non-existing snapshot is a no-op.
+			err = db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+				return operation.RemoveAlspSpamRecords(rw.Writer(), 1)
+			})
+			require.NoError(t, err)
+
+			snapshot := spamRecordsSnapshotFixture(4)
+			err = db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+				return operation.UpsertAlspSpamRecords(rw.Writer(), 1, snapshot)
+			})
+			require.NoError(t, err)
+
+			err = operation.RetrieveAlspSpamRecords(db.Reader(), 1, &s)
+			require.NoError(t, err)
+			requireSnapshotsEqual(t, snapshot, &s)
+		})
+	})
+}
+
+// spamRecordsSnapshotFixture returns a snapshot with the given number of random spam records.
+func spamRecordsSnapshotFixture(n int) *model.SpamRecordsSnapshot {
+	records := make([]model.ProtocolSpamRecord, 0, n)
+	for _, id := range unittest.IdentifierListFixture(n) {
+		records = append(records, model.ProtocolSpamRecord{
+			OriginId:       id,
+			Decay:          model.InitialDecaySpeed,
+			CutoffCounter:  2,
+			DisallowListed: true,
+			Penalty:        model.DisallowListingThreshold,
+		})
+	}
+	return &model.SpamRecordsSnapshot{
+		Records:   records,
+		Timestamp: time.Now().UTC(),
+	}
+}
+
+// requireSnapshotsEqual asserts that the two snapshots hold the same records and timestamp.
+func requireSnapshotsEqual(t *testing.T, expected *model.SpamRecordsSnapshot, actual *model.SpamRecordsSnapshot) {
+	require.ElementsMatch(t, expected.Records, actual.Records)
+	require.True(t, expected.Timestamp.Equal(actual.Timestamp))
+}
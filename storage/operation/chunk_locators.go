@@ -14,6 +14,12 @@ func RetrieveChunkLocator(r storage.Reader, locatorID flow.Identifier, locator *
 	return RetrieveByKey(r, MakePrefix(codeChunk, locatorID), locator)
 }
 
+// RemoveChunkLocator removes the chunk locator with the given ID.
+// No errors are expected during normal operation, removing a non-existing locator is a no-op.
+func RemoveChunkLocator(w storage.Writer, locatorID flow.Identifier) error {
+	return RemoveByKey(w, MakePrefix(codeChunk, locatorID))
+}
+
 func ExistChunkLocator(r storage.Reader, locatorID flow.Identifier) (bool, error) {
 	return KeyExists(r, MakePrefix(codeChunk, locatorID))
 }
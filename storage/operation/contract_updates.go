@@ -0,0 +1,49 @@
+package operation
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// contractUpdatePrefix builds the key prefix of all updates of a contract. The name is prefixed by its
+// length, so that the prefix of a contract is never a prefix of another contract's keys.
+func contractUpdatePrefix(address flow.Address, name string) []byte {
+	return MakePrefix(codeContractUpdate, address, uint32(len(name)), name)
+}
+
+// contractUpdateKey builds the key of a contract update index entry. Entries of the same contract are
+// ordered by ascending block height, transaction index and event index.
+func contractUpdateKey(update *flow.ContractUpdate) []byte {
+	name := update.ContractName
+	return MakePrefix(codeContractUpdate, update.Address, uint32(len(name)), name,
+		update.BlockHeight, update.TransactionIndex, update.EventIndex)
+}
+
+// IndexContractUpdate indexes the given contract update by its contract, block height, transaction index
+// and event index. Indexing the same entry again overwrites the existing one.
+// No errors are expected during normal operation.
+func IndexContractUpdate(w storage.Writer, update *flow.ContractUpdate) error {
+	return UpsertByKey(w, contractUpdateKey(update), update)
+}
+
+// LookupContractUpdates retrieves all updates of the given contract, ordered by ascending block height,
+// transaction index and event index.
+// No errors are expected during normal operation. If no entries are found, an empty slice is returned.
+func LookupContractUpdates(r storage.Reader, address flow.Address, name string, updates *[]flow.ContractUpdate) error {
+	iterationFunc := func() (CheckFunc, CreateFunc, HandleFunc) {
+		check := func(key []byte) (bool, error) {
+			return true, nil
+		}
+		var val flow.ContractUpdate
+		create := func() interface{} {
+			return &val
+		}
+		handle := func() error {
+			*updates = append(*updates, val)
+			return nil
+		}
+		return check, create, handle
+	}
+
+	return TraverseByPrefix(r, contractUpdatePrefix(address, name), iterationFunc, storage.DefaultIteratorOptions())
+}
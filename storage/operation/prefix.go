@@ -102,6 +102,8 @@ const (
 	codeLightTransactionResultIndex        = 109
 	codeTransactionResultErrorMessage      = 110
 	codeTransactionResultErrorMessageIndex = 111
+	codeAccountTransaction                 = 112 // index mapping account address to the transactions which touched it
+	codeContractUpdate                     = 113 // index mapping contracts to the transactions which deployed, updated or removed them
 	codeIndexCollection                    = 200
 	codeIndexExecutionResultByBlock        = 202
 	codeIndexCollectionByTransaction       = 203
@@ -110,6 +112,9 @@ const (
 	// TEMPORARY codes
 	blockedNodeIDs = 205 // manual override for adding node IDs to list of ejected nodes, applies to networking layer only
 
+	// networking layer state that should be preserved across restarts
+	codeAlspSpamRecords = 206 // snapshot of the spam records of the application layer spam prevention (ALSP), keyed by networking type
+
 	// internal failure information that should be preserved across restarts
 	codeExecutionFork                   = 254
 	codeEpochEmergencyFallbackTriggered = 255
@@ -142,6 +147,8 @@ func KeyPartToBytes(v interface{}) []byte {
 		return []byte{byte(i)}
 	case flow.Identifier:
 		return i[:]
+	case flow.Address:
+		return i[:]
 	case flow.ChainID:
 		return []byte(i)
 	default:
@@ -69,6 +69,20 @@ func BatchRemoveTransactionResultsByBlockID(blockID flow.Identifier, batch stora
 	return nil
 }
 
+// BatchRemoveTransactionResultIndicesByBlockID removes the index of transaction results by transaction index
+// for the given blockID in a provided batch.
+// No errors are expected during normal operation, but it may return generic error
+// if the database fails to process request
+func BatchRemoveTransactionResultIndicesByBlockID(blockID flow.Identifier, batch storage.ReaderBatchWriter) error {
+	prefix := MakePrefix(codeTransactionResultIndex, blockID)
+	err := RemoveByKeyPrefix(batch.GlobalReader(), batch.Writer(), prefix)
+	if err != nil {
+		return fmt.Errorf("could not remove transaction result indices for block %v: %w", blockID, err)
+	}
+
+	return nil
+}
+
 // deprecated
 func InsertLightTransactionResult(w storage.Writer, blockID flow.Identifier, transactionResult *flow.LightTransactionResult) error {
 	return UpsertByKey(w, MakePrefix(codeLightTransactionResult, blockID, transactionResult.TransactionID), transactionResult)
@@ -36,4 +36,6 @@ const (
 	// codeFirstBlockHeight and codeLatestBlockHeight are keys for the range of block heights in the register store
 	codeFirstBlockHeight  byte = 3
 	codeLatestBlockHeight byte = 4
+	// codePruningCursor is the key for the register from which the next pruning run continues
+	codePruningCursor byte = 5
 )
@@ -21,6 +21,12 @@ var latestHeightKey = binary.BigEndian.AppendUint64(
 var firstHeightKey = binary.BigEndian.AppendUint64(
 	[]byte{codeFirstBlockHeight, byte('/'), byte('/')}, placeHolderHeight)
 
+// pruningCursorKey is a special case of a lookupKey
+// with codePruningCursor as key, no owner and a placeholder height of 0.
+// This is to ensure SeekPrefixGE in pebble does not break
+var pruningCursorKey = binary.BigEndian.AppendUint64(
+	[]byte{codePruningCursor, byte('/'), byte('/')}, placeHolderHeight)
+
 // lookupKey is the encoded format of the storage key for looking up register value
 type lookupKey struct {
 	encoded []byte
@@ -1,9 +1,11 @@
 package pebble
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/pkg/errors"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/pebble/registers"
 )
 
 // Registers library that implements pebble storage for registers
@@ -19,7 +22,13 @@ type Registers struct {
 	db             *pebble.DB
 	firstHeight    uint64
 	latestHeight   *atomic.Uint64
-	pruneThreshold uint64
+	pruneThreshold *atomic.Uint64
+	// prunedHeight is the height up to which superseded register values are pruned,
+	// values below this height might no longer be available.
+	prunedHeight *atomic.Uint64
+	// progress records the pruned height. It is initialized by the RegistersPruner, so that
+	// constructing a reader doesn't write to the database.
+	progress storage.ConsumerProgress
 }
 
 // PruningDisabled represents the absence of a pruning threshold.
@@ -43,12 +52,18 @@ func NewRegisters(db *pebble.DB, pruneThreshold uint64) (*Registers, error) {
 		pruneThreshold = PruningDisabled
 	}
 
+	prunedHeight, err := readPrunedHeight(db, firstHeight)
+	if err != nil {
+		return nil, err
+	}
+
 	// All registers between firstHeight and lastHeight have been indexed
 	return &Registers{
 		db:             db,
 		firstHeight:    firstHeight,
 		latestHeight:   atomic.NewUint64(latestHeight),
-		pruneThreshold: pruneThreshold,
+		pruneThreshold: atomic.NewUint64(pruneThreshold),
+		prunedHeight:   atomic.NewUint64(prunedHeight),
 	}, nil
 }
 
@@ -64,17 +79,101 @@ func (s *Registers) Get(
 	reg flow.RegisterID,
 	height uint64,
 ) (flow.RegisterValue, error) {
+	err := s.checkIndexed(height)
+	if err != nil {
+		return nil, err
+	}
+	key := newLookupKey(height, reg)
+	return s.lookupRegister(key.Bytes())
+}
+
+// ByOwner returns the value at the given height of every register of the given owner, sorted by key.
+// The values of all the registers of the owner are iterated, so the scan stops with an error once more
+// than limit registers were found. Registers which do not exist at the given height, or were removed,
+// are not included.
+//
+// - storage.ErrHeightNotIndexed if the requested height is out of the range of stored heights
+// - storage.ErrLimitExceeded if the owner has more than limit registers at the given height
+func (s *Registers) ByOwner(owner string, height uint64, limit uint) (flow.RegisterEntries, error) {
+	err := s.checkIndexed(height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the keys of the owner's registers are prefixed by <code><owner>/
+	lowerBound := make([]byte, 0, len(owner)+2)
+	lowerBound = append(lowerBound, codeRegister)
+	lowerBound = append(lowerBound, owner...)
+	lowerBound = append(lowerBound, '/')
+	upperBound := bytes.Clone(lowerBound)
+	upperBound[len(upperBound)-1]++
+
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: lowerBound,
+		UpperBound: upperBound,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create register iterator: %w", err)
+	}
+	defer iter.Close()
+
+	// the values of a register are sorted by descending height, so the first value at or below the
+	// height is the value at the height.
+	found := make(map[string]struct{})
+	var entries flow.RegisterEntries
+	for valid := iter.First(); valid; valid = iter.Next() {
+		key := iter.Key()
+		if len(key) < len(lowerBound)+1+registers.HeightSuffixLen {
+			return nil, fmt.Errorf("invalid register key %x", key)
+		}
+
+		registerKey := key[len(lowerBound) : len(key)-1-registers.HeightSuffixLen]
+		if _, ok := found[string(registerKey)]; ok {
+			continue
+		}
+		if ^binary.BigEndian.Uint64(key[len(key)-registers.HeightSuffixLen:]) > height {
+			continue
+		}
+		found[string(registerKey)] = struct{}{}
+
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get value: %w", err)
+		}
+		if len(value) == 0 {
+			// the register was removed
+			continue
+		}
+
+		if uint(len(entries)) == limit {
+			return nil, fmt.Errorf("owner %x has more than %d registers: %w", owner, limit, storage.ErrLimitExceeded)
+		}
+		entries = append(entries, flow.RegisterEntry{
+			Key:   flow.RegisterID{Owner: owner, Key: string(registerKey)},
+			Value: bytes.Clone(value),
+		})
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("could not iterate registers: %w", err)
+	}
+
+	sort.Sort(entries)
+	return entries, nil
+}
+
+// checkIndexed returns storage.ErrHeightNotIndexed if the height is out of the range of stored heights.
+func (s *Registers) checkIndexed(height uint64) error {
 	latestHeight := s.LatestHeight()
 	if height > latestHeight {
-		return nil, fmt.Errorf("height %d not indexed, latestHeight: %d, %w", height, latestHeight, storage.ErrHeightNotIndexed)
+		return fmt.Errorf("height %d not indexed, latestHeight: %d, %w", height, latestHeight, storage.ErrHeightNotIndexed)
 	}
 
 	firstHeight := s.calculateFirstHeight(latestHeight)
 	if height < firstHeight {
-		return nil, fmt.Errorf("height %d not indexed, indexed range: [%d-%d], %w", height, firstHeight, latestHeight, storage.ErrHeightNotIndexed)
+		return fmt.Errorf("height %d not indexed, indexed range: [%d-%d], %w", height, firstHeight, latestHeight, storage.ErrHeightNotIndexed)
 	}
-	key := newLookupKey(height, reg)
-	return s.lookupRegister(key.Bytes())
+	return nil
 }
 
 func (s *Registers) lookupRegister(key []byte) (flow.RegisterValue, error) {
@@ -165,6 +264,7 @@ func (s *Registers) FirstHeight() uint64 {
 // latest height and the configured pruning threshold. If the latest height is below the pruning threshold, the
 // first indexed height will be the same as the initial height when the store was initialized. If the pruning
 // threshold has been exceeded, the first indexed height is adjusted accordingly.
+// The first indexed height is never below the height up to which register values were pruned.
 //
 // Parameters:
 // - latestHeight: the most recent height of complete registers available.
@@ -172,16 +272,14 @@ func (s *Registers) FirstHeight() uint64 {
 // Returns:
 // - The first indexed height, either as the initialized height or adjusted for pruning.
 func (s *Registers) calculateFirstHeight(latestHeight uint64) uint64 {
-	if latestHeight < s.pruneThreshold {
-		return s.firstHeight
-	}
+	firstHeight := max(s.firstHeight, s.prunedHeight.Load())
 
-	pruneHeight := latestHeight - s.pruneThreshold
-	if pruneHeight < s.firstHeight {
-		return s.firstHeight
+	pruneThreshold := s.pruneThreshold.Load()
+	if latestHeight < pruneThreshold {
+		return firstHeight
 	}
 
-	return pruneHeight
+	return max(firstHeight, latestHeight-pruneThreshold)
 }
 
 func firstStoredHeight(db *pebble.DB) (uint64, error) {
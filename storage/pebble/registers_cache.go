@@ -58,5 +58,9 @@ func (c *RegistersCache) Get(
 	reg flow.RegisterID,
 	height uint64,
 ) (flow.RegisterValue, error) {
+	err := c.checkIndexed(height)
+	if err != nil {
+		return nil, err
+	}
 	return c.cache.Get(newLookupKey(height, reg).String())
 }
@@ -0,0 +1,137 @@
+package pebble
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/module/component"
+	"github.com/onflow/flow-go/module/irrecoverable"
+)
+
+// RegistersPrunerConfig configures the RegistersPruner.
+type RegistersPrunerConfig struct {
+	BatchSize                 uint          // The number of register values deleted in one batch.
+	ScanLimit                 uint          // The number of register values scanned in one pruning iteration.
+	SleepAfterEachBatchCommit time.Duration // The sleep time after each batch commit.
+	PruningInterval           time.Duration // The interval between two pruning iterations.
+}
+
+// DefaultRegistersPrunerConfig is the default configuration of the RegistersPruner.
+var DefaultRegistersPrunerConfig = RegistersPrunerConfig{
+	BatchSize:                 10_000,
+	ScanLimit:                 10_000_000,
+	SleepAfterEachBatchCommit: 100 * time.Millisecond,
+	PruningInterval:           10 * time.Minute,
+}
+
+// RegistersPruner uses component.ComponentManager to implement module.Startable and module.ReadyDoneAware
+// to run an internal goroutine which removes the register values superseded below the first indexed height
+// of the registers, at a regular interval.
+// The first indexed height follows the latest height and the prune threshold of the registers, which can
+// be updated at runtime with Registers.SetPruneThreshold. If pruning is disabled, nothing is removed.
+type RegistersPruner struct {
+	component.Component
+	log       zerolog.Logger
+	registers *Registers
+	config    RegistersPrunerConfig
+}
+
+var _ component.Component = (*RegistersPruner)(nil)
+
+// NewRegistersPruner returns a pruner removing the superseded values of the registers, and initializes
+// the persisted pruned height of the registers if it is missing.
+// If a pruning interval of zero is passed in, the values are never pruned.
+// No errors are expected during normal operations.
+func NewRegistersPruner(log zerolog.Logger, registers *Registers, config RegistersPrunerConfig) (*RegistersPruner, error) {
+	if config.BatchSize == 0 {
+		return nil, fmt.Errorf("batch size must be positive")
+	}
+	if config.ScanLimit == 0 {
+		return nil, fmt.Errorf("scan limit must be positive")
+	}
+
+	err := registers.initPruningProgress()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &RegistersPruner{
+		log:       log.With().Str("component", "registers_pruner").Logger(),
+		registers: registers,
+		config:    config,
+	}
+
+	// Disable if passed in 0 as interval
+	if config.PruningInterval == 0 {
+		p.Component = &module.NoopComponent{}
+		return p, nil
+	}
+
+	p.Component = component.NewComponentManagerBuilder().
+		AddWorker(p.loop).
+		Build()
+
+	return p, nil
+}
+
+// loop prunes the registers at a regular interval.
+func (p *RegistersPruner) loop(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	ready()
+	ticker := time.NewTicker(p.config.PruningInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := p.prune(ctx)
+			if err != nil {
+				ctx.Throw(err)
+			}
+		}
+	}
+}
+
+// prune removes the register values superseded below the first indexed height, if it is above the
+// pruned height, or continues the unfinished sweep of the registers.
+// No errors are expected during normal operations.
+func (p *RegistersPruner) prune(ctx irrecoverable.SignalerContext) error {
+	prunedHeight := p.registers.PrunedHeight()
+	pruneHeight := p.registers.FirstHeight()
+	cursor, err := p.registers.pruningCursor()
+	if err != nil {
+		return err
+	}
+	if pruneHeight <= prunedHeight && cursor == nil {
+		return nil
+	}
+
+	lg := p.log.With().
+		Uint64("pruned_height", prunedHeight).
+		Uint64("prune_height", pruneHeight).
+		Uint64("threshold", p.registers.PruneThreshold()).
+		Bool("continued", cursor != nil).
+		Logger()
+	lg.Info().Msg("pruning registers")
+
+	start := time.Now()
+	deleted, err := p.registers.PruneUpToHeight(ctx, pruneHeight, p.config.BatchSize, p.config.ScanLimit, p.config.SleepAfterEachBatchCommit)
+	if err != nil {
+		if ctx.Err() != nil {
+			lg.Info().Uint64("deleted", deleted).Msg("registers pruning interrupted")
+			return nil
+		}
+		return fmt.Errorf("could not prune registers up to height %d: %w", pruneHeight, err)
+	}
+
+	lg.Info().
+		Uint64("deleted", deleted).
+		Dur("duration", time.Since(start)).
+		Msg("registers pruned")
+
+	return nil
+}
@@ -0,0 +1,296 @@
+package pebble
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation"
+	"github.com/onflow/flow-go/storage/operation/pebbleimpl"
+	"github.com/onflow/flow-go/storage/pebble/registers"
+	"github.com/onflow/flow-go/storage/store"
+)
+
+// prunedHeightKey is the name of the consumer progress recording the height up to which
+// superseded register values were pruned.
+// The key must be longer than registers.HeightSuffixLen to be split by the MVCC comparer.
+const prunedHeightKey = "RegistersPrunedHeight"
+
+// readPrunedHeight returns the persisted height up to which superseded register values were pruned,
+// or the first height if the registers were never pruned.
+// No errors are expected during normal operations.
+func readPrunedHeight(db *pebble.DB, firstHeight uint64) (uint64, error) {
+	var prunedHeight uint64
+	err := operation.RetrieveProcessedIndex(pebbleimpl.ToDB(db).Reader(), prunedHeightKey, &prunedHeight)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return firstHeight, nil
+		}
+		return 0, fmt.Errorf("unable to read register pruned height: %w", err)
+	}
+	return prunedHeight, nil
+}
+
+// initPruningProgress initializes the persisted pruned height, which is required before pruning.
+// It must be called before any concurrent call to PruneUpToHeight.
+// No errors are expected during normal operations.
+func (s *Registers) initPruningProgress() error {
+	progress, err := store.NewConsumerProgress(pebbleimpl.ToDB(s.db), prunedHeightKey).Initialize(s.PrunedHeight())
+	if err != nil {
+		return fmt.Errorf("unable to initialize register pruning progress: %w", err)
+	}
+	s.progress = progress
+	return nil
+}
+
+// PrunedHeight returns the height up to which superseded register values were pruned.
+func (s *Registers) PrunedHeight() uint64 {
+	return s.prunedHeight.Load()
+}
+
+// PruneThreshold returns the number of blocks below the latest height for which register values are kept.
+func (s *Registers) PruneThreshold() uint64 {
+	return s.pruneThreshold.Load()
+}
+
+// SetPruneThreshold updates the number of blocks below the latest height for which register values are kept.
+// A threshold of 0 disables pruning.
+// Increasing the threshold doesn't restore values which were already pruned.
+func (s *Registers) SetPruneThreshold(threshold uint64) {
+	if threshold == 0 {
+		threshold = PruningDisabled
+	}
+	s.pruneThreshold.Store(threshold)
+}
+
+// pruningCursor returns the register prefix from which the next pruning run continues, or nil if the
+// previous sweep over all the registers was completed.
+// No errors are expected during normal operations.
+func (s *Registers) pruningCursor() ([]byte, error) {
+	value, closer, err := s.db.Get(pruningCursorKey)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read register pruning cursor: %w", err)
+	}
+	defer closer.Close()
+	return bytes.Clone(value), nil
+}
+
+// PruneUpToHeight removes the register values which were superseded at or below the given height,
+// keeping for every register its latest value at or below the height, and all values above it.
+// Once pruned, the registers can't be read below the pruned height anymore.
+//
+// The registers are swept over successive runs: every run continues from the register recorded by the
+// previous run, and scans at most about scanLimit register values, stopping at the start of a register.
+// Once the last register was scanned, the next run starts a new sweep from the first register, so that
+// values superseded since the previous sweep are removed as well.
+// Deletions are committed in batches of batchSize values, together with the register to continue from,
+// sleeping after each commit to limit the impact on the node. The pruned height is recorded before any
+// value is removed, so that readers never observe partially pruned heights.
+//
+// It returns the number of removed register values. Pruning up to a height not above the pruned height
+// continues an unfinished sweep, and is a no-op otherwise. The pruning progress must have been initialized
+// by the RegistersPruner.
+// No errors are expected during normal operations, except the context error if it is cancelled.
+func (s *Registers) PruneUpToHeight(
+	ctx context.Context,
+	pruneHeight uint64,
+	batchSize uint,
+	scanLimit uint,
+	sleepAfterEachBatchCommit time.Duration,
+) (uint64, error) {
+	if batchSize == 0 {
+		return 0, fmt.Errorf("batch size must be positive")
+	}
+	if scanLimit == 0 {
+		return 0, fmt.Errorf("scan limit must be positive")
+	}
+	if s.progress == nil {
+		return 0, fmt.Errorf("register pruning progress is not initialized")
+	}
+
+	cursor, err := s.pruningCursor()
+	if err != nil {
+		return 0, err
+	}
+
+	if pruneHeight > s.PrunedHeight() {
+		latestHeight := s.LatestHeight()
+		if pruneHeight > latestHeight {
+			return 0, fmt.Errorf("cannot prune up to height %d above latest height %d", pruneHeight, latestHeight)
+		}
+
+		err = s.progress.SetProcessedIndex(pruneHeight)
+		if err != nil {
+			return 0, fmt.Errorf("could not record pruned height %d: %w", pruneHeight, err)
+		}
+		s.prunedHeight.Store(pruneHeight)
+	} else if cursor == nil {
+		return 0, nil
+	}
+
+	scan := &supersededScan{
+		pruneHeight: s.PrunedHeight(),
+		batchSize:   batchSize,
+		scanLimit:   scanLimit,
+		next:        cursor,
+	}
+	if scan.next == nil {
+		scan.next = []byte{codeRegister}
+	}
+
+	var deleted uint64
+	for {
+		batch, err := s.collectSuperseded(scan)
+		if err != nil {
+			return deleted, err
+		}
+
+		err = s.commitPruned(batch, scan.cursor())
+		if err != nil {
+			return deleted, err
+		}
+		deleted += uint64(len(batch))
+
+		if scan.next == nil || scan.limitReached {
+			return deleted, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return deleted, ctx.Err()
+		case <-time.After(sleepAfterEachBatchCommit):
+		}
+	}
+}
+
+// supersededScan is the state of a pruning run iterating the registers in batches.
+type supersededScan struct {
+	pruneHeight uint64
+	batchSize   uint
+	scanLimit   uint
+
+	// next is the key to continue the iteration from, or nil once all the registers were iterated.
+	next []byte
+	// scanned is the number of register values iterated in this run.
+	scanned uint
+	// limitReached is set once the scan limit is reached at the start of a register.
+	limitReached bool
+	// currentPrefix is the register being iterated, and keptLatest whether its latest value at or below
+	// the prune height was found.
+	currentPrefix []byte
+	keptLatest    bool
+}
+
+// cursor returns the register prefix from which the next run continues, or nil once all the registers
+// were iterated. A run interrupted within a register continues from the start of the register.
+func (scan *supersededScan) cursor() []byte {
+	if scan.next == nil {
+		return nil
+	}
+	return scan.next[:len(scan.next)-registers.HeightSuffixLen]
+}
+
+// collectSuperseded iterates the register values from scan.next, and collects up to batchSize keys of
+// values superseded at or below the prune height. The iteration stops at the start of a register once
+// the scan limit is reached. The scan state is updated to continue from the next key.
+//
+// The iterator is closed after each batch, so that it doesn't pin the files of the database while
+// the pruner sleeps.
+// No errors are expected during normal operations.
+func (s *Registers) collectSuperseded(scan *supersededScan) ([][]byte, error) {
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: scan.next,
+		UpperBound: []byte{codeRegister + 1},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create register iterator: %w", err)
+	}
+	defer iter.Close()
+
+	var superseded [][]byte
+	for valid := iter.First(); valid; valid = iter.Next() {
+		key := iter.Key()
+		if len(key) < MinLookupKeyLen {
+			return nil, fmt.Errorf("invalid register key %x", key)
+		}
+
+		prefix := key[:len(key)-registers.HeightSuffixLen]
+		height := ^binary.BigEndian.Uint64(key[len(key)-registers.HeightSuffixLen:])
+		newRegister := !bytes.Equal(prefix, scan.currentPrefix)
+
+		if newRegister && scan.scanned >= scan.scanLimit {
+			// continue from this register in the next run
+			scan.next = bytes.Clone(key)
+			scan.limitReached = true
+			return superseded, nil
+		}
+		if uint(len(superseded)) == scan.batchSize {
+			// continue from this key in the next batch
+			scan.next = bytes.Clone(key)
+			return superseded, nil
+		}
+
+		scan.scanned++
+		if newRegister {
+			scan.currentPrefix = bytes.Clone(prefix)
+			scan.keptLatest = false
+		}
+
+		if height > scan.pruneHeight {
+			continue
+		}
+		if !scan.keptLatest {
+			scan.keptLatest = true
+			continue
+		}
+
+		superseded = append(superseded, bytes.Clone(key))
+	}
+
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("could not iterate registers: %w", err)
+	}
+
+	scan.next = nil
+	return superseded, nil
+}
+
+// commitPruned deletes the given register values, and records the register prefix from which the next
+// run continues in the same batch. A nil cursor records that all the registers were iterated.
+// No errors are expected during normal operations.
+func (s *Registers) commitPruned(keys [][]byte, cursor []byte) error {
+	b := s.db.NewBatch()
+	defer b.Close()
+
+	for _, key := range keys {
+		err := b.Delete(key, nil)
+		if err != nil {
+			return fmt.Errorf("could not delete register value %x: %w", key, err)
+		}
+	}
+
+	var err error
+	if cursor == nil {
+		err = b.Delete(pruningCursorKey, nil)
+	} else {
+		err = b.Set(pruningCursorKey, cursor, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("could not record register pruning cursor: %w", err)
+	}
+
+	err = b.Commit(pebble.Sync)
+	if err != nil {
+		return fmt.Errorf("could not commit pruned register values: %w", err)
+	}
+	return nil
+}
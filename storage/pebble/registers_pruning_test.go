@@ -0,0 +1,284 @@
+package pebble
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation"
+	"github.com/onflow/flow-go/storage/operation/pebbleimpl"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// storeVersions stores a new value of key1 at every height from 2 to 10, key2 at height 3, and key3 at height 8.
+func storeVersions(t *testing.T, r *Registers) (key1, key2, key3 flow.RegisterID) {
+	key1 = flow.RegisterID{Owner: "owner", Key: "key1"}
+	key2 = flow.RegisterID{Owner: "owner", Key: "key2"}
+	key3 = flow.RegisterID{Owner: "", Key: "key3"}
+
+	for height := uint64(2); height <= 10; height++ {
+		entries := flow.RegisterEntries{
+			{Key: key1, Value: []byte(fmt.Sprintf("value1-%d", height))},
+		}
+		if height == 3 {
+			entries = append(entries, flow.RegisterEntry{Key: key2, Value: []byte("value2")})
+		}
+		if height == 8 {
+			entries = append(entries, flow.RegisterEntry{Key: key3, Value: []byte("value3")})
+		}
+		require.NoError(t, r.Store(entries, height))
+	}
+	return key1, key2, key3
+}
+
+// countRegisterValues returns the number of stored register values.
+func countRegisterValues(t *testing.T, db *pebble.DB) int {
+	iter, err := db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{codeRegister},
+		UpperBound: []byte{codeRegister + 1},
+	})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	count := 0
+	for valid := iter.First(); valid; valid = iter.Next() {
+		count++
+	}
+	require.NoError(t, iter.Error())
+	return count
+}
+
+// TestRegisters_PruneUpToHeight tests that pruning removes the superseded register values, keeping the
+// latest value at or below the pruned height.
+func TestRegisters_PruneUpToHeight(t *testing.T) {
+	t.Parallel()
+	unittest.RunWithTempDir(t, func(dir string) {
+		db := NewBootstrappedRegistersWithPathForTest(t, dir, 1, 1)
+		r, err := NewRegisters(db, PruningDisabled)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), r.PrunedHeight())
+
+		key1, key2, key3 := storeVersions(t, r)
+		require.Equal(t, 11, countRegisterValues(t, db))
+
+		t.Run("pruning progress not initialized", func(t *testing.T) {
+			_, err := r.PruneUpToHeight(context.Background(), 6, 3, 100, 0)
+			require.Error(t, err)
+			require.Equal(t, 11, countRegisterValues(t, db))
+		})
+
+		require.NoError(t, r.initPruningProgress())
+
+		t.Run("prune above latest height", func(t *testing.T) {
+			_, err := r.PruneUpToHeight(context.Background(), 11, 2, 100, 0)
+			require.Error(t, err)
+			require.Equal(t, uint64(1), r.PrunedHeight())
+		})
+
+		// use a batch size smaller than the number of deleted values to prune in several batches
+		deleted, err := r.PruneUpToHeight(context.Background(), 6, 3, 100, 0)
+		require.NoError(t, err)
+		// values of key1 at heights 2 to 5
+		require.Equal(t, uint64(4), deleted)
+		require.Equal(t, 7, countRegisterValues(t, db))
+
+		require.Equal(t, uint64(6), r.PrunedHeight())
+		require.Equal(t, uint64(6), r.FirstHeight())
+
+		value, err := r.Get(key1, 6)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1-6"), value)
+
+		value, err = r.Get(key1, 10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1-10"), value)
+
+		value, err = r.Get(key2, 6)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value2"), value)
+
+		value, err = r.Get(key3, 8)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value3"), value)
+
+		_, err = r.Get(key3, 7)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		_, err = r.Get(key1, 5)
+		require.ErrorIs(t, err, storage.ErrHeightNotIndexed)
+
+		t.Run("prune at pruned height is a no-op", func(t *testing.T) {
+			deleted, err := r.PruneUpToHeight(context.Background(), 6, 3, 100, 0)
+			require.NoError(t, err)
+			require.Zero(t, deleted)
+		})
+
+		t.Run("pruned height is persisted", func(t *testing.T) {
+			reopened, err := NewRegisters(db, PruningDisabled)
+			require.NoError(t, err)
+			require.Equal(t, uint64(6), reopened.PrunedHeight())
+			require.Equal(t, uint64(6), reopened.FirstHeight())
+		})
+
+		t.Run("cancelled context", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			// key1 values at heights 6 to 8 are superseded, only the first batch is committed
+			_, err := r.PruneUpToHeight(ctx, 9, 1, 100, time.Hour)
+			require.ErrorIs(t, err, context.Canceled)
+			require.Equal(t, uint64(9), r.PrunedHeight())
+
+			// the next run removes the remaining values
+			deleted, err := r.PruneUpToHeight(context.Background(), 10, 1, 100, 0)
+			require.NoError(t, err)
+			require.Equal(t, uint64(3), deleted)
+			require.Equal(t, 3, countRegisterValues(t, db))
+		})
+
+		require.NoError(t, db.Close())
+	})
+}
+
+// TestRegisters_PruneUpToHeight_ScanLimit tests that a run stops once the scan limit is reached, and that the
+// next run continues from the persisted cursor without rescanning the registers pruned by the previous run.
+func TestRegisters_PruneUpToHeight_ScanLimit(t *testing.T) {
+	t.Parallel()
+	unittest.RunWithTempDir(t, func(dir string) {
+		db := NewBootstrappedRegistersWithPathForTest(t, dir, 1, 1)
+		r, err := NewRegisters(db, PruningDisabled)
+		require.NoError(t, err)
+		require.NoError(t, r.initPruningProgress())
+
+		// both registers have a value at every height from 2 to 10, the values of keyA are iterated first
+		keyA := flow.RegisterID{Owner: "a", Key: "key"}
+		keyB := flow.RegisterID{Owner: "b", Key: "key"}
+		for height := uint64(2); height <= 10; height++ {
+			require.NoError(t, r.Store(flow.RegisterEntries{
+				{Key: keyA, Value: []byte(fmt.Sprintf("a-%d", height))},
+				{Key: keyB, Value: []byte(fmt.Sprintf("b-%d", height))},
+			}, height))
+		}
+
+		// the scan limit is reached after the values of keyA
+		deleted, err := r.PruneUpToHeight(context.Background(), 6, 100, 9, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), deleted)
+		require.Equal(t, 14, countRegisterValues(t, db))
+
+		cursor, err := r.pruningCursor()
+		require.NoError(t, err)
+		require.NotNil(t, cursor)
+
+		// a superseded value of keyA is not removed by the next run, since keyA is not scanned again
+		supersededKey := newLookupKey(3, keyA).Bytes()
+		require.NoError(t, db.Set(supersededKey, []byte("a-3"), nil))
+
+		deleted, err = r.PruneUpToHeight(context.Background(), 6, 100, 9, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), deleted)
+		require.Equal(t, 11, countRegisterValues(t, db))
+
+		_, closer, err := db.Get(supersededKey)
+		require.NoError(t, err)
+		require.NoError(t, closer.Close())
+
+		// the sweep is complete, pruning at the pruned height is a no-op
+		cursor, err = r.pruningCursor()
+		require.NoError(t, err)
+		require.Nil(t, cursor)
+
+		deleted, err = r.PruneUpToHeight(context.Background(), 6, 100, 9, 0)
+		require.NoError(t, err)
+		require.Zero(t, deleted)
+
+		// the next sweep starts from the first register again
+		deleted, err = r.PruneUpToHeight(context.Background(), 7, 100, 100, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), deleted)
+		require.Equal(t, 8, countRegisterValues(t, db))
+
+		require.NoError(t, db.Close())
+	})
+}
+
+// TestNewRegisters_PrunedHeightNotWritten tests that constructing the registers doesn't write the pruned height,
+// which is only initialized by the pruner.
+func TestNewRegisters_PrunedHeightNotWritten(t *testing.T) {
+	t.Parallel()
+	unittest.RunWithTempDir(t, func(dir string) {
+		db := NewBootstrappedRegistersWithPathForTest(t, dir, 1, 1)
+		r, err := NewRegisters(db, PruningDisabled)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), r.PrunedHeight())
+
+		var prunedHeight uint64
+		err = operation.RetrieveProcessedIndex(pebbleimpl.ToDB(db).Reader(), prunedHeightKey, &prunedHeight)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		_, err = NewRegistersPruner(unittest.Logger(), r, DefaultRegistersPrunerConfig)
+		require.NoError(t, err)
+
+		err = operation.RetrieveProcessedIndex(pebbleimpl.ToDB(db).Reader(), prunedHeightKey, &prunedHeight)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), prunedHeight)
+
+		require.NoError(t, db.Close())
+	})
+}
+
+// TestRegisters_SetPruneThreshold tests that the first height follows the updated prune threshold.
+func TestRegisters_SetPruneThreshold(t *testing.T) {
+	t.Parallel()
+	RunWithRegistersStorageAtInitialHeights(t, 1, 1, func(r *Registers) {
+		storeVersions(t, r)
+		require.Equal(t, uint64(1), r.FirstHeight())
+
+		r.SetPruneThreshold(3)
+		require.Equal(t, uint64(3), r.PruneThreshold())
+		require.Equal(t, uint64(7), r.FirstHeight())
+
+		r.SetPruneThreshold(0)
+		require.Equal(t, uint64(PruningDisabled), r.PruneThreshold())
+		require.Equal(t, uint64(1), r.FirstHeight())
+	})
+}
+
+// TestRegistersPruner tests that the pruner removes the register values superseded below the first height.
+func TestRegistersPruner(t *testing.T) {
+	t.Parallel()
+	RunWithRegistersStorageAtInitialHeights(t, 1, 1, func(r *Registers) {
+		key1, _, _ := storeVersions(t, r)
+		r.SetPruneThreshold(2)
+
+		pruner, err := NewRegistersPruner(unittest.Logger(), r, RegistersPrunerConfig{
+			BatchSize:                 2,
+			ScanLimit:                 5,
+			SleepAfterEachBatchCommit: time.Millisecond,
+			PruningInterval:           10 * time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		ctx, cancel := irrecoverable.NewMockSignalerContextWithCancel(t, context.Background())
+		pruner.Start(ctx)
+		unittest.RequireComponentsReadyBefore(t, time.Second, pruner)
+
+		require.Eventually(t, func() bool {
+			return r.PrunedHeight() == 8
+		}, 5*time.Second, 10*time.Millisecond)
+
+		value, err := r.Get(key1, 8)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1-8"), value)
+
+		cancel()
+		unittest.RequireComponentsDoneBefore(t, time.Second, pruner)
+	})
+}
@@ -209,6 +209,53 @@ func TestRegisters_Store_Versioning(t *testing.T) {
 	})
 }
 
+// TestRegisters_ByOwner tests reading the values of all the registers of an owner at a height
+func TestRegisters_ByOwner(t *testing.T) {
+	t.Parallel()
+	RunWithRegistersStorageAtHeight1(t, func(r *Registers) {
+		key1 := flow.RegisterID{Owner: "owner", Key: "key1"}
+		key11 := flow.RegisterID{Owner: "owner", Key: "key11"}
+		key2 := flow.RegisterID{Owner: "owner", Key: "key2"}
+		otherKey := flow.RegisterID{Owner: "owner1", Key: "key1"}
+
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: key1, Value: []byte("value1")},
+			{Key: key11, Value: []byte("value11")},
+			{Key: otherKey, Value: []byte("other")},
+		}, 2))
+		// key1 is updated, key11 is removed and key2 is added
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: key1, Value: []byte("value1ge3")},
+			{Key: key11, Value: []byte{}},
+			{Key: key2, Value: []byte("value2")},
+		}, 3))
+
+		entries, err := r.ByOwner("owner", 2, 10)
+		require.NoError(t, err)
+		require.Equal(t, flow.RegisterEntries{
+			{Key: key1, Value: []byte("value1")},
+			{Key: key11, Value: []byte("value11")},
+		}, entries)
+
+		entries, err = r.ByOwner("owner", 3, 10)
+		require.NoError(t, err)
+		require.Equal(t, flow.RegisterEntries{
+			{Key: key1, Value: []byte("value1ge3")},
+			{Key: key2, Value: []byte("value2")},
+		}, entries)
+
+		entries, err = r.ByOwner("owner", 1, 10)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		_, err = r.ByOwner("owner", 3, 1)
+		require.ErrorIs(t, err, storage.ErrLimitExceeded)
+
+		_, err = r.ByOwner("owner", 4, 10)
+		require.ErrorIs(t, err, storage.ErrHeightNotIndexed)
+	})
+}
+
 // TestRegisters_GetAndStoreEmptyOwner tests behavior of storing and retrieving registers with
 // an empty owner value, which is used for global state variables.
 func TestRegisters_GetAndStoreEmptyOwner(t *testing.T) {
@@ -15,6 +15,14 @@ type RegisterIndex interface {
 	// - storage.ErrNotFound if the given height is indexed, but the register does not exist.
 	Get(ID flow.RegisterID, height uint64) (flow.RegisterValue, error)
 
+	// ByOwner returns the value at the given block height of every register of the given owner.
+	// Registers which do not exist at the given height, or were removed, are not included.
+	//
+	// Expected errors:
+	// - storage.ErrHeightNotIndexed if the given height was not indexed yet or lower than the first indexed height.
+	// - storage.ErrLimitExceeded if the owner has more than limit registers.
+	ByOwner(owner string, height uint64, limit uint) (flow.RegisterEntries, error)
+
 	// LatestHeight returns the latest indexed height.
 	LatestHeight() uint64
 
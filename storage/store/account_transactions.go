@@ -0,0 +1,52 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation"
+)
+
+var _ storage.AccountTransactions = (*AccountTransactions)(nil)
+
+// AccountTransactions implements persistent storage for the account transaction index.
+// Entries are not cached, since lookups are paginated range scans which are rarely repeated.
+type AccountTransactions struct {
+	db storage.DB
+}
+
+func NewAccountTransactions(db storage.DB) *AccountTransactions {
+	return &AccountTransactions{
+		db: db,
+	}
+}
+
+// BatchStore indexes the given account transactions in the provided batch.
+// Indexing the same entries again overwrites the existing ones.
+//
+// No errors are expected during normal operation.
+func (a *AccountTransactions) BatchStore(txs []flow.AccountTransaction, batch storage.ReaderBatchWriter) error {
+	writer := batch.Writer()
+	for i := range txs {
+		err := operation.IndexAccountTransaction(writer, &txs[i])
+		if err != nil {
+			return fmt.Errorf("could not index account transaction: %w", err)
+		}
+	}
+	return nil
+}
+
+// ByAddress returns up to `limit` transactions which touched the given account, ordered by
+// descending block height and transaction index. The lookup starts with the entry at the given
+// height and transaction index (inclusive) and continues with older entries.
+//
+// No errors are expected during normal operation. If no entries are found, an empty slice is returned.
+func (a *AccountTransactions) ByAddress(address flow.Address, startHeight uint64, startTxIndex uint32, limit uint32) ([]flow.AccountTransaction, error) {
+	txs := make([]flow.AccountTransaction, 0)
+	err := operation.LookupAccountTransactions(a.db.Reader(), address, startHeight, startTxIndex, limit, &txs)
+	if err != nil {
+		return nil, fmt.Errorf("could not lookup account transactions: %w", err)
+	}
+	return txs, nil
+}
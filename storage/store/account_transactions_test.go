@@ -0,0 +1,80 @@
+package store_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
+	"github.com/onflow/flow-go/storage/store"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestAccountTransactionsStoreRetrieve(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		accountTxs := store.NewAccountTransactions(db)
+
+		address := unittest.RandomAddressFixture()
+		other := unittest.RandomAddressFixture()
+
+		entry := func(address flow.Address, height uint64, txIndex uint32, roles ...flow.TransactionRole) flow.AccountTransaction {
+			return flow.AccountTransaction{
+				Address:          address,
+				BlockHeight:      height,
+				TransactionID:    unittest.IdentifierFixture(),
+				TransactionIndex: txIndex,
+				Roles:            roles,
+			}
+		}
+
+		tx1 := entry(address, 10, 0, flow.TransactionRolePayer, flow.TransactionRoleProposer)
+		tx2 := entry(address, 10, 3, flow.TransactionRoleAuthorizer)
+		tx3 := entry(address, 12, 1, flow.TransactionRoleEventEmitter)
+		tx4 := entry(address, 300, 0, flow.TransactionRolePayer)
+		otherTx := entry(other, 11, 0, flow.TransactionRolePayer)
+
+		require.NoError(t, db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+			return accountTxs.BatchStore([]flow.AccountTransaction{tx1, tx2, otherTx}, rw)
+		}))
+		require.NoError(t, db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+			return accountTxs.BatchStore([]flow.AccountTransaction{tx3, tx4}, rw)
+		}))
+
+		t.Run("returns all entries newest first", func(t *testing.T) {
+			actual, err := accountTxs.ByAddress(address, math.MaxUint64, math.MaxUint32, 10)
+			require.NoError(t, err)
+			require.Equal(t, []flow.AccountTransaction{tx4, tx3, tx2, tx1}, actual)
+		})
+
+		t.Run("respects limit", func(t *testing.T) {
+			actual, err := accountTxs.ByAddress(address, math.MaxUint64, math.MaxUint32, 2)
+			require.NoError(t, err)
+			require.Equal(t, []flow.AccountTransaction{tx4, tx3}, actual)
+		})
+
+		t.Run("starts at the given position inclusive", func(t *testing.T) {
+			actual, err := accountTxs.ByAddress(address, tx2.BlockHeight, tx2.TransactionIndex, 10)
+			require.NoError(t, err)
+			require.Equal(t, []flow.AccountTransaction{tx2, tx1}, actual)
+
+			actual, err = accountTxs.ByAddress(address, 11, math.MaxUint32, 10)
+			require.NoError(t, err)
+			require.Equal(t, []flow.AccountTransaction{tx2, tx1}, actual)
+		})
+
+		t.Run("other accounts are not included", func(t *testing.T) {
+			actual, err := accountTxs.ByAddress(other, math.MaxUint64, math.MaxUint32, 10)
+			require.NoError(t, err)
+			require.Equal(t, []flow.AccountTransaction{otherTx}, actual)
+		})
+
+		t.Run("unknown account returns empty result", func(t *testing.T) {
+			actual, err := accountTxs.ByAddress(unittest.RandomAddressFixture(), math.MaxUint64, math.MaxUint32, 10)
+			require.NoError(t, err)
+			require.Empty(t, actual)
+		})
+	})
+}
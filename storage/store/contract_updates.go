@@ -0,0 +1,51 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation"
+)
+
+var _ storage.ContractUpdates = (*ContractUpdates)(nil)
+
+// ContractUpdates implements persistent storage for the contract update index.
+// Entries are not cached, since lookups are range scans which are rarely repeated.
+type ContractUpdates struct {
+	db storage.DB
+}
+
+func NewContractUpdates(db storage.DB) *ContractUpdates {
+	return &ContractUpdates{
+		db: db,
+	}
+}
+
+// BatchStore indexes the given contract updates in the provided batch.
+// Indexing the same entries again overwrites the existing ones.
+//
+// No errors are expected during normal operation.
+func (c *ContractUpdates) BatchStore(updates []flow.ContractUpdate, batch storage.ReaderBatchWriter) error {
+	writer := batch.Writer()
+	for i := range updates {
+		err := operation.IndexContractUpdate(writer, &updates[i])
+		if err != nil {
+			return fmt.Errorf("could not index contract update: %w", err)
+		}
+	}
+	return nil
+}
+
+// ByContract returns the updates of the given contract, ordered by ascending block height,
+// transaction index and event index.
+//
+// No errors are expected during normal operation. If no entries are found, an empty slice is returned.
+func (c *ContractUpdates) ByContract(address flow.Address, name string) ([]flow.ContractUpdate, error) {
+	updates := make([]flow.ContractUpdate, 0)
+	err := operation.LookupContractUpdates(c.db.Reader(), address, name, &updates)
+	if err != nil {
+		return nil, fmt.Errorf("could not lookup contract updates: %w", err)
+	}
+	return updates, nil
+}
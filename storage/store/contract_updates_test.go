@@ -0,0 +1,72 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/operation/dbtest"
+	"github.com/onflow/flow-go/storage/store"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestContractUpdatesStoreRetrieve(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		contractUpdates := store.NewContractUpdates(db)
+
+		address := unittest.RandomAddressFixture()
+		other := unittest.RandomAddressFixture()
+
+		entry := func(address flow.Address, name string, updateType flow.ContractUpdateType, height uint64, txIndex uint32, eventIndex uint32) flow.ContractUpdate {
+			return flow.ContractUpdate{
+				Address:          address,
+				ContractName:     name,
+				Type:             updateType,
+				BlockHeight:      height,
+				TransactionID:    unittest.IdentifierFixture(),
+				TransactionIndex: txIndex,
+				EventIndex:       eventIndex,
+				CodeHash:         unittest.RandomBytes(32),
+			}
+		}
+
+		added := entry(address, "Foo", flow.ContractUpdateTypeAdded, 10, 1, 0)
+		updated1 := entry(address, "Foo", flow.ContractUpdateTypeUpdated, 300, 0, 2)
+		updated2 := entry(address, "Foo", flow.ContractUpdateTypeUpdated, 300, 0, 5)
+		removed := entry(address, "Foo", flow.ContractUpdateTypeRemoved, 301, 2, 0)
+		// contracts whose name starts with the name of another contract are indexed separately
+		otherName := entry(address, "FooBar", flow.ContractUpdateTypeAdded, 11, 0, 0)
+		otherAddress := entry(other, "Foo", flow.ContractUpdateTypeAdded, 12, 0, 0)
+
+		require.NoError(t, db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+			return contractUpdates.BatchStore([]flow.ContractUpdate{added, otherName, otherAddress}, rw)
+		}))
+		require.NoError(t, db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+			return contractUpdates.BatchStore([]flow.ContractUpdate{removed, updated2, updated1}, rw)
+		}))
+
+		t.Run("returns all updates oldest first", func(t *testing.T) {
+			actual, err := contractUpdates.ByContract(address, "Foo")
+			require.NoError(t, err)
+			require.Equal(t, []flow.ContractUpdate{added, updated1, updated2, removed}, actual)
+		})
+
+		t.Run("contracts are indexed separately", func(t *testing.T) {
+			actual, err := contractUpdates.ByContract(address, "FooBar")
+			require.NoError(t, err)
+			require.Equal(t, []flow.ContractUpdate{otherName}, actual)
+
+			actual, err = contractUpdates.ByContract(other, "Foo")
+			require.NoError(t, err)
+			require.Equal(t, []flow.ContractUpdate{otherAddress}, actual)
+		})
+
+		t.Run("unknown contract", func(t *testing.T) {
+			actual, err := contractUpdates.ByContract(address, "Fo")
+			require.NoError(t, err)
+			require.Empty(t, actual)
+		})
+	})
+}
@@ -145,6 +145,9 @@ func (e *Events) RemoveByBlockID(blockID flow.Identifier) error {
 // No errors are expected during normal operation, even if no entries are matched.
 // If Badger unexpectedly fails to process the request, the error is wrapped in a generic error and returned.
 func (e *Events) BatchRemoveByBlockID(blockID flow.Identifier, rw storage.ReaderBatchWriter) error {
+	storage.OnCommitSucceed(rw, func() {
+		e.cache.Remove(blockID)
+	})
 	return operation.RemoveEventsByBlockID(rw.GlobalReader(), rw.Writer(), blockID)
 }
 
@@ -207,7 +210,8 @@ func (e *ServiceEvents) RemoveByBlockID(blockID flow.Identifier) error {
 // No errors are expected during normal operation, even if no entries are matched.
 // If Badger unexpectedly fails to process the request, the error is wrapped in a generic error and returned.
 func (e *ServiceEvents) BatchRemoveByBlockID(blockID flow.Identifier, rw storage.ReaderBatchWriter) error {
-	return e.db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
-		return operation.RemoveServiceEventsByBlockID(rw.GlobalReader(), rw.Writer(), blockID)
+	storage.OnCommitSucceed(rw, func() {
+		e.cache.Remove(blockID)
 	})
+	return operation.RemoveServiceEventsByBlockID(rw.GlobalReader(), rw.Writer(), blockID)
 }
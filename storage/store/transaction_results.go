@@ -213,7 +213,33 @@ func (tr *TransactionResults) RemoveByBlockID(blockID flow.Identifier) error {
 	})
 }
 
-// BatchRemoveByBlockID batch removes transaction results by block ID
+// BatchRemoveByBlockID batch removes transaction results by block ID, and their index by transaction index.
+// The cached results of the block are removed once the batch is committed.
+// No errors are expected during normal operation.
 func (tr *TransactionResults) BatchRemoveByBlockID(blockID flow.Identifier, batch storage.ReaderBatchWriter) error {
-	return operation.BatchRemoveTransactionResultsByBlockID(blockID, batch)
+	// read the removed results, to know the keys of the cached results
+	var txResults []flow.TransactionResult
+	err := operation.LookupTransactionResultsByBlockIDUsingIndex(batch.GlobalReader(), blockID, &txResults)
+	if err != nil {
+		return fmt.Errorf("could not retrieve transaction results for block %v: %w", blockID, err)
+	}
+
+	err = operation.BatchRemoveTransactionResultsByBlockID(blockID, batch)
+	if err != nil {
+		return err
+	}
+
+	err = operation.BatchRemoveTransactionResultIndicesByBlockID(blockID, batch)
+	if err != nil {
+		return err
+	}
+
+	storage.OnCommitSucceed(batch, func() {
+		for i, result := range txResults {
+			tr.cache.Remove(KeyFromBlockIDTransactionID(blockID, result.TransactionID))
+			tr.indexCache.Remove(KeyFromBlockIDIndex(blockID, uint32(i)))
+		}
+		tr.blockCache.Remove(KeyFromBlockID(blockID))
+	})
+	return nil
 }
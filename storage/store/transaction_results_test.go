@@ -67,6 +67,49 @@ func TestBatchStoringTransactionResults(t *testing.T) {
 	})
 }
 
+// TestBatchRemoveTransactionResults tests that removing the results of a block removes the results, their
+// index by transaction index, and the cached results of the block.
+func TestBatchRemoveTransactionResults(t *testing.T) {
+	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
+		st := store.NewTransactionResults(metrics.NewNoopCollector(), db, 1000)
+
+		blockID := unittest.IdentifierFixture()
+		txResults := unittest.TransactionResultsFixture(3)
+		require.NoError(t, db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+			return st.BatchStore(blockID, txResults, rw)
+		}))
+
+		// populate the caches
+		stored, err := st.ByBlockID(blockID)
+		require.NoError(t, err)
+		require.Equal(t, txResults, stored)
+		for i, txResult := range txResults {
+			_, err = st.ByBlockIDTransactionID(blockID, txResult.TransactionID)
+			require.NoError(t, err)
+			_, err = st.ByBlockIDTransactionIndex(blockID, uint32(i))
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, db.WithReaderBatchWriter(func(rw storage.ReaderBatchWriter) error {
+			return st.BatchRemoveByBlockID(blockID, rw)
+		}))
+
+		// the removed results are neither served from the caches nor from the database
+		for _, results := range []*store.TransactionResults{st, store.NewTransactionResults(metrics.NewNoopCollector(), db, 1000)} {
+			removed, err := results.ByBlockID(blockID)
+			require.NoError(t, err)
+			require.Empty(t, removed)
+
+			for i, txResult := range txResults {
+				_, err = results.ByBlockIDTransactionID(blockID, txResult.TransactionID)
+				require.ErrorIs(t, err, storage.ErrNotFound)
+				_, err = results.ByBlockIDTransactionIndex(blockID, uint32(i))
+				require.ErrorIs(t, err, storage.ErrNotFound)
+			}
+		}
+	})
+}
+
 func TestReadingNotstTransaction(t *testing.T) {
 	dbtest.RunWithDB(t, func(t *testing.T, db storage.DB) {
 		metrics := metrics.NewNoopCollector()
@@ -20,7 +20,7 @@ type TransactionResults interface {
 	// BatchStore inserts a batch of transaction result into a batch
 	BatchStore(blockID flow.Identifier, transactionResults []flow.TransactionResult, batch ReaderBatchWriter) error
 
-	// RemoveByBlockID removes all transaction results for a block
+	// BatchRemoveByBlockID removes all transaction results for a block, and their index by transaction index
 	BatchRemoveByBlockID(id flow.Identifier, batch ReaderBatchWriter) error
 }
 